@@ -65,6 +65,111 @@ type Attribute struct {
 	Value string `json:"value"`
 }
 
+// +kubebuilder:validation:Enum=AMAZON_ISSUED;PRIVATE;IMPORTED
+// CertificateType is the type of ACM certificate.
+type CertificateType string
+
+const (
+	CertificateTypeAmazonIssued CertificateType = "AMAZON_ISSUED"
+	CertificateTypePrivate      CertificateType = "PRIVATE"
+	CertificateTypeImported     CertificateType = "IMPORTED"
+)
+
+// CertificateSelection defines how certificates are selected when they are auto-discovered from ACM.
+type CertificateSelection struct {
+	// PreferExactMatch prefers certificates with a domain name that exactly matches the host over wildcard certificates.
+	// +optional
+	PreferExactMatch *bool `json:"preferExactMatch,omitempty"`
+
+	// PreferLatestExpiry prefers the certificate that expires last when multiple certificates match the host.
+	// +optional
+	PreferLatestExpiry *bool `json:"preferLatestExpiry,omitempty"`
+
+	// ExcludeExpiringWithinDays excludes certificates that expire within the given number of days.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	ExcludeExpiringWithinDays *int64 `json:"excludeExpiringWithinDays,omitempty"`
+
+	// Tags restricts the selection to certificates with all of the given ACM tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// Types restricts the selection to certificates of the given types.
+	// +optional
+	Types []CertificateType `json:"types,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=off;passthrough;verify
+// MutualAuthenticationMode is the mode of mutual TLS authentication.
+//
+// * off: mutual TLS authentication is disabled.
+// * passthrough: the client certificate chain is sent to targets in HTTP headers without verification.
+// * verify: the client certificate is verified against a trust store.
+type MutualAuthenticationMode string
+
+const (
+	MutualAuthenticationModeOff         MutualAuthenticationMode = "off"
+	MutualAuthenticationModePassthrough MutualAuthenticationMode = "passthrough"
+	MutualAuthenticationModeVerify      MutualAuthenticationMode = "verify"
+)
+
+// +kubebuilder:validation:Enum=ConfigMap;Secret
+// CABundleSourceKind is the kind of Kubernetes object a CA certificates bundle is taken from.
+type CABundleSourceKind string
+
+const (
+	CABundleSourceKindConfigMap CABundleSourceKind = "ConfigMap"
+	CABundleSourceKindSecret    CABundleSourceKind = "Secret"
+)
+
+// CABundleSource defines the Kubernetes ConfigMap or Secret a CA certificates bundle is taken from.
+type CABundleSource struct {
+	// Kind is the kind of the object, either ConfigMap or Secret.
+	Kind CABundleSourceKind `json:"kind"`
+
+	// Namespace is the namespace of the object.
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the object.
+	Name string `json:"name"`
+
+	// Key is the key of the PEM encoded CA certificates bundle within the object, defaults to ca.crt.
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// MutualAuthentication defines the mutual TLS authentication of an HTTPS listener.
+type MutualAuthentication struct {
+	// Port is the port of the HTTPS listener.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int64 `json:"port"`
+
+	// Mode is the mode of mutual TLS authentication.
+	Mode MutualAuthenticationMode `json:"mode"`
+
+	// TrustStore is the ARN of an existing trust store to verify client certificates, exclusive with CABundle.
+	// +optional
+	TrustStore *string `json:"trustStore,omitempty"`
+
+	// CABundle is the CA certificates bundle to verify client certificates, a trust store is managed from it.
+	// exclusive with TrustStore.
+	// +optional
+	CABundle *CABundleSource `json:"caBundle,omitempty"`
+
+	// IgnoreClientCertificateExpiry specifies whether expired client certificates are accepted in verify mode.
+	// +optional
+	IgnoreClientCertificateExpiry *bool `json:"ignoreClientCertificateExpiry,omitempty"`
+}
+
+// FrontendNLB defines a Network Load Balancer in front of the Application Load Balancer, which provides static IP addresses.
+type FrontendNLB struct {
+	// EIPAllocations are the allocation IDs of Elastic IP addresses for the Network Load Balancer, one for each subnet of the Application Load Balancer.
+	// they can only be used with internet-facing scheme.
+	// +optional
+	EIPAllocations []string `json:"eipAllocations,omitempty"`
+}
+
 // IngressClassParamsSpec defines the desired state of IngressClassParams
 type IngressClassParamsSpec struct {
 	// NamespaceSelector restrict the namespaces of Ingresses that are allowed to specify the IngressClass with this IngressClassParams.
@@ -90,6 +195,21 @@ type IngressClassParamsSpec struct {
 	// LoadBalancerAttributes define the custom attributes to LoadBalancers for all Ingress that that belong to IngressClass with this IngressClassParams.
 	// +optional
 	LoadBalancerAttributes []Attribute `json:"loadBalancerAttributes,omitempty"`
+
+	// CertificateSelection defines how certificates are selected when auto-discovered from ACM for Ingresses that belong to IngressClass with this IngressClassParams.
+	// the fields that are set override the controller-wide certificate selection policy.
+	// +optional
+	CertificateSelection *CertificateSelection `json:"certificateSelection,omitempty"`
+
+	// MutualAuthentication defines the mutual TLS authentication of HTTPS listeners for Ingresses that belong to IngressClass with this IngressClassParams.
+	// it overrides the mutual-authentication annotation on Ingresses.
+	// +optional
+	MutualAuthentication []MutualAuthentication `json:"mutualAuthentication,omitempty"`
+
+	// FrontendNLB defines a Network Load Balancer in front of the Application Load Balancer for Ingresses that belong to IngressClass with this IngressClassParams.
+	// it overrides the frontend-nlb annotations on Ingresses.
+	// +optional
+	FrontendNLB *FrontendNLB `json:"frontendNLB,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,156 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum=Pause;Rollback
+// TrafficShiftFailurePolicy is the policy applied when the canary Service becomes unhealthy.
+//
+// * with `Pause` policy, traffic shifting stays at current step until the canary Service becomes healthy again.
+// * with `Rollback` policy, all traffic is routed back to the stable Service.
+type TrafficShiftFailurePolicy string
+
+const (
+	TrafficShiftFailurePolicyPause    TrafficShiftFailurePolicy = "Pause"
+	TrafficShiftFailurePolicyRollback TrafficShiftFailurePolicy = "Rollback"
+)
+
+// TrafficShiftPhase is the phase of traffic shifting.
+type TrafficShiftPhase string
+
+const (
+	TrafficShiftPhaseProgressing TrafficShiftPhase = "Progressing"
+	TrafficShiftPhasePaused      TrafficShiftPhase = "Paused"
+	TrafficShiftPhaseCompleted   TrafficShiftPhase = "Completed"
+	TrafficShiftPhaseRolledBack  TrafficShiftPhase = "RolledBack"
+)
+
+// TrafficShiftStep defines a step of traffic shifting.
+type TrafficShiftStep struct {
+	// Weight is the percentage of traffic routed to the canary Service during this step.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight int64 `json:"weight"`
+}
+
+// TrafficShiftHealthCheck defines the health requirement of canary Service's targets.
+type TrafficShiftHealthCheck struct {
+	// MinHealthyPercent is the minimum percentage of healthy targets in canary Service's TargetGroups.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MinHealthyPercent int64 `json:"minHealthyPercent"`
+
+	// FailurePolicy is the policy applied when the percentage of healthy targets drops below MinHealthyPercent.
+	// If unspecified, it defaults to Pause.
+	// +optional
+	FailurePolicy *TrafficShiftFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// TrafficShiftSpec defines the desired state of TrafficShift
+type TrafficShiftSpec struct {
+	// IngressName is the name of the Ingress in the same namespace.
+	// +kubebuilder:validation:MinLength=1
+	IngressName string `json:"ingressName"`
+
+	// ActionName is the name of the Ingress backend action, i.e. the service name of backend with servicePort `use-annotation`.
+	// The weights of this forward action will be managed by the TrafficShift.
+	// +kubebuilder:validation:MinLength=1
+	ActionName string `json:"actionName"`
+
+	// StableService is the Service that receives the remaining traffic.
+	StableService ServiceReference `json:"stableService"`
+
+	// CanaryService is the Service that traffic is shifted to.
+	CanaryService ServiceReference `json:"canaryService"`
+
+	// Steps are the canary weights applied in order.
+	// +kubebuilder:validation:MinItems=1
+	Steps []TrafficShiftStep `json:"steps"`
+
+	// Interval is the duration of each step. If unspecified, it defaults to 10m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// HealthCheck defines the health requirement of canary Service's targets.
+	// If unspecified, traffic shifting progresses regardless of target health.
+	// +optional
+	HealthCheck *TrafficShiftHealthCheck `json:"healthCheck,omitempty"`
+
+	// Paused stops traffic shifting at current step.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// TrafficShiftStatus defines the observed state of TrafficShift
+type TrafficShiftStatus struct {
+	// The generation observed by the TrafficShift controller.
+	// +optional
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// Phase is the phase of traffic shifting.
+	// +optional
+	Phase TrafficShiftPhase `json:"phase,omitempty"`
+
+	// CurrentStep is the index of current step.
+	// +optional
+	CurrentStep int32 `json:"currentStep,omitempty"`
+
+	// CanaryWeight is the percentage of traffic currently routed to the canary Service.
+	// +optional
+	CanaryWeight *int64 `json:"canaryWeight,omitempty"`
+
+	// LastStepTime is the time current step started.
+	// +optional
+	LastStepTime *metav1.Time `json:"lastStepTime,omitempty"`
+
+	// Message is a human readable explanation of current phase.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="INGRESS",type="string",JSONPath=".spec.ingressName",description="The Kubernetes Ingress's name"
+// +kubebuilder:printcolumn:name="ACTION",type="string",JSONPath=".spec.actionName",description="The Ingress backend action's name"
+// +kubebuilder:printcolumn:name="PHASE",type="string",JSONPath=".status.phase",description="The phase of traffic shifting"
+// +kubebuilder:printcolumn:name="WEIGHT",type="integer",JSONPath=".status.canaryWeight",description="The percentage of traffic routed to canary Service"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// TrafficShift is the Schema for the TrafficShift API
+type TrafficShift struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TrafficShiftSpec   `json:"spec,omitempty"`
+	Status TrafficShiftStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TrafficShiftList contains a list of TrafficShift
+type TrafficShiftList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TrafficShift `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TrafficShift{}, &TrafficShiftList{})
+}
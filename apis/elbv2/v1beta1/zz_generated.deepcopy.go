@@ -42,6 +42,81 @@ func (in *Attribute) DeepCopy() *Attribute {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CABundleSource) DeepCopyInto(out *CABundleSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CABundleSource.
+func (in *CABundleSource) DeepCopy() *CABundleSource {
+	if in == nil {
+		return nil
+	}
+	out := new(CABundleSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateSelection) DeepCopyInto(out *CertificateSelection) {
+	*out = *in
+	if in.PreferExactMatch != nil {
+		in, out := &in.PreferExactMatch, &out.PreferExactMatch
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PreferLatestExpiry != nil {
+		in, out := &in.PreferLatestExpiry, &out.PreferLatestExpiry
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExcludeExpiringWithinDays != nil {
+		in, out := &in.ExcludeExpiringWithinDays, &out.ExcludeExpiringWithinDays
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]CertificateType, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateSelection.
+func (in *CertificateSelection) DeepCopy() *CertificateSelection {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateSelection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrontendNLB) DeepCopyInto(out *FrontendNLB) {
+	*out = *in
+	if in.EIPAllocations != nil {
+		in, out := &in.EIPAllocations, &out.EIPAllocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontendNLB.
+func (in *FrontendNLB) DeepCopy() *FrontendNLB {
+	if in == nil {
+		return nil
+	}
+	out := new(FrontendNLB)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPBlock) DeepCopyInto(out *IPBlock) {
 	*out = *in
@@ -148,6 +223,23 @@ func (in *IngressClassParamsSpec) DeepCopyInto(out *IngressClassParamsSpec) {
 		*out = make([]Attribute, len(*in))
 		copy(*out, *in)
 	}
+	if in.CertificateSelection != nil {
+		in, out := &in.CertificateSelection, &out.CertificateSelection
+		*out = new(CertificateSelection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MutualAuthentication != nil {
+		in, out := &in.MutualAuthentication, &out.MutualAuthentication
+		*out = make([]MutualAuthentication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FrontendNLB != nil {
+		in, out := &in.FrontendNLB, &out.FrontendNLB
+		*out = new(FrontendNLB)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParamsSpec.
@@ -175,6 +267,36 @@ func (in *IngressGroup) DeepCopy() *IngressGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MutualAuthentication) DeepCopyInto(out *MutualAuthentication) {
+	*out = *in
+	if in.TrustStore != nil {
+		in, out := &in.TrustStore, &out.TrustStore
+		*out = new(string)
+		**out = **in
+	}
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = new(CABundleSource)
+		**out = **in
+	}
+	if in.IgnoreClientCertificateExpiry != nil {
+		in, out := &in.IgnoreClientCertificateExpiry, &out.IgnoreClientCertificateExpiry
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MutualAuthentication.
+func (in *MutualAuthentication) DeepCopy() *MutualAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(MutualAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkingIngressRule) DeepCopyInto(out *NetworkingIngressRule) {
 	*out = *in
@@ -436,3 +558,158 @@ func (in *TargetGroupBindingStatus) DeepCopy() *TargetGroupBindingStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficShift) DeepCopyInto(out *TrafficShift) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficShift.
+func (in *TrafficShift) DeepCopy() *TrafficShift {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficShift)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrafficShift) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficShiftHealthCheck) DeepCopyInto(out *TrafficShiftHealthCheck) {
+	*out = *in
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(TrafficShiftFailurePolicy)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficShiftHealthCheck.
+func (in *TrafficShiftHealthCheck) DeepCopy() *TrafficShiftHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficShiftHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficShiftList) DeepCopyInto(out *TrafficShiftList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TrafficShift, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficShiftList.
+func (in *TrafficShiftList) DeepCopy() *TrafficShiftList {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficShiftList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrafficShiftList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficShiftSpec) DeepCopyInto(out *TrafficShiftSpec) {
+	*out = *in
+	out.StableService = in.StableService
+	out.CanaryService = in.CanaryService
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]TrafficShiftStep, len(*in))
+		copy(*out, *in)
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(TrafficShiftHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficShiftSpec.
+func (in *TrafficShiftSpec) DeepCopy() *TrafficShiftSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficShiftSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficShiftStatus) DeepCopyInto(out *TrafficShiftStatus) {
+	*out = *in
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CanaryWeight != nil {
+		in, out := &in.CanaryWeight, &out.CanaryWeight
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LastStepTime != nil {
+		in, out := &in.LastStepTime, &out.LastStepTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficShiftStatus.
+func (in *TrafficShiftStatus) DeepCopy() *TrafficShiftStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficShiftStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficShiftStep) DeepCopyInto(out *TrafficShiftStep) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficShiftStep.
+func (in *TrafficShiftStep) DeepCopy() *TrafficShiftStep {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficShiftStep)
+	in.DeepCopyInto(out)
+	return out
+}
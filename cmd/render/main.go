@@ -0,0 +1,167 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// render builds the model stacks of Ingresses and Services from manifests, without a cluster or AWS access.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	zapraw "go.uber.org/zap"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/render"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+const (
+	flagManifests   = "manifests"
+	flagConfig      = "config"
+	flagGraphFormat = "graph-format"
+	flagOutputDir   = "output-dir"
+)
+
+var scheme = k8sruntime.NewScheme()
+
+func init() {
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = elbv2api.AddToScheme(scheme)
+}
+
+// renderOptions are the command line options for render.
+type renderOptions struct {
+	manifests   []string
+	configPath  string
+	graphFormat string
+	outputDir   string
+}
+
+func (o *renderOptions) bindFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVar(&o.manifests, flagManifests, nil,
+		"Manifest files or directories containing Ingress, Service, IngressClass and IngressClassParams objects")
+	fs.StringVar(&o.configPath, flagConfig, "",
+		"Path to the config file for the VPC, subnets, certificates and security groups")
+	fs.StringVar(&o.graphFormat, flagGraphFormat, string(deploy.GraphFormatMermaid),
+		"Format of the resource graph, either dot or mermaid")
+	fs.StringVar(&o.outputDir, flagOutputDir, "",
+		"Directory to write the model and graph of each stack into, if empty the result is written to stdout as JSON")
+}
+
+func (o *renderOptions) validate() error {
+	if len(o.manifests) == 0 {
+		return errors.Errorf("--%v must be specified", flagManifests)
+	}
+	switch deploy.GraphFormat(o.graphFormat) {
+	case deploy.GraphFormatDOT, deploy.GraphFormatMermaid:
+	default:
+		return errors.Errorf("--%v must be one of %v, %v", flagGraphFormat, deploy.GraphFormatDOT, deploy.GraphFormatMermaid)
+	}
+	return nil
+}
+
+func main() {
+	opts := renderOptions{}
+	fs := pflag.NewFlagSet("render", pflag.ExitOnError)
+	opts.bindFlags(fs)
+	if err := fs.Parse(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := run(context.Background(), opts); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, opts renderOptions) error {
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	cfg, err := render.LoadConfig(opts.configPath)
+	if err != nil {
+		return err
+	}
+	objs, err := render.NewDefaultManifestLoader(scheme).Load(opts.manifests)
+	if err != nil {
+		return err
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	logger := runtime.NewConciseLogger(zap.New(zap.UseDevMode(false),
+		zap.WriteTo(os.Stderr),
+		zap.Level(zapraw.NewAtomicLevelAt(zapraw.WarnLevel))))
+
+	renderer := render.NewDefaultRenderer(k8sClient, cfg, logger)
+	renderedStacks, err := renderer.Render(ctx, deploy.GraphFormat(opts.graphFormat))
+	if err != nil {
+		return err
+	}
+	if opts.outputDir == "" {
+		return writeStdout(renderedStacks)
+	}
+	return writeOutputDir(opts.outputDir, deploy.GraphFormat(opts.graphFormat), renderedStacks)
+}
+
+// writeStdout writes the rendered stacks to stdout as a JSON array.
+func writeStdout(renderedStacks []render.RenderedStack) error {
+	if renderedStacks == nil {
+		renderedStacks = []render.RenderedStack{}
+	}
+	payload, err := json.MarshalIndent(renderedStacks, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(os.Stdout, string(payload))
+	return err
+}
+
+// writeOutputDir writes the model and graph of each rendered stack into separate files within outputDir.
+// files are named after the source and ID of stack, e.g. ingress-namespace-name.json and ingress-namespace-name.mmd.
+func writeOutputDir(outputDir string, graphFormat deploy.GraphFormat, renderedStacks []render.RenderedStack) error {
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return err
+	}
+	graphExt := ".mmd"
+	if graphFormat == deploy.GraphFormatDOT {
+		graphExt = ".dot"
+	}
+	for _, renderedStack := range renderedStacks {
+		baseName := renderedStack.Source + "-" + strings.NewReplacer("/", "-").Replace(strings.TrimPrefix(renderedStack.StackID, "/"))
+		var model bytes.Buffer
+		if err := json.Indent(&model, renderedStack.Model, "", "  "); err != nil {
+			return err
+		}
+		model.WriteString("\n")
+		if err := os.WriteFile(filepath.Join(outputDir, baseName+".json"), model.Bytes(), 0644); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(outputDir, baseName+graphExt), []byte(renderedStack.Graph), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/trafficshift"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	trafficShiftControllerName = "trafficShift"
+	trafficShiftKind           = "TrafficShift"
+)
+
+// NewTrafficShiftReconciler constructs new trafficShiftReconciler
+func NewTrafficShiftReconciler(k8sClient client.Client, tsResourceManager trafficshift.ResourceManager,
+	logger logr.Logger) *trafficShiftReconciler {
+	return &trafficShiftReconciler{
+		k8sClient:         k8sClient,
+		tsResourceManager: tsResourceManager,
+		logger:            logger,
+	}
+}
+
+// trafficShiftReconciler reconciles a TrafficShift object
+type trafficShiftReconciler struct {
+	k8sClient         client.Client
+	tsResourceManager trafficshift.ResourceManager
+	logger            logr.Logger
+}
+
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=trafficshifts,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=trafficshifts/status,verbs=update;patch
+
+func (r *trafficShiftReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	r.logger.V(1).Info("Reconcile request", "name", req.Name)
+	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
+}
+
+func (r *trafficShiftReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
+	ts := &elbv2api.TrafficShift{}
+	if err := r.k8sClient.Get(ctx, req.NamespacedName, ts); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !ts.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	return r.tsResourceManager.Reconcile(ctx, ts)
+}
+
+func (r *trafficShiftReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	installed, err := k8s.IsKindInstalled(mgr.GetRESTMapper(), elbv2api.GroupVersion.WithKind(trafficShiftKind))
+	if err != nil {
+		return err
+	}
+	if !installed {
+		r.logger.Info("kind not installed, skipping controller", "kind", trafficShiftKind)
+		return nil
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&elbv2api.TrafficShift{}).
+		Named(trafficShiftControllerName).
+		Complete(r)
+}
@@ -0,0 +1,79 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/workqueue"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestsForGatewayClassEvent constructs new enqueueRequestsForGatewayClassEvent.
+func NewEnqueueRequestsForGatewayClassEvent(k8sClient client.Client, logger logr.Logger) *enqueueRequestsForGatewayClassEvent {
+	return &enqueueRequestsForGatewayClassEvent{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForGatewayClassEvent)(nil)
+
+type enqueueRequestsForGatewayClassEvent struct {
+	k8sClient client.Client
+	logger    logr.Logger
+}
+
+func (h *enqueueRequestsForGatewayClassEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*unstructured.Unstructured))
+}
+
+func (h *enqueueRequestsForGatewayClassEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	gwClassOld := e.ObjectOld.(*unstructured.Unstructured)
+	gwClassNew := e.ObjectNew.(*unstructured.Unstructured)
+
+	// we only care below update event:
+	//	1. GatewayClass spec updates
+	//	2. GatewayClass deletions
+	if equality.Semantic.DeepEqual(gwClassOld.Object["spec"], gwClassNew.Object["spec"]) &&
+		equality.Semantic.DeepEqual(gwClassOld.GetDeletionTimestamp().IsZero(), gwClassNew.GetDeletionTimestamp().IsZero()) {
+		return
+	}
+
+	h.enqueueImpactedGateways(queue, gwClassNew)
+}
+
+func (h *enqueueRequestsForGatewayClassEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*unstructured.Unstructured))
+}
+
+func (h *enqueueRequestsForGatewayClassEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*unstructured.Unstructured))
+}
+
+func (h *enqueueRequestsForGatewayClassEvent) enqueueImpactedGateways(queue workqueue.RateLimitingInterface, gwClass *unstructured.Unstructured) {
+	gwList := gwapi.NewUnstructuredList(gwapi.GatewayGVK)
+	if err := h.k8sClient.List(context.Background(), gwList); err != nil {
+		h.logger.Error(err, "failed to fetch gateways")
+		return
+	}
+
+	for index := range gwList.Items {
+		gw := &gwList.Items[index]
+		gwClassName, _, _ := unstructured.NestedString(gw.Object, "spec", "gatewayClassName")
+		if gwClassName != gwClass.GetName() {
+			continue
+		}
+
+		h.logger.V(1).Info("enqueue gateway for gatewayClass event",
+			"gatewayClass", gwClass.GetName(),
+			"gateway", k8s.NamespacedName(gw))
+		queue.Add(reconcile.Request{NamespacedName: k8s.NamespacedName(gw)})
+	}
+}
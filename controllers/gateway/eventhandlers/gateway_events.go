@@ -0,0 +1,58 @@
+package eventhandlers
+
+import (
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestsForGatewayEvent constructs new enqueueRequestsForGatewayEvent.
+func NewEnqueueRequestsForGatewayEvent(logger logr.Logger) *enqueueRequestsForGatewayEvent {
+	return &enqueueRequestsForGatewayEvent{
+		logger: logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForGatewayEvent)(nil)
+
+type enqueueRequestsForGatewayEvent struct {
+	logger logr.Logger
+}
+
+func (h *enqueueRequestsForGatewayEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueGateway(queue, e.Object.(*unstructured.Unstructured))
+}
+
+func (h *enqueueRequestsForGatewayEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	gwOld := e.ObjectOld.(*unstructured.Unstructured)
+	gwNew := e.ObjectNew.(*unstructured.Unstructured)
+
+	// we only care below update event:
+	//	1. Gateway annotation updates
+	//	2. Gateway spec updates
+	//	3. Gateway deletions
+	if equality.Semantic.DeepEqual(gwOld.GetAnnotations(), gwNew.GetAnnotations()) &&
+		equality.Semantic.DeepEqual(gwOld.Object["spec"], gwNew.Object["spec"]) &&
+		equality.Semantic.DeepEqual(gwOld.GetDeletionTimestamp().IsZero(), gwNew.GetDeletionTimestamp().IsZero()) {
+		return
+	}
+
+	h.enqueueGateway(queue, gwNew)
+}
+
+func (h *enqueueRequestsForGatewayEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	// we attach a finalizer during reconcile, and handle the user triggered delete action during the update event.
+}
+
+func (h *enqueueRequestsForGatewayEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueGateway(queue, e.Object.(*unstructured.Unstructured))
+}
+
+func (h *enqueueRequestsForGatewayEvent) enqueueGateway(queue workqueue.RateLimitingInterface, gw *unstructured.Unstructured) {
+	queue.Add(reconcile.Request{NamespacedName: k8s.NamespacedName(gw)})
+}
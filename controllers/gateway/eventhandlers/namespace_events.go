@@ -0,0 +1,96 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestsForNamespaceEvent constructs new enqueueRequestsForNamespaceEvent.
+func NewEnqueueRequestsForNamespaceEvent(k8sClient client.Client, logger logr.Logger) *enqueueRequestsForNamespaceEvent {
+	return &enqueueRequestsForNamespaceEvent{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForNamespaceEvent)(nil)
+
+// enqueueRequestsForNamespaceEvent enqueues the Gateways that select routes by namespace labels.
+type enqueueRequestsForNamespaceEvent struct {
+	k8sClient client.Client
+	logger    logr.Logger
+}
+
+func (h *enqueueRequestsForNamespaceEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*corev1.Namespace))
+}
+
+func (h *enqueueRequestsForNamespaceEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	nsOld := e.ObjectOld.(*corev1.Namespace)
+	nsNew := e.ObjectNew.(*corev1.Namespace)
+
+	// we only care below update event:
+	//	1. Namespace label updates
+	if equality.Semantic.DeepEqual(nsOld.Labels, nsNew.Labels) {
+		return
+	}
+
+	h.enqueueImpactedGateways(queue, nsNew)
+}
+
+func (h *enqueueRequestsForNamespaceEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*corev1.Namespace))
+}
+
+func (h *enqueueRequestsForNamespaceEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*corev1.Namespace))
+}
+
+// enqueueImpactedGateways enqueues Gateways that have listeners allowing routes from namespaces selected by labels.
+// such Gateways may gain or lose routes from ns when its labels change.
+func (h *enqueueRequestsForNamespaceEvent) enqueueImpactedGateways(queue workqueue.RateLimitingInterface, ns *corev1.Namespace) {
+	gwList := gwapi.NewUnstructuredList(gwapi.GatewayGVK)
+	if err := h.k8sClient.List(context.Background(), gwList); err != nil {
+		h.logger.Error(err, "failed to fetch gateways")
+		return
+	}
+
+	for index := range gwList.Items {
+		gwObj := &gwList.Items[index]
+		gw := &gwapi.Gateway{}
+		if err := gwapi.FromUnstructured(gwObj, gw); err != nil {
+			continue
+		}
+		if !gatewaySelectsNamespacesByLabels(gw) {
+			continue
+		}
+
+		h.logger.V(1).Info("enqueue gateway for namespace event",
+			"namespace", ns.Name,
+			"gateway", k8s.NamespacedName(gwObj))
+		queue.Add(reconcile.Request{NamespacedName: k8s.NamespacedName(gwObj)})
+	}
+}
+
+// gatewaySelectsNamespacesByLabels checks whether any listener of gw allows routes from namespaces selected by labels.
+func gatewaySelectsNamespacesByLabels(gw *gwapi.Gateway) bool {
+	for _, listener := range gw.Spec.Listeners {
+		if listener.AllowedRoutes == nil || listener.AllowedRoutes.Namespaces == nil || listener.AllowedRoutes.Namespaces.From == nil {
+			continue
+		}
+		if *listener.AllowedRoutes.Namespaces.From == gwapi.NamespacesFromSelector {
+			return true
+		}
+	}
+	return false
+}
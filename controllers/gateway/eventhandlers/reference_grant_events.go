@@ -0,0 +1,108 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestsForReferenceGrantEvent constructs new enqueueRequestsForReferenceGrantEvent.
+func NewEnqueueRequestsForReferenceGrantEvent(k8sClient client.Client, routeGVKs []schema.GroupVersionKind,
+	logger logr.Logger) *enqueueRequestsForReferenceGrantEvent {
+	return &enqueueRequestsForReferenceGrantEvent{
+		k8sClient: k8sClient,
+		routeGVKs: routeGVKs,
+		logger:    logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForReferenceGrantEvent)(nil)
+
+// enqueueRequestsForReferenceGrantEvent enqueues the Gateways of xRoutes that are trusted by a ReferenceGrant.
+type enqueueRequestsForReferenceGrantEvent struct {
+	k8sClient client.Client
+	routeGVKs []schema.GroupVersionKind
+	logger    logr.Logger
+}
+
+func (h *enqueueRequestsForReferenceGrantEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*unstructured.Unstructured))
+}
+
+func (h *enqueueRequestsForReferenceGrantEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	refGrantOld := e.ObjectOld.(*unstructured.Unstructured)
+	refGrantNew := e.ObjectNew.(*unstructured.Unstructured)
+
+	// we only care below update event:
+	//	1. ReferenceGrant spec updates
+	//	2. ReferenceGrant deletions
+	if equality.Semantic.DeepEqual(refGrantOld.Object["spec"], refGrantNew.Object["spec"]) &&
+		equality.Semantic.DeepEqual(refGrantOld.GetDeletionTimestamp().IsZero(), refGrantNew.GetDeletionTimestamp().IsZero()) {
+		return
+	}
+
+	// routes that lost the grant must be reconciled as well.
+	h.enqueueImpactedGateways(queue, refGrantOld)
+	h.enqueueImpactedGateways(queue, refGrantNew)
+}
+
+func (h *enqueueRequestsForReferenceGrantEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*unstructured.Unstructured))
+}
+
+func (h *enqueueRequestsForReferenceGrantEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*unstructured.Unstructured))
+}
+
+// enqueueImpactedGateways enqueues the Gateways of routes in the namespaces trusted by refGrant
+// that reference a Service in the namespace of refGrant.
+func (h *enqueueRequestsForReferenceGrantEvent) enqueueImpactedGateways(queue workqueue.RateLimitingInterface, refGrantObj *unstructured.Unstructured) {
+	refGrant := &gwapi.ReferenceGrant{}
+	if err := gwapi.FromUnstructured(refGrantObj, refGrant); err != nil {
+		h.logger.Error(err, "ignoring invalid ReferenceGrant")
+		return
+	}
+	refGrantKey := k8s.NamespacedName(refGrantObj)
+	for _, from := range refGrant.Spec.From {
+		if from.Group != gwapi.GroupName {
+			continue
+		}
+		for _, routeGVK := range h.routeGVKs {
+			if routeGVK.Kind != from.Kind {
+				continue
+			}
+			routeList := gwapi.NewUnstructuredList(routeGVK)
+			if err := h.k8sClient.List(context.Background(), routeList, client.InNamespace(from.Namespace)); err != nil {
+				if !meta.IsNoMatchError(err) {
+					h.logger.Error(err, "failed to fetch routes", "kind", routeGVK.Kind)
+				}
+				continue
+			}
+			for index := range routeList.Items {
+				route := &routeList.Items[index]
+				if !gateway.RouteReferencesNamespace(route, refGrant.Namespace) {
+					continue
+				}
+				for _, gwKey := range gateway.RouteParentGateways(route) {
+					h.logger.V(1).Info("enqueue gateway for referenceGrant event",
+						"referenceGrant", refGrantKey,
+						"route", k8s.NamespacedName(route),
+						"gateway", gwKey)
+					queue.Add(reconcile.Request{NamespacedName: gwKey})
+				}
+			}
+		}
+	}
+}
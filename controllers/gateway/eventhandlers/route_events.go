@@ -0,0 +1,67 @@
+package eventhandlers
+
+import (
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestsForRouteEvent constructs new enqueueRequestsForRouteEvent.
+func NewEnqueueRequestsForRouteEvent(logger logr.Logger) *enqueueRequestsForRouteEvent {
+	return &enqueueRequestsForRouteEvent{
+		logger: logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForRouteEvent)(nil)
+
+// enqueueRequestsForRouteEvent enqueues the Gateways referenced by xRoutes.
+type enqueueRequestsForRouteEvent struct {
+	logger logr.Logger
+}
+
+func (h *enqueueRequestsForRouteEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueParentGateways(queue, e.Object.(*unstructured.Unstructured))
+}
+
+func (h *enqueueRequestsForRouteEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	routeOld := e.ObjectOld.(*unstructured.Unstructured)
+	routeNew := e.ObjectNew.(*unstructured.Unstructured)
+
+	// we only care below update event:
+	//	1. route spec updates
+	//	2. route deletions
+	// status updates are made by the Gateway controllers and shouldn't trigger reconciles.
+	if equality.Semantic.DeepEqual(routeOld.Object["spec"], routeNew.Object["spec"]) &&
+		equality.Semantic.DeepEqual(routeOld.GetDeletionTimestamp().IsZero(), routeNew.GetDeletionTimestamp().IsZero()) {
+		return
+	}
+
+	// Gateways that the route has been detached from must be reconciled as well.
+	h.enqueueParentGateways(queue, routeOld)
+	h.enqueueParentGateways(queue, routeNew)
+}
+
+func (h *enqueueRequestsForRouteEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueParentGateways(queue, e.Object.(*unstructured.Unstructured))
+}
+
+func (h *enqueueRequestsForRouteEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueParentGateways(queue, e.Object.(*unstructured.Unstructured))
+}
+
+func (h *enqueueRequestsForRouteEvent) enqueueParentGateways(queue workqueue.RateLimitingInterface, route *unstructured.Unstructured) {
+	for _, gwKey := range gateway.RouteParentGateways(route) {
+		h.logger.V(1).Info("enqueue gateway for route event",
+			"route", k8s.NamespacedName(route),
+			"kind", route.GetKind(),
+			"gateway", gwKey)
+		queue.Add(reconcile.Request{NamespacedName: gwKey})
+	}
+}
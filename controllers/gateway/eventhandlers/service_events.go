@@ -0,0 +1,93 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/gateway"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestsForServiceEvent constructs new enqueueRequestsForServiceEvent.
+func NewEnqueueRequestsForServiceEvent(k8sClient client.Client, routeGVKs []schema.GroupVersionKind,
+	logger logr.Logger) *enqueueRequestsForServiceEvent {
+	return &enqueueRequestsForServiceEvent{
+		k8sClient: k8sClient,
+		routeGVKs: routeGVKs,
+		logger:    logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForServiceEvent)(nil)
+
+// enqueueRequestsForServiceEvent enqueues the Gateways of xRoutes that reference a Service as backend.
+type enqueueRequestsForServiceEvent struct {
+	k8sClient client.Client
+	routeGVKs []schema.GroupVersionKind
+	logger    logr.Logger
+}
+
+func (h *enqueueRequestsForServiceEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*corev1.Service))
+}
+
+func (h *enqueueRequestsForServiceEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	svcOld := e.ObjectOld.(*corev1.Service)
+	svcNew := e.ObjectNew.(*corev1.Service)
+
+	// we only care below update event:
+	//	1. Service annotation updates
+	//	2. Service spec updates
+	//	3. Service deletions
+	if equality.Semantic.DeepEqual(svcOld.Annotations, svcNew.Annotations) &&
+		equality.Semantic.DeepEqual(svcOld.Spec, svcNew.Spec) &&
+		equality.Semantic.DeepEqual(svcOld.DeletionTimestamp.IsZero(), svcNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+
+	h.enqueueImpactedGateways(queue, svcNew)
+}
+
+func (h *enqueueRequestsForServiceEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*corev1.Service))
+}
+
+func (h *enqueueRequestsForServiceEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*corev1.Service))
+}
+
+func (h *enqueueRequestsForServiceEvent) enqueueImpactedGateways(queue workqueue.RateLimitingInterface, svc *corev1.Service) {
+	svcKey := k8s.NamespacedName(svc)
+	for _, routeGVK := range h.routeGVKs {
+		routeList := gwapi.NewUnstructuredList(routeGVK)
+		if err := h.k8sClient.List(context.Background(), routeList); err != nil {
+			if !meta.IsNoMatchError(err) {
+				h.logger.Error(err, "failed to fetch routes", "kind", routeGVK.Kind)
+			}
+			continue
+		}
+		for index := range routeList.Items {
+			route := &routeList.Items[index]
+			if !gateway.RouteReferencesService(route, svcKey) {
+				continue
+			}
+			for _, gwKey := range gateway.RouteParentGateways(route) {
+				h.logger.V(1).Info("enqueue gateway for service event",
+					"service", svcKey,
+					"route", k8s.NamespacedName(route),
+					"gateway", gwKey)
+				queue.Add(reconcile.Request{NamespacedName: gwKey})
+			}
+		}
+	}
+}
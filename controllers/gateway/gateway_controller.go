@@ -0,0 +1,443 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/controllers/gateway/eventhandlers"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certs"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	gatewaypkg "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	gatewayFinalizer = "gateway.k8s.aws/resources"
+	gatewayTagPrefix = "gateway.k8s.aws"
+	controllerName   = "gateway"
+	// Network LoadBalancer backed Gateways are configured with the Service annotations.
+	serviceAnnotationPrefix = "service.beta.kubernetes.io"
+)
+
+// NewGatewayReconciler constructs new gatewayReconciler
+func NewGatewayReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
+	finalizerManager k8s.FinalizerManager, networkingSGManager networking.SecurityGroupManager,
+	networkingSGReconciler networking.SecurityGroupReconciler, subnetsResolver networking.SubnetsResolver,
+	vpcInfoProvider networking.VPCInfoProvider, config config.ControllerConfig, logger logr.Logger) *gatewayReconciler {
+
+	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixGateway)
+	trackingProvider := tracking.NewDefaultProvider(gatewayTagPrefix, config.ClusterName)
+	elbv2TaggingManager := elbv2.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), config.FeatureGates, logger)
+	certDiscovery := certs.NewACMCertDiscovery(cloud.ACM(), cloud.RGT(), certs.NewCertSelectionPolicy(config.IngressConfig.CertDiscovery), logger)
+	albModelBuilder := gatewaypkg.NewDefaultModelBuilder(annotationParser, subnetsResolver, certDiscovery, trackingProvider,
+		elbv2TaggingManager, config.ClusterName, config.DefaultTags, config.ExternalManagedTags, config.DefaultSSLPolicy,
+		config.DisableRestrictedSGRules, logger)
+	svcAnnotationParser := annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix)
+	sgResolver := networking.NewDefaultSecurityGroupResolver(cloud.EC2(), cloud.VpcID())
+	serviceUtils := service.NewServiceUtils(svcAnnotationParser, gatewayFinalizer, config.ServiceConfig.LoadBalancerClass, config.FeatureGates)
+	sharedLBBuilder := service.NewDefaultModelBuilder(svcAnnotationParser, subnetsResolver, vpcInfoProvider, sgResolver, cloud.VpcID(), trackingProvider,
+		elbv2TaggingManager, certDiscovery, config.ClusterName, config.DefaultTags, config.ExternalManagedTags, config.DefaultSSLPolicy, serviceUtils, config.FeatureGates)
+	nlbModelBuilder := gatewaypkg.NewNLBModelBuilder(sharedLBBuilder, certDiscovery, logger)
+	stackMarshaller := deploy.NewDefaultStackMarshaller()
+	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, config, gatewayTagPrefix, logger)
+	return &gatewayReconciler{
+		k8sClient:        k8sClient,
+		eventRecorder:    eventRecorder,
+		finalizerManager: finalizerManager,
+		implementations: map[string]gatewayImplementation{
+			gatewaypkg.ControllerNameALB: {
+				gatewayLoader: gatewaypkg.NewDefaultGatewayLoader(k8sClient, logger),
+				modelBuilder:  albModelBuilder,
+			},
+			gatewaypkg.ControllerNameNLB: {
+				gatewayLoader: gatewaypkg.NewNLBGatewayLoader(k8sClient, logger),
+				modelBuilder:  nlbModelBuilder,
+			},
+		},
+
+		stackMarshaller: stackMarshaller,
+		stackDeployer:   stackDeployer,
+		logger:          logger,
+
+		maxConcurrentReconciles: config.GatewayMaxConcurrentReconciles,
+	}
+}
+
+// gatewayImplementation implements Gateways of GatewayClasses with a specific controllerName.
+type gatewayImplementation struct {
+	gatewayLoader gatewaypkg.GatewayLoader
+	modelBuilder  gatewaypkg.ModelBuilder
+}
+
+// gatewayReconciler reconciles Gateways of GatewayClasses managed by this controller.
+type gatewayReconciler struct {
+	k8sClient        client.Client
+	eventRecorder    record.EventRecorder
+	finalizerManager k8s.FinalizerManager
+	// implementations by GatewayClass controllerName.
+	implementations map[string]gatewayImplementation
+
+	stackMarshaller deploy.StackMarshaller
+	stackDeployer   deploy.StackDeployer
+	logger          logr.Logger
+
+	maxConcurrentReconciles int
+}
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses/status,verbs=update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways/status,verbs=update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes;grpcroutes;tcproutes;udproutes;tlsroutes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes/status;grpcroutes/status;tcproutes/status;udproutes/status;tlsroutes/status,verbs=update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=referencegrants,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *gatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
+}
+
+func (r *gatewayReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
+	gwObj := gwapi.NewUnstructured(gwapi.GatewayGVK)
+	if err := r.k8sClient.Get(ctx, req.NamespacedName, gwObj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	gwClass, err := r.loadGatewayClass(ctx, gwObj)
+	if err != nil {
+		return err
+	}
+	var impl gatewayImplementation
+	var managed bool
+	if gwClass != nil {
+		impl, managed = r.implementations[gwClass.Spec.ControllerName]
+	}
+	if !managed {
+		// the Gateway might have been moved to a GatewayClass not managed by us, resources must still be cleaned up.
+		if !k8s.HasFinalizer(gwObj, gatewayFinalizer) {
+			return nil
+		}
+		return r.cleanupGatewayResources(ctx, gwObj)
+	}
+	if err := r.updateGatewayClassStatus(ctx, gwClass); err != nil {
+		return err
+	}
+	if !gwObj.GetDeletionTimestamp().IsZero() {
+		return r.cleanupGatewayResources(ctx, gwObj)
+	}
+	return r.reconcileGatewayResources(ctx, gwObj, gwClass, impl)
+}
+
+func (r *gatewayReconciler) reconcileGatewayResources(ctx context.Context, gwObj *unstructured.Unstructured, gwClass *gwapi.GatewayClass,
+	impl gatewayImplementation) error {
+	if err := r.finalizerManager.AddFinalizers(ctx, gwObj, gatewayFinalizer); err != nil {
+		r.eventRecorder.Event(gwObj, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
+		return err
+	}
+	gw, err := impl.gatewayLoader.Load(ctx, gwObj, gwClass)
+	if err != nil {
+		r.eventRecorder.Event(gwObj, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedLoadRoutes, fmt.Sprintf("Failed load routes due to %v", err))
+		return err
+	}
+	stack, lb, err := r.buildModel(ctx, impl.modelBuilder, gw)
+	if err != nil {
+		return r.updateStatusWithError(ctx, gw, gwapi.GatewayReasonInvalid, err)
+	}
+	if err := r.deployModel(ctx, gw, stack); err != nil {
+		return r.updateStatusWithError(ctx, gw, gwapi.GatewayReasonPending, err)
+	}
+	lbDNS, err := lb.DNSName().Resolve(ctx)
+	if err != nil {
+		return err
+	}
+	programmed := gatewaypkg.ProgrammedResult{
+		Programmed: true,
+		Reason:     gwapi.GatewayReasonProgrammed,
+		Message:    "Gateway is programmed",
+		DNSName:    lbDNS,
+	}
+	if err := r.updateStatus(ctx, gw, programmed); err != nil {
+		r.eventRecorder.Event(gwObj, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return err
+	}
+	r.eventRecorder.Event(gwObj, corev1.EventTypeNormal, k8s.GatewayEventReasonSuccessfullyReconciled, "Successfully reconciled")
+	return nil
+}
+
+func (r *gatewayReconciler) cleanupGatewayResources(ctx context.Context, gwObj *unstructured.Unstructured) error {
+	gwKey := k8s.NamespacedName(gwObj)
+	stack := core.NewDefaultStack(core.StackID(gwKey))
+	if err := r.stackDeployer.Deploy(ctx, stack); err != nil {
+		r.eventRecorder.Event(gwObj, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully cleaned up gateway resources", "gateway", gwKey)
+	if err := r.cleanupRouteStatuses(ctx, gwObj); err != nil {
+		r.eventRecorder.Event(gwObj, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return err
+	}
+	if err := r.finalizerManager.RemoveFinalizers(ctx, gwObj, gatewayFinalizer); err != nil {
+		r.eventRecorder.Event(gwObj, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
+		return err
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) buildModel(ctx context.Context, modelBuilder gatewaypkg.ModelBuilder, gw gatewaypkg.Gateway) (core.Stack, *elbv2model.LoadBalancer, error) {
+	stack, lb, err := modelBuilder.Build(ctx, gw)
+	if err != nil {
+		r.eventRecorder.Event(gw.Object, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return nil, nil, err
+	}
+	stackJSON, err := r.stackMarshaller.Marshal(stack)
+	if err != nil {
+		r.eventRecorder.Event(gw.Object, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return nil, nil, err
+	}
+	r.logger.Info("successfully built model", "model", stackJSON)
+	return stack, lb, nil
+}
+
+func (r *gatewayReconciler) deployModel(ctx context.Context, gw gatewaypkg.Gateway, stack core.Stack) error {
+	if err := r.stackDeployer.Deploy(ctx, stack); err != nil {
+		r.eventRecorder.Event(gw.Object, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully deployed model", "gateway", gw.Key())
+	return nil
+}
+
+// updateStatusWithError reports reconcileErr in the Gateway status, and returns reconcileErr.
+func (r *gatewayReconciler) updateStatusWithError(ctx context.Context, gw gatewaypkg.Gateway, reason string, reconcileErr error) error {
+	programmed := gatewaypkg.ProgrammedResult{
+		Programmed: false,
+		Reason:     reason,
+		Message:    reconcileErr.Error(),
+	}
+	if err := r.updateStatus(ctx, gw, programmed); err != nil {
+		r.eventRecorder.Event(gw.Object, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+	}
+	return reconcileErr
+}
+
+func (r *gatewayReconciler) updateStatus(ctx context.Context, gw gatewaypkg.Gateway, programmed gatewaypkg.ProgrammedResult) error {
+	if err := r.updateRouteStatuses(ctx, gw); err != nil {
+		return err
+	}
+	gwStatus := gatewaypkg.BuildGatewayStatus(gw, programmed)
+	return r.patchStatus(ctx, gw.Object, gwStatus)
+}
+
+func (r *gatewayReconciler) updateRouteStatuses(ctx context.Context, gw gatewaypkg.Gateway) error {
+	routes, resultsByRoute := gatewaypkg.GroupRouteParentResultsByRoute(gw)
+	for _, route := range routes {
+		routeStatus := gatewaypkg.BuildRouteStatus(gw, route, resultsByRoute[route])
+		if err := r.patchStatus(ctx, route.Object, routeStatus); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cleanupRouteStatuses removes the parent statuses written by this controller for the Gateway from all routes.
+func (r *gatewayReconciler) cleanupRouteStatuses(ctx context.Context, gwObj *unstructured.Unstructured) error {
+	controllerNames := make([]string, 0, len(r.implementations))
+	for gwControllerName := range r.implementations {
+		controllerNames = append(controllerNames, gwControllerName)
+	}
+	sort.Strings(controllerNames)
+	for _, gwControllerName := range controllerNames {
+		gwClass := &gwapi.GatewayClass{
+			Spec: gwapi.GatewayClassSpec{
+				ControllerName: gwControllerName,
+			},
+		}
+		gw, err := r.implementations[gwControllerName].gatewayLoader.Load(ctx, gwObj, gwClass)
+		if err != nil {
+			return err
+		}
+		gw.StaleRoutes = append(gw.StaleRoutes, routesOfParentResults(gw.RouteParentResults)...)
+		gw.RouteParentResults = nil
+		if err := r.updateRouteStatuses(ctx, gw); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// patchStatus patches the status of obj if it differs from status.
+func (r *gatewayReconciler) patchStatus(ctx context.Context, obj *unstructured.Unstructured, status interface{}) error {
+	statusContent, err := gwapi.ToUnstructuredContent(status)
+	if err != nil {
+		return err
+	}
+	existingStatusContent, _, _ := unstructured.NestedMap(obj.Object, "status")
+	if equality.Semantic.DeepEqual(existingStatusContent, statusContent) {
+		return nil
+	}
+	oldObj := obj.DeepCopy()
+	obj.Object["status"] = statusContent
+	if err := r.k8sClient.Status().Patch(ctx, obj, client.MergeFrom(oldObj)); err != nil {
+		return errors.Wrapf(err, "failed to update %v status: %v", obj.GetKind(), k8s.NamespacedName(obj))
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) loadGatewayClass(ctx context.Context, gwObj *unstructured.Unstructured) (*gwapi.GatewayClass, error) {
+	gwClassName, _, _ := unstructured.NestedString(gwObj.Object, "spec", "gatewayClassName")
+	gwClassObj := gwapi.NewUnstructured(gwapi.GatewayClassGVK)
+	if err := r.k8sClient.Get(ctx, types.NamespacedName{Name: gwClassName}, gwClassObj); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	gwClass := &gwapi.GatewayClass{}
+	if err := gwapi.FromUnstructured(gwClassObj, gwClass); err != nil {
+		return nil, err
+	}
+	return gwClass, nil
+}
+
+func (r *gatewayReconciler) updateGatewayClassStatus(ctx context.Context, gwClass *gwapi.GatewayClass) error {
+	if meta.IsStatusConditionTrue(gwClass.Status.Conditions, gwapi.GatewayClassConditionAccepted) {
+		return nil
+	}
+	gwClassObj := gwapi.NewUnstructured(gwapi.GatewayClassGVK)
+	if err := r.k8sClient.Get(ctx, types.NamespacedName{Name: gwClass.Name}, gwClassObj); err != nil {
+		return err
+	}
+	status := gwapi.GatewayClassStatus{
+		Conditions: gwClass.Status.Conditions,
+	}
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               gwapi.GatewayClassConditionAccepted,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gwClass.Generation,
+		Reason:             gwapi.GatewayClassReasonAccepted,
+		Message:            "GatewayClass is accepted",
+	})
+	return r.patchStatus(ctx, gwClassObj, status)
+}
+
+func (r *gatewayReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	c, err := controller.New(controllerName, mgr, controller.Options{
+		MaxConcurrentReconciles: r.maxConcurrentReconciles,
+		Reconciler:              r,
+	})
+	if err != nil {
+		return err
+	}
+	if err := r.setupWatches(ctx, c, mgr.GetRESTMapper()); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) setupWatches(_ context.Context, c controller.Controller, restMapper meta.RESTMapper) error {
+	routeGVKs, err := r.installedRouteGVKs(restMapper)
+	if err != nil {
+		return err
+	}
+	gwEventHandler := eventhandlers.NewEnqueueRequestsForGatewayEvent(r.logger.WithName("eventHandlers").WithName("gateway"))
+	gwClassEventHandler := eventhandlers.NewEnqueueRequestsForGatewayClassEvent(r.k8sClient,
+		r.logger.WithName("eventHandlers").WithName("gatewayClass"))
+	routeEventHandler := eventhandlers.NewEnqueueRequestsForRouteEvent(r.logger.WithName("eventHandlers").WithName("route"))
+	svcEventHandler := eventhandlers.NewEnqueueRequestsForServiceEvent(r.k8sClient, routeGVKs,
+		r.logger.WithName("eventHandlers").WithName("service"))
+	nsEventHandler := eventhandlers.NewEnqueueRequestsForNamespaceEvent(r.k8sClient,
+		r.logger.WithName("eventHandlers").WithName("namespace"))
+	if err := c.Watch(&source.Kind{Type: gwapi.NewUnstructured(gwapi.GatewayGVK)}, gwEventHandler); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Kind{Type: gwapi.NewUnstructured(gwapi.GatewayClassGVK)}, gwClassEventHandler); err != nil {
+		return err
+	}
+	for _, gvk := range routeGVKs {
+		if err := c.Watch(&source.Kind{Type: gwapi.NewUnstructured(gvk)}, routeEventHandler); err != nil {
+			return err
+		}
+	}
+	if err := c.Watch(&source.Kind{Type: &corev1.Service{}}, svcEventHandler); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Kind{Type: &corev1.Namespace{}}, nsEventHandler); err != nil {
+		return err
+	}
+	refGrantInstalled, err := r.isKindInstalled(restMapper, gwapi.ReferenceGrantGVK)
+	if err != nil {
+		return err
+	}
+	if refGrantInstalled {
+		refGrantEventHandler := eventhandlers.NewEnqueueRequestsForReferenceGrantEvent(r.k8sClient, routeGVKs,
+			r.logger.WithName("eventHandlers").WithName("referenceGrant"))
+		if err := c.Watch(&source.Kind{Type: gwapi.NewUnstructured(gwapi.ReferenceGrantGVK)}, refGrantEventHandler); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// installedRouteGVKs returns the route kinds whose CRDs are installed.
+// the experimental route kinds like TCPRoute are not installed with the standard Gateway API CRDs.
+func (r *gatewayReconciler) installedRouteGVKs(restMapper meta.RESTMapper) ([]schema.GroupVersionKind, error) {
+	var routeGVKs []schema.GroupVersionKind
+	for _, gvk := range []schema.GroupVersionKind{gwapi.HTTPRouteGVK, gwapi.GRPCRouteGVK, gwapi.TCPRouteGVK, gwapi.UDPRouteGVK, gwapi.TLSRouteGVK} {
+		installed, err := r.isKindInstalled(restMapper, gvk)
+		if err != nil {
+			return nil, err
+		}
+		if installed {
+			routeGVKs = append(routeGVKs, gvk)
+		}
+	}
+	return routeGVKs, nil
+}
+
+// isKindInstalled checks whether the CRD of gvk is installed.
+func (r *gatewayReconciler) isKindInstalled(restMapper meta.RESTMapper, gvk schema.GroupVersionKind) (bool, error) {
+	if _, err := restMapper.RESTMapping(gvk.GroupKind(), gvk.Version); err != nil {
+		if meta.IsNoMatchError(err) {
+			r.logger.Info("kind not installed, skipping watch", "kind", gvk.Kind)
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func routesOfParentResults(results []gatewaypkg.RouteParentResult) []*gatewaypkg.Route {
+	var routes []*gatewaypkg.Route
+	seen := make(map[*gatewaypkg.Route]bool)
+	for _, result := range results {
+		if seen[result.Route] {
+			continue
+		}
+		seen[result.Route] = true
+		routes = append(routes, result.Route)
+	}
+	return routes
+}
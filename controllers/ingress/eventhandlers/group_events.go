@@ -0,0 +1,41 @@
+package eventhandlers
+
+import (
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// NewEnqueueRequestsForGroupEvent constructs new enqueueRequestsForGroupEvent.
+// it enqueues the IngressGroup with ID as the namespace/name of generic events, e.g. when AWS secrets referenced by IngressGroup changes.
+func NewEnqueueRequestsForGroupEvent(logger logr.Logger) *enqueueRequestsForGroupEvent {
+	return &enqueueRequestsForGroupEvent{
+		logger: logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForGroupEvent)(nil)
+
+type enqueueRequestsForGroupEvent struct {
+	logger logr.Logger
+}
+
+func (h *enqueueRequestsForGroupEvent) Create(_ event.CreateEvent, _ workqueue.RateLimitingInterface) {
+	// this event handler only receives generic events
+}
+
+func (h *enqueueRequestsForGroupEvent) Update(_ event.UpdateEvent, _ workqueue.RateLimitingInterface) {
+	// this event handler only receives generic events
+}
+
+func (h *enqueueRequestsForGroupEvent) Delete(_ event.DeleteEvent, _ workqueue.RateLimitingInterface) {
+	// this event handler only receives generic events
+}
+
+func (h *enqueueRequestsForGroupEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	groupID := ingress.GroupID{Namespace: e.Object.GetNamespace(), Name: e.Object.GetName()}
+	h.logger.V(1).Info("enqueue ingressGroup for group event", "groupID", groupID)
+	queue.Add(ingress.EncodeGroupIDToReconcileRequest(groupID))
+}
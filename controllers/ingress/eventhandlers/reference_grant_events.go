@@ -0,0 +1,87 @@
+package eventhandlers
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// NewEnqueueRequestsForReferenceGrantEvent constructs new enqueueRequestsForReferenceGrantEvent.
+func NewEnqueueRequestsForReferenceGrantEvent(ingEventChan chan<- event.GenericEvent,
+	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) *enqueueRequestsForReferenceGrantEvent {
+	return &enqueueRequestsForReferenceGrantEvent{
+		ingEventChan:  ingEventChan,
+		k8sClient:     k8sClient,
+		eventRecorder: eventRecorder,
+		logger:        logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForReferenceGrantEvent)(nil)
+
+type enqueueRequestsForReferenceGrantEvent struct {
+	ingEventChan  chan<- event.GenericEvent
+	k8sClient     client.Client
+	eventRecorder record.EventRecorder
+	logger        logr.Logger
+}
+
+func (h *enqueueRequestsForReferenceGrantEvent) Create(e event.CreateEvent, _ workqueue.RateLimitingInterface) {
+	refGrantNew := e.Object.(*unstructured.Unstructured)
+	h.enqueueImpactedIngresses(refGrantNew)
+}
+
+func (h *enqueueRequestsForReferenceGrantEvent) Update(e event.UpdateEvent, _ workqueue.RateLimitingInterface) {
+	refGrantOld := e.ObjectOld.(*unstructured.Unstructured)
+	refGrantNew := e.ObjectNew.(*unstructured.Unstructured)
+
+	// we only care below update event:
+	//	1. ReferenceGrant spec updates
+	//	2. ReferenceGrant deletions
+	if equality.Semantic.DeepEqual(refGrantOld.Object["spec"], refGrantNew.Object["spec"]) &&
+		equality.Semantic.DeepEqual(refGrantOld.GetDeletionTimestamp().IsZero(), refGrantNew.GetDeletionTimestamp().IsZero()) {
+		return
+	}
+
+	h.enqueueImpactedIngresses(refGrantNew)
+}
+
+func (h *enqueueRequestsForReferenceGrantEvent) Delete(e event.DeleteEvent, _ workqueue.RateLimitingInterface) {
+	refGrantOld := e.Object.(*unstructured.Unstructured)
+	h.enqueueImpactedIngresses(refGrantOld)
+}
+
+func (h *enqueueRequestsForReferenceGrantEvent) Generic(e event.GenericEvent, _ workqueue.RateLimitingInterface) {
+	// we don't have any generic event for referenceGrants.
+}
+
+// enqueueImpactedIngresses enqueues ingresses that reference services in the namespace of referenceGrant.
+func (h *enqueueRequestsForReferenceGrantEvent) enqueueImpactedIngresses(refGrant *unstructured.Unstructured) {
+	ingList := &networking.IngressList{}
+	if err := h.k8sClient.List(context.Background(), ingList,
+		client.MatchingFields{ingress.IndexKeyServiceRefNamespace: refGrant.GetNamespace()}); err != nil {
+		h.logger.Error(err, "failed to fetch ingresses")
+		return
+	}
+
+	refGrantKey := k8s.NamespacedName(refGrant)
+	for index := range ingList.Items {
+		ing := &ingList.Items[index]
+
+		h.logger.V(1).Info("enqueue ingress for referenceGrant event",
+			"referenceGrant", refGrantKey,
+			"ingress", k8s.NamespacedName(ing))
+		h.ingEventChan <- event.GenericEvent{
+			Object: ing,
+		}
+	}
+}
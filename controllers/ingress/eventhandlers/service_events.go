@@ -77,6 +77,15 @@ func (h *enqueueRequestsForServiceEvent) enqueueImpactedIngresses(svc *corev1.Se
 	}
 
 	svcKey := k8s.NamespacedName(svc)
+	// ingresses from other namespaces reference this service as `namespace/name`.
+	crossNamespaceIngList := &networking.IngressList{}
+	if err := h.k8sClient.List(context.Background(), crossNamespaceIngList,
+		client.MatchingFields{ingress.IndexKeyServiceRefName: svcKey.String()}); err != nil {
+		h.logger.Error(err, "failed to fetch ingresses")
+		return
+	}
+	ingList.Items = append(ingList.Items, crossNamespaceIngList.Items...)
+
 	for index := range ingList.Items {
 		ing := &ingList.Items[index]
 
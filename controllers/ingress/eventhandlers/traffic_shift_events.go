@@ -0,0 +1,91 @@
+package eventhandlers
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// NewEnqueueRequestsForTrafficShiftEvent constructs new enqueueRequestsForTrafficShiftEvent.
+func NewEnqueueRequestsForTrafficShiftEvent(ingEventChan chan<- event.GenericEvent,
+	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) *enqueueRequestsForTrafficShiftEvent {
+	return &enqueueRequestsForTrafficShiftEvent{
+		ingEventChan:  ingEventChan,
+		k8sClient:     k8sClient,
+		eventRecorder: eventRecorder,
+		logger:        logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForTrafficShiftEvent)(nil)
+
+type enqueueRequestsForTrafficShiftEvent struct {
+	ingEventChan  chan<- event.GenericEvent
+	k8sClient     client.Client
+	eventRecorder record.EventRecorder
+	logger        logr.Logger
+}
+
+func (h *enqueueRequestsForTrafficShiftEvent) Create(e event.CreateEvent, _ workqueue.RateLimitingInterface) {
+	tsNew := e.Object.(*elbv2api.TrafficShift)
+	h.enqueueImpactedIngress(tsNew)
+}
+
+func (h *enqueueRequestsForTrafficShiftEvent) Update(e event.UpdateEvent, _ workqueue.RateLimitingInterface) {
+	tsOld := e.ObjectOld.(*elbv2api.TrafficShift)
+	tsNew := e.ObjectNew.(*elbv2api.TrafficShift)
+
+	// we only care below update event:
+	//	1. TrafficShift canary weight updates
+	//	2. TrafficShift Ingress action or Service updates
+	//	3. TrafficShift deletions
+	if equality.Semantic.DeepEqual(tsOld.Status.CanaryWeight, tsNew.Status.CanaryWeight) &&
+		tsOld.Spec.IngressName == tsNew.Spec.IngressName &&
+		tsOld.Spec.ActionName == tsNew.Spec.ActionName &&
+		equality.Semantic.DeepEqual(tsOld.Spec.StableService, tsNew.Spec.StableService) &&
+		equality.Semantic.DeepEqual(tsOld.Spec.CanaryService, tsNew.Spec.CanaryService) &&
+		equality.Semantic.DeepEqual(tsOld.DeletionTimestamp.IsZero(), tsNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+
+	if tsOld.Spec.IngressName != tsNew.Spec.IngressName {
+		h.enqueueImpactedIngress(tsOld)
+	}
+	h.enqueueImpactedIngress(tsNew)
+}
+
+func (h *enqueueRequestsForTrafficShiftEvent) Delete(e event.DeleteEvent, _ workqueue.RateLimitingInterface) {
+	tsOld := e.Object.(*elbv2api.TrafficShift)
+	h.enqueueImpactedIngress(tsOld)
+}
+
+func (h *enqueueRequestsForTrafficShiftEvent) Generic(e event.GenericEvent, _ workqueue.RateLimitingInterface) {
+	// we don't have any generic event for trafficShifts.
+}
+
+func (h *enqueueRequestsForTrafficShiftEvent) enqueueImpactedIngress(ts *elbv2api.TrafficShift) {
+	ingKey := types.NamespacedName{Namespace: ts.Namespace, Name: ts.Spec.IngressName}
+	ing := &networking.Ingress{}
+	if err := h.k8sClient.Get(context.Background(), ingKey, ing); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			h.logger.Error(err, "failed to fetch ingress", "ingress", ingKey)
+		}
+		return
+	}
+
+	h.logger.V(1).Info("enqueue ingress for trafficShift event",
+		"trafficShift", k8s.NamespacedName(ts),
+		"ingress", ingKey)
+	h.ingEventChan <- event.GenericEvent{
+		Object: ing,
+	}
+}
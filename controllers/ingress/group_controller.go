@@ -3,21 +3,28 @@ package ingress
 import (
 	"context"
 	"fmt"
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/ingress/eventhandlers"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certs"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
@@ -28,7 +35,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/source"
+	"sort"
 )
 
 const (
@@ -38,49 +47,77 @@ const (
 	// the groupVersion of used Ingress & IngressClass resource.
 	ingressResourcesGroupVersion = "networking.k8s.io/v1"
 	ingressClassKind             = "IngressClass"
+	// the kind of TrafficShift resource, which is only watched when its CRD is installed.
+	trafficShiftKind = "TrafficShift"
 )
 
 // NewGroupReconciler constructs new GroupReconciler
-func NewGroupReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
+func NewGroupReconciler(cloud aws.Cloud, k8sClient client.Client, clientSet kubernetes.Interface, eventRecorder record.EventRecorder,
 	finalizerManager k8s.FinalizerManager, networkingSGManager networkingpkg.SecurityGroupManager,
 	networkingSGReconciler networkingpkg.SecurityGroupReconciler, subnetsResolver networkingpkg.SubnetsResolver,
-	config config.ControllerConfig, backendSGProvider networkingpkg.BackendSGProvider, logger logr.Logger) *groupReconciler {
+	config config.ControllerConfig, backendSGProvider networkingpkg.BackendSGProvider,
+	ruleOptimizerMetricsCollector ingress.RuleOptimizerMetricsCollector, logger logr.Logger) *groupReconciler {
 
 	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
 	authConfigBuilder := ingress.NewDefaultAuthConfigBuilder(annotationParser)
 	enhancedBackendBuilder := ingress.NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder)
-	referenceIndexer := ingress.NewDefaultReferenceIndexer(enhancedBackendBuilder, authConfigBuilder, logger)
+	referenceIndexer := ingress.NewDefaultReferenceIndexer(enhancedBackendBuilder, authConfigBuilder, config.FeatureGates, logger)
 	trackingProvider := tracking.NewDefaultProvider(ingressTagPrefix, config.ClusterName)
 	elbv2TaggingManager := elbv2deploy.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), config.FeatureGates, logger)
+	certDiscovery := certs.NewACMCertDiscovery(cloud.ACM(), cloud.RGT(), certs.NewCertSelectionPolicy(config.IngressConfig.CertDiscovery), logger)
+	var certRequester ingress.CertRequester
+	if len(config.IngressConfig.CertRequestHostedZoneIDs) != 0 {
+		certRequester = ingress.NewACMCertRequester(cloud.ACM(), cloud.RGT(), cloud.Route53(), config.IngressConfig.CertRequestHostedZoneIDs,
+			trackingProvider, config.DefaultTags, logger)
+	}
+	groupEventChan := make(chan event.GenericEvent)
+	awsSecretsManager := ingress.NewDefaultAWSSecretsManager(cloud.SecretsManager(), groupEventChan, logger.WithName("aws-secrets-manager"))
+	caBundleMonitor := ingress.NewDefaultCABundleMonitor(clientSet, groupEventChan, logger.WithName("ca-bundle-monitor"))
+	ruleOptimizer := ingress.NewDefaultRuleOptimizer(config.FeatureGates, logger)
 	modelBuilder := ingress.NewDefaultModelBuilder(k8sClient, eventRecorder,
-		cloud.EC2(), cloud.ACM(),
+		cloud.EC2(), certDiscovery, certRequester, awsSecretsManager, caBundleMonitor,
 		annotationParser, subnetsResolver,
-		authConfigBuilder, enhancedBackendBuilder, trackingProvider, elbv2TaggingManager,
+		authConfigBuilder, enhancedBackendBuilder, ruleOptimizer, trackingProvider, elbv2TaggingManager,
 		cloud.VpcID(), config.ClusterName, config.DefaultTags, config.ExternalManagedTags,
-		config.DefaultSSLPolicy, backendSGProvider, config.EnableBackendSecurityGroup, config.DisableRestrictedSGRules, logger)
+		config.DefaultSSLPolicy, backendSGProvider, config.EnableBackendSecurityGroup, config.DisableRestrictedSGRules,
+		len(config.IngressConfig.TrustStoreS3Bucket) != 0, config.FeatureGates, logger)
 	stackMarshaller := deploy.NewDefaultStackMarshaller()
 	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler,
 		config, ingressTagPrefix, logger)
+	stackPlanner := deploy.NewDryRunStackDeployer(cloud, k8sClient, networkingSGManager, config, ingressTagPrefix, logger)
+	planWriter := plan.NewDefaultConfigMapWriter(k8sClient)
 	classLoader := ingress.NewDefaultClassLoader(k8sClient)
 	classAnnotationMatcher := ingress.NewDefaultClassAnnotationMatcher(config.IngressConfig.IngressClass)
 	manageIngressesWithoutIngressClass := config.IngressConfig.IngressClass == ""
 	groupLoader := ingress.NewDefaultGroupLoader(k8sClient, eventRecorder, annotationParser, classLoader, classAnnotationMatcher, manageIngressesWithoutIngressClass)
 	groupFinalizerManager := ingress.NewDefaultFinalizerManager(finalizerManager)
+	memberQuarantiner := ingress.NewDefaultMemberQuarantiner(k8sClient)
 
 	return &groupReconciler{
 		k8sClient:         k8sClient,
 		eventRecorder:     eventRecorder,
 		referenceIndexer:  referenceIndexer,
 		modelBuilder:      modelBuilder,
+		certRequester:     certRequester,
 		stackMarshaller:   stackMarshaller,
 		stackDeployer:     stackDeployer,
+		stackPlanner:      stackPlanner,
+		planWriter:        planWriter,
 		backendSGProvider: backendSGProvider,
+		annotationParser:  annotationParser,
+		awsSecretsMonitor: awsSecretsManager,
+		groupEventChan:    groupEventChan,
 
 		groupLoader:           groupLoader,
 		groupFinalizerManager: groupFinalizerManager,
+		memberQuarantiner:     memberQuarantiner,
 		logger:                logger,
 
+		ruleOptimizerMetricsCollector: ruleOptimizerMetricsCollector,
+
 		maxConcurrentReconciles: config.IngressConfig.MaxConcurrentReconciles,
+		dryRun:                  config.DryRun,
+		featureGates:            config.FeatureGates,
 	}
 }
 
@@ -90,26 +127,41 @@ type groupReconciler struct {
 	eventRecorder     record.EventRecorder
 	referenceIndexer  ingress.ReferenceIndexer
 	modelBuilder      ingress.ModelBuilder
+	certRequester     ingress.CertRequester
 	stackMarshaller   deploy.StackMarshaller
 	stackDeployer     deploy.StackDeployer
+	stackPlanner      deploy.StackPlanner
+	planWriter        plan.ConfigMapWriter
 	backendSGProvider networkingpkg.BackendSGProvider
 	secretsManager    k8s.SecretsManager
+	annotationParser  annotations.Parser
+	awsSecretsMonitor manager.Runnable
+	groupEventChan    chan event.GenericEvent
 
 	groupLoader           ingress.GroupLoader
 	groupFinalizerManager ingress.FinalizerManager
+	memberQuarantiner     ingress.MemberQuarantiner
 	logger                logr.Logger
 
+	// ruleOptimizerMetricsCollector is optional, it only observes models that are deployed.
+	ruleOptimizerMetricsCollector ingress.RuleOptimizerMetricsCollector
+
 	maxConcurrentReconciles int
+	dryRun                  bool
+	featureGates            config.FeatureGates
 }
 
 // +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=ingressclassparams,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=trafficshifts,verbs=get;list;watch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses/status,verbs=update;patch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingressclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=referencegrants,verbs=get;list;watch
 // +kubebuilder:rbac:groups=extensions,resources=ingresses,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=extensions,resources=ingresses/status,verbs=update;patch
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 
 func (r *groupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
@@ -126,17 +178,28 @@ func (r *groupReconciler) reconcile(ctx context.Context, req ctrl.Request) error
 		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
 		return err
 	}
-	_, lb, err := r.buildAndDeployModel(ctx, ingGroup)
+	dryRun, err := r.isDryRun(ingGroup)
 	if err != nil {
 		return err
 	}
-
-	if len(ingGroup.Members) > 0 && lb != nil {
-		lbDNS, err := lb.DNSName().Resolve(ctx)
-		if err != nil {
+	if dryRun {
+		if err := r.buildAndPlanModel(ctx, ingGroup); err != nil {
 			return err
 		}
-		if err := r.updateIngressGroupStatus(ctx, ingGroup, lbDNS); err != nil {
+		// the rules of inactive members are removed on the next deployment of IngressGroup, thus their finalizers can be removed.
+		// when no active members are left, the finalizers are kept so that the load balancer isn't left behind.
+		if len(ingGroup.Members) > 0 && len(ingGroup.InactiveMembers) > 0 {
+			return r.removeInactiveMemberFinalizers(ctx, ingGroup)
+		}
+		return nil
+	}
+	stack, lb, lbByIngKey, quarantined, err := r.buildAndDeployModel(ctx, ingGroup)
+	if err != nil {
+		return err
+	}
+
+	if len(ingGroup.Members) > 0 && lb != nil {
+		if err := r.updateIngressGroupStatus(ctx, ingGroup, stack, lb, lbByIngKey, quarantined); err != nil {
 			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
 			return err
 		}
@@ -149,36 +212,166 @@ func (r *groupReconciler) reconcile(ctx context.Context, req ctrl.Request) error
 	}
 
 	if len(ingGroup.InactiveMembers) > 0 {
-		if err := r.groupFinalizerManager.RemoveGroupFinalizer(ctx, ingGroupID, ingGroup.InactiveMembers); err != nil {
-			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
+		if err := r.removeInactiveMemberFinalizers(ctx, ingGroup); err != nil {
 			return err
 		}
 	}
 
-	r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeNormal, k8s.IngressEventReasonSuccessfullyReconciled, "Successfully reconciled")
+	r.recordIngressGroupEvent(ctx, excludeQuarantinedMembers(ingGroup, quarantined), corev1.EventTypeNormal, k8s.IngressEventReasonSuccessfullyReconciled, "Successfully reconciled")
+	// members quarantined while waiting for requested certificates are rebuilt once the certificates are issued.
+	return findQuarantinedMemberRequeue(quarantined)
+}
+
+// removeInactiveMemberFinalizers removes the group finalizer from inactive members of ingGroup.
+func (r *groupReconciler) removeInactiveMemberFinalizers(ctx context.Context, ingGroup ingress.Group) error {
+	if err := r.groupFinalizerManager.RemoveGroupFinalizer(ctx, ingGroup.ID, ingGroup.InactiveMembers); err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
+		return err
+	}
 	return nil
 }
 
-func (r *groupReconciler) buildAndDeployModel(ctx context.Context, ingGroup ingress.Group) (core.Stack, *elbv2model.LoadBalancer, error) {
-	stack, lb, secrets, err := r.modelBuilder.Build(ctx, ingGroup)
+func (r *groupReconciler) buildAndDeployModel(ctx context.Context, ingGroup ingress.Group) (core.Stack, *elbv2model.LoadBalancer, map[types.NamespacedName]*elbv2model.LoadBalancer, []ingress.QuarantinedMember, error) {
+	ruleOptimizerMetrics := ingress.NewPendingRuleOptimizerMetrics()
+	stack, lb, lbByIngKey, secrets, modelGroup, quarantined, err := r.buildModel(ingress.ContextWithRuleOptimizerMetricsCollector(ctx, ruleOptimizerMetrics), ingGroup)
 	if err != nil {
-		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
-		return nil, nil, err
+		r.recordBuildModelFailure(ctx, ingGroup, err)
+		return nil, nil, nil, nil, err
 	}
 	stackJSON, err := r.stackMarshaller.Marshal(stack)
 	if err != nil {
 		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	r.logger.Info("successfully built model", "model", stackJSON)
 
 	if err := r.stackDeployer.Deploy(ctx, stack); err != nil {
 		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
-		return nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 	r.logger.Info("successfully deployed model", "ingressGroup", ingGroup.ID)
+	if r.certRequester != nil {
+		// certificates requested for hosts of quarantined members are kept, they're still waited for.
+		if err := r.certRequester.Cleanup(ctx, core.StackID(ingGroup.ID), ingress.ComputeGroupTLSHosts(ingGroup)); err != nil {
+			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedCleanupCertificates, fmt.Sprintf("Failed cleanup requested certificates due to %v", err))
+			return nil, nil, nil, nil, err
+		}
+	}
+	if r.ruleOptimizerMetricsCollector != nil {
+		ruleOptimizerMetrics.Flush(r.ruleOptimizerMetricsCollector)
+	}
+	if err := r.memberQuarantiner.Remember(ctx, modelGroup, quarantined); err != nil {
+		return nil, nil, nil, nil, err
+	}
 	r.secretsManager.MonitorSecrets(ingGroup.ID.String(), secrets)
-	return stack, lb, err
+	return stack, lb, lbByIngKey, quarantined, err
+}
+
+// buildAndPlanModel builds the model for ingGroup and plans the changes to deploy it without applying them.
+// the plan is written into a ConfigMap for each member Ingress.
+func (r *groupReconciler) buildAndPlanModel(ctx context.Context, ingGroup ingress.Group) error {
+	stack, _, _, _, _, _, err := r.buildModel(ctx, ingGroup, ingress.WithDryRun(true))
+	if err != nil {
+		r.recordBuildModelFailure(ctx, ingGroup, err)
+		return err
+	}
+	stackPlan, err := r.stackPlanner.Plan(ctx, stack)
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedDeployModel, fmt.Sprintf("Failed plan model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully planned model", "ingressGroup", ingGroup.ID, "plan", stackPlan)
+	for _, member := range ingGroup.Members {
+		if err := r.planWriter.Write(ctx, member.Ing, stackPlan); err != nil {
+			return err
+		}
+		r.eventRecorder.Event(member.Ing, corev1.EventTypeNormal, k8s.IngressEventReasonPlannedChanges,
+			fmt.Sprintf("Planned changes without applying them: %v, see configMap %v", stackPlan.Summary(), plan.ConfigMapKeyForOwner(member.Ing)))
+	}
+	return nil
+}
+
+// isDryRun checks whether changes to the load balancer of ingGroup should only be planned instead of applied,
+// which is the case when controller runs in dry-run mode, or any active or inactive member Ingress requested it.
+func (r *groupReconciler) isDryRun(ingGroup ingress.Group) (bool, error) {
+	if r.dryRun {
+		return true, nil
+	}
+	ings := make([]*networking.Ingress, 0, len(ingGroup.Members)+len(ingGroup.InactiveMembers))
+	for _, member := range ingGroup.Members {
+		ings = append(ings, member.Ing)
+	}
+	ings = append(ings, ingGroup.InactiveMembers...)
+	for _, ing := range ings {
+		dryRun := false
+		if _, err := r.annotationParser.ParseBoolAnnotation(annotations.IngressSuffixDryRun, &dryRun, ing.Annotations); err != nil {
+			return false, errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing))
+		}
+		if dryRun {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// buildModel builds the model for ingGroup, member Ingresses that fail to build are quarantined instead of failing the whole IngressGroup.
+// the RuleOptimizer metrics of the returned model are reported to the RuleOptimizerMetricsCollector of ctx if any.
+// the IngressGroup used to build the model is returned along with the quarantined members.
+func (r *groupReconciler) buildModel(ctx context.Context, ingGroup ingress.Group, opts ...ingress.ModelBuildOption) (core.Stack, *elbv2model.LoadBalancer, map[types.NamespacedName]*elbv2model.LoadBalancer,
+	[]types.NamespacedName, ingress.Group, []ingress.QuarantinedMember, error) {
+	var quarantined []ingress.QuarantinedMember
+	modelGroup := ingGroup
+	for {
+		// metrics of builds discarded by quarantine are dropped.
+		buildMetrics := ingress.NewPendingRuleOptimizerMetrics()
+		stack, lb, lbByIngKey, secrets, err := r.modelBuilder.Build(ingress.ContextWithRuleOptimizerMetricsCollector(ctx, buildMetrics), modelGroup, opts...)
+		if err == nil {
+			if metricsCollector := ingress.ContextGetRuleOptimizerMetricsCollector(ctx); metricsCollector != nil {
+				buildMetrics.Flush(metricsCollector)
+			}
+			return stack, lb, lbByIngKey, secrets, modelGroup, quarantined, nil
+		}
+		quarantinedGroup, member, ok, quarantineErr := r.memberQuarantiner.Quarantine(ctx, modelGroup, err)
+		if quarantineErr != nil {
+			return nil, nil, nil, nil, ingress.Group{}, nil, quarantineErr
+		}
+		if !ok {
+			return nil, nil, nil, nil, ingress.Group{}, nil, err
+		}
+		r.logger.Info("quarantined ingress", "ingressGroup", ingGroup.ID, "ingress", k8s.NamespacedName(member.Ing),
+			"lastKnownGood", member.LastKnownGood, "error", member.Err.Error())
+		r.eventRecorder.Event(member.Ing, corev1.EventTypeWarning, k8s.IngressEventReasonQuarantined, quarantineEventMessage(member))
+		quarantined = append(quarantined, member)
+		modelGroup = quarantinedGroup
+	}
+}
+
+// findQuarantinedMemberRequeue returns the earliest requeue needed by members quarantined while waiting, if any.
+func findQuarantinedMemberRequeue(quarantined []ingress.QuarantinedMember) error {
+	var earliest *runtime.RequeueNeededAfter
+	for _, member := range quarantined {
+		var requeueNeededAfter *runtime.RequeueNeededAfter
+		if !errors.As(member.Err, &requeueNeededAfter) {
+			continue
+		}
+		if earliest == nil || requeueNeededAfter.Duration() < earliest.Duration() {
+			earliest = requeueNeededAfter
+		}
+	}
+	if earliest == nil {
+		return nil
+	}
+	return earliest
+}
+
+// recordBuildModelFailure records the build failure on member Ingresses of ingGroup.
+// the model build requeued to wait for requested certificates is not a failure, its progress is already recorded by the model builder.
+func (r *groupReconciler) recordBuildModelFailure(ctx context.Context, ingGroup ingress.Group, err error) {
+	var requeueNeededAfter *runtime.RequeueNeededAfter
+	if errors.As(err, &requeueNeededAfter) {
+		return
+	}
+	r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
 }
 
 func (r *groupReconciler) recordIngressGroupEvent(_ context.Context, ingGroup ingress.Group, eventType string, reason string, message string) {
@@ -187,25 +380,74 @@ func (r *groupReconciler) recordIngressGroupEvent(_ context.Context, ingGroup in
 	}
 }
 
-func (r *groupReconciler) updateIngressGroupStatus(ctx context.Context, ingGroup ingress.Group, lbDNS string) error {
+// updateIngressGroupStatus updates the status of member Ingresses with the DNS name of the LoadBalancer hosting them, and its frontend NLB if any.
+// members absent from lbByIngKey, i.e. quarantined members dropped from the model, are reported with the primary LoadBalancer lb.
+func (r *groupReconciler) updateIngressGroupStatus(ctx context.Context, ingGroup ingress.Group, stack core.Stack,
+	lb *elbv2model.LoadBalancer, lbByIngKey map[types.NamespacedName]*elbv2model.LoadBalancer, quarantined []ingress.QuarantinedMember) error {
+	var listeners []*elbv2model.Listener
+	if err := stack.ListResources(&listeners); err != nil {
+		return err
+	}
+	listenersByLBARN := make(map[string][]*elbv2model.Listener)
+	for _, ls := range listeners {
+		lbARN, err := ls.Spec.LoadBalancerARN.Resolve(ctx)
+		if err != nil {
+			return err
+		}
+		listenersByLBARN[lbARN] = append(listenersByLBARN[lbARN], ls)
+	}
+	quarantinedKeys := sets.NewString()
+	for _, member := range quarantined {
+		quarantinedKeys.Insert(k8s.NamespacedName(member.Ing).String())
+	}
 	for _, member := range ingGroup.Members {
-		if err := r.updateIngressStatus(ctx, lbDNS, member.Ing); err != nil {
+		memberLB, ok := lbByIngKey[k8s.NamespacedName(member.Ing)]
+		if !ok {
+			memberLB = lb
+		}
+		lbARN, err := memberLB.LoadBalancerARN().Resolve(ctx)
+		if err != nil {
+			return err
+		}
+		lbDNS, err := memberLB.DNSName().Resolve(ctx)
+		if err != nil {
+			return err
+		}
+		var portStatuses []corev1.PortStatus
+		if quarantinedKeys.Has(k8s.NamespacedName(member.Ing).String()) {
+			portStatuses = buildQuarantinedPortStatuses(listenersByLBARN[lbARN])
+		}
+		lbIngresses := []corev1.LoadBalancerIngress{
+			{
+				Hostname: lbDNS,
+				Ports:    portStatuses,
+			},
+		}
+		frontendNLB, err := ingress.FindFrontendNLB(stack, memberLB)
+		if err != nil {
+			return err
+		}
+		if frontendNLB != nil {
+			frontendNLBDNS, err := frontendNLB.DNSName().Resolve(ctx)
+			if err != nil {
+				return err
+			}
+			lbIngresses = append(lbIngresses, corev1.LoadBalancerIngress{
+				Hostname: frontendNLBDNS,
+			})
+		}
+		if err := r.updateIngressStatus(ctx, lbIngresses, member.Ing); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (r *groupReconciler) updateIngressStatus(ctx context.Context, lbDNS string, ing *networking.Ingress) error {
-	if len(ing.Status.LoadBalancer.Ingress) != 1 ||
-		ing.Status.LoadBalancer.Ingress[0].IP != "" ||
-		ing.Status.LoadBalancer.Ingress[0].Hostname != lbDNS {
+// updateIngressStatus updates the status of ing with lbIngresses, the first of which is the ALB, followed by the frontend NLB if any.
+func (r *groupReconciler) updateIngressStatus(ctx context.Context, lbIngresses []corev1.LoadBalancerIngress, ing *networking.Ingress) error {
+	if !equality.Semantic.DeepEqual(ing.Status.LoadBalancer.Ingress, lbIngresses) {
 		ingOld := ing.DeepCopy()
-		ing.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{
-			{
-				Hostname: lbDNS,
-			},
-		}
+		ing.Status.LoadBalancer.Ingress = lbIngresses
 		if err := r.k8sClient.Status().Patch(ctx, ing, client.MergeFrom(ingOld)); err != nil {
 			return errors.Wrapf(err, "failed to update ingress status: %v", k8s.NamespacedName(ing))
 		}
@@ -230,7 +472,18 @@ func (r *groupReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager
 	if err := r.setupIndexes(ctx, mgr.GetFieldIndexer(), ingressClassResourceAvailable); err != nil {
 		return err
 	}
-	if err := r.setupWatches(ctx, c, ingressClassResourceAvailable, clientSet); err != nil {
+	referenceGrantResourceAvailable, err := k8s.IsKindInstalled(mgr.GetRESTMapper(), gwapi.ReferenceGrantGVK)
+	if err != nil {
+		return err
+	}
+	trafficShiftResourceAvailable, err := k8s.IsKindInstalled(mgr.GetRESTMapper(), elbv2api.GroupVersion.WithKind(trafficShiftKind))
+	if err != nil {
+		return err
+	}
+	if err := r.setupWatches(ctx, c, ingressClassResourceAvailable, referenceGrantResourceAvailable, trafficShiftResourceAvailable, clientSet); err != nil {
+		return err
+	}
+	if err := mgr.Add(r.awsSecretsMonitor); err != nil {
 		return err
 	}
 	return nil
@@ -244,6 +497,13 @@ func (r *groupReconciler) setupIndexes(ctx context.Context, fieldIndexer client.
 	); err != nil {
 		return err
 	}
+	if err := fieldIndexer.IndexField(ctx, &networking.Ingress{}, ingress.IndexKeyServiceRefNamespace,
+		func(obj client.Object) []string {
+			return r.referenceIndexer.BuildServiceRefNamespaceIndexes(context.Background(), obj.(*networking.Ingress))
+		},
+	); err != nil {
+		return err
+	}
 	if err := fieldIndexer.IndexField(ctx, &networking.Ingress{}, ingress.IndexKeySecretRefName,
 		func(obj client.Object) []string {
 			return r.referenceIndexer.BuildSecretRefIndexes(context.Background(), obj.(*networking.Ingress))
@@ -277,7 +537,8 @@ func (r *groupReconciler) setupIndexes(ctx context.Context, fieldIndexer client.
 	return nil
 }
 
-func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controller, ingressClassResourceAvailable bool, clientSet *kubernetes.Clientset) error {
+func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controller, ingressClassResourceAvailable bool,
+	referenceGrantResourceAvailable bool, trafficShiftResourceAvailable bool, clientSet *kubernetes.Clientset) error {
 	ingEventChan := make(chan event.GenericEvent)
 	svcEventChan := make(chan event.GenericEvent)
 	secretEventsChan := make(chan event.GenericEvent)
@@ -287,6 +548,7 @@ func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controlle
 		r.logger.WithName("eventHandlers").WithName("service"))
 	secretEventHandler := eventhandlers.NewEnqueueRequestsForSecretEvent(ingEventChan, svcEventChan, r.k8sClient, r.eventRecorder,
 		r.logger.WithName("eventHandlers").WithName("secret"))
+	groupEventHandler := eventhandlers.NewEnqueueRequestsForGroupEvent(r.logger.WithName("eventHandlers").WithName("group"))
 	if err := c.Watch(&source.Channel{Source: ingEventChan}, ingEventHandler); err != nil {
 		return err
 	}
@@ -302,6 +564,24 @@ func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controlle
 	if err := c.Watch(&source.Channel{Source: secretEventsChan}, secretEventHandler); err != nil {
 		return err
 	}
+	if err := c.Watch(&source.Channel{Source: r.groupEventChan}, groupEventHandler); err != nil {
+		return err
+	}
+	// TrafficShifts are only watched when their CRD is installed, as helm upgrades don't install new CRDs.
+	if r.featureGates.Enabled(config.WeightedTargetGroups) && trafficShiftResourceAvailable {
+		trafficShiftEventHandler := eventhandlers.NewEnqueueRequestsForTrafficShiftEvent(ingEventChan, r.k8sClient, r.eventRecorder,
+			r.logger.WithName("eventHandlers").WithName("trafficShift"))
+		if err := c.Watch(&source.Kind{Type: &elbv2api.TrafficShift{}}, trafficShiftEventHandler); err != nil {
+			return err
+		}
+	}
+	if referenceGrantResourceAvailable {
+		refGrantEventHandler := eventhandlers.NewEnqueueRequestsForReferenceGrantEvent(ingEventChan, r.k8sClient, r.eventRecorder,
+			r.logger.WithName("eventHandlers").WithName("referenceGrant"))
+		if err := c.Watch(&source.Kind{Type: gwapi.NewUnstructured(gwapi.ReferenceGrantGVK)}, refGrantEventHandler); err != nil {
+			return err
+		}
+	}
 	if ingressClassResourceAvailable {
 		ingClassEventChan := make(chan event.GenericEvent)
 		ingClassParamsEventHandler := eventhandlers.NewEnqueueRequestsForIngressClassParamsEvent(ingClassEventChan, r.k8sClient, r.eventRecorder,
@@ -322,6 +602,56 @@ func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controlle
 	return nil
 }
 
+// excludeQuarantinedMembers returns ingGroup without the quarantined members.
+func excludeQuarantinedMembers(ingGroup ingress.Group, quarantined []ingress.QuarantinedMember) ingress.Group {
+	if len(quarantined) == 0 {
+		return ingGroup
+	}
+	quarantinedKeys := sets.NewString()
+	for _, member := range quarantined {
+		quarantinedKeys.Insert(k8s.NamespacedName(member.Ing).String())
+	}
+	members := make([]ingress.ClassifiedIngress, 0, len(ingGroup.Members))
+	for _, member := range ingGroup.Members {
+		if !quarantinedKeys.Has(k8s.NamespacedName(member.Ing).String()) {
+			members = append(members, member)
+		}
+	}
+	return ingress.Group{
+		ID:              ingGroup.ID,
+		Members:         members,
+		InactiveMembers: ingGroup.InactiveMembers,
+	}
+}
+
+// quarantineEventMessage builds the message of the event for a quarantined member Ingress.
+func quarantineEventMessage(member ingress.QuarantinedMember) string {
+	if member.LastKnownGood {
+		return fmt.Sprintf("Quarantined due to %v, keeping rules from last successful reconcile", member.Err)
+	}
+	return fmt.Sprintf("Quarantined due to %v, keeping existing rules as is", member.Err)
+}
+
+// buildQuarantinedPortStatuses builds the Ingress status ports for quarantined member Ingress.
+func buildQuarantinedPortStatuses(listeners []*elbv2model.Listener) []corev1.PortStatus {
+	ports := make([]int64, 0, len(listeners))
+	for _, ls := range listeners {
+		ports = append(ports, ls.Spec.Port)
+	}
+	sort.Slice(ports, func(i, j int) bool {
+		return ports[i] < ports[j]
+	})
+	portStatuses := make([]corev1.PortStatus, 0, len(ports))
+	for _, port := range ports {
+		portStatuses = append(portStatuses, corev1.PortStatus{
+			Port:     int32(port),
+			Protocol: corev1.ProtocolTCP,
+			Error:    awssdk.String(ingress.PortStatusErrorQuarantined),
+		})
+	}
+	return portStatuses
+}
+
 // isResourceKindAvailable checks whether specific kind is available.
 func isResourceKindAvailable(resList *metav1.APIResourceList, kind string) bool {
 	for _, res := range resList.APIResources {
@@ -1,12 +1,15 @@
 package eventhandlers
 
 import (
+	"fmt"
+
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	svcpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/service"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -15,10 +18,11 @@ import (
 
 // NewEnqueueRequestForServiceEvent constructs new enqueueRequestsForServiceEvent.
 func NewEnqueueRequestForServiceEvent(eventRecorder record.EventRecorder,
-	serviceUtils svcpkg.ServiceUtils, logger logr.Logger) *enqueueRequestsForServiceEvent {
+	serviceUtils svcpkg.ServiceUtils, groupLoader svcpkg.GroupLoader, logger logr.Logger) *enqueueRequestsForServiceEvent {
 	return &enqueueRequestsForServiceEvent{
 		eventRecorder: eventRecorder,
 		serviceUtils:  serviceUtils,
+		groupLoader:   groupLoader,
 		logger:        logger,
 	}
 }
@@ -28,6 +32,7 @@ var _ handler.EventHandler = (*enqueueRequestsForServiceEvent)(nil)
 type enqueueRequestsForServiceEvent struct {
 	eventRecorder record.EventRecorder
 	serviceUtils  svcpkg.ServiceUtils
+	groupLoader   svcpkg.GroupLoader
 	logger        logr.Logger
 }
 
@@ -58,8 +63,21 @@ func (h *enqueueRequestsForServiceEvent) Generic(e event.GenericEvent, queue wor
 }
 
 func (h *enqueueRequestsForServiceEvent) enqueueManagedService(queue workqueue.RateLimitingInterface, service *corev1.Service) {
+	// the Service groups that service belongs to, or is pending finalization for, need to be reconciled.
+	groupID, inGroup, err := h.groupLoader.LoadGroupIDIfAny(service)
+	if err != nil {
+		h.eventRecorder.Event(service, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedLoadGroupName, fmt.Sprintf("Failed load groupName due to %v", err))
+	}
+	groupIDs := h.groupLoader.LoadGroupIDsPendingFinalization(service)
+	if inGroup {
+		groupIDs = append(groupIDs, groupID)
+	}
+	for _, id := range groupIDs {
+		queue.Add(svcpkg.EncodeGroupIDToReconcileRequest(id))
+	}
+
 	// Check if the svc needs to be handled
-	if !h.serviceUtils.IsServicePendingFinalization(service) && !h.serviceUtils.IsServiceSupported(service) {
+	if !h.serviceUtils.IsServicePendingFinalization(service) && (inGroup || !h.serviceUtils.IsServiceSupported(service)) {
 		return
 	}
 	queue.Add(reconcile.Request{
@@ -6,13 +6,16 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/service/eventhandlers"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certs"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
@@ -41,11 +44,16 @@ func NewServiceReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorde
 	annotationParser := annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix)
 	trackingProvider := tracking.NewDefaultProvider(serviceTagPrefix, config.ClusterName)
 	elbv2TaggingManager := elbv2.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), config.FeatureGates, logger)
+	sgResolver := networking.NewDefaultSecurityGroupResolver(cloud.EC2(), cloud.VpcID())
 	serviceUtils := service.NewServiceUtils(annotationParser, serviceFinalizer, config.ServiceConfig.LoadBalancerClass, config.FeatureGates)
-	modelBuilder := service.NewDefaultModelBuilder(annotationParser, subnetsResolver, vpcInfoProvider, cloud.VpcID(), trackingProvider,
-		elbv2TaggingManager, config.ClusterName, config.DefaultTags, config.ExternalManagedTags, config.DefaultSSLPolicy, serviceUtils)
+	certDiscovery := certs.NewACMCertDiscovery(cloud.ACM(), cloud.RGT(), certs.NewCertSelectionPolicy(config.IngressConfig.CertDiscovery), logger)
+	modelBuilder := service.NewDefaultModelBuilder(annotationParser, subnetsResolver, vpcInfoProvider, sgResolver, cloud.VpcID(), trackingProvider,
+		elbv2TaggingManager, certDiscovery, config.ClusterName, config.DefaultTags, config.ExternalManagedTags, config.DefaultSSLPolicy, serviceUtils, config.FeatureGates)
+	groupLoader := service.NewDefaultGroupLoader(k8sClient, annotationParser, serviceUtils)
 	stackMarshaller := deploy.NewDefaultStackMarshaller()
 	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, config, serviceTagPrefix, logger)
+	stackPlanner := deploy.NewDryRunStackDeployer(cloud, k8sClient, networkingSGManager, config, serviceTagPrefix, logger)
+	planWriter := plan.NewDefaultConfigMapWriter(k8sClient)
 	return &serviceReconciler{
 		k8sClient:         k8sClient,
 		eventRecorder:     eventRecorder,
@@ -53,13 +61,18 @@ func NewServiceReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorde
 		annotationParser:  annotationParser,
 		loadBalancerClass: config.ServiceConfig.LoadBalancerClass,
 		serviceUtils:      serviceUtils,
+		groupLoader:       groupLoader,
 
-		modelBuilder:    modelBuilder,
-		stackMarshaller: stackMarshaller,
-		stackDeployer:   stackDeployer,
-		logger:          logger,
+		modelBuilder:      modelBuilder,
+		groupModelBuilder: modelBuilder,
+		stackMarshaller:   stackMarshaller,
+		stackDeployer:     stackDeployer,
+		stackPlanner:      stackPlanner,
+		planWriter:        planWriter,
+		logger:            logger,
 
 		maxConcurrentReconciles: config.ServiceMaxConcurrentReconciles,
+		dryRun:                  config.DryRun,
 	}
 }
 
@@ -70,24 +83,33 @@ type serviceReconciler struct {
 	annotationParser  annotations.Parser
 	loadBalancerClass string
 	serviceUtils      service.ServiceUtils
+	groupLoader       service.GroupLoader
 
-	modelBuilder    service.ModelBuilder
-	stackMarshaller deploy.StackMarshaller
-	stackDeployer   deploy.StackDeployer
-	logger          logr.Logger
+	modelBuilder      service.ModelBuilder
+	groupModelBuilder service.GroupModelBuilder
+	stackMarshaller   deploy.StackMarshaller
+	stackDeployer     deploy.StackDeployer
+	stackPlanner      deploy.StackPlanner
+	planWriter        plan.ConfigMapWriter
+	logger            logr.Logger
 
 	maxConcurrentReconciles int
+	dryRun                  bool
 }
 
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=services/status,verbs=update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;create;update;patch
 
 func (r *serviceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
 }
 
 func (r *serviceReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
+	if groupID, isGroup := service.DecodeGroupIDFromReconcileRequest(req); isGroup {
+		return r.reconcileGroup(ctx, groupID)
+	}
 	svc := &corev1.Service{}
 	if err := r.k8sClient.Get(ctx, req.NamespacedName, svc); err != nil {
 		return client.IgnoreNotFound(err)
@@ -96,12 +118,52 @@ func (r *serviceReconciler) reconcile(ctx context.Context, req ctrl.Request) err
 	if err != nil {
 		return err
 	}
+	if lb == nil && !k8s.HasFinalizer(svc, serviceFinalizer) {
+		return nil
+	}
+	dryRun, err := r.isDryRun(svc)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		return r.planModel(ctx, svc, stack)
+	}
 	if lb == nil {
 		return r.cleanupLoadBalancerResources(ctx, svc, stack)
 	}
 	return r.reconcileLoadBalancerResources(ctx, svc, stack, lb)
 }
 
+// isDryRun checks whether changes to the load balancer of svc should only be planned instead of applied,
+// which is the case when controller runs in dry-run mode, or the service requested it.
+func (r *serviceReconciler) isDryRun(svc *corev1.Service) (bool, error) {
+	if r.dryRun {
+		return true, nil
+	}
+	dryRun := false
+	if _, err := r.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixDryRun, &dryRun, svc.Annotations); err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return false, err
+	}
+	return dryRun, nil
+}
+
+// planModel plans the changes to deploy the model for svc without applying them, the plan is written into a ConfigMap.
+func (r *serviceReconciler) planModel(ctx context.Context, svc *corev1.Service, stack core.Stack) error {
+	stackPlan, err := r.stackPlanner.Plan(ctx, stack)
+	if err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedDeployModel, fmt.Sprintf("Failed plan model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully planned model", "service", k8s.NamespacedName(svc), "plan", stackPlan)
+	if err := r.planWriter.Write(ctx, svc, stackPlan); err != nil {
+		return err
+	}
+	r.eventRecorder.Event(svc, corev1.EventTypeNormal, k8s.ServiceEventReasonPlannedChanges,
+		fmt.Sprintf("Planned changes without applying them: %v, see configMap %v", stackPlan.Summary(), plan.ConfigMapKeyForOwner(svc)))
+	return nil
+}
+
 func (r *serviceReconciler) buildModel(ctx context.Context, svc *corev1.Service) (core.Stack, *elbv2model.LoadBalancer, error) {
 	stack, lb, err := r.modelBuilder.Build(ctx, svc)
 	if err != nil {
@@ -155,9 +217,12 @@ func (r *serviceReconciler) cleanupLoadBalancerResources(ctx context.Context, sv
 		if err != nil {
 			return err
 		}
-		if err = r.cleanupServiceStatus(ctx, svc); err != nil {
-			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedCleanupStatus, fmt.Sprintf("Failed update status due to %v", err))
-			return err
+		// the status of Services that joined a Service group is maintained by the group.
+		if _, inGroup, _ := r.groupLoader.LoadGroupIDIfAny(svc); !inGroup {
+			if err = r.cleanupServiceStatus(ctx, svc); err != nil {
+				r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedCleanupStatus, fmt.Sprintf("Failed update status due to %v", err))
+				return err
+			}
 		}
 		if err := r.finalizerManager.RemoveFinalizers(ctx, svc, serviceFinalizer); err != nil {
 			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
@@ -184,6 +249,112 @@ func (r *serviceReconciler) updateServiceStatus(ctx context.Context, lbDNS strin
 	return nil
 }
 
+// reconcileGroup reconciles the load balancer shared by the Service group with groupID.
+// active members get the group finalizer and the load balancer hostname in status, while inactive members are released from the group.
+// members excluded from the load balancer due to build errors keep the group finalizer, but not the load balancer hostname.
+func (r *serviceReconciler) reconcileGroup(ctx context.Context, groupID service.GroupID) error {
+	group, err := r.groupLoader.Load(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	finalizer := service.BuildGroupFinalizer(groupID.Name)
+	if !r.dryRun {
+		for _, member := range group.Members {
+			if err := r.finalizerManager.AddFinalizers(ctx, member, finalizer); err != nil {
+				r.eventRecorder.Event(member, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
+				return err
+			}
+		}
+	}
+	stack, lb, memberErrs, err := r.buildGroupModel(ctx, group)
+	if err != nil {
+		return err
+	}
+	excludedMembers := sets.NewString()
+	for _, memberErr := range memberErrs {
+		excludedMembers.Insert(k8s.NamespacedName(memberErr.Member).String())
+	}
+	if r.dryRun {
+		return r.planGroupModel(ctx, group, stack)
+	}
+	if err := r.stackDeployer.Deploy(ctx, stack); err != nil {
+		for _, member := range group.Members {
+			r.eventRecorder.Event(member, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+		}
+		return err
+	}
+	r.logger.Info("successfully deployed model", "serviceGroup", groupID)
+
+	if lb != nil {
+		lbDNS, err := lb.DNSName().Resolve(ctx)
+		if err != nil {
+			return err
+		}
+		for _, member := range group.Members {
+			if excludedMembers.Has(k8s.NamespacedName(member).String()) {
+				if err := r.cleanupServiceStatus(ctx, member); err != nil {
+					r.eventRecorder.Event(member, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedCleanupStatus, fmt.Sprintf("Failed update status due to %v", err))
+					return err
+				}
+				continue
+			}
+			if err := r.updateServiceStatus(ctx, lbDNS, member); err != nil {
+				r.eventRecorder.Event(member, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+				return err
+			}
+			r.eventRecorder.Event(member, corev1.EventTypeNormal, k8s.ServiceEventReasonSuccessfullyReconciled, "Successfully reconciled")
+		}
+	}
+	for _, inactiveMember := range group.InactiveMembers {
+		if err := r.cleanupServiceStatus(ctx, inactiveMember); err != nil {
+			r.eventRecorder.Event(inactiveMember, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedCleanupStatus, fmt.Sprintf("Failed update status due to %v", err))
+			return err
+		}
+		if err := r.finalizerManager.RemoveFinalizers(ctx, inactiveMember, finalizer); err != nil {
+			r.eventRecorder.Event(inactiveMember, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *serviceReconciler) buildGroupModel(ctx context.Context, group service.Group) (core.Stack, *elbv2model.LoadBalancer, []service.GroupMemberError, error) {
+	stack, lb, memberErrs, err := r.groupModelBuilder.BuildGroup(ctx, group)
+	if err != nil {
+		for _, member := range group.Members {
+			r.eventRecorder.Event(member, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		}
+		return nil, nil, nil, err
+	}
+	for _, memberErr := range memberErrs {
+		r.logger.Info("excluded service from serviceGroup", "serviceGroup", group.ID, "service", k8s.NamespacedName(memberErr.Member), "error", memberErr.Err.Error())
+		r.eventRecorder.Event(memberErr.Member, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", memberErr.Err))
+	}
+	stackJSON, err := r.stackMarshaller.Marshal(stack)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	r.logger.Info("successfully built model", "model", stackJSON)
+	return stack, lb, memberErrs, nil
+}
+
+// planGroupModel plans the changes to deploy the model for group without applying them, the plan is written into a ConfigMap for each member.
+func (r *serviceReconciler) planGroupModel(ctx context.Context, group service.Group, stack core.Stack) error {
+	stackPlan, err := r.stackPlanner.Plan(ctx, stack)
+	if err != nil {
+		return err
+	}
+	r.logger.Info("successfully planned model", "serviceGroup", group.ID, "plan", stackPlan)
+	for _, member := range group.Members {
+		if err := r.planWriter.Write(ctx, member, stackPlan); err != nil {
+			return err
+		}
+		r.eventRecorder.Event(member, corev1.EventTypeNormal, k8s.ServiceEventReasonPlannedChanges,
+			fmt.Sprintf("Planned changes without applying them: %v, see configMap %v", stackPlan.Summary(), plan.ConfigMapKeyForOwner(member)))
+	}
+	return nil
+}
+
 func (r *serviceReconciler) cleanupServiceStatus(ctx context.Context, svc *corev1.Service) error {
 	svcOld := svc.DeepCopy()
 	svc.Status.LoadBalancer = corev1.LoadBalancerStatus{}
@@ -209,7 +380,7 @@ func (r *serviceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manag
 
 func (r *serviceReconciler) setupWatches(_ context.Context, c controller.Controller) error {
 	svcEventHandler := eventhandlers.NewEnqueueRequestForServiceEvent(r.eventRecorder,
-		r.serviceUtils, r.logger.WithName("eventHandlers").WithName("service"))
+		r.serviceUtils, r.groupLoader, r.logger.WithName("eventHandlers").WithName("service"))
 	if err := c.Watch(&source.Kind{Type: &corev1.Service{}}, svcEventHandler); err != nil {
 		return err
 	}
@@ -28,16 +28,19 @@ import (
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	elbv2controller "sigs.k8s.io/aws-load-balancer-controller/controllers/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/controllers/gateway"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/service"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/throttle"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	ingresspkg "sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/inject"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/trafficshift"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/version"
 	corewebhook "sigs.k8s.io/aws-load-balancer-controller/webhooks/core"
 	elbv2webhook "sigs.k8s.io/aws-load-balancer-controller/webhooks/elbv2"
@@ -111,15 +114,29 @@ func main() {
 		mgr.GetEventRecorderFor("targetGroupBinding"), ctrl.Log)
 	backendSGProvider := networking.NewBackendSGProvider(controllerCFG.ClusterName, controllerCFG.BackendSecurityGroup,
 		cloud.VpcID(), cloud.EC2(), mgr.GetClient(), controllerCFG.DefaultTags, ctrl.Log.WithName("backend-sg-provider"))
-	ingGroupReconciler := ingress.NewGroupReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("ingress"),
+	ruleOptimizerMetricsCollector, err := ingresspkg.NewRuleOptimizerMetricsCollector(metrics.Registry)
+	if err != nil {
+		setupLog.Error(err, "unable to register rule optimizer metrics")
+		os.Exit(1)
+	}
+	ingGroupReconciler := ingress.NewGroupReconciler(cloud, mgr.GetClient(), clientSet, mgr.GetEventRecorderFor("ingress"),
 		finalizerManager, sgManager, sgReconciler, subnetResolver,
-		controllerCFG, backendSGProvider, ctrl.Log.WithName("controllers").WithName("ingress"))
+		controllerCFG, backendSGProvider, ruleOptimizerMetricsCollector, ctrl.Log.WithName("controllers").WithName("ingress"))
 	svcReconciler := service.NewServiceReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("service"),
 		finalizerManager, sgManager, sgReconciler, subnetResolver, vpcInfoProvider,
 		controllerCFG, ctrl.Log.WithName("controllers").WithName("service"))
+	gwReconciler := gateway.NewGatewayReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("gateway"),
+		finalizerManager, sgManager, sgReconciler, subnetResolver, vpcInfoProvider,
+		controllerCFG, ctrl.Log.WithName("controllers").WithName("gateway"))
 	tgbReconciler := elbv2controller.NewTargetGroupBindingReconciler(mgr.GetClient(), mgr.GetEventRecorderFor("targetGroupBinding"),
 		finalizerManager, tgbResManager,
 		controllerCFG, ctrl.Log.WithName("controllers").WithName("targetGroupBinding"))
+	tsHealthChecker := trafficshift.NewDefaultHealthChecker(mgr.GetClient(),
+		targetgroupbinding.NewCachedTargetsManager(cloud.ELBV2(), ctrl.Log), ctrl.Log.WithName("traffic-shift-health-checker"))
+	tsResManager := trafficshift.NewDefaultResourceManager(mgr.GetClient(), tsHealthChecker,
+		mgr.GetEventRecorderFor("trafficShift"), ctrl.Log)
+	tsReconciler := elbv2controller.NewTrafficShiftReconciler(mgr.GetClient(), tsResManager,
+		ctrl.Log.WithName("controllers").WithName("trafficShift"))
 
 	ctx := ctrl.SetupSignalHandler()
 	if err = ingGroupReconciler.SetupWithManager(ctx, mgr, clientSet); err != nil {
@@ -135,11 +152,27 @@ func main() {
 		}
 	}
 
+	// Setup gateway reconciler only if EnableGatewayController is set to true.
+	if controllerCFG.FeatureGates.Enabled(config.EnableGatewayController) {
+		if err = gwReconciler.SetupWithManager(ctx, mgr); err != nil {
+			setupLog.Error(err, "Unable to create controller", "controller", "Gateway")
+			os.Exit(1)
+		}
+	}
+
 	if err := tgbReconciler.SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "TargetGroupBinding")
 		os.Exit(1)
 	}
 
+	// Setup trafficShift reconciler only if WeightedTargetGroups is set to true, it is skipped if the TrafficShift CRD isn't installed.
+	if controllerCFG.FeatureGates.Enabled(config.WeightedTargetGroups) {
+		if err := tsReconciler.SetupWithManager(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "TrafficShift")
+			os.Exit(1)
+		}
+	}
+
 	// Add liveness probe
 	err = mgr.AddHealthzCheck("health-ping", healthz.Ping)
 	setupLog.Info("adding health check for controller")
@@ -9,6 +9,7 @@ const (
 	IngressSuffixLoadBalancerName             = "load-balancer-name"
 	IngressSuffixGroupName                    = "group.name"
 	IngressSuffixGroupOrder                   = "group.order"
+	IngressSuffixGroupSharding                = "group.sharding"
 	IngressSuffixTags                         = "tags"
 	IngressSuffixIPAddressType                = "ip-address-type"
 	IngressSuffixScheme                       = "scheme"
@@ -46,6 +47,15 @@ const (
 	IngressSuffixAuthSessionTimeout           = "auth-session-timeout"
 	IngressSuffixTargetNodeLabels             = "target-node-labels"
 	IngressSuffixManageSecurityGroupRules     = "manage-backend-security-group-rules"
+	IngressSuffixDryRun                       = "dry-run"
+	IngressSuffixRulePriorities               = "rule-priorities"
+	IngressSuffixMutualAuthentication         = "mutual-authentication"
+	IngressSuffixEnableFrontendNLB            = "enable-frontend-nlb"
+	IngressSuffixFrontendNLBEIPAllocations    = "frontend-nlb-eip-allocations"
+
+	// Gateway annotation prefix
+	// Gateways and their backend Services use the Ingress annotation suffixes with this prefix.
+	AnnotationPrefixGateway = "gateway.k8s.aws"
 
 	// NLB annotation suffixes
 	// prefixes service.beta.kubernetes.io, service.kubernetes.io
@@ -64,6 +74,8 @@ const (
 	SvcLBSuffixSSLCertificate                = "aws-load-balancer-ssl-cert"
 	SvcLBSuffixSSLPorts                      = "aws-load-balancer-ssl-ports"
 	SvcLBSuffixSSLNegotiationPolicy          = "aws-load-balancer-ssl-negotiation-policy"
+	SvcLBSuffixSSLHostnames                  = "aws-load-balancer-ssl-hostnames"
+	SvcLBSuffixSSLHostnamesFromExternalDNS   = "aws-load-balancer-ssl-hostnames-from-external-dns"
 	SvcLBSuffixBEProtocol                    = "aws-load-balancer-backend-protocol"
 	SvcLBSuffixAdditionalTags                = "aws-load-balancer-additional-resource-tags"
 	SvcLBSuffixHCHealthyThreshold            = "aws-load-balancer-healthcheck-healthy-threshold"
@@ -81,4 +93,10 @@ const (
 	SvcLBSuffixTargetNodeLabels              = "aws-load-balancer-target-node-labels"
 	SvcLBSuffixLoadBalancerAttributes        = "aws-load-balancer-attributes"
 	SvcLBSuffixManageSGRules                 = "aws-load-balancer-manage-backend-security-group-rules"
+	SvcLBSuffixSecurityGroups                = "aws-load-balancer-security-groups"
+	SvcLBSuffixDryRun                        = "aws-load-balancer-dry-run"
+	SvcLBSuffixGroupName                     = "aws-load-balancer-group-name"
+
+	// ExternalDNSHostname is the external-dns annotation that specifies the DNS hostnames of Service.
+	ExternalDNSHostname = "external-dns.alpha.kubernetes.io/hostname"
 )
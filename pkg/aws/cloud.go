@@ -35,6 +35,15 @@ type Cloud interface {
 	// RGT provides API to AWS RGT
 	RGT() services.RGT
 
+	// Route53 provides API to AWS Route53
+	Route53() services.Route53
+
+	// S3 provides API to AWS S3
+	S3() services.S3
+
+	// SecretsManager provides API to AWS SecretsManager
+	SecretsManager() services.SecretsManager
+
 	// Region for the kubernetes cluster
 	Region() string
 
@@ -88,14 +97,17 @@ func NewCloud(cfg CloudConfig, metricsRegisterer prometheus.Registerer) (Cloud,
 	}
 
 	return &defaultCloud{
-		cfg:         cfg,
-		ec2:         services.NewEC2(sess),
-		elbv2:       services.NewELBV2(sess),
-		acm:         services.NewACM(sess),
-		wafv2:       services.NewWAFv2(sess),
-		wafRegional: services.NewWAFRegional(sess, cfg.Region),
-		shield:      services.NewShield(sess),
-		rgt:         services.NewRGT(sess),
+		cfg:            cfg,
+		ec2:            services.NewEC2(sess),
+		elbv2:          services.NewELBV2(sess),
+		acm:            services.NewACM(sess),
+		wafv2:          services.NewWAFv2(sess),
+		wafRegional:    services.NewWAFRegional(sess, cfg.Region),
+		shield:         services.NewShield(sess),
+		rgt:            services.NewRGT(sess),
+		route53:        services.NewRoute53(sess),
+		s3:             services.NewS3(sess),
+		secretsManager: services.NewSecretsManager(sess),
 	}, nil
 }
 
@@ -107,11 +119,14 @@ type defaultCloud struct {
 	ec2   services.EC2
 	elbv2 services.ELBV2
 
-	acm         services.ACM
-	wafv2       services.WAFv2
-	wafRegional services.WAFRegional
-	shield      services.Shield
-	rgt         services.RGT
+	acm            services.ACM
+	wafv2          services.WAFv2
+	wafRegional    services.WAFRegional
+	shield         services.Shield
+	rgt            services.RGT
+	route53        services.Route53
+	s3             services.S3
+	secretsManager services.SecretsManager
 }
 
 func (c *defaultCloud) EC2() services.EC2 {
@@ -142,6 +157,18 @@ func (c *defaultCloud) RGT() services.RGT {
 	return c.rgt
 }
 
+func (c *defaultCloud) Route53() services.Route53 {
+	return c.route53
+}
+
+func (c *defaultCloud) S3() services.S3 {
+	return c.s3
+}
+
+func (c *defaultCloud) SecretsManager() services.SecretsManager {
+	return c.secretsManager
+}
+
 func (c *defaultCloud) Region() string {
 	return c.cfg.Region
 }
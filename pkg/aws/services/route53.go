@@ -0,0 +1,23 @@
+package services
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+)
+
+type Route53 interface {
+	route53iface.Route53API
+}
+
+// NewRoute53 constructs new Route53 implementation.
+func NewRoute53(session *session.Session) Route53 {
+	return &defaultRoute53{
+		Route53API: route53.New(session),
+	}
+}
+
+// default implementation for Route53.
+type defaultRoute53 struct {
+	route53iface.Route53API
+}
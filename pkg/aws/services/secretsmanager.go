@@ -0,0 +1,23 @@
+package services
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+)
+
+type SecretsManager interface {
+	secretsmanageriface.SecretsManagerAPI
+}
+
+// NewSecretsManager constructs new SecretsManager implementation.
+func NewSecretsManager(session *session.Session) SecretsManager {
+	return &defaultSecretsManager{
+		SecretsManagerAPI: secretsmanager.New(session),
+	}
+}
+
+// default implementation for SecretsManager.
+type defaultSecretsManager struct {
+	secretsmanageriface.SecretsManagerAPI
+}
@@ -0,0 +1,487 @@
+package certs
+
+import (
+	"context"
+	"fmt"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	rgtsdk "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	certARNsCacheKey = "certARNs"
+	certTagsCacheKey = "certTags"
+	// the resource type of ACM certificates in resource groups tagging API.
+	resourceTypeFilterCertificate = "acm:certificate"
+	// the certARNs in AWS account will be cached for 1 minute.
+	defaultCertARNsCacheTTL = 1 * time.Minute
+	// the details for imported certificates will be cached for 5 minute.
+	defaultImportedCertDetailsCacheTTL = 5 * time.Minute
+	// the details for private certificates won't change often, cache for a longer time.
+	defaultPrivateCertDetailsCacheTTL = 10 * time.Hour
+	// the tags for all certificates will be cached for 5 minute.
+	defaultCertTagsCacheTTL = 5 * time.Minute
+)
+
+// CertSelectionPolicy controls which certificates are selected for a tlsHost among the discovered ones.
+// the zero value selects the only certificate that matches the tlsHost.
+type CertSelectionPolicy struct {
+	// PreferExactMatch prefers certificates with a domain name that exactly matches the tlsHost over wildcard ones.
+	PreferExactMatch bool
+	// PreferLatestExpiry prefers the certificate that expires last.
+	PreferLatestExpiry bool
+	// ExcludeExpiringWithinDays excludes certificates that expire within the given number of days.
+	ExcludeExpiringWithinDays int64
+	// Tags restricts the selection to certificates with all the given ACM tags.
+	Tags map[string]string
+	// Types restricts the selection to certificates of the given ACM certificate types.
+	Types []string
+}
+
+// NewCertSelectionPolicy constructs the CertSelectionPolicy from controller configuration.
+func NewCertSelectionPolicy(cfg config.CertDiscoveryConfig) CertSelectionPolicy {
+	return CertSelectionPolicy{
+		PreferExactMatch:          cfg.PreferExactMatch,
+		PreferLatestExpiry:        cfg.PreferLatestExpiry,
+		ExcludeExpiringWithinDays: cfg.ExcludeExpiringWithinDays,
+		Tags:                      cfg.Tags,
+		Types:                     cfg.Types,
+	}
+}
+
+// CertSelectionPolicyOverride overrides the fields of a CertSelectionPolicy that are set.
+type CertSelectionPolicyOverride struct {
+	PreferExactMatch          *bool
+	PreferLatestExpiry        *bool
+	ExcludeExpiringWithinDays *int64
+	// Tags overrides the tags of policy if not empty.
+	Tags map[string]string
+	// Types overrides the types of policy if not empty.
+	Types []string
+}
+
+// Apply returns policy with the fields set by override.
+func (o *CertSelectionPolicyOverride) Apply(policy CertSelectionPolicy) CertSelectionPolicy {
+	if o == nil {
+		return policy
+	}
+	if o.PreferExactMatch != nil {
+		policy.PreferExactMatch = *o.PreferExactMatch
+	}
+	if o.PreferLatestExpiry != nil {
+		policy.PreferLatestExpiry = *o.PreferLatestExpiry
+	}
+	if o.ExcludeExpiringWithinDays != nil {
+		policy.ExcludeExpiringWithinDays = *o.ExcludeExpiringWithinDays
+	}
+	if len(o.Tags) != 0 {
+		policy.Tags = o.Tags
+	}
+	if len(o.Types) != 0 {
+		policy.Types = o.Types
+	}
+	return policy
+}
+
+// CertSelection is the certificate selected for a tlsHost.
+type CertSelection struct {
+	// the tlsHost.
+	Host string
+	// the selected certificateARN.
+	CertARN string
+	// Decision explains how the certificate was selected, it's empty if it's the only certificate matching the tlsHost.
+	Decision string
+}
+
+// CertSelectionARNs returns the sorted and deduplicated certificateARNs of certificate selections.
+func CertSelectionARNs(selections []CertSelection) []string {
+	certARNs := sets.NewString()
+	for _, selection := range selections {
+		certARNs.Insert(selection.CertARN)
+	}
+	return certARNs.List()
+}
+
+// CertificateNotFoundError is returned by CertDiscovery when no certificate can be selected for some tlsHosts.
+type CertificateNotFoundError struct {
+	// Hosts are the tlsHosts without certificate.
+	Hosts []string
+	// the reason for each tlsHost without certificate.
+	reasons []string
+}
+
+func (e *CertificateNotFoundError) Error() string {
+	return strings.Join(e.reasons, "; ")
+}
+
+// CertDiscovery is responsible for auto-discover TLS certificates for tls hosts.
+type CertDiscovery interface {
+	// Discover will try to find valid certificateARNs for each tlsHost.
+	// the default selection policy of CertDiscovery is used, with the fields set by policyOverride if any.
+	Discover(ctx context.Context, tlsHosts []string, policyOverride *CertSelectionPolicyOverride) ([]CertSelection, error)
+}
+
+// NewACMCertDiscovery constructs new acmCertDiscovery
+func NewACMCertDiscovery(acmClient services.ACM, rgtClient services.RGT, defaultPolicy CertSelectionPolicy, logger logr.Logger) *acmCertDiscovery {
+	return &acmCertDiscovery{
+		acmClient:     acmClient,
+		rgtClient:     rgtClient,
+		defaultPolicy: defaultPolicy,
+		logger:        logger,
+
+		loadCertDetailsMutex:        sync.Mutex{},
+		loadCertTagsMutex:           sync.Mutex{},
+		certARNsCache:               cache.NewExpiring(),
+		certARNsCacheTTL:            defaultCertARNsCacheTTL,
+		certDetailsCache:            cache.NewExpiring(),
+		importedCertDetailsCacheTTL: defaultImportedCertDetailsCacheTTL,
+		privateCertDetailsCacheTTL:  defaultPrivateCertDetailsCacheTTL,
+		certTagsCache:               cache.NewExpiring(),
+		certTagsCacheTTL:            defaultCertTagsCacheTTL,
+	}
+}
+
+var _ CertDiscovery = &acmCertDiscovery{}
+
+// CertDiscovery implementation for ACM certificates.
+type acmCertDiscovery struct {
+	acmClient     services.ACM
+	rgtClient     services.RGT
+	defaultPolicy CertSelectionPolicy
+	logger        logr.Logger
+
+	// mutex to serialize the call to loadDetailsForAllCertificates
+	loadCertDetailsMutex sync.Mutex
+	// mutex to serialize the call to loadTagsForAllCertificates
+	loadCertTagsMutex           sync.Mutex
+	certARNsCache               *cache.Expiring
+	certARNsCacheTTL            time.Duration
+	certDetailsCache            *cache.Expiring
+	importedCertDetailsCacheTTL time.Duration
+	privateCertDetailsCacheTTL  time.Duration
+	certTagsCache               *cache.Expiring
+	certTagsCacheTTL            time.Duration
+}
+
+func (d *acmCertDiscovery) Discover(ctx context.Context, tlsHosts []string, policyOverride *CertSelectionPolicyOverride) ([]CertSelection, error) {
+	policy := policyOverride.Apply(d.defaultPolicy)
+	certDetailsByARN, err := d.loadDetailsForAllCertificates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(policy.Tags) != 0 {
+		tagsByCertARN, err := d.loadTagsForAllCertificates(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for certARN, details := range certDetailsByARN {
+			details.tags = tagsByCertARN[certARN]
+			certDetailsByARN[certARN] = details
+		}
+	}
+	selections, err := selectCertificates(certDetailsByARN, tlsHosts, policy, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	for _, selection := range selections {
+		if len(selection.Decision) != 0 {
+			d.logger.Info("selected certificate",
+				"host", selection.Host,
+				"certARN", selection.CertARN,
+				"decision", selection.Decision)
+		}
+	}
+	return selections, nil
+}
+
+// NewStaticCertDiscovery constructs new staticCertDiscovery, which discovers certificates from a static set of
+// certificateARNs and their domain names.
+func NewStaticCertDiscovery(domainsByCertARN map[string][]string) *staticCertDiscovery {
+	certDetailsByARN := make(map[string]certDetails, len(domainsByCertARN))
+	for certARN, domains := range domainsByCertARN {
+		certDetailsByARN[certARN] = certDetails{
+			domains: sets.NewString(domains...),
+		}
+	}
+	return &staticCertDiscovery{
+		certDetailsByARN: certDetailsByARN,
+	}
+}
+
+var _ CertDiscovery = &staticCertDiscovery{}
+
+// CertDiscovery implementation for a static set of certificates.
+type staticCertDiscovery struct {
+	certDetailsByARN map[string]certDetails
+}
+
+func (d *staticCertDiscovery) Discover(_ context.Context, tlsHosts []string, policyOverride *CertSelectionPolicyOverride) ([]CertSelection, error) {
+	return selectCertificates(d.certDetailsByARN, tlsHosts, policyOverride.Apply(CertSelectionPolicy{}), time.Now())
+}
+
+// certDetails contains the details of a certificate that are used for selection.
+type certDetails struct {
+	// the domain names of certificate.
+	domains sets.String
+	// the ACM certificate type, empty if unknown.
+	certType string
+	// the time after which the certificate is not valid, nil if unknown.
+	notAfter *time.Time
+	// the ACM tags of certificate, only loaded when needed by selection policy.
+	tags map[string]string
+}
+
+// selectCertificates selects the certificateARN for each tlsHost per selection policy, given the details of certificates.
+func selectCertificates(certDetailsByARN map[string]certDetails, tlsHosts []string, policy CertSelectionPolicy, now time.Time) ([]CertSelection, error) {
+	selections := make([]CertSelection, 0, len(tlsHosts))
+	var notFoundErr *CertificateNotFoundError
+	for _, host := range tlsHosts {
+		selection, err := selectCertificateForHost(certDetailsByARN, host, policy, now)
+		if err != nil {
+			var hostNotFoundErr *CertificateNotFoundError
+			if !errors.As(err, &hostNotFoundErr) {
+				return nil, err
+			}
+			if notFoundErr == nil {
+				notFoundErr = &CertificateNotFoundError{}
+			}
+			notFoundErr.Hosts = append(notFoundErr.Hosts, hostNotFoundErr.Hosts...)
+			notFoundErr.reasons = append(notFoundErr.reasons, hostNotFoundErr.reasons...)
+			continue
+		}
+		selections = append(selections, selection)
+	}
+	if notFoundErr != nil {
+		return nil, notFoundErr
+	}
+	return selections, nil
+}
+
+func selectCertificateForHost(certDetailsByARN map[string]certDetails, host string, policy CertSelectionPolicy, now time.Time) (CertSelection, error) {
+	var matchedCertARNs []string
+	for certARN, details := range certDetailsByARN {
+		for domain := range details.domains {
+			if domainMatchesHost(domain, host) {
+				matchedCertARNs = append(matchedCertARNs, certARN)
+				break
+			}
+		}
+	}
+	if len(matchedCertARNs) == 0 {
+		return CertSelection{}, &CertificateNotFoundError{
+			Hosts:   []string{host},
+			reasons: []string{fmt.Sprintf("no certificate found for host: %s", host)},
+		}
+	}
+	sort.Strings(matchedCertARNs)
+
+	var decisions []string
+	var candidates []string
+	var exclusions []string
+	for _, certARN := range matchedCertARNs {
+		if reason := certExclusionReason(certDetailsByARN[certARN], policy, now); len(reason) != 0 {
+			exclusions = append(exclusions, fmt.Sprintf("%v(%v)", certARN, reason))
+			continue
+		}
+		candidates = append(candidates, certARN)
+	}
+	if len(exclusions) != 0 {
+		decisions = append(decisions, fmt.Sprintf("excluded %v", strings.Join(exclusions, ", ")))
+	}
+	if len(candidates) == 0 {
+		return CertSelection{}, &CertificateNotFoundError{
+			Hosts:   []string{host},
+			reasons: []string{fmt.Sprintf("no certificate found for host: %s, %v", host, decisions[0])},
+		}
+	}
+
+	if policy.PreferExactMatch && len(candidates) > 1 {
+		var exactCandidates []string
+		for _, certARN := range candidates {
+			if certDetailsByARN[certARN].domains.Has(host) {
+				exactCandidates = append(exactCandidates, certARN)
+			}
+		}
+		if len(exactCandidates) != 0 && len(exactCandidates) != len(candidates) {
+			candidates = exactCandidates
+			decisions = append(decisions, "preferred exact match")
+		}
+	}
+	if policy.PreferLatestExpiry && len(candidates) > 1 {
+		latestCandidates := selectLatestExpiryCertARNs(certDetailsByARN, candidates)
+		if len(latestCandidates) != len(candidates) {
+			candidates = latestCandidates
+			decisions = append(decisions, "preferred latest expiry")
+		}
+	}
+	if len(candidates) > 1 {
+		return CertSelection{}, errors.Errorf("multiple certificates found for host: %s, certARNs: %v", host, candidates)
+	}
+	return CertSelection{
+		Host:     host,
+		CertARN:  candidates[0],
+		Decision: strings.Join(decisions, "; "),
+	}, nil
+}
+
+// certExclusionReason returns the reason why a certificate is excluded by selection policy, empty if it's not excluded.
+func certExclusionReason(details certDetails, policy CertSelectionPolicy, now time.Time) string {
+	if len(policy.Types) != 0 && !sets.NewString(policy.Types...).Has(details.certType) {
+		return fmt.Sprintf("type %v", details.certType)
+	}
+	for key, value := range policy.Tags {
+		if actualValue, ok := details.tags[key]; !ok || actualValue != value {
+			return fmt.Sprintf("missing tag %v=%v", key, value)
+		}
+	}
+	if policy.ExcludeExpiringWithinDays > 0 && details.notAfter != nil {
+		expiryThreshold := now.Add(time.Duration(policy.ExcludeExpiringWithinDays) * 24 * time.Hour)
+		if details.notAfter.Before(expiryThreshold) {
+			return fmt.Sprintf("expires at %v", details.notAfter.UTC().Format(time.RFC3339))
+		}
+	}
+	return ""
+}
+
+// selectLatestExpiryCertARNs returns the certificateARNs that expires last, certificates with unknown expiry are never preferred.
+func selectLatestExpiryCertARNs(certDetailsByARN map[string]certDetails, certARNs []string) []string {
+	var latestNotAfter *time.Time
+	var latestCertARNs []string
+	for _, certARN := range certARNs {
+		notAfter := certDetailsByARN[certARN].notAfter
+		switch {
+		case notAfter == nil:
+			continue
+		case latestNotAfter == nil || notAfter.After(*latestNotAfter):
+			latestNotAfter = notAfter
+			latestCertARNs = []string{certARN}
+		case notAfter.Equal(*latestNotAfter):
+			latestCertARNs = append(latestCertARNs, certARN)
+		}
+	}
+	if len(latestCertARNs) == 0 {
+		return certARNs
+	}
+	return latestCertARNs
+}
+
+func (d *acmCertDiscovery) loadDetailsForAllCertificates(ctx context.Context) (map[string]certDetails, error) {
+	d.loadCertDetailsMutex.Lock()
+	defer d.loadCertDetailsMutex.Unlock()
+
+	certARNs, err := d.loadAllCertificateARNs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	certDetailsByARN := make(map[string]certDetails, len(certARNs))
+	for _, certARN := range certARNs {
+		details, err := d.loadDetailsForCertificate(ctx, certARN)
+		if err != nil {
+			return nil, err
+		}
+		certDetailsByARN[certARN] = details
+	}
+	return certDetailsByARN, nil
+}
+
+func (d *acmCertDiscovery) loadAllCertificateARNs(ctx context.Context) ([]string, error) {
+	if rawCacheItem, ok := d.certARNsCache.Get(certARNsCacheKey); ok {
+		return rawCacheItem.([]string), nil
+	}
+	req := &acm.ListCertificatesInput{
+		CertificateStatuses: aws.StringSlice([]string{acm.CertificateStatusIssued}),
+	}
+	certSummaries, err := d.acmClient.ListCertificatesAsList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var certARNs []string
+	for _, certSummary := range certSummaries {
+		certARN := aws.StringValue(certSummary.CertificateArn)
+		certARNs = append(certARNs, certARN)
+	}
+	d.certARNsCache.Set(certARNsCacheKey, certARNs, d.certARNsCacheTTL)
+	return certARNs, nil
+}
+
+func (d *acmCertDiscovery) loadDetailsForCertificate(ctx context.Context, certARN string) (certDetails, error) {
+	if rawCacheItem, ok := d.certDetailsCache.Get(certARN); ok {
+		return rawCacheItem.(certDetails), nil
+	}
+	req := &acm.DescribeCertificateInput{
+		CertificateArn: aws.String(certARN),
+	}
+	resp, err := d.acmClient.DescribeCertificateWithContext(ctx, req)
+	if err != nil {
+		return certDetails{}, err
+	}
+	certDetail := resp.Certificate
+	details := certDetails{
+		domains:  sets.NewString(aws.StringValueSlice(certDetail.SubjectAlternativeNames)...),
+		certType: aws.StringValue(certDetail.Type),
+		notAfter: certDetail.NotAfter,
+	}
+	switch details.certType {
+	case acm.CertificateTypeImported:
+		d.certDetailsCache.Set(certARN, details, d.importedCertDetailsCacheTTL)
+	case acm.CertificateTypeAmazonIssued, acm.CertificateTypePrivate:
+		d.certDetailsCache.Set(certARN, details, d.privateCertDetailsCacheTTL)
+	}
+	return details, nil
+}
+
+// loadTagsForAllCertificates loads the tags of all certificates in a batch, certificates without tags are absent.
+func (d *acmCertDiscovery) loadTagsForAllCertificates(ctx context.Context) (map[string]map[string]string, error) {
+	d.loadCertTagsMutex.Lock()
+	defer d.loadCertTagsMutex.Unlock()
+
+	if rawCacheItem, ok := d.certTagsCache.Get(certTagsCacheKey); ok {
+		return rawCacheItem.(map[string]map[string]string), nil
+	}
+	req := &rgtsdk.GetResourcesInput{
+		ResourceTypeFilters: aws.StringSlice([]string{resourceTypeFilterCertificate}),
+	}
+	tagsByCertARN := make(map[string]map[string]string)
+	if err := d.rgtClient.GetResourcesPagesWithContext(ctx, req, func(output *rgtsdk.GetResourcesOutput, _ bool) bool {
+		for _, mapping := range output.ResourceTagMappingList {
+			tags := make(map[string]string, len(mapping.Tags))
+			for _, tag := range mapping.Tags {
+				tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+			tagsByCertARN[aws.StringValue(mapping.ResourceARN)] = tags
+		}
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	d.certTagsCache.Set(certTagsCacheKey, tagsByCertARN, d.certTagsCacheTTL)
+	return tagsByCertARN, nil
+}
+
+func domainMatchesHost(domainName string, tlsHost string) bool {
+	if strings.HasPrefix(domainName, "*.") {
+		ds := strings.Split(domainName, ".")
+		hs := strings.Split(tlsHost, ".")
+
+		if len(ds) != len(hs) {
+			return false
+		}
+
+		return cmp.Equal(ds[1:], hs[1:], cmpopts.EquateEmpty())
+	}
+
+	return domainName == tlsHost
+}
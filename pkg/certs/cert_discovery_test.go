@@ -0,0 +1,302 @@
+package certs
+
+import (
+	"context"
+	"errors"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"testing"
+	"time"
+)
+
+func Test_domainMatchesHost(t *testing.T) {
+	type args struct {
+		domainName string
+		tlsHost    string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "exact domain matches",
+			args: args{
+				domainName: "example.com",
+				tlsHost:    "example.com",
+			},
+			want: true,
+		},
+		{
+			name: "exact domain didn't matches",
+			args: args{
+				domainName: "example.com",
+				tlsHost:    "www.example.com",
+			},
+			want: false,
+		},
+		{
+			name: "wildcard domain matches",
+			args: args{
+				domainName: "*.example.com",
+				tlsHost:    "www.example.com",
+			},
+			want: true,
+		},
+		{
+			name: "wildcard domain didn't matches - case 1",
+			args: args{
+				domainName: "*.example.com",
+				tlsHost:    "example.com",
+			},
+			want: false,
+		},
+		{
+			name: "wildcard domain didn't matches - case 2",
+			args: args{
+				domainName: "*.example.com",
+				tlsHost:    "www.app.example.com",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := domainMatchesHost(tt.args.domainName, tt.args.tlsHost)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_staticCertDiscovery_Discover(t *testing.T) {
+	domainsByCertARN := map[string][]string{
+		"arn:aws:acm:us-west-2:123456789012:certificate/cert-1": {"example.com", "*.example.com"},
+		"arn:aws:acm:us-west-2:123456789012:certificate/cert-2": {"www.example.org"},
+		"arn:aws:acm:us-west-2:123456789012:certificate/cert-3": {"www.example.org"},
+	}
+	tests := []struct {
+		name     string
+		tlsHosts []string
+		want     []string
+		wantErr  error
+	}{
+		{
+			name:     "hosts matches single certificate",
+			tlsHosts: []string{"example.com", "foo.example.com"},
+			want:     []string{"arn:aws:acm:us-west-2:123456789012:certificate/cert-1"},
+		},
+		{
+			name:     "host matches no certificate",
+			tlsHosts: []string{"foo.bar.example.com"},
+			wantErr:  errors.New("no certificate found for host: foo.bar.example.com"),
+		},
+		{
+			name:     "host matches multiple certificates",
+			tlsHosts: []string{"www.example.org"},
+			wantErr:  errors.New("multiple certificates found for host: www.example.org"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewStaticCertDiscovery(domainsByCertARN)
+			got, err := d.Discover(context.Background(), tt.tlsHosts, nil)
+			if tt.wantErr != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, CertSelectionARNs(got))
+			}
+		})
+	}
+}
+
+func Test_selectCertificates(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	expireIn := func(days int) *time.Time {
+		notAfter := now.Add(time.Duration(days) * 24 * time.Hour)
+		return &notAfter
+	}
+	certDetailsByARN := map[string]certDetails{
+		"arn-wildcard": {
+			domains:  sets.NewString("*.example.com"),
+			certType: "AMAZON_ISSUED",
+			notAfter: expireIn(300),
+			tags:     map[string]string{"owner": "platform"},
+		},
+		"arn-exact": {
+			domains:  sets.NewString("www.example.com"),
+			certType: "IMPORTED",
+			notAfter: expireIn(100),
+			tags:     map[string]string{"owner": "team-a"},
+		},
+		"arn-exact-expiring": {
+			domains:  sets.NewString("www.example.com"),
+			certType: "IMPORTED",
+			notAfter: expireIn(5),
+			tags:     map[string]string{"owner": "team-a"},
+		},
+	}
+	tests := []struct {
+		name     string
+		tlsHosts []string
+		policy   CertSelectionPolicy
+		want     []CertSelection
+		wantErr  error
+	}{
+		{
+			name:     "single matching certificate",
+			tlsHosts: []string{"api.example.com"},
+			policy:   CertSelectionPolicy{},
+			want: []CertSelection{
+				{Host: "api.example.com", CertARN: "arn-wildcard"},
+			},
+		},
+		{
+			name:     "multiple matching certificates without policy",
+			tlsHosts: []string{"www.example.com"},
+			policy:   CertSelectionPolicy{},
+			wantErr:  errors.New("multiple certificates found for host: www.example.com, certARNs: [arn-exact arn-exact-expiring arn-wildcard]"),
+		},
+		{
+			name:     "exclude expiring certificates and prefer exact match",
+			tlsHosts: []string{"www.example.com"},
+			policy: CertSelectionPolicy{
+				PreferExactMatch:          true,
+				ExcludeExpiringWithinDays: 30,
+			},
+			want: []CertSelection{
+				{
+					Host:     "www.example.com",
+					CertARN:  "arn-exact",
+					Decision: "excluded arn-exact-expiring(expires at 2021-06-06T00:00:00Z); preferred exact match",
+				},
+			},
+		},
+		{
+			name:     "prefer latest expiry",
+			tlsHosts: []string{"www.example.com"},
+			policy: CertSelectionPolicy{
+				PreferLatestExpiry: true,
+			},
+			want: []CertSelection{
+				{
+					Host:     "www.example.com",
+					CertARN:  "arn-wildcard",
+					Decision: "preferred latest expiry",
+				},
+			},
+		},
+		{
+			name:     "prefer exact match then latest expiry",
+			tlsHosts: []string{"www.example.com"},
+			policy: CertSelectionPolicy{
+				PreferExactMatch:   true,
+				PreferLatestExpiry: true,
+			},
+			want: []CertSelection{
+				{
+					Host:     "www.example.com",
+					CertARN:  "arn-exact",
+					Decision: "preferred exact match; preferred latest expiry",
+				},
+			},
+		},
+		{
+			name:     "filter by tags",
+			tlsHosts: []string{"www.example.com"},
+			policy: CertSelectionPolicy{
+				Tags: map[string]string{"owner": "platform"},
+			},
+			want: []CertSelection{
+				{
+					Host:     "www.example.com",
+					CertARN:  "arn-wildcard",
+					Decision: "excluded arn-exact(missing tag owner=platform), arn-exact-expiring(missing tag owner=platform)",
+				},
+			},
+		},
+		{
+			name:     "filter by types excludes all certificates",
+			tlsHosts: []string{"api.example.com"},
+			policy: CertSelectionPolicy{
+				Types: []string{"PRIVATE"},
+			},
+			wantErr: errors.New("no certificate found for host: api.example.com, excluded arn-wildcard(type AMAZON_ISSUED)"),
+		},
+		{
+			name:     "no matching certificate",
+			tlsHosts: []string{"www.example.org"},
+			policy:   CertSelectionPolicy{},
+			wantErr:  errors.New("no certificate found for host: www.example.org"),
+		},
+		{
+			name:     "no matching certificate for multiple hosts",
+			tlsHosts: []string{"www.example.org", "api.example.com", "www.example.net"},
+			policy:   CertSelectionPolicy{},
+			wantErr:  errors.New("no certificate found for host: www.example.org; no certificate found for host: www.example.net"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectCertificates(certDetailsByARN, tt.tlsHosts, tt.policy, now)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCertSelectionPolicyOverride_Apply(t *testing.T) {
+	defaultPolicy := CertSelectionPolicy{
+		PreferExactMatch:          true,
+		ExcludeExpiringWithinDays: 30,
+		Tags:                      map[string]string{"owner": "platform"},
+	}
+	tests := []struct {
+		name     string
+		override *CertSelectionPolicyOverride
+		want     CertSelectionPolicy
+	}{
+		{
+			name:     "nil override",
+			override: nil,
+			want:     defaultPolicy,
+		},
+		{
+			name: "override only the fields that are set",
+			override: &CertSelectionPolicyOverride{
+				PreferLatestExpiry: awssdk.Bool(true),
+				Types:              []string{"IMPORTED"},
+			},
+			want: CertSelectionPolicy{
+				PreferExactMatch:          true,
+				PreferLatestExpiry:        true,
+				ExcludeExpiringWithinDays: 30,
+				Tags:                      map[string]string{"owner": "platform"},
+				Types:                     []string{"IMPORTED"},
+			},
+		},
+		{
+			name: "override with zero values",
+			override: &CertSelectionPolicyOverride{
+				PreferExactMatch:          awssdk.Bool(false),
+				ExcludeExpiringWithinDays: awssdk.Int64(0),
+				Tags:                      map[string]string{"owner": "team-a"},
+			},
+			want: CertSelectionPolicy{
+				Tags: map[string]string{"owner": "team-a"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.override.Apply(defaultPolicy)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
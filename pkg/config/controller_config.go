@@ -17,6 +17,7 @@ const (
 	flagDefaultTags                                  = "default-tags"
 	flagExternalManagedTags                          = "external-managed-tags"
 	flagServiceMaxConcurrentReconciles               = "service-max-concurrent-reconciles"
+	flagGatewayMaxConcurrentReconciles               = "gateway-max-concurrent-reconciles"
 	flagTargetGroupBindingMaxConcurrentReconciles    = "targetgroupbinding-max-concurrent-reconciles"
 	flagTargetGroupBindingMaxExponentialBackoffDelay = "targetgroupbinding-max-exponential-backoff-delay"
 	flagDefaultSSLPolicy                             = "default-ssl-policy"
@@ -24,6 +25,7 @@ const (
 	flagBackendSecurityGroup                         = "backend-security-group"
 	flagEnableEndpointSlices                         = "enable-endpoint-slices"
 	flagDisableRestrictedSGRules                     = "disable-restricted-sg-rules"
+	flagDryRun                                       = "dry-run"
 	defaultLogLevel                                  = "info"
 	defaultMaxConcurrentReconciles                   = 3
 	defaultMaxExponentialBackoffDelay                = time.Second * 1000
@@ -31,6 +33,7 @@ const (
 	defaultEnableBackendSG                           = true
 	defaultEnableEndpointSlices                      = false
 	defaultDisableRestrictedSGRules                  = false
+	defaultDryRun                                    = false
 )
 
 var (
@@ -41,7 +44,10 @@ var (
 		"ingress.k8s.aws/resource",
 		"service.k8s.aws/stack",
 		"service.k8s.aws/resource",
+		"gateway.k8s.aws/stack",
+		"gateway.k8s.aws/resource",
 	)
+	certDiscoveryTypes = sets.NewString("AMAZON_ISSUED", "PRIVATE", "IMPORTED")
 )
 
 // ControllerConfig contains the controller configuration
@@ -78,6 +84,8 @@ type ControllerConfig struct {
 
 	// Max concurrent reconcile loops for Service objects
 	ServiceMaxConcurrentReconciles int
+	// Max concurrent reconcile loops for Gateway objects
+	GatewayMaxConcurrentReconciles int
 	// Max concurrent reconcile loops for TargetGroupBinding objects
 	TargetGroupBindingMaxConcurrentReconciles int
 	// Max exponential backoff delay for reconcile failures of TargetGroupBinding
@@ -93,6 +101,9 @@ type ControllerConfig struct {
 	// DisableRestrictedSGRules specifies whether to use restricted security group rules
 	DisableRestrictedSGRules bool
 
+	// DryRun specifies whether to only plan the changes to load balancers of Ingresses and Services without applying them
+	DryRun bool
+
 	FeatureGates FeatureGates
 }
 
@@ -107,6 +118,8 @@ func (cfg *ControllerConfig) BindFlags(fs *pflag.FlagSet) {
 		"List of Tag keys on AWS resources that will be managed externally")
 	fs.IntVar(&cfg.ServiceMaxConcurrentReconciles, flagServiceMaxConcurrentReconciles, defaultMaxConcurrentReconciles,
 		"Maximum number of concurrently running reconcile loops for service")
+	fs.IntVar(&cfg.GatewayMaxConcurrentReconciles, flagGatewayMaxConcurrentReconciles, defaultMaxConcurrentReconciles,
+		"Maximum number of concurrently running reconcile loops for gateway")
 	fs.IntVar(&cfg.TargetGroupBindingMaxConcurrentReconciles, flagTargetGroupBindingMaxConcurrentReconciles, defaultMaxConcurrentReconciles,
 		"Maximum number of concurrently running reconcile loops for targetGroupBinding")
 	fs.DurationVar(&cfg.TargetGroupBindingMaxExponentialBackoffDelay, flagTargetGroupBindingMaxExponentialBackoffDelay, defaultMaxExponentialBackoffDelay,
@@ -121,6 +134,8 @@ func (cfg *ControllerConfig) BindFlags(fs *pflag.FlagSet) {
 		"Enable EndpointSlices for IP targets instead of Endpoints")
 	fs.BoolVar(&cfg.DisableRestrictedSGRules, flagDisableRestrictedSGRules, defaultDisableRestrictedSGRules,
 		"Disable the usage of restricted security group rules")
+	fs.BoolVar(&cfg.DryRun, flagDryRun, defaultDryRun,
+		"Only plan the changes to load balancers of Ingresses and Services, without applying them")
 
 	cfg.FeatureGates.BindFlags(fs)
 	cfg.AWSConfig.BindFlags(fs)
@@ -150,6 +165,9 @@ func (cfg *ControllerConfig) Validate() error {
 	if err := cfg.validateBackendSecurityGroupConfiguration(); err != nil {
 		return err
 	}
+	if err := cfg.validateCertDiscoveryConfiguration(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -190,3 +208,17 @@ func (cfg *ControllerConfig) validateBackendSecurityGroupConfiguration() error {
 	}
 	return nil
 }
+
+func (cfg *ControllerConfig) validateCertDiscoveryConfiguration() error {
+	if cfg.IngressConfig.CertDiscovery.ExcludeExpiringWithinDays < 0 {
+		return errors.Errorf("invalid value %v for %v flag, must be non-negative",
+			cfg.IngressConfig.CertDiscovery.ExcludeExpiringWithinDays, flagCertDiscoveryExcludeExpiringDays)
+	}
+	for _, certType := range cfg.IngressConfig.CertDiscovery.Types {
+		if !certDiscoveryTypes.Has(certType) {
+			return errors.Errorf("invalid value %v for %v flag, must be one of %v",
+				certType, flagCertDiscoveryTypes, certDiscoveryTypes.List())
+		}
+	}
+	return nil
+}
@@ -15,6 +15,10 @@ const (
 	ServiceTypeLoadBalancerOnly Feature = "ServiceTypeLoadBalancerOnly"
 	EndpointsFailOpen           Feature = "EndpointsFailOpen"
 	EnableServiceController     Feature = "EnableServiceController"
+	EnableGatewayController     Feature = "EnableGatewayController"
+	ListenerRulesCompaction     Feature = "ListenerRulesCompaction"
+	ImportTLSSecrets            Feature = "ImportTLSSecrets"
+	NLBSecurityGroup            Feature = "NLBSecurityGroup"
 )
 
 type FeatureGates interface {
@@ -47,6 +51,10 @@ func NewFeatureGates() FeatureGates {
 			ServiceTypeLoadBalancerOnly: false,
 			EndpointsFailOpen:           false,
 			EnableServiceController:     true,
+			EnableGatewayController:     false,
+			ListenerRulesCompaction:     false,
+			ImportTLSSecrets:            false,
+			NLBSecurityGroup:            false,
 		},
 	}
 }
@@ -7,6 +7,13 @@ const (
 	flagDisableIngressClassAnnotation        = "disable-ingress-class-annotation"
 	flagDisableIngressGroupNameAnnotation    = "disable-ingress-group-name-annotation"
 	flagIngressMaxConcurrentReconciles       = "ingress-max-concurrent-reconciles"
+	flagCertDiscoveryPreferExactMatch        = "cert-discovery-prefer-exact-match"
+	flagCertDiscoveryPreferLatestExpiry      = "cert-discovery-prefer-latest-expiry"
+	flagCertDiscoveryExcludeExpiringDays     = "cert-discovery-exclude-expiring-within-days"
+	flagCertDiscoveryTags                    = "cert-discovery-tags"
+	flagCertDiscoveryTypes                   = "cert-discovery-types"
+	flagCertRequestHostedZoneIDs             = "cert-request-hosted-zone-ids"
+	flagTrustStoreS3Bucket                   = "trust-store-s3-bucket"
 	defaultIngressClass                      = "alb"
 	defaultDisableIngressClassAnnotation     = false
 	defaultDisableIngressGroupNameAnnotation = false
@@ -27,6 +34,36 @@ type IngressConfig struct {
 
 	// Max concurrent reconcile loops for Ingress objects
 	MaxConcurrentReconciles int
+
+	// CertDiscovery is the controller-wide policy to select certificates auto-discovered from ACM.
+	CertDiscovery CertDiscoveryConfig
+
+	// CertRequestHostedZoneIDs are the Route53 hosted zones to DNS-validate certificates requested from ACM for hosts without certificate.
+	// each validation record is written into the hosted zone with the longest name that contains it.
+	// If empty, certificates won't be requested.
+	CertRequestHostedZoneIDs []string
+
+	// TrustStoreS3Bucket is the S3 bucket to stage the CA certificates bundles of trust stores for mutual TLS authentication.
+	// If empty, trust stores won't be managed from CA certificates bundles in ConfigMaps or Secrets.
+	TrustStoreS3Bucket string
+}
+
+// CertDiscoveryConfig contains the policy to select certificates auto-discovered from ACM.
+type CertDiscoveryConfig struct {
+	// PreferExactMatch prefers certificates with a domain name that exactly matches the host over wildcard ones.
+	PreferExactMatch bool
+
+	// PreferLatestExpiry prefers the certificate that expires last.
+	PreferLatestExpiry bool
+
+	// ExcludeExpiringWithinDays excludes certificates that expire within the given number of days.
+	ExcludeExpiringWithinDays int64
+
+	// Tags restricts the selection to certificates with all the given ACM tags.
+	Tags map[string]string
+
+	// Types restricts the selection to certificates of the given ACM certificate types.
+	Types []string
 }
 
 // BindFlags binds the command line flags to the fields in the config object
@@ -39,4 +76,18 @@ func (cfg *IngressConfig) BindFlags(fs *pflag.FlagSet) {
 		"Disable new usage of alb.ingress.kubernetes.io/group.name annotation")
 	fs.IntVar(&cfg.MaxConcurrentReconciles, flagIngressMaxConcurrentReconciles, defaultMaxIngressConcurrentReconciles,
 		"Maximum number of concurrently running reconcile loops for ingress")
+	fs.BoolVar(&cfg.CertDiscovery.PreferExactMatch, flagCertDiscoveryPreferExactMatch, false,
+		"Prefer auto-discovered certificates that exactly match the host over wildcard certificates")
+	fs.BoolVar(&cfg.CertDiscovery.PreferLatestExpiry, flagCertDiscoveryPreferLatestExpiry, false,
+		"Prefer the auto-discovered certificate that expires last when multiple certificates match the host")
+	fs.Int64Var(&cfg.CertDiscovery.ExcludeExpiringWithinDays, flagCertDiscoveryExcludeExpiringDays, 0,
+		"Exclude auto-discovered certificates that expire within the given number of days")
+	fs.StringToStringVar(&cfg.CertDiscovery.Tags, flagCertDiscoveryTags, nil,
+		"Only auto-discover certificates with all of the given ACM tags")
+	fs.StringSliceVar(&cfg.CertDiscovery.Types, flagCertDiscoveryTypes, nil,
+		"Only auto-discover certificates of the given types, one of AMAZON_ISSUED, PRIVATE or IMPORTED")
+	fs.StringSliceVar(&cfg.CertRequestHostedZoneIDs, flagCertRequestHostedZoneIDs, nil,
+		"Route53 hosted zone IDs to DNS-validate the ACM certificates requested for Ingress hosts without certificate, certificates are only requested if specified")
+	fs.StringVar(&cfg.TrustStoreS3Bucket, flagTrustStoreS3Bucket, "",
+		"S3 bucket to stage the CA certificates bundles of trust stores for mutual TLS authentication, trust stores are only managed from CA certificates bundles if specified")
 }
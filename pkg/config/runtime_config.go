@@ -123,7 +123,7 @@ func BuildRuntimeOptions(rtCfg RuntimeConfig, scheme *runtime.Scheme) ctrl.Optio
 		LeaderElectionNamespace:    rtCfg.LeaderElectionNamespace,
 		Namespace:                  rtCfg.WatchNamespace,
 		SyncPeriod:                 &rtCfg.SyncPeriod,
-		ClientDisableCacheFor:      []client.Object{&corev1.Secret{}},
+		ClientDisableCacheFor:      []client.Object{&corev1.Secret{}, &corev1.ConfigMap{}},
 	}
 }
 
@@ -0,0 +1,166 @@
+package acm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	acmsdk "github.com/aws/aws-sdk-go/service/acm"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	acmmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/acm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"time"
+)
+
+const (
+	// certificateChecksumTagKey is the AWS TagKey that records the checksum of the imported certificate material.
+	certificateChecksumTagKey = "elbv2.k8s.aws/certificate-checksum"
+
+	defaultWaitCertDeletionPollInterval = 5 * time.Second
+	defaultWaitCertDeletionTimeout      = 2 * time.Minute
+)
+
+// CertificateManager is responsible for create/update/delete Certificate resources.
+type CertificateManager interface {
+	Create(ctx context.Context, resCert *acmmodel.Certificate) (acmmodel.CertificateStatus, error)
+
+	Update(ctx context.Context, resCert *acmmodel.Certificate, sdkCert CertificateWithTags) (acmmodel.CertificateStatus, error)
+
+	Delete(ctx context.Context, sdkCert CertificateWithTags) error
+}
+
+// NewDefaultCertificateManager constructs new defaultCertificateManager.
+func NewDefaultCertificateManager(acmClient services.ACM, trackingProvider tracking.Provider, taggingManager TaggingManager,
+	externalManagedTags []string, logger logr.Logger) *defaultCertificateManager {
+	return &defaultCertificateManager{
+		acmClient:           acmClient,
+		trackingProvider:    trackingProvider,
+		taggingManager:      taggingManager,
+		externalManagedTags: externalManagedTags,
+		logger:              logger,
+
+		waitCertDeletionPollInterval: defaultWaitCertDeletionPollInterval,
+		waitCertDeletionTimeout:      defaultWaitCertDeletionTimeout,
+	}
+}
+
+var _ CertificateManager = &defaultCertificateManager{}
+
+// default implementation for CertificateManager.
+type defaultCertificateManager struct {
+	acmClient           services.ACM
+	trackingProvider    tracking.Provider
+	taggingManager      TaggingManager
+	externalManagedTags []string
+	logger              logr.Logger
+
+	waitCertDeletionPollInterval time.Duration
+	waitCertDeletionTimeout      time.Duration
+}
+
+func (m *defaultCertificateManager) Create(ctx context.Context, resCert *acmmodel.Certificate) (acmmodel.CertificateStatus, error) {
+	certTags := m.buildCertificateTags(resCert)
+	req := buildSDKImportCertificateInput(resCert.Spec)
+	req.Tags = convertTagsToSDKTags(certTags)
+
+	m.logger.Info("importing certificate",
+		"resourceID", resCert.ID(),
+		"secret", resCert.Spec.SecretRef)
+	resp, err := m.acmClient.ImportCertificateWithContext(ctx, req)
+	if err != nil {
+		return acmmodel.CertificateStatus{}, err
+	}
+	certARN := awssdk.StringValue(resp.CertificateArn)
+	m.logger.Info("imported certificate",
+		"resourceID", resCert.ID(),
+		"arn", certARN)
+	return acmmodel.CertificateStatus{
+		CertificateARN: certARN,
+	}, nil
+}
+
+func (m *defaultCertificateManager) Update(ctx context.Context, resCert *acmmodel.Certificate, sdkCert CertificateWithTags) (acmmodel.CertificateStatus, error) {
+	certTags := m.buildCertificateTags(resCert)
+	if sdkCert.Tags[certificateChecksumTagKey] != certTags[certificateChecksumTagKey] {
+		// ACM doesn't allow tags to be specified when re-importing, they are reconciled below.
+		req := buildSDKImportCertificateInput(resCert.Spec)
+		req.CertificateArn = awssdk.String(sdkCert.CertificateARN)
+
+		m.logger.Info("re-importing certificate",
+			"resourceID", resCert.ID(),
+			"arn", sdkCert.CertificateARN)
+		if _, err := m.acmClient.ImportCertificateWithContext(ctx, req); err != nil {
+			return acmmodel.CertificateStatus{}, err
+		}
+		m.logger.Info("re-imported certificate",
+			"resourceID", resCert.ID(),
+			"arn", sdkCert.CertificateARN)
+	}
+	if err := m.taggingManager.ReconcileTags(ctx, sdkCert.CertificateARN, certTags,
+		WithCurrentTags(sdkCert.Tags),
+		WithIgnoredTagKeys(m.trackingProvider.LegacyTagKeys()),
+		WithIgnoredTagKeys(m.externalManagedTags)); err != nil {
+		return acmmodel.CertificateStatus{}, err
+	}
+	return acmmodel.CertificateStatus{
+		CertificateARN: sdkCert.CertificateARN,
+	}, nil
+}
+
+func (m *defaultCertificateManager) Delete(ctx context.Context, sdkCert CertificateWithTags) error {
+	req := &acmsdk.DeleteCertificateInput{
+		CertificateArn: awssdk.String(sdkCert.CertificateARN),
+	}
+
+	m.logger.Info("deleting certificate",
+		"arn", sdkCert.CertificateARN)
+	// the certificate stays in use for a short while after it's detached from listeners.
+	if err := runtime.RetryImmediateOnError(m.waitCertDeletionPollInterval, m.waitCertDeletionTimeout, isCertificateInUseError, func() error {
+		_, err := m.acmClient.DeleteCertificateWithContext(ctx, req)
+		return err
+	}); err != nil {
+		return errors.Wrap(err, "failed to delete certificate")
+	}
+	m.logger.Info("deleted certificate",
+		"arn", sdkCert.CertificateARN)
+	return nil
+}
+
+// buildCertificateTags returns the desired tags of certificate, including the checksum of certificate material.
+func (m *defaultCertificateManager) buildCertificateTags(resCert *acmmodel.Certificate) map[string]string {
+	certTags := m.trackingProvider.ResourceTags(resCert.Stack(), resCert, resCert.Spec.Tags)
+	certTags[certificateChecksumTagKey] = computeCertificateChecksum(resCert.Spec)
+	return certTags
+}
+
+func buildSDKImportCertificateInput(certSpec acmmodel.CertificateSpec) *acmsdk.ImportCertificateInput {
+	req := &acmsdk.ImportCertificateInput{
+		Certificate: []byte(certSpec.Certificate),
+		PrivateKey:  []byte(certSpec.PrivateKey),
+	}
+	if len(certSpec.CertificateChain) != 0 {
+		req.CertificateChain = []byte(certSpec.CertificateChain)
+	}
+	return req
+}
+
+// computeCertificateChecksum computes the checksum of certificate material.
+// the private key is excluded since it can only change together with the certificate.
+func computeCertificateChecksum(certSpec acmmodel.CertificateSpec) string {
+	checksum := sha256.New()
+	_, _ = checksum.Write([]byte(certSpec.Certificate))
+	_, _ = checksum.Write([]byte(certSpec.CertificateChain))
+	return hex.EncodeToString(checksum.Sum(nil))
+}
+
+func isCertificateInUseError(err error) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code() == acmsdk.ErrCodeResourceInUseException
+	}
+	return false
+}
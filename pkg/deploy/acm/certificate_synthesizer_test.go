@@ -0,0 +1,94 @@
+package acm
+
+import (
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	acmmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/acm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"testing"
+)
+
+func Test_matchResAndSDKCertificates(t *testing.T) {
+	stack := core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "name"})
+	resCert1 := acmmodel.NewCertificate(stack, "my-ns/cert-1", acmmodel.CertificateSpec{SecretRef: "my-ns/cert-1"})
+	resCert2 := acmmodel.NewCertificate(stack, "my-ns/cert-2", acmmodel.CertificateSpec{SecretRef: "my-ns/cert-2"})
+	sdkCert1 := CertificateWithTags{
+		CertificateARN: "arn-1",
+		Tags:           map[string]string{"elbv2.k8s.aws/resource": "my-ns/cert-1"},
+	}
+	sdkCert1Dup := CertificateWithTags{
+		CertificateARN: "arn-1-dup",
+		Tags:           map[string]string{"elbv2.k8s.aws/resource": "my-ns/cert-1"},
+	}
+	sdkCert3 := CertificateWithTags{
+		CertificateARN: "arn-3",
+		Tags:           map[string]string{"elbv2.k8s.aws/resource": "my-ns/cert-3"},
+	}
+	type args struct {
+		resCerts []*acmmodel.Certificate
+		sdkCerts []CertificateWithTags
+	}
+	tests := []struct {
+		name                  string
+		args                  args
+		wantMatchedResAndSDKs []resAndSDKCertificatePair
+		wantUnmatchedResCerts []*acmmodel.Certificate
+		wantUnmatchedSDKCerts []CertificateWithTags
+		wantErr               error
+	}{
+		{
+			name: "all certificates matched",
+			args: args{
+				resCerts: []*acmmodel.Certificate{resCert1},
+				sdkCerts: []CertificateWithTags{sdkCert1},
+			},
+			wantMatchedResAndSDKs: []resAndSDKCertificatePair{
+				{resCert: resCert1, sdkCert: sdkCert1},
+			},
+		},
+		{
+			name: "some certificates matched",
+			args: args{
+				resCerts: []*acmmodel.Certificate{resCert1, resCert2},
+				sdkCerts: []CertificateWithTags{sdkCert1, sdkCert3},
+			},
+			wantMatchedResAndSDKs: []resAndSDKCertificatePair{
+				{resCert: resCert1, sdkCert: sdkCert1},
+			},
+			wantUnmatchedResCerts: []*acmmodel.Certificate{resCert2},
+			wantUnmatchedSDKCerts: []CertificateWithTags{sdkCert3},
+		},
+		{
+			name: "duplicated certificates are unmatched",
+			args: args{
+				resCerts: []*acmmodel.Certificate{resCert1},
+				sdkCerts: []CertificateWithTags{sdkCert1, sdkCert1Dup},
+			},
+			wantMatchedResAndSDKs: []resAndSDKCertificatePair{
+				{resCert: resCert1, sdkCert: sdkCert1},
+			},
+			wantUnmatchedSDKCerts: []CertificateWithTags{sdkCert1Dup},
+		},
+		{
+			name: "certificate without resourceID",
+			args: args{
+				resCerts: []*acmmodel.Certificate{resCert1},
+				sdkCerts: []CertificateWithTags{{CertificateARN: "arn-x", Tags: map[string]string{}}},
+			},
+			wantErr: errors.New("unexpected certificate with no resourceID: arn-x"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMatched, gotUnmatchedRes, gotUnmatchedSDK, err := matchResAndSDKCertificates(tt.args.resCerts, tt.args.sdkCerts, "elbv2.k8s.aws/resource")
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantMatchedResAndSDKs, gotMatched)
+				assert.Equal(t, tt.wantUnmatchedResCerts, gotUnmatchedRes)
+				assert.Equal(t, tt.wantUnmatchedSDKCerts, gotUnmatchedSDK)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package acm
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	acmmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/acm"
+)
+
+const (
+	resourceTypeCertificate = "AWS::CertificateManager::Certificate"
+)
+
+// NewDryRunCertificateManager constructs a CertificateManager that records planned changes instead of applying them.
+func NewDryRunCertificateManager(recorder *plan.Recorder, trackingProvider tracking.Provider, externalManagedTags []string) *dryRunCertificateManager {
+	return &dryRunCertificateManager{
+		recorder:            recorder,
+		trackingProvider:    trackingProvider,
+		externalManagedTags: externalManagedTags,
+	}
+}
+
+var _ CertificateManager = &dryRunCertificateManager{}
+
+type dryRunCertificateManager struct {
+	recorder            *plan.Recorder
+	trackingProvider    tracking.Provider
+	externalManagedTags []string
+}
+
+func (m *dryRunCertificateManager) Create(_ context.Context, resCert *acmmodel.Certificate) (acmmodel.CertificateStatus, error) {
+	m.recorder.RecordCreate(resourceTypeCertificate, resCert.ID(), resCert.Spec.SecretRef)
+	return acmmodel.CertificateStatus{
+		CertificateARN: plan.PlannedIdentifier(resourceTypeCertificate, resCert.ID()),
+	}, nil
+}
+
+func (m *dryRunCertificateManager) Update(_ context.Context, resCert *acmmodel.Certificate, sdkCert CertificateWithTags) (acmmodel.CertificateStatus, error) {
+	diffBuilder := &plan.DiffBuilder{}
+	desiredTags := m.trackingProvider.ResourceTags(resCert.Stack(), resCert, resCert.Spec.Tags)
+	desiredTags[certificateChecksumTagKey] = computeCertificateChecksum(resCert.Spec)
+	ignoredTagKeys := sets.NewString(m.trackingProvider.LegacyTagKeys()...).Insert(m.externalManagedTags...)
+	diffBuilder.Compare("tags", filterTags(sdkCert.Tags, ignoredTagKeys), filterTags(desiredTags, ignoredTagKeys))
+
+	m.recorder.RecordUpdate(resourceTypeCertificate, resCert.ID(), sdkCert.CertificateARN, resCert.Spec.SecretRef, diffBuilder.Diffs())
+	return acmmodel.CertificateStatus{
+		CertificateARN: sdkCert.CertificateARN,
+	}, nil
+}
+
+func (m *dryRunCertificateManager) Delete(_ context.Context, sdkCert CertificateWithTags) error {
+	m.recorder.RecordDelete(resourceTypeCertificate, sdkCert.Tags[m.trackingProvider.ResourceIDTagKey()], sdkCert.CertificateARN, "")
+	return nil
+}
+
+// filterTags returns tags without the ones with ignoredTagKeys.
+func filterTags(tags map[string]string, ignoredTagKeys sets.String) map[string]string {
+	filteredTags := make(map[string]string, len(tags))
+	for key, value := range tags {
+		if !ignoredTagKeys.Has(key) {
+			filteredTags[key] = value
+		}
+	}
+	return filteredTags
+}
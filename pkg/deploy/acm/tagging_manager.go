@@ -0,0 +1,202 @@
+package acm
+
+import (
+	"context"
+	"errors"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	acmsdk "github.com/aws/aws-sdk-go/service/acm"
+	rgtsdk "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+)
+
+const (
+	// resourceTypeFilterCertificate is the ResourceGroupsTaggingAPI resource type filter for ACM certificates.
+	resourceTypeFilterCertificate = "acm:certificate"
+)
+
+// Certificate with it's tags.
+type CertificateWithTags struct {
+	CertificateARN string
+	Tags           map[string]string
+}
+
+// options for ReconcileTags API.
+type ReconcileTagsOptions struct {
+	// CurrentTags on resources.
+	// when it's nil, the TaggingManager will try to get the CurrentTags from AWS
+	CurrentTags map[string]string
+
+	// IgnoredTagKeys defines the tag keys that should be ignored.
+	// these tags shouldn't be altered or deleted.
+	IgnoredTagKeys []string
+}
+
+func (opts *ReconcileTagsOptions) ApplyOptions(options []ReconcileTagsOption) {
+	for _, option := range options {
+		option(opts)
+	}
+}
+
+type ReconcileTagsOption func(opts *ReconcileTagsOptions)
+
+// WithCurrentTags is a reconcile option that supplies current tags.
+func WithCurrentTags(tags map[string]string) ReconcileTagsOption {
+	return func(opts *ReconcileTagsOptions) {
+		opts.CurrentTags = tags
+	}
+}
+
+// WithIgnoredTagKeys is a reconcile option that configures IgnoredTagKeys.
+func WithIgnoredTagKeys(ignoredTagKeys []string) ReconcileTagsOption {
+	return func(opts *ReconcileTagsOptions) {
+		opts.IgnoredTagKeys = append(opts.IgnoredTagKeys, ignoredTagKeys...)
+	}
+}
+
+// abstraction around tagging operations for ACM.
+type TaggingManager interface {
+	// ReconcileTags will reconcile tags on resources.
+	ReconcileTags(ctx context.Context, certARN string, desiredTags map[string]string, opts ...ReconcileTagsOption) error
+
+	// ListCertificates returns Certificates that matches any of the tagging requirements.
+	ListCertificates(ctx context.Context, tagFilters ...tracking.TagFilter) ([]CertificateWithTags, error)
+}
+
+// NewDefaultTaggingManager constructs new defaultTaggingManager.
+func NewDefaultTaggingManager(acmClient services.ACM, rgtClient services.RGT, logger logr.Logger) *defaultTaggingManager {
+	return &defaultTaggingManager{
+		acmClient: acmClient,
+		rgtClient: rgtClient,
+		logger:    logger,
+	}
+}
+
+var _ TaggingManager = &defaultTaggingManager{}
+
+// default implementation for TaggingManager.
+type defaultTaggingManager struct {
+	acmClient services.ACM
+	rgtClient services.RGT
+	logger    logr.Logger
+}
+
+func (m *defaultTaggingManager) ReconcileTags(ctx context.Context, certARN string, desiredTags map[string]string, opts ...ReconcileTagsOption) error {
+	reconcileOpts := ReconcileTagsOptions{
+		CurrentTags:    nil,
+		IgnoredTagKeys: nil,
+	}
+	reconcileOpts.ApplyOptions(opts)
+	currentTags := reconcileOpts.CurrentTags
+	if currentTags == nil {
+		return errors.New("currentTags must be specified")
+	}
+
+	tagsToUpdate, tagsToRemove := algorithm.DiffStringMap(desiredTags, currentTags)
+	for _, ignoredTagKey := range reconcileOpts.IgnoredTagKeys {
+		delete(tagsToUpdate, ignoredTagKey)
+		delete(tagsToRemove, ignoredTagKey)
+	}
+
+	if len(tagsToUpdate) > 0 {
+		req := &acmsdk.AddTagsToCertificateInput{
+			CertificateArn: awssdk.String(certARN),
+			Tags:           convertTagsToSDKTags(tagsToUpdate),
+		}
+
+		m.logger.Info("adding resource tags",
+			"arn", certARN,
+			"change", tagsToUpdate)
+		if _, err := m.acmClient.AddTagsToCertificateWithContext(ctx, req); err != nil {
+			return err
+		}
+		m.logger.Info("added resource tags",
+			"arn", certARN)
+	}
+
+	if len(tagsToRemove) > 0 {
+		req := &acmsdk.RemoveTagsFromCertificateInput{
+			CertificateArn: awssdk.String(certARN),
+			Tags:           convertTagsToSDKTags(tagsToRemove),
+		}
+
+		m.logger.Info("removing resource tags",
+			"arn", certARN,
+			"change", tagsToRemove)
+		if _, err := m.acmClient.RemoveTagsFromCertificateWithContext(ctx, req); err != nil {
+			return err
+		}
+		m.logger.Info("removed resource tags",
+			"arn", certARN)
+	}
+	return nil
+}
+
+func (m *defaultTaggingManager) ListCertificates(ctx context.Context, tagFilters ...tracking.TagFilter) ([]CertificateWithTags, error) {
+	certsByARN := make(map[string]CertificateWithTags)
+	for _, tagFilter := range tagFilters {
+		certsByARNForTagFilter, err := m.listCertificatesWithTagFilter(ctx, tagFilter)
+		if err != nil {
+			return nil, err
+		}
+		for certARN, cert := range certsByARNForTagFilter {
+			certsByARN[certARN] = cert
+		}
+	}
+
+	certs := make([]CertificateWithTags, 0, len(certsByARN))
+	for _, cert := range certsByARN {
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
+
+func (m *defaultTaggingManager) listCertificatesWithTagFilter(ctx context.Context, tagFilter tracking.TagFilter) (map[string]CertificateWithTags, error) {
+	req := &rgtsdk.GetResourcesInput{
+		ResourceTypeFilters: awssdk.StringSlice([]string{resourceTypeFilterCertificate}),
+	}
+	for _, tagKey := range sets.StringKeySet(tagFilter).List() {
+		req.TagFilters = append(req.TagFilters, &rgtsdk.TagFilter{
+			Key:    awssdk.String(tagKey),
+			Values: awssdk.StringSlice(tagFilter[tagKey]),
+		})
+	}
+
+	certsByARN := make(map[string]CertificateWithTags)
+	if err := m.rgtClient.GetResourcesPagesWithContext(ctx, req, func(output *rgtsdk.GetResourcesOutput, _ bool) bool {
+		for _, mapping := range output.ResourceTagMappingList {
+			certARN := awssdk.StringValue(mapping.ResourceARN)
+			tags := make(map[string]string, len(mapping.Tags))
+			for _, tag := range mapping.Tags {
+				tags[awssdk.StringValue(tag.Key)] = awssdk.StringValue(tag.Value)
+			}
+			certsByARN[certARN] = CertificateWithTags{
+				CertificateARN: certARN,
+				Tags:           tags,
+			}
+		}
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return certsByARN, nil
+}
+
+// convert tags into AWS SDK tag presentation.
+func convertTagsToSDKTags(tags map[string]string) []*acmsdk.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	sdkTags := make([]*acmsdk.Tag, 0, len(tags))
+
+	for _, key := range sets.StringKeySet(tags).List() {
+		sdkTags = append(sdkTags, &acmsdk.Tag{
+			Key:   awssdk.String(key),
+			Value: awssdk.String(tags[key]),
+		})
+	}
+	return sdkTags
+}
@@ -0,0 +1,84 @@
+package ec2
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+const (
+	resourceTypeSecurityGroup = "AWS::EC2::SecurityGroup"
+)
+
+// NewDryRunSecurityGroupManager constructs a SecurityGroupManager that records planned changes instead of applying them.
+func NewDryRunSecurityGroupManager(recorder *plan.Recorder, trackingProvider tracking.Provider, externalManagedTags []string) *dryRunSecurityGroupManager {
+	return &dryRunSecurityGroupManager{
+		recorder:            recorder,
+		trackingProvider:    trackingProvider,
+		externalManagedTags: externalManagedTags,
+	}
+}
+
+var _ SecurityGroupManager = &dryRunSecurityGroupManager{}
+
+type dryRunSecurityGroupManager struct {
+	recorder            *plan.Recorder
+	trackingProvider    tracking.Provider
+	externalManagedTags []string
+}
+
+func (m *dryRunSecurityGroupManager) Create(_ context.Context, resSG *ec2model.SecurityGroup) (ec2model.SecurityGroupStatus, error) {
+	if _, err := buildIPPermissionInfos(resSG.Spec.Ingress); err != nil {
+		return ec2model.SecurityGroupStatus{}, err
+	}
+	m.recorder.RecordCreate(resourceTypeSecurityGroup, resSG.ID(), resSG.Spec.GroupName)
+	return ec2model.SecurityGroupStatus{
+		GroupID: plan.PlannedIdentifier(resourceTypeSecurityGroup, resSG.ID()),
+	}, nil
+}
+
+func (m *dryRunSecurityGroupManager) Update(_ context.Context, resSG *ec2model.SecurityGroup, sdkSG networking.SecurityGroupInfo) (ec2model.SecurityGroupStatus, error) {
+	permissionInfos, err := buildIPPermissionInfos(resSG.Spec.Ingress)
+	if err != nil {
+		return ec2model.SecurityGroupStatus{}, err
+	}
+	diffBuilder := &plan.DiffBuilder{}
+	desiredTags := m.trackingProvider.ResourceTags(resSG.Stack(), resSG, resSG.Spec.Tags)
+	ignoredTagKeys := sets.NewString(m.trackingProvider.LegacyTagKeys()...).Insert(m.externalManagedTags...)
+	diffBuilder.Compare("tags", filterTags(sdkSG.Tags, ignoredTagKeys), filterTags(desiredTags, ignoredTagKeys))
+	diffBuilder.Compare("ingress", permissionHashCodes(sdkSG.Ingress), permissionHashCodes(permissionInfos))
+
+	m.recorder.RecordUpdate(resourceTypeSecurityGroup, resSG.ID(), sdkSG.SecurityGroupID, resSG.Spec.GroupName, diffBuilder.Diffs())
+	return ec2model.SecurityGroupStatus{
+		GroupID: sdkSG.SecurityGroupID,
+	}, nil
+}
+
+func (m *dryRunSecurityGroupManager) Delete(_ context.Context, sdkSG networking.SecurityGroupInfo) error {
+	m.recorder.RecordDelete(resourceTypeSecurityGroup, sdkSG.Tags[m.trackingProvider.ResourceIDTagKey()], sdkSG.SecurityGroupID, "")
+	return nil
+}
+
+// filterTags returns tags without the ones with ignoredTagKeys.
+func filterTags(tags map[string]string, ignoredTagKeys sets.String) map[string]string {
+	filteredTags := make(map[string]string, len(tags))
+	for key, value := range tags {
+		if !ignoredTagKeys.Has(key) {
+			filteredTags[key] = value
+		}
+	}
+	return filteredTags
+}
+
+// permissionHashCodes returns the sorted hashCodes of permissions.
+func permissionHashCodes(permissions []networking.IPPermissionInfo) []string {
+	hashCodes := sets.NewString()
+	for i := range permissions {
+		hashCodes.Insert(permissions[i].HashCode())
+	}
+	return hashCodes.List()
+}
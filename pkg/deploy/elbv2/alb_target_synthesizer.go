@@ -0,0 +1,47 @@
+package elbv2
+
+import (
+	"context"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// NewALBTargetSynthesizer constructs albTargetSynthesizer
+func NewALBTargetSynthesizer(tgManager TargetGroupManager, logger logr.Logger, stack core.Stack) *albTargetSynthesizer {
+	return &albTargetSynthesizer{
+		tgManager: tgManager,
+		logger:    logger,
+		stack:     stack,
+	}
+}
+
+// albTargetSynthesizer is responsible for synthesize the ALB targets of TargetGroups with alb TargetType for certain stack.
+type albTargetSynthesizer struct {
+	tgManager TargetGroupManager
+	logger    logr.Logger
+
+	stack core.Stack
+}
+
+func (s *albTargetSynthesizer) Synthesize(ctx context.Context) error {
+	// ALB targets are synthesized after listeners given below facts:
+	// * the ALB can only be registered once it has a listener on the targetGroup's port.
+	// * stale targets left over by replaced ALBs must be deregistered before the replaced ALBs are deleted during post synthesize.
+	var resTGs []*elbv2model.TargetGroup
+	s.stack.ListResources(&resTGs)
+	for _, resTG := range resTGs {
+		if resTG.Spec.TargetType != elbv2model.TargetTypeALB || resTG.Spec.ALBTarget == nil {
+			continue
+		}
+		if err := s.tgManager.ReconcileALBTarget(ctx, resTG); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *albTargetSynthesizer) PostSynthesize(ctx context.Context) error {
+	// nothing to do here.
+	return nil
+}
@@ -0,0 +1,440 @@
+package elbv2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	elbv2equality "sigs.k8s.io/aws-load-balancer-controller/pkg/equality/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	resourceTypeLoadBalancer       = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+	resourceTypeTargetGroup        = "AWS::ElasticLoadBalancingV2::TargetGroup"
+	resourceTypeListener           = "AWS::ElasticLoadBalancingV2::Listener"
+	resourceTypeListenerRule       = "AWS::ElasticLoadBalancingV2::ListenerRule"
+	resourceTypeTrustStore         = "AWS::ElasticLoadBalancingV2::TrustStore"
+	resourceTypeTargetGroupBinding = "K8S::ElasticLoadBalancingV2::TargetGroupBinding"
+)
+
+// NewDryRunTaggingManager constructs a TaggingManager that lists nothing on resources planned to be created.
+func NewDryRunTaggingManager(taggingManager TaggingManager) *dryRunTaggingManager {
+	return &dryRunTaggingManager{
+		TaggingManager: taggingManager,
+	}
+}
+
+var _ TaggingManager = &dryRunTaggingManager{}
+
+// dryRunTaggingManager lists resources from AWS, except resources on load balancers or listeners that are planned to be created.
+type dryRunTaggingManager struct {
+	TaggingManager
+}
+
+func (m *dryRunTaggingManager) ListListeners(ctx context.Context, lbARN string) ([]ListenerWithTags, error) {
+	if plan.IsPlannedIdentifier(lbARN) {
+		return nil, nil
+	}
+	return m.TaggingManager.ListListeners(ctx, lbARN)
+}
+
+func (m *dryRunTaggingManager) ListListenerRules(ctx context.Context, lsARN string) ([]ListenerRuleWithTags, error) {
+	if plan.IsPlannedIdentifier(lsARN) {
+		return nil, nil
+	}
+	return m.TaggingManager.ListListenerRules(ctx, lsARN)
+}
+
+// NewDryRunLoadBalancerManager constructs a LoadBalancerManager that records planned changes instead of applying them.
+func NewDryRunLoadBalancerManager(elbv2Client services.ELBV2, recorder *plan.Recorder, trackingProvider tracking.Provider,
+	externalManagedTags []string, logger logr.Logger) *dryRunLoadBalancerManager {
+	return &dryRunLoadBalancerManager{
+		recorder:             recorder,
+		trackingProvider:     trackingProvider,
+		externalManagedTags:  externalManagedTags,
+		attributesReconciler: NewDefaultLoadBalancerAttributeReconciler(elbv2Client, logger),
+	}
+}
+
+var _ LoadBalancerManager = &dryRunLoadBalancerManager{}
+
+type dryRunLoadBalancerManager struct {
+	recorder             *plan.Recorder
+	trackingProvider     tracking.Provider
+	externalManagedTags  []string
+	attributesReconciler *defaultLoadBalancerAttributeReconciler
+}
+
+func (m *dryRunLoadBalancerManager) Create(_ context.Context, resLB *elbv2model.LoadBalancer) (elbv2model.LoadBalancerStatus, error) {
+	m.recorder.RecordCreate(resourceTypeLoadBalancer, resLB.ID(), resLB.Spec.Name)
+	return elbv2model.LoadBalancerStatus{
+		LoadBalancerARN: plan.PlannedIdentifier(resourceTypeLoadBalancer, resLB.ID()),
+	}, nil
+}
+
+func (m *dryRunLoadBalancerManager) Update(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) (elbv2model.LoadBalancerStatus, error) {
+	diffBuilder := &plan.DiffBuilder{}
+	desiredTags := m.trackingProvider.ResourceTags(resLB.Stack(), resLB, resLB.Spec.Tags)
+	compareTags(diffBuilder, sdkLB.Tags, desiredTags, m.trackingProvider.LegacyTagKeys(), m.externalManagedTags)
+
+	desiredSecurityGroups, currentSecurityGroups, err := buildDesiredAndCurrentLoadBalancerSecurityGroups(resLB, sdkLB)
+	if err != nil {
+		return elbv2model.LoadBalancerStatus{}, err
+	}
+	diffBuilder.Compare("securityGroups", currentSecurityGroups.List(), desiredSecurityGroups.List())
+
+	desiredSubnets, currentSubnets := buildDesiredAndCurrentLoadBalancerSubnets(resLB, sdkLB)
+	diffBuilder.Compare("subnets", currentSubnets.List(), desiredSubnets.List())
+
+	if resLB.Spec.IPAddressType != nil {
+		diffBuilder.Compare("ipAddressType", awssdk.StringValue(sdkLB.LoadBalancer.IpAddressType), string(*resLB.Spec.IPAddressType))
+	}
+
+	attributesToUpdate, currentAttrs, err := m.attributesReconciler.computeAttributesToUpdate(ctx, resLB, sdkLB)
+	if err != nil {
+		return elbv2model.LoadBalancerStatus{}, err
+	}
+	compareAttributes(diffBuilder, attributesToUpdate, currentAttrs)
+
+	m.recorder.RecordUpdate(resourceTypeLoadBalancer, resLB.ID(), awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn),
+		resLB.Spec.Name, diffBuilder.Diffs())
+	return buildResLoadBalancerStatus(sdkLB), nil
+}
+
+func (m *dryRunLoadBalancerManager) Delete(_ context.Context, sdkLB LoadBalancerWithTags) error {
+	m.recorder.RecordDelete(resourceTypeLoadBalancer, sdkLB.Tags[m.trackingProvider.ResourceIDTagKey()],
+		awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn), awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerName))
+	return nil
+}
+
+// NewDryRunTargetGroupManager constructs a TargetGroupManager that records planned changes instead of applying them.
+func NewDryRunTargetGroupManager(elbv2Client services.ELBV2, recorder *plan.Recorder, trackingProvider tracking.Provider,
+	externalManagedTags []string, logger logr.Logger) *dryRunTargetGroupManager {
+	return &dryRunTargetGroupManager{
+		recorder:             recorder,
+		trackingProvider:     trackingProvider,
+		externalManagedTags:  externalManagedTags,
+		attributesReconciler: NewDefaultTargetGroupAttributesReconciler(elbv2Client, logger),
+	}
+}
+
+var _ TargetGroupManager = &dryRunTargetGroupManager{}
+
+type dryRunTargetGroupManager struct {
+	recorder             *plan.Recorder
+	trackingProvider     tracking.Provider
+	externalManagedTags  []string
+	attributesReconciler *defaultTargetGroupAttributeReconciler
+}
+
+func (m *dryRunTargetGroupManager) Create(_ context.Context, resTG *elbv2model.TargetGroup) (elbv2model.TargetGroupStatus, error) {
+	m.recorder.RecordCreate(resourceTypeTargetGroup, resTG.ID(), resTG.Spec.Name)
+	return elbv2model.TargetGroupStatus{
+		TargetGroupARN: plan.PlannedIdentifier(resourceTypeTargetGroup, resTG.ID()),
+	}, nil
+}
+
+func (m *dryRunTargetGroupManager) Update(ctx context.Context, resTG *elbv2model.TargetGroup, sdkTG TargetGroupWithTags) (elbv2model.TargetGroupStatus, error) {
+	diffBuilder := &plan.DiffBuilder{}
+	desiredTags := m.trackingProvider.ResourceTags(resTG.Stack(), resTG, resTG.Spec.Tags)
+	compareTags(diffBuilder, sdkTG.Tags, desiredTags, m.trackingProvider.LegacyTagKeys(), m.externalManagedTags)
+
+	for _, field := range buildTargetGroupHealthCheckFields(resTG.Spec, sdkTG) {
+		diffBuilder.Compare("healthCheck."+field.name, field.current, field.desired)
+	}
+
+	attributesToUpdate, currentAttrs, err := m.attributesReconciler.computeAttributesToUpdate(ctx, resTG, sdkTG)
+	if err != nil {
+		return elbv2model.TargetGroupStatus{}, err
+	}
+	compareAttributes(diffBuilder, attributesToUpdate, currentAttrs)
+
+	m.recorder.RecordUpdate(resourceTypeTargetGroup, resTG.ID(), awssdk.StringValue(sdkTG.TargetGroup.TargetGroupArn),
+		resTG.Spec.Name, diffBuilder.Diffs())
+	return buildResTargetGroupStatus(sdkTG), nil
+}
+
+func (m *dryRunTargetGroupManager) Delete(_ context.Context, sdkTG TargetGroupWithTags) error {
+	m.recorder.RecordDelete(resourceTypeTargetGroup, sdkTG.Tags[m.trackingProvider.ResourceIDTagKey()],
+		awssdk.StringValue(sdkTG.TargetGroup.TargetGroupArn), awssdk.StringValue(sdkTG.TargetGroup.TargetGroupName))
+	return nil
+}
+
+func (m *dryRunTargetGroupManager) ReconcileALBTarget(_ context.Context, _ *elbv2model.TargetGroup) error {
+	// targets are not part of the plan, same as targets registered by TargetGroupBindings.
+	return nil
+}
+
+// NewDryRunListenerManager constructs a ListenerManager that records planned changes instead of applying them.
+func NewDryRunListenerManager(recorder *plan.Recorder, trackingProvider tracking.Provider, externalManagedTags []string,
+	featureGates config.FeatureGates) *dryRunListenerManager {
+	return &dryRunListenerManager{
+		recorder:            recorder,
+		trackingProvider:    trackingProvider,
+		externalManagedTags: externalManagedTags,
+		featureGates:        featureGates,
+	}
+}
+
+var _ ListenerManager = &dryRunListenerManager{}
+
+type dryRunListenerManager struct {
+	recorder            *plan.Recorder
+	trackingProvider    tracking.Provider
+	externalManagedTags []string
+	featureGates        config.FeatureGates
+}
+
+func (m *dryRunListenerManager) Create(_ context.Context, resLS *elbv2model.Listener) (elbv2model.ListenerStatus, error) {
+	m.recorder.RecordCreate(resourceTypeListener, resLS.ID(), fmt.Sprintf("port %v", resLS.Spec.Port))
+	return elbv2model.ListenerStatus{
+		ListenerARN: plan.PlannedIdentifier(resourceTypeListener, resLS.ID()),
+	}, nil
+}
+
+func (m *dryRunListenerManager) Update(ctx context.Context, resLS *elbv2model.Listener, sdkLS ListenerWithTags) (elbv2model.ListenerStatus, error) {
+	diffBuilder := &plan.DiffBuilder{}
+	if m.featureGates.Enabled(config.ListenerRulesTagging) {
+		desiredTags := m.trackingProvider.ResourceTags(resLS.Stack(), resLS, resLS.Spec.Tags)
+		compareTags(diffBuilder, sdkLS.Tags, desiredTags, m.externalManagedTags)
+	}
+
+	desiredDefaultActions, err := buildSDKActions(resLS.Spec.DefaultActions, m.featureGates)
+	if err != nil {
+		return elbv2model.ListenerStatus{}, err
+	}
+	desiredDefaultCerts, _, err := buildSDKCertificates(resLS.Spec.Certificates)
+	if err != nil {
+		return elbv2model.ListenerStatus{}, err
+	}
+	diffBuilder.Compare("protocol", awssdk.StringValue(sdkLS.Listener.Protocol), string(resLS.Spec.Protocol))
+	if !cmp.Equal(desiredDefaultActions, sdkLS.Listener.DefaultActions, elbv2equality.CompareOptionForActions()) {
+		diffBuilder.Compare("defaultActions", sdkLS.Listener.DefaultActions, desiredDefaultActions)
+	}
+	if !cmp.Equal(desiredDefaultCerts, sdkLS.Listener.Certificates, elbv2equality.CompareOptionForCertificates()) {
+		diffBuilder.Compare("certificates", certificateARNs(sdkLS.Listener.Certificates), certificateARNs(desiredDefaultCerts))
+	}
+	if resLS.Spec.SSLPolicy != nil {
+		diffBuilder.Compare("sslPolicy", sdkLS.Listener.SslPolicy, resLS.Spec.SSLPolicy)
+	}
+	if len(resLS.Spec.ALPNPolicy) != 0 {
+		diffBuilder.Compare("alpnPolicy", awssdk.StringValueSlice(sdkLS.Listener.AlpnPolicy), resLS.Spec.ALPNPolicy)
+	}
+	if resLS.Spec.MutualAuthentication != nil {
+		desiredMutualAuthentication, err := buildSDKMutualAuthenticationAttributes(ctx, *resLS.Spec.MutualAuthentication)
+		if err != nil {
+			return elbv2model.ListenerStatus{}, err
+		}
+		if !isSDKMutualAuthenticationAttributesEqual(desiredMutualAuthentication, sdkLS.Listener.MutualAuthentication) {
+			diffBuilder.Compare("mutualAuthentication", sdkLS.Listener.MutualAuthentication, desiredMutualAuthentication)
+		}
+	}
+
+	m.recorder.RecordUpdate(resourceTypeListener, resLS.ID(), awssdk.StringValue(sdkLS.Listener.ListenerArn),
+		fmt.Sprintf("port %v", resLS.Spec.Port), diffBuilder.Diffs())
+	return buildResListenerStatus(sdkLS), nil
+}
+
+func (m *dryRunListenerManager) Delete(_ context.Context, sdkLS ListenerWithTags) error {
+	m.recorder.RecordDelete(resourceTypeListener, "", awssdk.StringValue(sdkLS.Listener.ListenerArn),
+		fmt.Sprintf("port %v", awssdk.Int64Value(sdkLS.Listener.Port)))
+	return nil
+}
+
+// NewDryRunListenerRuleManager constructs a ListenerRuleManager that records planned changes instead of applying them.
+func NewDryRunListenerRuleManager(recorder *plan.Recorder, trackingProvider tracking.Provider, externalManagedTags []string,
+	featureGates config.FeatureGates) *dryRunListenerRuleManager {
+	return &dryRunListenerRuleManager{
+		recorder:            recorder,
+		trackingProvider:    trackingProvider,
+		externalManagedTags: externalManagedTags,
+		featureGates:        featureGates,
+	}
+}
+
+var _ ListenerRuleManager = &dryRunListenerRuleManager{}
+
+type dryRunListenerRuleManager struct {
+	recorder            *plan.Recorder
+	trackingProvider    tracking.Provider
+	externalManagedTags []string
+	featureGates        config.FeatureGates
+}
+
+func (m *dryRunListenerRuleManager) Create(_ context.Context, resLR *elbv2model.ListenerRule) (elbv2model.ListenerRuleStatus, error) {
+	m.recorder.RecordCreate(resourceTypeListenerRule, resLR.ID(), fmt.Sprintf("priority %v", resLR.Spec.Priority))
+	return elbv2model.ListenerRuleStatus{
+		RuleARN: plan.PlannedIdentifier(resourceTypeListenerRule, resLR.ID()),
+	}, nil
+}
+
+func (m *dryRunListenerRuleManager) Update(_ context.Context, resLR *elbv2model.ListenerRule, sdkLR ListenerRuleWithTags) (elbv2model.ListenerRuleStatus, error) {
+	diffBuilder := &plan.DiffBuilder{}
+	if m.featureGates.Enabled(config.ListenerRulesTagging) {
+		desiredTags := m.trackingProvider.ResourceTags(resLR.Stack(), resLR, resLR.Spec.Tags)
+		compareTags(diffBuilder, sdkLR.Tags, desiredTags, m.externalManagedTags)
+	}
+
+	desiredActions, err := buildSDKActions(resLR.Spec.Actions, m.featureGates)
+	if err != nil {
+		return elbv2model.ListenerRuleStatus{}, err
+	}
+	desiredConditions := buildSDKRuleConditions(resLR.Spec.Conditions)
+	if !cmp.Equal(desiredActions, sdkLR.ListenerRule.Actions, elbv2equality.CompareOptionForActions()) {
+		diffBuilder.Compare("actions", sdkLR.ListenerRule.Actions, desiredActions)
+	}
+	if !cmp.Equal(desiredConditions, sdkLR.ListenerRule.Conditions, elbv2equality.CompareOptionForRuleConditions()) {
+		diffBuilder.Compare("conditions", sdkLR.ListenerRule.Conditions, desiredConditions)
+	}
+
+	m.recorder.RecordUpdate(resourceTypeListenerRule, resLR.ID(), awssdk.StringValue(sdkLR.ListenerRule.RuleArn),
+		fmt.Sprintf("priority %v", resLR.Spec.Priority), diffBuilder.Diffs())
+	return buildResListenerRuleStatus(sdkLR), nil
+}
+
+func (m *dryRunListenerRuleManager) SetPriorities(_ context.Context, _ string, resAndSDKLRs []resAndSDKListenerRulePair) error {
+	for _, resAndSDKLR := range resAndSDKLRs {
+		diffBuilder := &plan.DiffBuilder{}
+		diffBuilder.Compare("priority", sdkListenerRulePriority(resAndSDKLR.sdkLR), resAndSDKLR.resLR.Spec.Priority)
+		m.recorder.RecordUpdate(resourceTypeListenerRule, resAndSDKLR.resLR.ID(), awssdk.StringValue(resAndSDKLR.sdkLR.ListenerRule.RuleArn),
+			fmt.Sprintf("priority %v", resAndSDKLR.resLR.Spec.Priority), diffBuilder.Diffs())
+	}
+	return nil
+}
+
+func (m *dryRunListenerRuleManager) Delete(_ context.Context, sdkLR ListenerRuleWithTags) error {
+	m.recorder.RecordDelete(resourceTypeListenerRule, "", awssdk.StringValue(sdkLR.ListenerRule.RuleArn),
+		fmt.Sprintf("priority %v", awssdk.StringValue(sdkLR.ListenerRule.Priority)))
+	return nil
+}
+
+// NewDryRunTrustStoreManager constructs a TrustStoreManager that records planned changes instead of applying them.
+func NewDryRunTrustStoreManager(recorder *plan.Recorder, trackingProvider tracking.Provider, externalManagedTags []string) *dryRunTrustStoreManager {
+	return &dryRunTrustStoreManager{
+		recorder:            recorder,
+		trackingProvider:    trackingProvider,
+		externalManagedTags: externalManagedTags,
+	}
+}
+
+var _ TrustStoreManager = &dryRunTrustStoreManager{}
+
+type dryRunTrustStoreManager struct {
+	recorder            *plan.Recorder
+	trackingProvider    tracking.Provider
+	externalManagedTags []string
+}
+
+func (m *dryRunTrustStoreManager) Create(_ context.Context, resTS *elbv2model.TrustStore) (elbv2model.TrustStoreStatus, error) {
+	m.recorder.RecordCreate(resourceTypeTrustStore, resTS.ID(), resTS.Spec.Name)
+	return elbv2model.TrustStoreStatus{
+		TrustStoreARN: plan.PlannedIdentifier(resourceTypeTrustStore, resTS.ID()),
+	}, nil
+}
+
+func (m *dryRunTrustStoreManager) Update(_ context.Context, resTS *elbv2model.TrustStore, sdkTS TrustStoreWithTags) (elbv2model.TrustStoreStatus, error) {
+	diffBuilder := &plan.DiffBuilder{}
+	desiredTags := m.trackingProvider.ResourceTags(resTS.Stack(), resTS, resTS.Spec.Tags)
+	desiredTags[trustStoreBundleChecksumTagKey] = computeCACertificatesBundleChecksum(resTS.Spec)
+	compareTags(diffBuilder, sdkTS.Tags, desiredTags, m.trackingProvider.LegacyTagKeys(), m.externalManagedTags)
+
+	tsARN := awssdk.StringValue(sdkTS.TrustStore.TrustStoreArn)
+	m.recorder.RecordUpdate(resourceTypeTrustStore, resTS.ID(), tsARN, resTS.Spec.Name, diffBuilder.Diffs())
+	return elbv2model.TrustStoreStatus{
+		TrustStoreARN: tsARN,
+	}, nil
+}
+
+func (m *dryRunTrustStoreManager) Delete(_ context.Context, sdkTS TrustStoreWithTags) error {
+	m.recorder.RecordDelete(resourceTypeTrustStore, sdkTS.Tags[m.trackingProvider.ResourceIDTagKey()],
+		awssdk.StringValue(sdkTS.TrustStore.TrustStoreArn), awssdk.StringValue(sdkTS.TrustStore.Name))
+	return nil
+}
+
+// NewDryRunTargetGroupBindingManager constructs a TargetGroupBindingManager that records planned changes instead of applying them.
+func NewDryRunTargetGroupBindingManager(recorder *plan.Recorder) *dryRunTargetGroupBindingManager {
+	return &dryRunTargetGroupBindingManager{
+		recorder: recorder,
+	}
+}
+
+var _ TargetGroupBindingManager = &dryRunTargetGroupBindingManager{}
+
+type dryRunTargetGroupBindingManager struct {
+	recorder *plan.Recorder
+}
+
+func (m *dryRunTargetGroupBindingManager) Create(_ context.Context, resTGB *elbv2model.TargetGroupBindingResource) (elbv2model.TargetGroupBindingResourceStatus, error) {
+	tgbKey := fmt.Sprintf("%s/%s", resTGB.Spec.Template.Namespace, resTGB.Spec.Template.Name)
+	m.recorder.RecordCreate(resourceTypeTargetGroupBinding, resTGB.ID(), tgbKey)
+	return buildResTargetGroupBindingStatus(&elbv2api.TargetGroupBinding{
+		ObjectMeta: resTGB.Spec.Template.ObjectMeta,
+	}), nil
+}
+
+func (m *dryRunTargetGroupBindingManager) Update(ctx context.Context, resTGB *elbv2model.TargetGroupBindingResource, k8sTGB *elbv2api.TargetGroupBinding) (elbv2model.TargetGroupBindingResourceStatus, error) {
+	k8sTGBSpec, err := buildK8sTargetGroupBindingSpec(ctx, resTGB)
+	if err != nil {
+		return elbv2model.TargetGroupBindingResourceStatus{}, err
+	}
+	diffBuilder := &plan.DiffBuilder{}
+	if !equality.Semantic.DeepEqual(k8sTGB.Spec, k8sTGBSpec) {
+		diffBuilder.Compare("spec", k8sTGB.Spec, k8sTGBSpec)
+	}
+	m.recorder.RecordUpdate(resourceTypeTargetGroupBinding, resTGB.ID(), k8s.NamespacedName(k8sTGB).String(),
+		k8s.NamespacedName(k8sTGB).String(), diffBuilder.Diffs())
+	return buildResTargetGroupBindingStatus(k8sTGB), nil
+}
+
+func (m *dryRunTargetGroupBindingManager) Delete(_ context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	m.recorder.RecordDelete(resourceTypeTargetGroupBinding, "", k8s.NamespacedName(tgb).String(), k8s.NamespacedName(tgb).String())
+	return nil
+}
+
+// compareTags compares the tags of a resource the same way as ReconcileTags, ignoring tags with ignoredTagKeys.
+func compareTags(diffBuilder *plan.DiffBuilder, currentTags map[string]string, desiredTags map[string]string, ignoredTagKeys ...[]string) {
+	ignoredKeys := sets.NewString()
+	for _, keys := range ignoredTagKeys {
+		ignoredKeys.Insert(keys...)
+	}
+	filterTags := func(tags map[string]string) map[string]string {
+		filteredTags := make(map[string]string, len(tags))
+		for key, value := range tags {
+			if !ignoredKeys.Has(key) {
+				filteredTags[key] = value
+			}
+		}
+		return filteredTags
+	}
+	diffBuilder.Compare("tags", filterTags(currentTags), filterTags(desiredTags))
+}
+
+// compareAttributes records the attributes to update into diffBuilder, along with their current values.
+func compareAttributes(diffBuilder *plan.DiffBuilder, attributesToUpdate map[string]string, currentAttrs map[string]string) {
+	for _, key := range sets.StringKeySet(attributesToUpdate).List() {
+		diffBuilder.Compare("attributes."+key, currentAttrs[key], attributesToUpdate[key])
+	}
+}
+
+// certificateARNs returns the sorted ARNs of certificates.
+func certificateARNs(certs []*elbv2sdk.Certificate) []string {
+	arns := make([]string, 0, len(certs))
+	for _, cert := range certs {
+		arns = append(arns, awssdk.StringValue(cert.CertificateArn))
+	}
+	sort.Strings(arns)
+	return arns
+}
@@ -0,0 +1,232 @@
+package elbv2
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_dryRunLoadBalancerManager_Update(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	trackingProvider := tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name")
+	dualstack := elbv2model.IPAddressTypeDualStack
+	resLB := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{
+		Name: "my-lb",
+		SubnetMappings: []elbv2model.SubnetMapping{
+			{SubnetID: "subnet-b"},
+			{SubnetID: "subnet-a"},
+		},
+		SecurityGroups: []coremodel.StringToken{coremodel.LiteralStringToken("sg-a")},
+		IPAddressType:  &dualstack,
+		LoadBalancerAttributes: []elbv2model.LoadBalancerAttribute{
+			{Key: "idle_timeout.timeout_seconds", Value: "120"},
+		},
+	})
+	trackingTags := trackingProvider.ResourceTags(stack, resLB, nil)
+
+	tests := []struct {
+		name         string
+		sdkLB        LoadBalancerWithTags
+		currentAttrs []*elbv2sdk.LoadBalancerAttribute
+		want         *plan.Plan
+	}{
+		{
+			name: "load balancer is up to date",
+			sdkLB: LoadBalancerWithTags{
+				LoadBalancer: &elbv2sdk.LoadBalancer{
+					LoadBalancerArn: awssdk.String("lb-arn"),
+					AvailabilityZones: []*elbv2sdk.AvailabilityZone{
+						{SubnetId: awssdk.String("subnet-a")},
+						{SubnetId: awssdk.String("subnet-b")},
+					},
+					SecurityGroups: awssdk.StringSlice([]string{"sg-a"}),
+					IpAddressType:  awssdk.String("dualstack"),
+				},
+				Tags: trackingTags,
+			},
+			currentAttrs: []*elbv2sdk.LoadBalancerAttribute{
+				{Key: awssdk.String("idle_timeout.timeout_seconds"), Value: awssdk.String("120")},
+				{Key: awssdk.String("deletion_protection.enabled"), Value: awssdk.String("false")},
+			},
+			want: &plan.Plan{
+				StackID: "namespace/name",
+				Changes: []plan.ResourceChange{},
+			},
+		},
+		{
+			name: "load balancer needs update",
+			sdkLB: LoadBalancerWithTags{
+				LoadBalancer: &elbv2sdk.LoadBalancer{
+					LoadBalancerArn: awssdk.String("lb-arn"),
+					AvailabilityZones: []*elbv2sdk.AvailabilityZone{
+						{SubnetId: awssdk.String("subnet-a")},
+					},
+					SecurityGroups: awssdk.StringSlice([]string{"sg-a"}),
+					IpAddressType:  awssdk.String("ipv4"),
+				},
+				Tags: trackingTags,
+			},
+			currentAttrs: []*elbv2sdk.LoadBalancerAttribute{
+				{Key: awssdk.String("idle_timeout.timeout_seconds"), Value: awssdk.String("60")},
+			},
+			want: &plan.Plan{
+				StackID: "namespace/name",
+				Changes: []plan.ResourceChange{
+					{
+						Action:       plan.ChangeActionUpdate,
+						ResourceType: resourceTypeLoadBalancer,
+						ResourceID:   "LoadBalancer",
+						Identifier:   "lb-arn",
+						Description:  "my-lb",
+						Diffs: []plan.FieldDiff{
+							{Field: "subnets", Current: `["subnet-a"]`, Desired: `["subnet-a","subnet-b"]`},
+							{Field: "ipAddressType", Current: "ipv4", Desired: "dualstack"},
+							{Field: "attributes.idle_timeout.timeout_seconds", Current: "60", Desired: "120"},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			elbv2Client.EXPECT().DescribeLoadBalancerAttributesWithContext(gomock.Any(), &elbv2sdk.DescribeLoadBalancerAttributesInput{
+				LoadBalancerArn: awssdk.String("lb-arn"),
+			}).Return(&elbv2sdk.DescribeLoadBalancerAttributesOutput{Attributes: tt.currentAttrs}, nil)
+			recorder := plan.NewRecorder()
+			m := NewDryRunLoadBalancerManager(elbv2Client, recorder, trackingProvider, nil, &log.NullLogger{})
+			got, err := m.Update(context.Background(), resLB, tt.sdkLB)
+			assert.NoError(t, err)
+			assert.Equal(t, "lb-arn", got.LoadBalancerARN)
+			assert.Equal(t, tt.want, recorder.Plan(stack.StackID().String()))
+		})
+	}
+}
+
+func Test_dryRunLoadBalancerManager_Create(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	resLB := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{
+		Name: "my-lb",
+	})
+	recorder := plan.NewRecorder()
+	m := NewDryRunLoadBalancerManager(nil, recorder, tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name"), nil, &log.NullLogger{})
+	got, err := m.Create(context.Background(), resLB)
+	assert.NoError(t, err)
+	assert.True(t, plan.IsPlannedIdentifier(got.LoadBalancerARN))
+	assert.Equal(t, &plan.Plan{
+		StackID: "namespace/name",
+		Changes: []plan.ResourceChange{
+			{
+				Action:       plan.ChangeActionCreate,
+				ResourceType: resourceTypeLoadBalancer,
+				ResourceID:   "LoadBalancer",
+				Description:  "my-lb",
+			},
+		},
+	}, recorder.Plan(stack.StackID().String()))
+}
+
+func Test_dryRunTargetGroupManager_Update(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	trackingProvider := tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name")
+	resTG := elbv2model.NewTargetGroup(stack, "TargetGroup", elbv2model.TargetGroupSpec{
+		Name: "my-tg",
+		HealthCheckConfig: &elbv2model.TargetGroupHealthCheckConfig{
+			Path:            awssdk.String("/healthz"),
+			IntervalSeconds: awssdk.Int64(10),
+			Matcher:         &elbv2model.HealthCheckMatcher{HTTPCode: awssdk.String("200")},
+		},
+		TargetGroupAttributes: []elbv2model.TargetGroupAttribute{
+			{Key: "deregistration_delay.timeout_seconds", Value: "30"},
+		},
+	})
+	trackingTags := trackingProvider.ResourceTags(stack, resTG, nil)
+
+	tests := []struct {
+		name         string
+		sdkTG        TargetGroupWithTags
+		currentAttrs []*elbv2sdk.TargetGroupAttribute
+		want         *plan.Plan
+	}{
+		{
+			name: "target group is up to date",
+			sdkTG: TargetGroupWithTags{
+				TargetGroup: &elbv2sdk.TargetGroup{
+					TargetGroupArn:             awssdk.String("tg-arn"),
+					HealthCheckPath:            awssdk.String("/healthz"),
+					HealthCheckIntervalSeconds: awssdk.Int64(10),
+					Matcher:                    &elbv2sdk.Matcher{HttpCode: awssdk.String("200")},
+				},
+				Tags: trackingTags,
+			},
+			currentAttrs: []*elbv2sdk.TargetGroupAttribute{
+				{Key: awssdk.String("deregistration_delay.timeout_seconds"), Value: awssdk.String("30")},
+			},
+			want: &plan.Plan{
+				StackID: "namespace/name",
+				Changes: []plan.ResourceChange{},
+			},
+		},
+		{
+			name: "target group needs update",
+			sdkTG: TargetGroupWithTags{
+				TargetGroup: &elbv2sdk.TargetGroup{
+					TargetGroupArn:             awssdk.String("tg-arn"),
+					HealthCheckPath:            awssdk.String("/"),
+					HealthCheckIntervalSeconds: awssdk.Int64(15),
+					Matcher:                    &elbv2sdk.Matcher{HttpCode: awssdk.String("200")},
+				},
+				Tags: trackingTags,
+			},
+			currentAttrs: []*elbv2sdk.TargetGroupAttribute{
+				{Key: awssdk.String("deregistration_delay.timeout_seconds"), Value: awssdk.String("300")},
+			},
+			want: &plan.Plan{
+				StackID: "namespace/name",
+				Changes: []plan.ResourceChange{
+					{
+						Action:       plan.ChangeActionUpdate,
+						ResourceType: resourceTypeTargetGroup,
+						ResourceID:   "TargetGroup",
+						Identifier:   "tg-arn",
+						Description:  "my-tg",
+						Diffs: []plan.FieldDiff{
+							{Field: "healthCheck.path", Current: "/", Desired: "/healthz"},
+							{Field: "healthCheck.intervalSeconds", Current: "15", Desired: "10"},
+							{Field: "attributes.deregistration_delay.timeout_seconds", Current: "300", Desired: "30"},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			elbv2Client.EXPECT().DescribeTargetGroupAttributesWithContext(gomock.Any(), &elbv2sdk.DescribeTargetGroupAttributesInput{
+				TargetGroupArn: awssdk.String("tg-arn"),
+			}).Return(&elbv2sdk.DescribeTargetGroupAttributesOutput{Attributes: tt.currentAttrs}, nil)
+			recorder := plan.NewRecorder()
+			m := NewDryRunTargetGroupManager(elbv2Client, recorder, trackingProvider, nil, &log.NullLogger{})
+			got, err := m.Update(context.Background(), resTG, tt.sdkTG)
+			assert.NoError(t, err)
+			assert.Equal(t, "tg-arn", got.TargetGroupARN)
+			assert.Equal(t, tt.want, recorder.Plan(stack.StackID().String()))
+		})
+	}
+}
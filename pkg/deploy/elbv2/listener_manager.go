@@ -132,11 +132,21 @@ func (m *defaultListenerManager) updateSDKListenerWithSettings(ctx context.Conte
 	if err != nil {
 		return err
 	}
-	desiredDefaultCerts, _ := buildSDKCertificates(resLS.Spec.Certificates)
-	if !isSDKListenerSettingsDrifted(resLS.Spec, sdkLS, desiredDefaultActions, desiredDefaultCerts) {
+	desiredDefaultCerts, _, err := buildSDKCertificates(resLS.Spec.Certificates)
+	if err != nil {
+		return err
+	}
+	var desiredMutualAuthentication *elbv2sdk.MutualAuthenticationAttributes
+	if resLS.Spec.MutualAuthentication != nil {
+		desiredMutualAuthentication, err = buildSDKMutualAuthenticationAttributes(ctx, *resLS.Spec.MutualAuthentication)
+		if err != nil {
+			return err
+		}
+	}
+	if !isSDKListenerSettingsDrifted(resLS.Spec, sdkLS, desiredDefaultActions, desiredDefaultCerts, desiredMutualAuthentication) {
 		return nil
 	}
-	req := buildSDKModifyListenerInput(resLS.Spec, desiredDefaultActions, desiredDefaultCerts)
+	req := buildSDKModifyListenerInput(resLS.Spec, desiredDefaultActions, desiredDefaultCerts, desiredMutualAuthentication)
 	req.ListenerArn = sdkLS.Listener.ListenerArn
 	m.logger.Info("modifying listener",
 		"stackID", resLS.Stack().StackID(),
@@ -163,7 +173,10 @@ func (m *defaultListenerManager) updateSDKListenerWithExtraCertificates(ctx cont
 	}
 
 	desiredExtraCertARNs := sets.NewString()
-	_, desiredExtraCerts := buildSDKCertificates(resLS.Spec.Certificates)
+	_, desiredExtraCerts, err := buildSDKCertificates(resLS.Spec.Certificates)
+	if err != nil {
+		return err
+	}
 	for _, cert := range desiredExtraCerts {
 		desiredExtraCertARNs.Insert(awssdk.StringValue(cert.CertificateArn))
 	}
@@ -245,7 +258,8 @@ func (m *defaultListenerManager) fetchSDKListenerExtraCertificateARNs(ctx contex
 }
 
 func isSDKListenerSettingsDrifted(lsSpec elbv2model.ListenerSpec, sdkLS ListenerWithTags,
-	desiredDefaultActions []*elbv2sdk.Action, desiredDefaultCerts []*elbv2sdk.Certificate) bool {
+	desiredDefaultActions []*elbv2sdk.Action, desiredDefaultCerts []*elbv2sdk.Certificate,
+	desiredMutualAuthentication *elbv2sdk.MutualAuthenticationAttributes) bool {
 	if lsSpec.Port != awssdk.Int64Value(sdkLS.Listener.Port) {
 		return true
 	}
@@ -264,10 +278,30 @@ func isSDKListenerSettingsDrifted(lsSpec elbv2model.ListenerSpec, sdkLS Listener
 	if len(lsSpec.ALPNPolicy) != 0 && !cmp.Equal(lsSpec.ALPNPolicy, awssdk.StringValueSlice(sdkLS.Listener.AlpnPolicy), cmpopts.EquateEmpty()) {
 		return true
 	}
+	if desiredMutualAuthentication != nil && !isSDKMutualAuthenticationAttributesEqual(desiredMutualAuthentication, sdkLS.Listener.MutualAuthentication) {
+		return true
+	}
 
 	return false
 }
 
+// isSDKMutualAuthenticationAttributesEqual checks whether the current mutual authentication settings fulfill the desired ones.
+// the trustStore settings only matter in verify mode.
+func isSDKMutualAuthenticationAttributesEqual(desired *elbv2sdk.MutualAuthenticationAttributes, current *elbv2sdk.MutualAuthenticationAttributes) bool {
+	currentMode := string(elbv2model.MutualAuthenticationModeOff)
+	if current != nil && current.Mode != nil {
+		currentMode = awssdk.StringValue(current.Mode)
+	}
+	if awssdk.StringValue(desired.Mode) != currentMode {
+		return false
+	}
+	if currentMode != string(elbv2model.MutualAuthenticationModeVerify) {
+		return true
+	}
+	return awssdk.StringValue(desired.TrustStoreArn) == awssdk.StringValue(current.TrustStoreArn) &&
+		awssdk.BoolValue(desired.IgnoreClientCertificateExpiry) == awssdk.BoolValue(current.IgnoreClientCertificateExpiry)
+}
+
 func buildSDKCreateListenerInput(lsSpec elbv2model.ListenerSpec, featureGates config.FeatureGates) (*elbv2sdk.CreateListenerInput, error) {
 	ctx := context.Background()
 	lbARN, err := lsSpec.LoadBalancerARN.Resolve(ctx)
@@ -283,15 +317,25 @@ func buildSDKCreateListenerInput(lsSpec elbv2model.ListenerSpec, featureGates co
 		return nil, err
 	}
 	sdkObj.DefaultActions = defaultActions
-	sdkObj.Certificates, _ = buildSDKCertificates(lsSpec.Certificates)
+	sdkObj.Certificates, _, err = buildSDKCertificates(lsSpec.Certificates)
+	if err != nil {
+		return nil, err
+	}
 	sdkObj.SslPolicy = lsSpec.SSLPolicy
 	if len(lsSpec.ALPNPolicy) != 0 {
 		sdkObj.AlpnPolicy = awssdk.StringSlice(lsSpec.ALPNPolicy)
 	}
+	if lsSpec.MutualAuthentication != nil {
+		sdkObj.MutualAuthentication, err = buildSDKMutualAuthenticationAttributes(ctx, *lsSpec.MutualAuthentication)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return sdkObj, nil
 }
 
-func buildSDKModifyListenerInput(lsSpec elbv2model.ListenerSpec, desiredDefaultActions []*elbv2sdk.Action, desiredDefaultCerts []*elbv2sdk.Certificate) *elbv2sdk.ModifyListenerInput {
+func buildSDKModifyListenerInput(lsSpec elbv2model.ListenerSpec, desiredDefaultActions []*elbv2sdk.Action, desiredDefaultCerts []*elbv2sdk.Certificate,
+	desiredMutualAuthentication *elbv2sdk.MutualAuthenticationAttributes) *elbv2sdk.ModifyListenerInput {
 	sdkObj := &elbv2sdk.ModifyListenerInput{}
 	sdkObj.Port = awssdk.Int64(lsSpec.Port)
 	sdkObj.Protocol = awssdk.String(string(lsSpec.Protocol))
@@ -301,29 +345,60 @@ func buildSDKModifyListenerInput(lsSpec elbv2model.ListenerSpec, desiredDefaultA
 	if len(lsSpec.ALPNPolicy) != 0 {
 		sdkObj.AlpnPolicy = awssdk.StringSlice(lsSpec.ALPNPolicy)
 	}
+	sdkObj.MutualAuthentication = desiredMutualAuthentication
 	return sdkObj
 }
 
+// buildSDKMutualAuthenticationAttributes builds the mutual authentication settings for listener.
+// the trustStore settings are only specified in verify mode.
+func buildSDKMutualAuthenticationAttributes(ctx context.Context, modelMutualAuthentication elbv2model.MutualAuthenticationAttributes) (*elbv2sdk.MutualAuthenticationAttributes, error) {
+	sdkObj := &elbv2sdk.MutualAuthenticationAttributes{
+		Mode: awssdk.String(string(modelMutualAuthentication.Mode)),
+	}
+	if modelMutualAuthentication.Mode != elbv2model.MutualAuthenticationModeVerify {
+		return sdkObj, nil
+	}
+	if modelMutualAuthentication.TrustStoreARN != nil {
+		tsARN, err := modelMutualAuthentication.TrustStoreARN.Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		sdkObj.TrustStoreArn = awssdk.String(tsARN)
+	}
+	sdkObj.IgnoreClientCertificateExpiry = awssdk.Bool(awssdk.BoolValue(modelMutualAuthentication.IgnoreClientCertificateExpiry))
+	return sdkObj, nil
+}
+
 // buildSDKCertificates builds the certificate list for listener.
 // returns the default certificates and extra certificates.
-func buildSDKCertificates(modelCerts []elbv2model.Certificate) ([]*elbv2sdk.Certificate, []*elbv2sdk.Certificate) {
+func buildSDKCertificates(modelCerts []elbv2model.Certificate) ([]*elbv2sdk.Certificate, []*elbv2sdk.Certificate, error) {
 	if len(modelCerts) == 0 {
-		return nil, nil
+		return nil, nil, nil
 	}
 
-	var defaultSDKCerts []*elbv2sdk.Certificate
-	var extraSDKCerts []*elbv2sdk.Certificate
-	defaultSDKCerts = append(defaultSDKCerts, buildSDKCertificate(modelCerts[0]))
-	for _, cert := range modelCerts[1:] {
-		extraSDKCerts = append(extraSDKCerts, buildSDKCertificate(cert))
+	var sdkCerts []*elbv2sdk.Certificate
+	for _, cert := range modelCerts {
+		sdkCert, err := buildSDKCertificate(cert)
+		if err != nil {
+			return nil, nil, err
+		}
+		sdkCerts = append(sdkCerts, sdkCert)
 	}
-	return defaultSDKCerts, extraSDKCerts
+	return sdkCerts[:1], sdkCerts[1:], nil
 }
 
-func buildSDKCertificate(modelCert elbv2model.Certificate) *elbv2sdk.Certificate {
-	return &elbv2sdk.Certificate{
-		CertificateArn: modelCert.CertificateARN,
+func buildSDKCertificate(modelCert elbv2model.Certificate) (*elbv2sdk.Certificate, error) {
+	if modelCert.CertificateARN == nil {
+		return &elbv2sdk.Certificate{}, nil
 	}
+	ctx := context.Background()
+	certARN, err := modelCert.CertificateARN.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &elbv2sdk.Certificate{
+		CertificateArn: awssdk.String(certARN),
+	}, nil
 }
 
 func buildResListenerStatus(sdkLS ListenerWithTags) elbv2model.ListenerStatus {
@@ -4,6 +4,7 @@ import (
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"testing"
 )
@@ -14,6 +15,8 @@ func Test_isSDKListenerSettingsDrifted(t *testing.T) {
 		sdkLS                 ListenerWithTags
 		desiredDefaultActions []*elbv2sdk.Action
 		desiredDefaultCerts   []*elbv2sdk.Certificate
+
+		desiredMutualAuthentication *elbv2sdk.MutualAuthenticationAttributes
 	}
 	tests := []struct {
 		name string
@@ -77,10 +80,10 @@ func Test_isSDKListenerSettingsDrifted(t *testing.T) {
 					ALPNPolicy: []string{"HTTP2Preferred"},
 					Certificates: []elbv2model.Certificate{
 						{
-							CertificateARN: awssdk.String("cert-arn1"),
+							CertificateARN: core.LiteralStringToken("cert-arn1"),
 						},
 						{
-							CertificateARN: awssdk.String("cert-arn2"),
+							CertificateARN: core.LiteralStringToken("cert-arn2"),
 						},
 					},
 				},
@@ -179,7 +182,95 @@ func Test_isSDKListenerSettingsDrifted(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := isSDKListenerSettingsDrifted(tt.args.lsSpec, tt.args.sdkLS, tt.args.desiredDefaultActions, tt.args.desiredDefaultCerts)
+			got := isSDKListenerSettingsDrifted(tt.args.lsSpec, tt.args.sdkLS, tt.args.desiredDefaultActions, tt.args.desiredDefaultCerts,
+				tt.args.desiredMutualAuthentication)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_isSDKMutualAuthenticationAttributesEqual(t *testing.T) {
+	type args struct {
+		desired *elbv2sdk.MutualAuthenticationAttributes
+		current *elbv2sdk.MutualAuthenticationAttributes
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "off mode matches listener without mutual authentication",
+			args: args{
+				desired: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode: awssdk.String("off"),
+				},
+				current: nil,
+			},
+			want: true,
+		},
+		{
+			name: "passthrough mode differs from off mode",
+			args: args{
+				desired: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode: awssdk.String("passthrough"),
+				},
+				current: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode: awssdk.String("off"),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "verify mode with same trustStore",
+			args: args{
+				desired: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode:                          awssdk.String("verify"),
+					TrustStoreArn:                 awssdk.String("ts-arn"),
+					IgnoreClientCertificateExpiry: awssdk.Bool(false),
+				},
+				current: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode:          awssdk.String("verify"),
+					TrustStoreArn: awssdk.String("ts-arn"),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "verify mode with different trustStore",
+			args: args{
+				desired: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode:                          awssdk.String("verify"),
+					TrustStoreArn:                 awssdk.String("ts-arn"),
+					IgnoreClientCertificateExpiry: awssdk.Bool(false),
+				},
+				current: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode:          awssdk.String("verify"),
+					TrustStoreArn: awssdk.String("ts-arn-other"),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "verify mode with different ignoreClientCertificateExpiry",
+			args: args{
+				desired: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode:                          awssdk.String("verify"),
+					TrustStoreArn:                 awssdk.String("ts-arn"),
+					IgnoreClientCertificateExpiry: awssdk.Bool(true),
+				},
+				current: &elbv2sdk.MutualAuthenticationAttributes{
+					Mode:                          awssdk.String("verify"),
+					TrustStoreArn:                 awssdk.String("ts-arn"),
+					IgnoreClientCertificateExpiry: awssdk.Bool(false),
+				},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isSDKMutualAuthenticationAttributesEqual(tt.args.desired, tt.args.current)
 			assert.Equal(t, tt.want, got)
 		})
 	}
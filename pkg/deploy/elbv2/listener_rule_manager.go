@@ -23,6 +23,9 @@ type ListenerRuleManager interface {
 	Update(ctx context.Context, resLR *elbv2model.ListenerRule, sdkLR ListenerRuleWithTags) (elbv2model.ListenerRuleStatus, error)
 
 	Delete(ctx context.Context, sdkLR ListenerRuleWithTags) error
+
+	// SetPriorities reorders existing listener rules on listener to the priorities of their paired ListenerRule resources.
+	SetPriorities(ctx context.Context, lsARN string, resAndSDKLRs []resAndSDKListenerRulePair) error
 }
 
 // NewDefaultListenerRuleManager constructs new defaultListenerRuleManager.
@@ -115,6 +118,20 @@ func (m *defaultListenerRuleManager) Delete(ctx context.Context, sdkLR ListenerR
 	return nil
 }
 
+func (m *defaultListenerRuleManager) SetPriorities(ctx context.Context, lsARN string, resAndSDKLRs []resAndSDKListenerRulePair) error {
+	req := buildSDKSetRulePrioritiesInput(resAndSDKLRs)
+	m.logger.Info("setting listener rule priorities",
+		"listenerARN", lsARN,
+		"count", len(req.RulePriorities))
+	if _, err := m.elbv2Client.SetRulePrioritiesWithContext(ctx, req); err != nil {
+		return errors.Wrap(err, "failed to set listener rule priorities")
+	}
+	m.logger.Info("set listener rule priorities",
+		"listenerARN", lsARN,
+		"count", len(req.RulePriorities))
+	return nil
+}
+
 func (m *defaultListenerRuleManager) updateSDKListenerRuleWithSettings(ctx context.Context, resLR *elbv2model.ListenerRule, sdkLR ListenerRuleWithTags) error {
 	desiredActions, err := buildSDKActions(resLR.Spec.Actions, m.featureGates)
 	if err != nil {
@@ -186,6 +203,19 @@ func buildSDKModifyListenerRuleInput(_ elbv2model.ListenerRuleSpec, desiredActio
 	return sdkObj
 }
 
+// buildSDKSetRulePrioritiesInput builds a single request to reorder all listener rules at once,
+// so that priorities can be swapped between rules without conflicts.
+func buildSDKSetRulePrioritiesInput(resAndSDKLRs []resAndSDKListenerRulePair) *elbv2sdk.SetRulePrioritiesInput {
+	sdkObj := &elbv2sdk.SetRulePrioritiesInput{}
+	for _, resAndSDKLR := range resAndSDKLRs {
+		sdkObj.RulePriorities = append(sdkObj.RulePriorities, &elbv2sdk.RulePriorityPair{
+			RuleArn:  resAndSDKLR.sdkLR.ListenerRule.RuleArn,
+			Priority: awssdk.Int64(resAndSDKLR.resLR.Spec.Priority),
+		})
+	}
+	return sdkObj
+}
+
 func buildResListenerRuleStatus(sdkLR ListenerRuleWithTags) elbv2model.ListenerRuleStatus {
 	return elbv2model.ListenerRuleStatus{
 		RuleARN: awssdk.StringValue(sdkLR.ListenerRule.RuleArn),
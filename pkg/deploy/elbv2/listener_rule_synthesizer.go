@@ -6,17 +6,20 @@ import (
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sort"
 	"strconv"
 )
 
 // NewListenerRuleSynthesizer constructs new listenerRuleSynthesizer.
 func NewListenerRuleSynthesizer(elbv2Client services.ELBV2, taggingManager TaggingManager,
-	lrManager ListenerRuleManager, logger logr.Logger, stack core.Stack) *listenerRuleSynthesizer {
+	lrManager ListenerRuleManager, featureGates config.FeatureGates, logger logr.Logger, stack core.Stack) *listenerRuleSynthesizer {
 	return &listenerRuleSynthesizer{
 		elbv2Client:    elbv2Client,
 		lrManager:      lrManager,
+		featureGates:   featureGates,
 		logger:         logger,
 		taggingManager: taggingManager,
 		stack:          stack,
@@ -26,6 +29,7 @@ func NewListenerRuleSynthesizer(elbv2Client services.ELBV2, taggingManager Taggi
 type listenerRuleSynthesizer struct {
 	elbv2Client    services.ELBV2
 	lrManager      ListenerRuleManager
+	featureGates   config.FeatureGates
 	logger         logr.Logger
 	taggingManager TaggingManager
 
@@ -39,6 +43,11 @@ func (s *listenerRuleSynthesizer) Synthesize(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	var resRetainedLRs []*elbv2model.RetainedListenerRules
+	s.stack.ListResources(&resRetainedLRs)
+	for _, resRetainedLR := range resRetainedLRs {
+		resRetainedLR.SetStatus(elbv2model.RetainedListenerRulesStatus{})
+	}
 
 	var resLSs []*elbv2model.Listener
 	s.stack.ListResources(&resLSs)
@@ -48,7 +57,7 @@ func (s *listenerRuleSynthesizer) Synthesize(ctx context.Context) error {
 			return err
 		}
 		resLRs := resLRsByLSARN[lsARN]
-		if err := s.synthesizeListenerRulesOnListener(ctx, lsARN, resLRs); err != nil {
+		if err := s.synthesizeListenerRulesOnListener(ctx, lsARN, resLRs, resRetainedLRs); err != nil {
 			return err
 		}
 	}
@@ -60,24 +69,36 @@ func (s *listenerRuleSynthesizer) PostSynthesize(ctx context.Context) error {
 	return nil
 }
 
-func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnListener(ctx context.Context, lsARN string, resLRs []*elbv2model.ListenerRule) error {
+func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnListener(ctx context.Context, lsARN string, resLRs []*elbv2model.ListenerRule,
+	resRetainedLRs []*elbv2model.RetainedListenerRules) error {
 	sdkLRs, err := s.findSDKListenersRulesOnLS(ctx, lsARN)
 	if err != nil {
 		return err
 	}
+	sdkLRs, retainedPriorities := retainSDKListenerRules(sdkLRs, resRetainedLRs)
+	if retainedPriorities.Len() != 0 {
+		shiftListenerRulePriorities(resLRs, retainedPriorities)
+	}
 
-	matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs := matchResAndSDKListenerRules(resLRs, sdkLRs)
+	matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs, err := matchResAndSDKListenerRules(resLRs, sdkLRs, s.featureGates)
+	if err != nil {
+		return err
+	}
 	for _, sdkLR := range unmatchedSDKLRs {
 		if err := s.lrManager.Delete(ctx, sdkLR); err != nil {
 			return err
 		}
 	}
-	for _, resLR := range unmatchedResLRs {
-		lrStatus, err := s.lrManager.Create(ctx, resLR)
-		if err != nil {
+	var reprioritizedResAndSDKLRs []resAndSDKListenerRulePair
+	for _, resAndSDKLR := range matchedResAndSDKLRs {
+		if resAndSDKLR.resLR.Spec.Priority != sdkListenerRulePriority(resAndSDKLR.sdkLR) {
+			reprioritizedResAndSDKLRs = append(reprioritizedResAndSDKLRs, resAndSDKLR)
+		}
+	}
+	if len(reprioritizedResAndSDKLRs) != 0 {
+		if err := s.lrManager.SetPriorities(ctx, lsARN, reprioritizedResAndSDKLRs); err != nil {
 			return err
 		}
-		resLR.SetStatus(lrStatus)
 	}
 	for _, resAndSDKLR := range matchedResAndSDKLRs {
 		lsStatus, err := s.lrManager.Update(ctx, resAndSDKLR.resLR, resAndSDKLR.sdkLR)
@@ -86,6 +107,13 @@ func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnListener(ctx context.
 		}
 		resAndSDKLR.resLR.SetStatus(lsStatus)
 	}
+	for _, resLR := range unmatchedResLRs {
+		lrStatus, err := s.lrManager.Create(ctx, resLR)
+		if err != nil {
+			return err
+		}
+		resLR.SetStatus(lrStatus)
+	}
 	return nil
 }
 
@@ -105,12 +133,103 @@ func (s *listenerRuleSynthesizer) findSDKListenersRulesOnLS(ctx context.Context,
 	return nonDefaultRules, nil
 }
 
+// retainSDKListenerRules excludes the listenerRules matching resRetainedLRs from sdkLRs, so that they are kept as is.
+// the retained listenerRules and the targetGroups they forward to are recorded in the status of resRetainedLRs,
+// the remaining listenerRules and the priorities occupied by retained listenerRules are returned.
+func retainSDKListenerRules(sdkLRs []ListenerRuleWithTags, resRetainedLRs []*elbv2model.RetainedListenerRules) ([]ListenerRuleWithTags, sets.Int64) {
+	retainedPriorities := sets.NewInt64()
+	if len(resRetainedLRs) == 0 {
+		return sdkLRs, retainedPriorities
+	}
+	remainingSDKLRs := make([]ListenerRuleWithTags, 0, len(sdkLRs))
+	for _, sdkLR := range sdkLRs {
+		var matchedRetainedLR *elbv2model.RetainedListenerRules
+		for _, resRetainedLR := range resRetainedLRs {
+			if resRetainedLR.MatchesTags(sdkLR.Tags) {
+				matchedRetainedLR = resRetainedLR
+				break
+			}
+		}
+		if matchedRetainedLR == nil {
+			remainingSDKLRs = append(remainingSDKLRs, sdkLR)
+			continue
+		}
+		status := elbv2model.RetainedListenerRulesStatus{}
+		if matchedRetainedLR.Status != nil {
+			status = *matchedRetainedLR.Status
+		}
+		status.RuleARNs = append(status.RuleARNs, awssdk.StringValue(sdkLR.ListenerRule.RuleArn))
+		status.TargetGroupARNs = append(status.TargetGroupARNs, sdkListenerRuleTargetGroupARNs(sdkLR)...)
+		matchedRetainedLR.SetStatus(status)
+		retainedPriorities.Insert(sdkListenerRulePriority(sdkLR))
+	}
+	return remainingSDKLRs, retainedPriorities
+}
+
+// shiftListenerRulePriorities shifts the priorities of resLRs to skip the priorities occupied by retained listenerRules,
+// the relative order of resLRs is preserved.
+func shiftListenerRulePriorities(resLRs []*elbv2model.ListenerRule, retainedPriorities sets.Int64) {
+	sortedResLRs := append([]*elbv2model.ListenerRule(nil), resLRs...)
+	sort.Slice(sortedResLRs, func(i, j int) bool {
+		return sortedResLRs[i].Spec.Priority < sortedResLRs[j].Spec.Priority
+	})
+	var lastPriority int64
+	for _, resLR := range sortedResLRs {
+		priority := resLR.Spec.Priority
+		if priority <= lastPriority {
+			priority = lastPriority + 1
+		}
+		for retainedPriorities.Has(priority) {
+			priority++
+		}
+		resLR.Spec.Priority = priority
+		lastPriority = priority
+	}
+}
+
+// sdkListenerRuleTargetGroupARNs returns the ARNs of targetGroups a listenerRule forwards to.
+func sdkListenerRuleTargetGroupARNs(sdkLR ListenerRuleWithTags) []string {
+	var tgARNs []string
+	for _, action := range sdkLR.ListenerRule.Actions {
+		if action.TargetGroupArn != nil {
+			tgARNs = append(tgARNs, awssdk.StringValue(action.TargetGroupArn))
+		}
+		if action.ForwardConfig == nil {
+			continue
+		}
+		for _, tgTuple := range action.ForwardConfig.TargetGroups {
+			if tgTuple.TargetGroupArn != nil {
+				tgARNs = append(tgARNs, awssdk.StringValue(tgTuple.TargetGroupArn))
+			}
+		}
+	}
+	return tgARNs
+}
+
+// retainedTargetGroupARNs returns the ARNs of targetGroups used by retained listenerRules in stack.
+func retainedTargetGroupARNs(stack core.Stack) sets.String {
+	var resRetainedLRs []*elbv2model.RetainedListenerRules
+	stack.ListResources(&resRetainedLRs)
+	tgARNs := sets.NewString()
+	for _, resRetainedLR := range resRetainedLRs {
+		if resRetainedLR.Status != nil {
+			tgARNs.Insert(resRetainedLR.Status.TargetGroupARNs...)
+		}
+	}
+	return tgARNs
+}
+
 type resAndSDKListenerRulePair struct {
 	resLR *elbv2model.ListenerRule
 	sdkLR ListenerRuleWithTags
 }
 
-func matchResAndSDKListenerRules(resLRs []*elbv2model.ListenerRule, sdkLRs []ListenerRuleWithTags) ([]resAndSDKListenerRulePair, []*elbv2model.ListenerRule, []ListenerRuleWithTags) {
+// matchResAndSDKListenerRules matches listenerRules in stack with listenerRules on listener with minimal changes:
+// 1. listenerRules with same priority and settings are matched as is.
+// 2. listenerRules with same settings are matched regardless of priority, so that they can be reordered instead of recreated.
+// 3. listenerRules with same priority are matched, so that they can be modified in place.
+// the remaining listenerRules in stack needs to be created, and the remaining listenerRules on listener needs to be deleted.
+func matchResAndSDKListenerRules(resLRs []*elbv2model.ListenerRule, sdkLRs []ListenerRuleWithTags, featureGates config.FeatureGates) ([]resAndSDKListenerRulePair, []*elbv2model.ListenerRule, []ListenerRuleWithTags, error) {
 	var matchedResAndSDKLRs []resAndSDKListenerRulePair
 	var unmatchedResLRs []*elbv2model.ListenerRule
 	var unmatchedSDKLRs []ListenerRuleWithTags
@@ -119,22 +238,56 @@ func matchResAndSDKListenerRules(resLRs []*elbv2model.ListenerRule, sdkLRs []Lis
 	sdkLRByPriority := mapSDKListenerRuleByPriority(sdkLRs)
 	resLRPriorities := sets.Int64KeySet(resLRByPriority)
 	sdkLRPriorities := sets.Int64KeySet(sdkLRByPriority)
-	for _, priority := range resLRPriorities.Intersection(sdkLRPriorities).List() {
-		resLR := resLRByPriority[priority]
-		sdkLR := sdkLRByPriority[priority]
+
+	settingsMatchesByPriority := make(map[int64]map[int64]bool, len(resLRByPriority))
+	for _, resPriority := range resLRPriorities.List() {
+		desiredActions, err := buildSDKActions(resLRByPriority[resPriority].Spec.Actions, featureGates)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		desiredConditions := buildSDKRuleConditions(resLRByPriority[resPriority].Spec.Conditions)
+		settingsMatchesByPriority[resPriority] = make(map[int64]bool, len(sdkLRByPriority))
+		for _, sdkPriority := range sdkLRPriorities.List() {
+			settingsMatchesByPriority[resPriority][sdkPriority] = !isSDKListenerRuleSettingsDrifted(resLRByPriority[resPriority].Spec,
+				sdkLRByPriority[sdkPriority], desiredActions, desiredConditions)
+		}
+	}
+
+	matchPair := func(resPriority int64, sdkPriority int64) {
 		matchedResAndSDKLRs = append(matchedResAndSDKLRs, resAndSDKListenerRulePair{
-			resLR: resLR,
-			sdkLR: sdkLR,
+			resLR: resLRByPriority[resPriority],
+			sdkLR: sdkLRByPriority[sdkPriority],
 		})
+		resLRPriorities.Delete(resPriority)
+		sdkLRPriorities.Delete(sdkPriority)
 	}
-	for _, priority := range resLRPriorities.Difference(sdkLRPriorities).List() {
+	for _, priority := range resLRPriorities.Intersection(sdkLRPriorities).List() {
+		if settingsMatchesByPriority[priority][priority] {
+			matchPair(priority, priority)
+		}
+	}
+	for _, resPriority := range resLRPriorities.List() {
+		for _, sdkPriority := range sdkLRPriorities.List() {
+			if settingsMatchesByPriority[resPriority][sdkPriority] {
+				matchPair(resPriority, sdkPriority)
+				break
+			}
+		}
+	}
+	for _, priority := range resLRPriorities.Intersection(sdkLRPriorities).List() {
+		matchPair(priority, priority)
+	}
+
+	for _, priority := range resLRPriorities.List() {
 		unmatchedResLRs = append(unmatchedResLRs, resLRByPriority[priority])
 	}
-	for _, priority := range sdkLRPriorities.Difference(resLRPriorities).List() {
+	for _, priority := range sdkLRPriorities.List() {
 		unmatchedSDKLRs = append(unmatchedSDKLRs, sdkLRByPriority[priority])
 	}
-
-	return matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs
+	sort.Slice(matchedResAndSDKLRs, func(i, j int) bool {
+		return matchedResAndSDKLRs[i].resLR.Spec.Priority < matchedResAndSDKLRs[j].resLR.Spec.Priority
+	})
+	return matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs, nil
 }
 
 func mapResListenerRuleByPriority(resLRs []*elbv2model.ListenerRule) map[int64]*elbv2model.ListenerRule {
@@ -148,12 +301,16 @@ func mapResListenerRuleByPriority(resLRs []*elbv2model.ListenerRule) map[int64]*
 func mapSDKListenerRuleByPriority(sdkLRs []ListenerRuleWithTags) map[int64]ListenerRuleWithTags {
 	sdkLRByPriority := make(map[int64]ListenerRuleWithTags, len(sdkLRs))
 	for _, sdkLR := range sdkLRs {
-		priority, _ := strconv.ParseInt(awssdk.StringValue(sdkLR.ListenerRule.Priority), 10, 64)
-		sdkLRByPriority[priority] = sdkLR
+		sdkLRByPriority[sdkListenerRulePriority(sdkLR)] = sdkLR
 	}
 	return sdkLRByPriority
 }
 
+func sdkListenerRulePriority(sdkLR ListenerRuleWithTags) int64 {
+	priority, _ := strconv.ParseInt(awssdk.StringValue(sdkLR.ListenerRule.Priority), 10, 64)
+	return priority
+}
+
 func mapResListenerRuleByListenerARN(resLRs []*elbv2model.ListenerRule) (map[string][]*elbv2model.ListenerRule, error) {
 	resLRsByLSARN := make(map[string][]*elbv2model.ListenerRule, len(resLRs))
 	ctx := context.Background()
@@ -0,0 +1,257 @@
+package elbv2
+
+import (
+	"strconv"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_matchResAndSDKListenerRules(t *testing.T) {
+	featureGates := config.NewFeatureGates()
+	stack := core.NewDefaultStack(core.StackID{Name: "awesome-stack"})
+	buildLRSpec := func(priority int64, path string) elbv2model.ListenerRuleSpec {
+		return elbv2model.ListenerRuleSpec{
+			ListenerARN: core.LiteralStringToken("ls-arn"),
+			Priority:    priority,
+			Actions: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeFixedResponse,
+					FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+						StatusCode: "404",
+					},
+				},
+			},
+			Conditions: []elbv2model.RuleCondition{
+				{
+					Field: elbv2model.RuleConditionFieldPathPattern,
+					PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+						Values: []string{path},
+					},
+				},
+			},
+		}
+	}
+	buildResLR := func(priority int64, path string) *elbv2model.ListenerRule {
+		return elbv2model.NewListenerRule(stack, path, buildLRSpec(priority, path))
+	}
+	buildSDKLR := func(priority int64, path string) ListenerRuleWithTags {
+		lrSpec := buildLRSpec(priority, path)
+		actions, _ := buildSDKActions(lrSpec.Actions, featureGates)
+		return ListenerRuleWithTags{
+			ListenerRule: &elbv2sdk.Rule{
+				RuleArn:    awssdk.String("arn-" + path),
+				Priority:   awssdk.String(strconv.FormatInt(priority, 10)),
+				Actions:    actions,
+				Conditions: buildSDKRuleConditions(lrSpec.Conditions),
+			},
+		}
+	}
+	type pair struct {
+		resPath string
+		sdkARN  string
+	}
+	tests := []struct {
+		name                 string
+		resLRs               []*elbv2model.ListenerRule
+		sdkLRs               []ListenerRuleWithTags
+		wantMatchedPairs     []pair
+		wantUnmatchedResLRs  []string
+		wantUnmatchedSDKARNs []string
+	}{
+		{
+			name:   "rules with same priority and settings are matched",
+			resLRs: []*elbv2model.ListenerRule{buildResLR(1, "/a"), buildResLR(2, "/b")},
+			sdkLRs: []ListenerRuleWithTags{buildSDKLR(1, "/a"), buildSDKLR(2, "/b")},
+			wantMatchedPairs: []pair{
+				{resPath: "/a", sdkARN: "arn-/a"},
+				{resPath: "/b", sdkARN: "arn-/b"},
+			},
+		},
+		{
+			name:   "rule inserted in the middle keeps existing rules",
+			resLRs: []*elbv2model.ListenerRule{buildResLR(1, "/a"), buildResLR(2, "/new"), buildResLR(3, "/b"), buildResLR(4, "/c")},
+			sdkLRs: []ListenerRuleWithTags{buildSDKLR(1, "/a"), buildSDKLR(2, "/b"), buildSDKLR(3, "/c")},
+			wantMatchedPairs: []pair{
+				{resPath: "/a", sdkARN: "arn-/a"},
+				{resPath: "/b", sdkARN: "arn-/b"},
+				{resPath: "/c", sdkARN: "arn-/c"},
+			},
+			wantUnmatchedResLRs: []string{"/new"},
+		},
+		{
+			name:   "rule removed from the middle keeps existing rules",
+			resLRs: []*elbv2model.ListenerRule{buildResLR(1, "/a"), buildResLR(2, "/c")},
+			sdkLRs: []ListenerRuleWithTags{buildSDKLR(1, "/a"), buildSDKLR(2, "/b"), buildSDKLR(3, "/c")},
+			wantMatchedPairs: []pair{
+				{resPath: "/a", sdkARN: "arn-/a"},
+				{resPath: "/c", sdkARN: "arn-/c"},
+			},
+			wantUnmatchedSDKARNs: []string{"arn-/b"},
+		},
+		{
+			name:   "rule changed in place is modified",
+			resLRs: []*elbv2model.ListenerRule{buildResLR(1, "/a"), buildResLR(2, "/changed")},
+			sdkLRs: []ListenerRuleWithTags{buildSDKLR(1, "/a"), buildSDKLR(2, "/b")},
+			wantMatchedPairs: []pair{
+				{resPath: "/a", sdkARN: "arn-/a"},
+				{resPath: "/changed", sdkARN: "arn-/b"},
+			},
+		},
+		{
+			name:   "swapped rules are matched",
+			resLRs: []*elbv2model.ListenerRule{buildResLR(1, "/b"), buildResLR(2, "/a")},
+			sdkLRs: []ListenerRuleWithTags{buildSDKLR(1, "/a"), buildSDKLR(2, "/b")},
+			wantMatchedPairs: []pair{
+				{resPath: "/b", sdkARN: "arn-/b"},
+				{resPath: "/a", sdkARN: "arn-/a"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMatched, gotUnmatchedResLRs, gotUnmatchedSDKLRs, err := matchResAndSDKListenerRules(tt.resLRs, tt.sdkLRs, featureGates)
+			assert.NoError(t, err)
+			var gotMatchedPairs []pair
+			for _, resAndSDKLR := range gotMatched {
+				gotMatchedPairs = append(gotMatchedPairs, pair{
+					resPath: resAndSDKLR.resLR.ID(),
+					sdkARN:  awssdk.StringValue(resAndSDKLR.sdkLR.ListenerRule.RuleArn),
+				})
+			}
+			var gotUnmatchedResPaths []string
+			for _, resLR := range gotUnmatchedResLRs {
+				gotUnmatchedResPaths = append(gotUnmatchedResPaths, resLR.ID())
+			}
+			var gotUnmatchedSDKARNs []string
+			for _, sdkLR := range gotUnmatchedSDKLRs {
+				gotUnmatchedSDKARNs = append(gotUnmatchedSDKARNs, awssdk.StringValue(sdkLR.ListenerRule.RuleArn))
+			}
+			assert.Equal(t, tt.wantMatchedPairs, gotMatchedPairs)
+			assert.Equal(t, tt.wantUnmatchedResLRs, gotUnmatchedResPaths)
+			assert.Equal(t, tt.wantUnmatchedSDKARNs, gotUnmatchedSDKARNs)
+		})
+	}
+}
+
+func Test_buildSDKSetRulePrioritiesInput(t *testing.T) {
+	stack := core.NewDefaultStack(core.StackID{Name: "awesome-stack"})
+	resAndSDKLRs := []resAndSDKListenerRulePair{
+		{
+			resLR: elbv2model.NewListenerRule(stack, "80:1", elbv2model.ListenerRuleSpec{ListenerARN: core.LiteralStringToken("ls-arn"), Priority: 1}),
+			sdkLR: ListenerRuleWithTags{ListenerRule: &elbv2sdk.Rule{RuleArn: awssdk.String("arn-b"), Priority: awssdk.String("2")}},
+		},
+		{
+			resLR: elbv2model.NewListenerRule(stack, "80:2", elbv2model.ListenerRuleSpec{ListenerARN: core.LiteralStringToken("ls-arn"), Priority: 2}),
+			sdkLR: ListenerRuleWithTags{ListenerRule: &elbv2sdk.Rule{RuleArn: awssdk.String("arn-a"), Priority: awssdk.String("1")}},
+		},
+	}
+	want := &elbv2sdk.SetRulePrioritiesInput{
+		RulePriorities: []*elbv2sdk.RulePriorityPair{
+			{RuleArn: awssdk.String("arn-b"), Priority: awssdk.Int64(1)},
+			{RuleArn: awssdk.String("arn-a"), Priority: awssdk.Int64(2)},
+		},
+	}
+	assert.Equal(t, want, buildSDKSetRulePrioritiesInput(resAndSDKLRs))
+}
+
+func Test_retainSDKListenerRules(t *testing.T) {
+	stack := core.NewDefaultStack(core.StackID{Name: "awesome-stack"})
+	retainedLR := elbv2model.NewRetainedListenerRules(stack, "ns-1/ing-2", elbv2model.RetainedListenerRulesSpec{
+		Tags: map[string]string{"ingress.k8s.aws/ingress": "ns-1/ing-2"},
+	})
+	sdkLR1 := ListenerRuleWithTags{
+		ListenerRule: &elbv2sdk.Rule{
+			RuleArn:  awssdk.String("arn-1"),
+			Priority: awssdk.String("1"),
+			Actions:  []*elbv2sdk.Action{{Type: awssdk.String("forward"), TargetGroupArn: awssdk.String("tg-1")}},
+		},
+		Tags: map[string]string{"ingress.k8s.aws/ingress": "ns-1/ing-1"},
+	}
+	sdkLR2 := ListenerRuleWithTags{
+		ListenerRule: &elbv2sdk.Rule{
+			RuleArn:  awssdk.String("arn-2"),
+			Priority: awssdk.String("2"),
+			Actions: []*elbv2sdk.Action{
+				{
+					Type: awssdk.String("forward"),
+					ForwardConfig: &elbv2sdk.ForwardActionConfig{
+						TargetGroups: []*elbv2sdk.TargetGroupTuple{
+							{TargetGroupArn: awssdk.String("tg-2")},
+							{TargetGroupArn: awssdk.String("tg-3")},
+						},
+					},
+				},
+			},
+		},
+		Tags: map[string]string{"ingress.k8s.aws/ingress": "ns-1/ing-2"},
+	}
+	sdkLR3 := ListenerRuleWithTags{
+		ListenerRule: &elbv2sdk.Rule{
+			RuleArn:  awssdk.String("arn-3"),
+			Priority: awssdk.String("3"),
+		},
+	}
+
+	gotSDKLRs, gotRetainedPriorities := retainSDKListenerRules([]ListenerRuleWithTags{sdkLR1, sdkLR2, sdkLR3},
+		[]*elbv2model.RetainedListenerRules{retainedLR})
+	assert.Equal(t, []ListenerRuleWithTags{sdkLR1, sdkLR3}, gotSDKLRs)
+	assert.Equal(t, []int64{2}, gotRetainedPriorities.List())
+	assert.Equal(t, &elbv2model.RetainedListenerRulesStatus{
+		RuleARNs:        []string{"arn-2"},
+		TargetGroupARNs: []string{"tg-2", "tg-3"},
+	}, retainedLR.Status)
+	assert.Equal(t, []string{"tg-2", "tg-3"}, retainedTargetGroupARNs(stack).List())
+}
+
+func Test_shiftListenerRulePriorities(t *testing.T) {
+	tests := []struct {
+		name               string
+		priorities         []int64
+		retainedPriorities []int64
+		wantPriorities     []int64
+	}{
+		{
+			name:               "no collision",
+			priorities:         []int64{1, 2},
+			retainedPriorities: []int64{3},
+			wantPriorities:     []int64{1, 2},
+		},
+		{
+			name:               "collisions are shifted in order",
+			priorities:         []int64{1, 2, 3, 5},
+			retainedPriorities: []int64{2, 3},
+			wantPriorities:     []int64{1, 4, 5, 6},
+		},
+		{
+			name:               "unordered rules keep their relative order",
+			priorities:         []int64{3, 1, 2},
+			retainedPriorities: []int64{1},
+			wantPriorities:     []int64{4, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := core.NewDefaultStack(core.StackID{Name: "awesome-stack"})
+			var resLRs []*elbv2model.ListenerRule
+			for idx, priority := range tt.priorities {
+				resLRs = append(resLRs, elbv2model.NewListenerRule(stack, strconv.Itoa(idx), elbv2model.ListenerRuleSpec{
+					ListenerARN: core.LiteralStringToken("ls-arn"),
+					Priority:    priority,
+				}))
+			}
+			shiftListenerRulePriorities(resLRs, sets.NewInt64(tt.retainedPriorities...))
+			var gotPriorities []int64
+			for _, resLR := range resLRs {
+				gotPriorities = append(gotPriorities, resLR.Spec.Priority)
+			}
+			assert.Equal(t, tt.wantPriorities, gotPriorities)
+		})
+	}
+}
@@ -34,13 +34,10 @@ type defaultLoadBalancerAttributeReconciler struct {
 }
 
 func (r *defaultLoadBalancerAttributeReconciler) Reconcile(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) error {
-	desiredAttrs := r.getDesiredLoadBalancerAttributes(ctx, resLB)
-	currentAttrs, err := r.getCurrentLoadBalancerAttributes(ctx, sdkLB)
+	attributesToUpdate, _, err := r.computeAttributesToUpdate(ctx, resLB, sdkLB)
 	if err != nil {
 		return err
 	}
-
-	attributesToUpdate, _ := algorithm.DiffStringMap(desiredAttrs, currentAttrs)
 	if len(attributesToUpdate) > 0 {
 		req := &elbv2sdk.ModifyLoadBalancerAttributesInput{
 			LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
@@ -69,6 +66,17 @@ func (r *defaultLoadBalancerAttributeReconciler) Reconcile(ctx context.Context,
 	return nil
 }
 
+// computeAttributesToUpdate computes the loadBalancer attributes to update, along with the current loadBalancer attributes.
+func (r *defaultLoadBalancerAttributeReconciler) computeAttributesToUpdate(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) (map[string]string, map[string]string, error) {
+	desiredAttrs := r.getDesiredLoadBalancerAttributes(ctx, resLB)
+	currentAttrs, err := r.getCurrentLoadBalancerAttributes(ctx, sdkLB)
+	if err != nil {
+		return nil, nil, err
+	}
+	attributesToUpdate, _ := algorithm.DiffStringMap(desiredAttrs, currentAttrs)
+	return attributesToUpdate, currentAttrs, nil
+}
+
 func (r *defaultLoadBalancerAttributeReconciler) getDesiredLoadBalancerAttributes(ctx context.Context, resLB *elbv2model.LoadBalancer) map[string]string {
 	lbAttributes := make(map[string]string, len(resLB.Spec.LoadBalancerAttributes))
 	for _, attr := range resLB.Spec.LoadBalancerAttributes {
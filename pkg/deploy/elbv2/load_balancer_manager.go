@@ -146,14 +146,7 @@ func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithIPAddressType(ctx
 }
 
 func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithSubnetMappings(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) error {
-	desiredSubnets := sets.NewString()
-	for _, mapping := range resLB.Spec.SubnetMappings {
-		desiredSubnets.Insert(mapping.SubnetID)
-	}
-	currentSubnets := sets.NewString()
-	for _, az := range sdkLB.LoadBalancer.AvailabilityZones {
-		currentSubnets.Insert(awssdk.StringValue(az.SubnetId))
-	}
+	desiredSubnets, currentSubnets := buildDesiredAndCurrentLoadBalancerSubnets(resLB, sdkLB)
 	if desiredSubnets.Equal(currentSubnets) {
 		return nil
 	}
@@ -180,19 +173,17 @@ func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithSubnetMappings(ctx
 }
 
 func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithSecurityGroups(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) error {
-	securityGroups, err := buildSDKSecurityGroups(resLB.Spec.SecurityGroups)
+	desiredSecurityGroups, currentSecurityGroups, err := buildDesiredAndCurrentLoadBalancerSecurityGroups(resLB, sdkLB)
 	if err != nil {
 		return err
 	}
-	desiredSecurityGroups := sets.NewString(awssdk.StringValueSlice(securityGroups)...)
-	currentSecurityGroups := sets.NewString(awssdk.StringValueSlice(sdkLB.LoadBalancer.SecurityGroups)...)
 	if desiredSecurityGroups.Equal(currentSecurityGroups) {
 		return nil
 	}
 
 	req := &elbv2sdk.SetSecurityGroupsInput{
 		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
-		SecurityGroups:  securityGroups,
+		SecurityGroups:  awssdk.StringSlice(desiredSecurityGroups.List()),
 	}
 	changeDesc := fmt.Sprintf("%v => %v", currentSecurityGroups.List(), desiredSecurityGroups.List())
 	m.logger.Info("modifying loadBalancer securityGroups",
@@ -228,6 +219,30 @@ func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithTags(ctx context.C
 		WithIgnoredTagKeys(m.externalManagedTags))
 }
 
+// buildDesiredAndCurrentLoadBalancerSubnets returns the desired and current subnets of a loadBalancer.
+func buildDesiredAndCurrentLoadBalancerSubnets(resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) (sets.String, sets.String) {
+	desiredSubnets := sets.NewString()
+	for _, mapping := range resLB.Spec.SubnetMappings {
+		desiredSubnets.Insert(mapping.SubnetID)
+	}
+	currentSubnets := sets.NewString()
+	for _, az := range sdkLB.LoadBalancer.AvailabilityZones {
+		currentSubnets.Insert(awssdk.StringValue(az.SubnetId))
+	}
+	return desiredSubnets, currentSubnets
+}
+
+// buildDesiredAndCurrentLoadBalancerSecurityGroups returns the desired and current securityGroups of a loadBalancer.
+func buildDesiredAndCurrentLoadBalancerSecurityGroups(resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) (sets.String, sets.String, error) {
+	securityGroups, err := buildSDKSecurityGroups(resLB.Spec.SecurityGroups)
+	if err != nil {
+		return nil, nil, err
+	}
+	desiredSecurityGroups := sets.NewString(awssdk.StringValueSlice(securityGroups)...)
+	currentSecurityGroups := sets.NewString(awssdk.StringValueSlice(sdkLB.LoadBalancer.SecurityGroups)...)
+	return desiredSecurityGroups, currentSecurityGroups, nil
+}
+
 func buildSDKCreateLoadBalancerInput(lbSpec elbv2model.LoadBalancerSpec) (*elbv2sdk.CreateLoadBalancerInput, error) {
 	sdkObj := &elbv2sdk.CreateLoadBalancerInput{}
 	sdkObj.Name = awssdk.String(lbSpec.Name)
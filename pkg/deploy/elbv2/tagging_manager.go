@@ -41,6 +41,12 @@ type ListenerRuleWithTags struct {
 	Tags         map[string]string
 }
 
+// TrustStore with it's tags.
+type TrustStoreWithTags struct {
+	TrustStore *elbv2sdk.TrustStore
+	Tags       map[string]string
+}
+
 // options for ReconcileTags API.
 type ReconcileTagsOptions struct {
 	// CurrentTags on resources.
@@ -90,6 +96,9 @@ type TaggingManager interface {
 
 	// ListListenerRules returns the Listener Rules along with tags
 	ListListenerRules(ctx context.Context, lsARN string) ([]ListenerRuleWithTags, error)
+
+	// ListTrustStores returns TrustStores that matches any of the tagging requirements.
+	ListTrustStores(ctx context.Context, tagFilters ...tracking.TagFilter) ([]TrustStoreWithTags, error)
 }
 
 // NewDefaultTaggingManager constructs default TaggingManager.
@@ -321,6 +330,46 @@ func (m *defaultTaggingManager) ListTargetGroups(ctx context.Context, tagFilters
 	return matchedTGs, nil
 }
 
+func (m *defaultTaggingManager) ListTrustStores(ctx context.Context, tagFilters ...tracking.TagFilter) ([]TrustStoreWithTags, error) {
+	req := &elbv2sdk.DescribeTrustStoresInput{}
+	trustStores, err := m.elbv2Client.DescribeTrustStoresAsList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// trust stores are not scoped to a VPC, the tags of all trust stores in the region are described.
+	tsARNs := make([]string, 0, len(trustStores))
+	tsByARN := make(map[string]*elbv2sdk.TrustStore, len(trustStores))
+	for _, ts := range trustStores {
+		tsARN := awssdk.StringValue(ts.TrustStoreArn)
+		tsARNs = append(tsARNs, tsARN)
+		tsByARN[tsARN] = ts
+	}
+	tagsByARN, err := m.describeResourceTags(ctx, tsARNs)
+	if err != nil {
+		return nil, err
+	}
+
+	var matchedTrustStores []TrustStoreWithTags
+	for _, arn := range tsARNs {
+		tags := tagsByARN[arn]
+		matchedAnyTagFilter := false
+		for _, tagFilter := range tagFilters {
+			if tagFilter.Matches(tags) {
+				matchedAnyTagFilter = true
+				break
+			}
+		}
+		if matchedAnyTagFilter {
+			matchedTrustStores = append(matchedTrustStores, TrustStoreWithTags{
+				TrustStore: tsByARN[arn],
+				Tags:       tags,
+			})
+		}
+	}
+	return matchedTrustStores, nil
+}
+
 // describeResourceTags describes tags for elbv2 resources.
 // returns tags indexed by resource ARN.
 func (m *defaultTaggingManager) describeResourceTags(ctx context.Context, arns []string) (map[string]map[string]string, error) {
@@ -34,13 +34,10 @@ type defaultTargetGroupAttributeReconciler struct {
 }
 
 func (r *defaultTargetGroupAttributeReconciler) Reconcile(ctx context.Context, resTG *elbv2model.TargetGroup, sdkTG TargetGroupWithTags) error {
-	desiredAttrs := r.getDesiredTargetGroupAttributes(ctx, resTG)
-	currentAttrs, err := r.getCurrentTargetGroupAttributes(ctx, sdkTG)
+	attributesToUpdate, _, err := r.computeAttributesToUpdate(ctx, resTG, sdkTG)
 	if err != nil {
 		return err
 	}
-
-	attributesToUpdate, _ := algorithm.DiffStringMap(desiredAttrs, currentAttrs)
 	if len(attributesToUpdate) > 0 {
 		req := &elbv2sdk.ModifyTargetGroupAttributesInput{
 			TargetGroupArn: sdkTG.TargetGroup.TargetGroupArn,
@@ -69,6 +66,17 @@ func (r *defaultTargetGroupAttributeReconciler) Reconcile(ctx context.Context, r
 	return nil
 }
 
+// computeAttributesToUpdate computes the targetGroup attributes to update, along with the current targetGroup attributes.
+func (r *defaultTargetGroupAttributeReconciler) computeAttributesToUpdate(ctx context.Context, resTG *elbv2model.TargetGroup, sdkTG TargetGroupWithTags) (map[string]string, map[string]string, error) {
+	desiredAttrs := r.getDesiredTargetGroupAttributes(ctx, resTG)
+	currentAttrs, err := r.getCurrentTargetGroupAttributes(ctx, sdkTG)
+	if err != nil {
+		return nil, nil, err
+	}
+	attributesToUpdate, _ := algorithm.DiffStringMap(desiredAttrs, currentAttrs)
+	return attributesToUpdate, currentAttrs, nil
+}
+
 func (r *defaultTargetGroupAttributeReconciler) getDesiredTargetGroupAttributes(ctx context.Context, resTG *elbv2model.TargetGroup) map[string]string {
 	tgAttributes := make(map[string]string, len(resTG.Spec.TargetGroupAttributes))
 	for _, attr := range resTG.Spec.TargetGroupAttributes {
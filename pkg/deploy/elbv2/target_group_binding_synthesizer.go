@@ -67,7 +67,12 @@ func (s *targetGroupBindingSynthesizer) Synthesize(ctx context.Context) error {
 }
 
 func (s *targetGroupBindingSynthesizer) PostSynthesize(ctx context.Context) error {
+	// targetGroupBindings of targetGroups used by retained listenerRules are kept as well.
+	retainedTGARNs := retainedTargetGroupARNs(s.stack)
 	for _, k8sTGB := range s.unmatchedK8sTGBs {
+		if retainedTGARNs.Has(k8sTGB.Spec.TargetGroupARN) {
+			continue
+		}
 		if err := s.tgbManager.Delete(ctx, k8sTGB); err != nil {
 			return err
 		}
@@ -11,6 +11,7 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"strconv"
 	"time"
 )
 
@@ -26,6 +27,9 @@ type TargetGroupManager interface {
 	Update(ctx context.Context, resTG *elbv2model.TargetGroup, sdkTG TargetGroupWithTags) (elbv2model.TargetGroupStatus, error)
 
 	Delete(ctx context.Context, sdkTG TargetGroupWithTags) error
+
+	// ReconcileALBTarget registers the Application Load Balancer as the only target of a fulfilled TargetGroup with alb TargetType.
+	ReconcileALBTarget(ctx context.Context, resTG *elbv2model.TargetGroup) error
 }
 
 // NewDefaultTargetGroupManager constructs new defaultTargetGroupManager.
@@ -123,6 +127,67 @@ func (m *defaultTargetGroupManager) Delete(ctx context.Context, sdkTG TargetGrou
 	return nil
 }
 
+func (m *defaultTargetGroupManager) ReconcileALBTarget(ctx context.Context, resTG *elbv2model.TargetGroup) error {
+	tgARN, err := resTG.TargetGroupARN().Resolve(ctx)
+	if err != nil {
+		return err
+	}
+	albARN, err := resTG.Spec.ALBTarget.LoadBalancerARN.Resolve(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := m.elbv2Client.DescribeTargetHealthWithContext(ctx, &elbv2sdk.DescribeTargetHealthInput{
+		TargetGroupArn: awssdk.String(tgARN),
+	})
+	if err != nil {
+		return err
+	}
+	albRegistered := false
+	var staleTargets []*elbv2sdk.TargetDescription
+	for _, targetHealth := range resp.TargetHealthDescriptions {
+		if awssdk.StringValue(targetHealth.Target.Id) == albARN {
+			albRegistered = true
+			continue
+		}
+		staleTargets = append(staleTargets, targetHealth.Target)
+	}
+
+	// the targets other than the desired ALB are left over by replaced ALBs.
+	// they must be deregistered first, as an ALB type targetGroup only accepts a single ALB target.
+	if len(staleTargets) != 0 {
+		m.logger.Info("deregistering stale targets",
+			"arn", tgARN)
+		if _, err := m.elbv2Client.DeregisterTargetsWithContext(ctx, &elbv2sdk.DeregisterTargetsInput{
+			TargetGroupArn: awssdk.String(tgARN),
+			Targets:        staleTargets,
+		}); err != nil {
+			return err
+		}
+		m.logger.Info("deregistered stale targets",
+			"arn", tgARN)
+	}
+	if !albRegistered {
+		m.logger.Info("registering ALB target",
+			"arn", tgARN,
+			"albARN", albARN)
+		if _, err := m.elbv2Client.RegisterTargetsWithContext(ctx, &elbv2sdk.RegisterTargetsInput{
+			TargetGroupArn: awssdk.String(tgARN),
+			Targets: []*elbv2sdk.TargetDescription{
+				{
+					Id:   awssdk.String(albARN),
+					Port: awssdk.Int64(resTG.Spec.Port),
+				},
+			},
+		}); err != nil {
+			return err
+		}
+		m.logger.Info("registered ALB target",
+			"arn", tgARN,
+			"albARN", albARN)
+	}
+	return nil
+}
+
 func (m *defaultTargetGroupManager) updateSDKTargetGroupWithHealthCheck(ctx context.Context, resTG *elbv2model.TargetGroup, sdkTG TargetGroupWithTags) error {
 	if !isSDKTargetGroupHealthCheckDrifted(resTG.Spec, sdkTG) {
 		return nil
@@ -154,36 +219,65 @@ func (m *defaultTargetGroupManager) updateSDKTargetGroupWithTags(ctx context.Con
 }
 
 func isSDKTargetGroupHealthCheckDrifted(tgSpec elbv2model.TargetGroupSpec, sdkTG TargetGroupWithTags) bool {
+	for _, field := range buildTargetGroupHealthCheckFields(tgSpec, sdkTG) {
+		if field.desired != field.current {
+			return true
+		}
+	}
+	return false
+}
+
+// targetGroupHealthCheckField is a healthCheck setting of targetGroup with its desired and current value.
+type targetGroupHealthCheckField struct {
+	name    string
+	desired string
+	current string
+}
+
+// buildTargetGroupHealthCheckFields builds the healthCheck settings specified in tgSpec, along with their current value on sdkTG.
+func buildTargetGroupHealthCheckFields(tgSpec elbv2model.TargetGroupSpec, sdkTG TargetGroupWithTags) []targetGroupHealthCheckField {
 	if tgSpec.HealthCheckConfig == nil {
-		return false
+		return nil
 	}
 	sdkObj := sdkTG.TargetGroup
 	hcConfig := *tgSpec.HealthCheckConfig
-	if hcConfig.Port != nil && hcConfig.Port.String() != awssdk.StringValue(sdkObj.HealthCheckPort) {
-		return true
+	var fields []targetGroupHealthCheckField
+	addField := func(name string, desired string, current string) {
+		fields = append(fields, targetGroupHealthCheckField{name: name, desired: desired, current: current})
 	}
-	if hcConfig.Protocol != nil && string(*hcConfig.Protocol) != awssdk.StringValue(sdkObj.HealthCheckProtocol) {
-		return true
+	addInt64Field := func(name string, desired *int64, current *int64) {
+		addField(name, strconv.FormatInt(awssdk.Int64Value(desired), 10), strconv.FormatInt(awssdk.Int64Value(current), 10))
 	}
-	if hcConfig.Path != nil && awssdk.StringValue(hcConfig.Path) != awssdk.StringValue(sdkObj.HealthCheckPath) {
-		return true
+	if hcConfig.Port != nil {
+		addField("port", hcConfig.Port.String(), awssdk.StringValue(sdkObj.HealthCheckPort))
 	}
-	if hcConfig.Matcher != nil && (sdkObj.Matcher == nil || awssdk.StringValue(hcConfig.Matcher.GRPCCode) != awssdk.StringValue(sdkObj.Matcher.GrpcCode) || awssdk.StringValue(hcConfig.Matcher.HTTPCode) != awssdk.StringValue(sdkObj.Matcher.HttpCode)) {
-		return true
+	if hcConfig.Protocol != nil {
+		addField("protocol", string(*hcConfig.Protocol), awssdk.StringValue(sdkObj.HealthCheckProtocol))
 	}
-	if hcConfig.IntervalSeconds != nil && awssdk.Int64Value(hcConfig.IntervalSeconds) != awssdk.Int64Value(sdkObj.HealthCheckIntervalSeconds) {
-		return true
+	if hcConfig.Path != nil {
+		addField("path", awssdk.StringValue(hcConfig.Path), awssdk.StringValue(sdkObj.HealthCheckPath))
 	}
-	if hcConfig.TimeoutSeconds != nil && awssdk.Int64Value(hcConfig.TimeoutSeconds) != awssdk.Int64Value(sdkObj.HealthCheckTimeoutSeconds) {
-		return true
+	if hcConfig.Matcher != nil {
+		currentMatcher := sdkObj.Matcher
+		if currentMatcher == nil {
+			currentMatcher = &elbv2sdk.Matcher{}
+		}
+		addField("matcher.grpcCode", awssdk.StringValue(hcConfig.Matcher.GRPCCode), awssdk.StringValue(currentMatcher.GrpcCode))
+		addField("matcher.httpCode", awssdk.StringValue(hcConfig.Matcher.HTTPCode), awssdk.StringValue(currentMatcher.HttpCode))
 	}
-	if hcConfig.HealthyThresholdCount != nil && awssdk.Int64Value(hcConfig.HealthyThresholdCount) != awssdk.Int64Value(sdkObj.HealthyThresholdCount) {
-		return true
+	if hcConfig.IntervalSeconds != nil {
+		addInt64Field("intervalSeconds", hcConfig.IntervalSeconds, sdkObj.HealthCheckIntervalSeconds)
 	}
-	if hcConfig.UnhealthyThresholdCount != nil && awssdk.Int64Value(hcConfig.UnhealthyThresholdCount) != awssdk.Int64Value(sdkObj.UnhealthyThresholdCount) {
-		return true
+	if hcConfig.TimeoutSeconds != nil {
+		addInt64Field("timeoutSeconds", hcConfig.TimeoutSeconds, sdkObj.HealthCheckTimeoutSeconds)
 	}
-	return false
+	if hcConfig.HealthyThresholdCount != nil {
+		addInt64Field("healthyThresholdCount", hcConfig.HealthyThresholdCount, sdkObj.HealthyThresholdCount)
+	}
+	if hcConfig.UnhealthyThresholdCount != nil {
+		addInt64Field("unhealthyThresholdCount", hcConfig.UnhealthyThresholdCount, sdkObj.UnhealthyThresholdCount)
+	}
+	return fields
 }
 
 func buildSDKCreateTargetGroupInput(tgSpec elbv2model.TargetGroupSpec) *elbv2sdk.CreateTargetGroupInput {
@@ -1,13 +1,18 @@
 package elbv2
 
 import (
+	"context"
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 	"testing"
 )
 
@@ -680,3 +685,235 @@ func Test_isTargetGroupResourceInUseError(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultTargetGroupManager_ReconcileALBTarget(t *testing.T) {
+	type describeTargetHealthWithContextCall struct {
+		req  *elbv2sdk.DescribeTargetHealthInput
+		resp *elbv2sdk.DescribeTargetHealthOutput
+		err  error
+	}
+	type registerTargetsWithContextCall struct {
+		req  *elbv2sdk.RegisterTargetsInput
+		resp *elbv2sdk.RegisterTargetsOutput
+		err  error
+	}
+	type deregisterTargetsWithContextCall struct {
+		req  *elbv2sdk.DeregisterTargetsInput
+		resp *elbv2sdk.DeregisterTargetsOutput
+		err  error
+	}
+	type fields struct {
+		describeTargetHealthWithContextCalls []describeTargetHealthWithContextCall
+		registerTargetsWithContextCalls      []registerTargetsWithContextCall
+		deregisterTargetsWithContextCalls    []deregisterTargetsWithContextCall
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name: "ALB is already registered",
+			fields: fields{
+				describeTargetHealthWithContextCalls: []describeTargetHealthWithContextCall{
+					{
+						req: &elbv2sdk.DescribeTargetHealthInput{
+							TargetGroupArn: awssdk.String("tg-arn"),
+						},
+						resp: &elbv2sdk.DescribeTargetHealthOutput{
+							TargetHealthDescriptions: []*elbv2sdk.TargetHealthDescription{
+								{
+									Target: &elbv2sdk.TargetDescription{
+										Id:   awssdk.String("alb-arn"),
+										Port: awssdk.Int64(443),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ALB isn't registered",
+			fields: fields{
+				describeTargetHealthWithContextCalls: []describeTargetHealthWithContextCall{
+					{
+						req: &elbv2sdk.DescribeTargetHealthInput{
+							TargetGroupArn: awssdk.String("tg-arn"),
+						},
+						resp: &elbv2sdk.DescribeTargetHealthOutput{},
+					},
+				},
+				registerTargetsWithContextCalls: []registerTargetsWithContextCall{
+					{
+						req: &elbv2sdk.RegisterTargetsInput{
+							TargetGroupArn: awssdk.String("tg-arn"),
+							Targets: []*elbv2sdk.TargetDescription{
+								{
+									Id:   awssdk.String("alb-arn"),
+									Port: awssdk.Int64(443),
+								},
+							},
+						},
+						resp: &elbv2sdk.RegisterTargetsOutput{},
+					},
+				},
+			},
+		},
+		{
+			name: "ALB is replaced",
+			fields: fields{
+				describeTargetHealthWithContextCalls: []describeTargetHealthWithContextCall{
+					{
+						req: &elbv2sdk.DescribeTargetHealthInput{
+							TargetGroupArn: awssdk.String("tg-arn"),
+						},
+						resp: &elbv2sdk.DescribeTargetHealthOutput{
+							TargetHealthDescriptions: []*elbv2sdk.TargetHealthDescription{
+								{
+									Target: &elbv2sdk.TargetDescription{
+										Id:   awssdk.String("old-alb-arn"),
+										Port: awssdk.Int64(443),
+									},
+								},
+							},
+						},
+					},
+				},
+				registerTargetsWithContextCalls: []registerTargetsWithContextCall{
+					{
+						req: &elbv2sdk.RegisterTargetsInput{
+							TargetGroupArn: awssdk.String("tg-arn"),
+							Targets: []*elbv2sdk.TargetDescription{
+								{
+									Id:   awssdk.String("alb-arn"),
+									Port: awssdk.Int64(443),
+								},
+							},
+						},
+						resp: &elbv2sdk.RegisterTargetsOutput{},
+					},
+				},
+				deregisterTargetsWithContextCalls: []deregisterTargetsWithContextCall{
+					{
+						req: &elbv2sdk.DeregisterTargetsInput{
+							TargetGroupArn: awssdk.String("tg-arn"),
+							Targets: []*elbv2sdk.TargetDescription{
+								{
+									Id:   awssdk.String("old-alb-arn"),
+									Port: awssdk.Int64(443),
+								},
+							},
+						},
+						resp: &elbv2sdk.DeregisterTargetsOutput{},
+					},
+				},
+			},
+		},
+		{
+			name: "failed to register ALB",
+			fields: fields{
+				describeTargetHealthWithContextCalls: []describeTargetHealthWithContextCall{
+					{
+						req: &elbv2sdk.DescribeTargetHealthInput{
+							TargetGroupArn: awssdk.String("tg-arn"),
+						},
+						resp: &elbv2sdk.DescribeTargetHealthOutput{},
+					},
+				},
+				registerTargetsWithContextCalls: []registerTargetsWithContextCall{
+					{
+						req: &elbv2sdk.RegisterTargetsInput{
+							TargetGroupArn: awssdk.String("tg-arn"),
+							Targets: []*elbv2sdk.TargetDescription{
+								{
+									Id:   awssdk.String("alb-arn"),
+									Port: awssdk.Int64(443),
+								},
+							},
+						},
+						err: errors.New("some error"),
+					},
+				},
+			},
+			wantErr: errors.New("some error"),
+		},
+		{
+			name: "failed to deregister stale targets",
+			fields: fields{
+				describeTargetHealthWithContextCalls: []describeTargetHealthWithContextCall{
+					{
+						req: &elbv2sdk.DescribeTargetHealthInput{
+							TargetGroupArn: awssdk.String("tg-arn"),
+						},
+						resp: &elbv2sdk.DescribeTargetHealthOutput{
+							TargetHealthDescriptions: []*elbv2sdk.TargetHealthDescription{
+								{
+									Target: &elbv2sdk.TargetDescription{
+										Id:   awssdk.String("old-alb-arn"),
+										Port: awssdk.Int64(443),
+									},
+								},
+							},
+						},
+					},
+				},
+				deregisterTargetsWithContextCalls: []deregisterTargetsWithContextCall{
+					{
+						req: &elbv2sdk.DeregisterTargetsInput{
+							TargetGroupArn: awssdk.String("tg-arn"),
+							Targets: []*elbv2sdk.TargetDescription{
+								{
+									Id:   awssdk.String("old-alb-arn"),
+									Port: awssdk.Int64(443),
+								},
+							},
+						},
+						err: errors.New("some error"),
+					},
+				},
+			},
+			wantErr: errors.New("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			// stale targets must be deregistered before the ALB is registered.
+			var calls []*gomock.Call
+			for _, call := range tt.fields.describeTargetHealthWithContextCalls {
+				calls = append(calls, elbv2Client.EXPECT().DescribeTargetHealthWithContext(gomock.Any(), call.req).Return(call.resp, call.err))
+			}
+			for _, call := range tt.fields.deregisterTargetsWithContextCalls {
+				calls = append(calls, elbv2Client.EXPECT().DeregisterTargetsWithContext(gomock.Any(), call.req).Return(call.resp, call.err))
+			}
+			for _, call := range tt.fields.registerTargetsWithContextCalls {
+				calls = append(calls, elbv2Client.EXPECT().RegisterTargetsWithContext(gomock.Any(), call.req).Return(call.resp, call.err))
+			}
+			gomock.InOrder(calls...)
+			stack := coremodel.NewDefaultStack(coremodel.StackID{Name: "awesome-group"})
+			resTG := elbv2model.NewTargetGroup(stack, "FrontendNLB:443", elbv2model.TargetGroupSpec{
+				TargetType: elbv2model.TargetTypeALB,
+				Port:       443,
+				Protocol:   elbv2model.ProtocolTCP,
+				ALBTarget: &elbv2model.TargetGroupALBTarget{
+					LoadBalancerARN: coremodel.LiteralStringToken("alb-arn"),
+				},
+			})
+			resTG.SetStatus(elbv2model.TargetGroupStatus{TargetGroupARN: "tg-arn"})
+			m := &defaultTargetGroupManager{
+				elbv2Client: elbv2Client,
+				logger:      &log.NullLogger{},
+			}
+			err := m.ReconcileALBTarget(context.Background(), resTG)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -72,7 +72,12 @@ func (s *targetGroupSynthesizer) Synthesize(ctx context.Context) error {
 }
 
 func (s *targetGroupSynthesizer) PostSynthesize(ctx context.Context) error {
+	// targetGroups used by retained listenerRules are kept as well.
+	retainedTGARNs := retainedTargetGroupARNs(s.stack)
 	for _, sdkTG := range s.unmatchedSDKTGs {
+		if retainedTGARNs.Has(awssdk.StringValue(sdkTG.TargetGroup.TargetGroupArn)) {
+			continue
+		}
 		if err := s.tgManager.Delete(ctx, sdkTG); err != nil {
 			return err
 		}
@@ -0,0 +1,248 @@
+package elbv2
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	s3sdk "github.com/aws/aws-sdk-go/service/s3"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"time"
+)
+
+const (
+	// trustStoreBundleChecksumTagKey is the AWS TagKey that records the checksum of the CA certificates bundle.
+	trustStoreBundleChecksumTagKey = "elbv2.k8s.aws/ca-certificates-bundle-checksum"
+
+	defaultWaitTSActivePollInterval   = 2 * time.Second
+	defaultWaitTSActiveTimeout        = 1 * time.Minute
+	defaultWaitTSDeletionPollInterval = 5 * time.Second
+	defaultWaitTSDeletionTimeout      = 2 * time.Minute
+)
+
+// TrustStoreManager is responsible for create/update/delete TrustStore resources.
+type TrustStoreManager interface {
+	Create(ctx context.Context, resTS *elbv2model.TrustStore) (elbv2model.TrustStoreStatus, error)
+
+	Update(ctx context.Context, resTS *elbv2model.TrustStore, sdkTS TrustStoreWithTags) (elbv2model.TrustStoreStatus, error)
+
+	Delete(ctx context.Context, sdkTS TrustStoreWithTags) error
+}
+
+// NewDefaultTrustStoreManager constructs new defaultTrustStoreManager.
+// CA certificates bundles are staged in the s3Bucket, since ELBV2 only accepts bundles from S3.
+func NewDefaultTrustStoreManager(elbv2Client services.ELBV2, s3Client services.S3, s3Bucket string, trackingProvider tracking.Provider,
+	taggingManager TaggingManager, externalManagedTags []string, logger logr.Logger) *defaultTrustStoreManager {
+	return &defaultTrustStoreManager{
+		elbv2Client:         elbv2Client,
+		s3Client:            s3Client,
+		s3Bucket:            s3Bucket,
+		trackingProvider:    trackingProvider,
+		taggingManager:      taggingManager,
+		externalManagedTags: externalManagedTags,
+		logger:              logger,
+
+		waitTSActivePollInterval:   defaultWaitTSActivePollInterval,
+		waitTSActiveTimeout:        defaultWaitTSActiveTimeout,
+		waitTSDeletionPollInterval: defaultWaitTSDeletionPollInterval,
+		waitTSDeletionTimeout:      defaultWaitTSDeletionTimeout,
+	}
+}
+
+var _ TrustStoreManager = &defaultTrustStoreManager{}
+
+// default implementation for TrustStoreManager.
+type defaultTrustStoreManager struct {
+	elbv2Client         services.ELBV2
+	s3Client            services.S3
+	s3Bucket            string
+	trackingProvider    tracking.Provider
+	taggingManager      TaggingManager
+	externalManagedTags []string
+	logger              logr.Logger
+
+	waitTSActivePollInterval   time.Duration
+	waitTSActiveTimeout        time.Duration
+	waitTSDeletionPollInterval time.Duration
+	waitTSDeletionTimeout      time.Duration
+}
+
+func (m *defaultTrustStoreManager) Create(ctx context.Context, resTS *elbv2model.TrustStore) (elbv2model.TrustStoreStatus, error) {
+	tsTags := m.buildTrustStoreTags(resTS)
+	s3Key, err := m.stageCACertificatesBundle(ctx, resTS.Spec, tsTags[trustStoreBundleChecksumTagKey])
+	if err != nil {
+		return elbv2model.TrustStoreStatus{}, err
+	}
+	defer m.unstageCACertificatesBundle(ctx, s3Key)
+
+	req := &elbv2sdk.CreateTrustStoreInput{
+		Name:                         awssdk.String(resTS.Spec.Name),
+		CaCertificatesBundleS3Bucket: awssdk.String(m.s3Bucket),
+		CaCertificatesBundleS3Key:    awssdk.String(s3Key),
+		Tags:                         convertTagsToSDKTags(tsTags),
+	}
+	m.logger.Info("creating trustStore",
+		"stackID", resTS.Stack().StackID(),
+		"resourceID", resTS.ID(),
+		"bundle", resTS.Spec.BundleRef)
+	resp, err := m.elbv2Client.CreateTrustStoreWithContext(ctx, req)
+	if err != nil {
+		return elbv2model.TrustStoreStatus{}, err
+	}
+	tsARN := awssdk.StringValue(resp.TrustStores[0].TrustStoreArn)
+	m.logger.Info("created trustStore",
+		"stackID", resTS.Stack().StackID(),
+		"resourceID", resTS.ID(),
+		"arn", tsARN)
+	if err := m.waitUntilTrustStoreActive(ctx, tsARN); err != nil {
+		return elbv2model.TrustStoreStatus{}, errors.Wrap(err, "failed to wait trustStore to become active")
+	}
+	return elbv2model.TrustStoreStatus{
+		TrustStoreARN: tsARN,
+	}, nil
+}
+
+func (m *defaultTrustStoreManager) Update(ctx context.Context, resTS *elbv2model.TrustStore, sdkTS TrustStoreWithTags) (elbv2model.TrustStoreStatus, error) {
+	tsARN := awssdk.StringValue(sdkTS.TrustStore.TrustStoreArn)
+	tsTags := m.buildTrustStoreTags(resTS)
+	if sdkTS.Tags[trustStoreBundleChecksumTagKey] != tsTags[trustStoreBundleChecksumTagKey] {
+		if err := m.updateCACertificatesBundle(ctx, resTS, tsARN, tsTags[trustStoreBundleChecksumTagKey]); err != nil {
+			return elbv2model.TrustStoreStatus{}, err
+		}
+	}
+	if err := m.taggingManager.ReconcileTags(ctx, tsARN, tsTags,
+		WithCurrentTags(sdkTS.Tags),
+		WithIgnoredTagKeys(m.trackingProvider.LegacyTagKeys()),
+		WithIgnoredTagKeys(m.externalManagedTags)); err != nil {
+		return elbv2model.TrustStoreStatus{}, err
+	}
+	return elbv2model.TrustStoreStatus{
+		TrustStoreARN: tsARN,
+	}, nil
+}
+
+func (m *defaultTrustStoreManager) Delete(ctx context.Context, sdkTS TrustStoreWithTags) error {
+	req := &elbv2sdk.DeleteTrustStoreInput{
+		TrustStoreArn: sdkTS.TrustStore.TrustStoreArn,
+	}
+
+	m.logger.Info("deleting trustStore",
+		"arn", awssdk.StringValue(req.TrustStoreArn))
+	// the trustStore stays in use for a short while after it's detached from listeners.
+	if err := runtime.RetryImmediateOnError(m.waitTSDeletionPollInterval, m.waitTSDeletionTimeout, isTrustStoreInUseError, func() error {
+		_, err := m.elbv2Client.DeleteTrustStoreWithContext(ctx, req)
+		return err
+	}); err != nil {
+		return errors.Wrap(err, "failed to delete trustStore")
+	}
+	m.logger.Info("deleted trustStore",
+		"arn", awssdk.StringValue(req.TrustStoreArn))
+	return nil
+}
+
+func (m *defaultTrustStoreManager) updateCACertificatesBundle(ctx context.Context, resTS *elbv2model.TrustStore, tsARN string, checksum string) error {
+	s3Key, err := m.stageCACertificatesBundle(ctx, resTS.Spec, checksum)
+	if err != nil {
+		return err
+	}
+	defer m.unstageCACertificatesBundle(ctx, s3Key)
+
+	req := &elbv2sdk.ModifyTrustStoreInput{
+		TrustStoreArn:                awssdk.String(tsARN),
+		CaCertificatesBundleS3Bucket: awssdk.String(m.s3Bucket),
+		CaCertificatesBundleS3Key:    awssdk.String(s3Key),
+	}
+	m.logger.Info("modifying trustStore",
+		"stackID", resTS.Stack().StackID(),
+		"resourceID", resTS.ID(),
+		"arn", tsARN)
+	if _, err := m.elbv2Client.ModifyTrustStoreWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("modified trustStore",
+		"stackID", resTS.Stack().StackID(),
+		"resourceID", resTS.ID(),
+		"arn", tsARN)
+	return nil
+}
+
+// stageCACertificatesBundle uploads the CA certificates bundle into S3, and returns the object key.
+func (m *defaultTrustStoreManager) stageCACertificatesBundle(ctx context.Context, tsSpec elbv2model.TrustStoreSpec, checksum string) (string, error) {
+	s3Key := fmt.Sprintf("%v/%v.pem", tsSpec.Name, checksum)
+	req := &s3sdk.PutObjectInput{
+		Bucket: awssdk.String(m.s3Bucket),
+		Key:    awssdk.String(s3Key),
+		Body:   bytes.NewReader([]byte(tsSpec.CACertificatesBundle)),
+	}
+	if _, err := m.s3Client.PutObjectWithContext(ctx, req); err != nil {
+		return "", errors.Wrapf(err, "failed to upload CA certificates bundle to s3 bucket: %v", m.s3Bucket)
+	}
+	return s3Key, nil
+}
+
+// unstageCACertificatesBundle deletes the CA certificates bundle from S3 once it's loaded into trustStore.
+// failures are only logged, since the trustStore doesn't depend on the S3 object after loading.
+func (m *defaultTrustStoreManager) unstageCACertificatesBundle(ctx context.Context, s3Key string) {
+	req := &s3sdk.DeleteObjectInput{
+		Bucket: awssdk.String(m.s3Bucket),
+		Key:    awssdk.String(s3Key),
+	}
+	if _, err := m.s3Client.DeleteObjectWithContext(ctx, req); err != nil {
+		m.logger.Error(err, "failed to delete CA certificates bundle from s3",
+			"bucket", m.s3Bucket,
+			"key", s3Key)
+	}
+}
+
+// waitUntilTrustStoreActive waits until the trustStore becomes active, listeners cannot reference trustStores that are still being created.
+func (m *defaultTrustStoreManager) waitUntilTrustStoreActive(ctx context.Context, tsARN string) error {
+	ctx, cancel := context.WithTimeout(ctx, m.waitTSActiveTimeout)
+	defer cancel()
+
+	req := &elbv2sdk.DescribeTrustStoresInput{
+		TrustStoreArns: awssdk.StringSlice([]string{tsARN}),
+	}
+	return wait.PollImmediateUntil(m.waitTSActivePollInterval, func() (bool, error) {
+		trustStores, err := m.elbv2Client.DescribeTrustStoresAsList(ctx, req)
+		if err != nil {
+			return false, err
+		}
+		for _, ts := range trustStores {
+			if awssdk.StringValue(ts.Status) == elbv2sdk.TrustStoreStatusActive {
+				return true, nil
+			}
+		}
+		return false, nil
+	}, ctx.Done())
+}
+
+// buildTrustStoreTags returns the desired tags of trustStore, including the checksum of CA certificates bundle.
+func (m *defaultTrustStoreManager) buildTrustStoreTags(resTS *elbv2model.TrustStore) map[string]string {
+	tsTags := m.trackingProvider.ResourceTags(resTS.Stack(), resTS, resTS.Spec.Tags)
+	tsTags[trustStoreBundleChecksumTagKey] = computeCACertificatesBundleChecksum(resTS.Spec)
+	return tsTags
+}
+
+// computeCACertificatesBundleChecksum computes the checksum of CA certificates bundle.
+func computeCACertificatesBundleChecksum(tsSpec elbv2model.TrustStoreSpec) string {
+	checksum := sha256.Sum256([]byte(tsSpec.CACertificatesBundle))
+	return hex.EncodeToString(checksum[:])
+}
+
+func isTrustStoreInUseError(err error) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code() == elbv2sdk.ErrCodeTrustStoreInUseException
+	}
+	return false
+}
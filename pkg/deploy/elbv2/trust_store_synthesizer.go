@@ -0,0 +1,152 @@
+package elbv2
+
+import (
+	"context"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// NewTrustStoreSynthesizer constructs trustStoreSynthesizer
+func NewTrustStoreSynthesizer(trackingProvider tracking.Provider, taggingManager TaggingManager,
+	tsManager TrustStoreManager, logger logr.Logger, stack core.Stack) *trustStoreSynthesizer {
+	return &trustStoreSynthesizer{
+		trackingProvider: trackingProvider,
+		taggingManager:   taggingManager,
+		tsManager:        tsManager,
+		logger:           logger,
+		stack:            stack,
+		unmatchedSDKTSs:  nil,
+	}
+}
+
+// trustStoreSynthesizer is responsible for synthesize TrustStore resources types for certain stack.
+type trustStoreSynthesizer struct {
+	trackingProvider tracking.Provider
+	taggingManager   TaggingManager
+	tsManager        TrustStoreManager
+	logger           logr.Logger
+
+	stack           core.Stack
+	unmatchedSDKTSs []TrustStoreWithTags
+}
+
+func (s *trustStoreSynthesizer) Synthesize(ctx context.Context) error {
+	var resTSs []*elbv2model.TrustStore
+	s.stack.ListResources(&resTSs)
+	sdkTSs, err := s.findSDKTrustStores(ctx)
+	if err != nil {
+		return err
+	}
+	matchedResAndSDKTSs, unmatchedResTSs, unmatchedSDKTSs, err := matchResAndSDKTrustStores(resTSs, sdkTSs, s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return err
+	}
+
+	// For TrustStores, we delete unmatched ones during post synthesize, after listeners stopped referencing them.
+	s.unmatchedSDKTSs = unmatchedSDKTSs
+
+	for _, resTS := range unmatchedResTSs {
+		tsStatus, err := s.tsManager.Create(ctx, resTS)
+		if err != nil {
+			return err
+		}
+		resTS.SetStatus(tsStatus)
+	}
+	for _, resAndSDKTS := range matchedResAndSDKTSs {
+		tsStatus, err := s.tsManager.Update(ctx, resAndSDKTS.resTS, resAndSDKTS.sdkTS)
+		if err != nil {
+			return err
+		}
+		resAndSDKTS.resTS.SetStatus(tsStatus)
+	}
+	return nil
+}
+
+func (s *trustStoreSynthesizer) PostSynthesize(ctx context.Context) error {
+	for _, sdkTS := range s.unmatchedSDKTSs {
+		if err := s.tsManager.Delete(ctx, sdkTS); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findSDKTrustStores will find all AWS TrustStores created for stack.
+func (s *trustStoreSynthesizer) findSDKTrustStores(ctx context.Context) ([]TrustStoreWithTags, error) {
+	stackTags := s.trackingProvider.StackTags(s.stack)
+	return s.taggingManager.ListTrustStores(ctx, tracking.TagsAsTagFilter(stackTags))
+}
+
+type resAndSDKTrustStorePair struct {
+	resTS *elbv2model.TrustStore
+	sdkTS TrustStoreWithTags
+}
+
+func matchResAndSDKTrustStores(resTSs []*elbv2model.TrustStore, sdkTSs []TrustStoreWithTags,
+	resourceIDTagKey string) ([]resAndSDKTrustStorePair, []*elbv2model.TrustStore, []TrustStoreWithTags, error) {
+	var matchedResAndSDKTSs []resAndSDKTrustStorePair
+	var unmatchedResTSs []*elbv2model.TrustStore
+	var unmatchedSDKTSs []TrustStoreWithTags
+
+	resTSsByID := mapResTrustStoreByResourceID(resTSs)
+	sdkTSsByID, err := mapSDKTrustStoreByResourceID(sdkTSs, resourceIDTagKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	resTSIDs := sets.StringKeySet(resTSsByID)
+	sdkTSIDs := sets.StringKeySet(sdkTSsByID)
+	for _, resID := range resTSIDs.Intersection(sdkTSIDs).List() {
+		resTS := resTSsByID[resID]
+		sdkTSs := sdkTSsByID[resID]
+		foundMatch := false
+		for _, sdkTS := range sdkTSs {
+			// the name of trustStore cannot be changed, and only one trustStore is kept for each resource.
+			if foundMatch || resTS.Spec.Name != awssdk.StringValue(sdkTS.TrustStore.Name) {
+				unmatchedSDKTSs = append(unmatchedSDKTSs, sdkTS)
+				continue
+			}
+			matchedResAndSDKTSs = append(matchedResAndSDKTSs, resAndSDKTrustStorePair{
+				resTS: resTS,
+				sdkTS: sdkTS,
+			})
+			foundMatch = true
+		}
+		if !foundMatch {
+			unmatchedResTSs = append(unmatchedResTSs, resTS)
+		}
+	}
+	for _, resID := range resTSIDs.Difference(sdkTSIDs).List() {
+		unmatchedResTSs = append(unmatchedResTSs, resTSsByID[resID])
+	}
+	for _, resID := range sdkTSIDs.Difference(resTSIDs).List() {
+		unmatchedSDKTSs = append(unmatchedSDKTSs, sdkTSsByID[resID]...)
+	}
+
+	return matchedResAndSDKTSs, unmatchedResTSs, unmatchedSDKTSs, nil
+}
+
+func mapResTrustStoreByResourceID(resTSs []*elbv2model.TrustStore) map[string]*elbv2model.TrustStore {
+	resTSsByID := make(map[string]*elbv2model.TrustStore, len(resTSs))
+	for _, resTS := range resTSs {
+		resTSsByID[resTS.ID()] = resTS
+	}
+	return resTSsByID
+}
+
+func mapSDKTrustStoreByResourceID(sdkTSs []TrustStoreWithTags, resourceIDTagKey string) (map[string][]TrustStoreWithTags, error) {
+	sdkTSsByID := make(map[string][]TrustStoreWithTags, len(sdkTSs))
+	for _, sdkTS := range sdkTSs {
+		resourceID, ok := sdkTS.Tags[resourceIDTagKey]
+		if !ok {
+			return nil, errors.Errorf("unexpected trustStore with no resourceID: %v", awssdk.StringValue(sdkTS.TrustStore.TrustStoreArn))
+		}
+		sdkTSsByID[resourceID] = append(sdkTSsByID[resourceID], sdkTS)
+	}
+	return sdkTSsByID, nil
+}
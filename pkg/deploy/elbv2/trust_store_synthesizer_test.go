@@ -0,0 +1,109 @@
+package elbv2
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"testing"
+)
+
+func Test_matchResAndSDKTrustStores(t *testing.T) {
+	stack := core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "name"})
+	resTS1 := elbv2model.NewTrustStore(stack, "my-ns/ca-1", elbv2model.TrustStoreSpec{Name: "k8s-ts-1"})
+	resTS2 := elbv2model.NewTrustStore(stack, "my-ns/ca-2", elbv2model.TrustStoreSpec{Name: "k8s-ts-2"})
+	sdkTS1 := TrustStoreWithTags{
+		TrustStore: &elbv2sdk.TrustStore{TrustStoreArn: awssdk.String("arn-1"), Name: awssdk.String("k8s-ts-1")},
+		Tags:       map[string]string{"elbv2.k8s.aws/resource": "my-ns/ca-1"},
+	}
+	sdkTS1Dup := TrustStoreWithTags{
+		TrustStore: &elbv2sdk.TrustStore{TrustStoreArn: awssdk.String("arn-1-dup"), Name: awssdk.String("k8s-ts-1")},
+		Tags:       map[string]string{"elbv2.k8s.aws/resource": "my-ns/ca-1"},
+	}
+	sdkTS1Renamed := TrustStoreWithTags{
+		TrustStore: &elbv2sdk.TrustStore{TrustStoreArn: awssdk.String("arn-1-renamed"), Name: awssdk.String("k8s-ts-1-old")},
+		Tags:       map[string]string{"elbv2.k8s.aws/resource": "my-ns/ca-1"},
+	}
+	sdkTS3 := TrustStoreWithTags{
+		TrustStore: &elbv2sdk.TrustStore{TrustStoreArn: awssdk.String("arn-3"), Name: awssdk.String("k8s-ts-3")},
+		Tags:       map[string]string{"elbv2.k8s.aws/resource": "my-ns/ca-3"},
+	}
+	type args struct {
+		resTSs []*elbv2model.TrustStore
+		sdkTSs []TrustStoreWithTags
+	}
+	tests := []struct {
+		name                  string
+		args                  args
+		wantMatchedResAndSDKs []resAndSDKTrustStorePair
+		wantUnmatchedResTSs   []*elbv2model.TrustStore
+		wantUnmatchedSDKTSs   []TrustStoreWithTags
+		wantErr               error
+	}{
+		{
+			name: "all trustStores matched",
+			args: args{
+				resTSs: []*elbv2model.TrustStore{resTS1},
+				sdkTSs: []TrustStoreWithTags{sdkTS1},
+			},
+			wantMatchedResAndSDKs: []resAndSDKTrustStorePair{
+				{resTS: resTS1, sdkTS: sdkTS1},
+			},
+		},
+		{
+			name: "some trustStores matched",
+			args: args{
+				resTSs: []*elbv2model.TrustStore{resTS1, resTS2},
+				sdkTSs: []TrustStoreWithTags{sdkTS1, sdkTS3},
+			},
+			wantMatchedResAndSDKs: []resAndSDKTrustStorePair{
+				{resTS: resTS1, sdkTS: sdkTS1},
+			},
+			wantUnmatchedResTSs: []*elbv2model.TrustStore{resTS2},
+			wantUnmatchedSDKTSs: []TrustStoreWithTags{sdkTS3},
+		},
+		{
+			name: "duplicated trustStores are unmatched",
+			args: args{
+				resTSs: []*elbv2model.TrustStore{resTS1},
+				sdkTSs: []TrustStoreWithTags{sdkTS1, sdkTS1Dup},
+			},
+			wantMatchedResAndSDKs: []resAndSDKTrustStorePair{
+				{resTS: resTS1, sdkTS: sdkTS1},
+			},
+			wantUnmatchedSDKTSs: []TrustStoreWithTags{sdkTS1Dup},
+		},
+		{
+			name: "trustStore with different name requires replacement",
+			args: args{
+				resTSs: []*elbv2model.TrustStore{resTS1},
+				sdkTSs: []TrustStoreWithTags{sdkTS1Renamed},
+			},
+			wantUnmatchedResTSs: []*elbv2model.TrustStore{resTS1},
+			wantUnmatchedSDKTSs: []TrustStoreWithTags{sdkTS1Renamed},
+		},
+		{
+			name: "trustStore without resourceID",
+			args: args{
+				resTSs: []*elbv2model.TrustStore{resTS1},
+				sdkTSs: []TrustStoreWithTags{{TrustStore: &elbv2sdk.TrustStore{TrustStoreArn: awssdk.String("arn-x")}, Tags: map[string]string{}}},
+			},
+			wantErr: errors.New("unexpected trustStore with no resourceID: arn-x"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMatched, gotUnmatchedRes, gotUnmatchedSDK, err := matchResAndSDKTrustStores(tt.args.resTSs, tt.args.sdkTSs, "elbv2.k8s.aws/resource")
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantMatchedResAndSDKs, gotMatched)
+				assert.Equal(t, tt.wantUnmatchedResTSs, gotUnmatchedRes)
+				assert.Equal(t, tt.wantUnmatchedSDKTSs, gotUnmatchedSDK)
+			}
+		})
+	}
+}
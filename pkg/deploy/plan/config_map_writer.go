@@ -0,0 +1,119 @@
+package plan
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// ConfigMapKeyPlan is the ConfigMap key for the plan in JSON format.
+	ConfigMapKeyPlan = "plan.json"
+	// ConfigMapKeyPlanText is the ConfigMap key for the plan in human readable format.
+	ConfigMapKeyPlanText = "plan.txt"
+
+	configMapNameSuffix = "-lb-plan"
+	// configMapLabelKey labels the ConfigMaps managed by the controller to hold plans.
+	configMapLabelKey   = "elbv2.k8s.aws/lb-plan"
+	configMapLabelValue = "true"
+)
+
+// ConfigMapWriter writes plans into ConfigMaps.
+type ConfigMapWriter interface {
+	// Write the plan into the ConfigMap for owner, the ConfigMap is owned by owner so that it's garbage collected with it.
+	// an existing ConfigMap is only updated if it's managed by the controller.
+	Write(ctx context.Context, owner client.Object, plan *Plan) error
+}
+
+// ConfigMapKeyForOwner returns the key of ConfigMap that holds the plan for owner.
+func ConfigMapKeyForOwner(owner client.Object) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: owner.GetNamespace(),
+		Name:      owner.GetName() + configMapNameSuffix,
+	}
+}
+
+// NewDefaultConfigMapWriter constructs new defaultConfigMapWriter.
+func NewDefaultConfigMapWriter(k8sClient client.Client) *defaultConfigMapWriter {
+	return &defaultConfigMapWriter{
+		k8sClient: k8sClient,
+	}
+}
+
+var _ ConfigMapWriter = &defaultConfigMapWriter{}
+
+// default implementation for ConfigMapWriter.
+type defaultConfigMapWriter struct {
+	k8sClient client.Client
+}
+
+func (w *defaultConfigMapWriter) Write(ctx context.Context, owner client.Object, plan *Plan) error {
+	payload, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return err
+	}
+	cmKey := ConfigMapKeyForOwner(owner)
+	cmData := map[string]string{
+		ConfigMapKeyPlan:     string(payload),
+		ConfigMapKeyPlanText: plan.String(),
+	}
+	cm := &corev1.ConfigMap{}
+	if err := w.k8sClient.Get(ctx, cmKey, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to load plan configMap: %v", cmKey)
+		}
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: cmKey.Namespace,
+				Name:      cmKey.Name,
+				Labels:    map[string]string{configMapLabelKey: configMapLabelValue},
+			},
+			Data: cmData,
+		}
+		if err := controllerutil.SetOwnerReference(owner, cm, w.k8sClient.Scheme()); err != nil {
+			return err
+		}
+		if err := w.k8sClient.Create(ctx, cm); err != nil {
+			return errors.Wrapf(err, "failed to write plan into configMap: %v", cmKey)
+		}
+		return nil
+	}
+	if !isPlanConfigMap(cm, owner) {
+		return errors.Errorf("configMap %v isn't managed by the controller, cannot write plan into it", cmKey)
+	}
+	oldCM := cm.DeepCopy()
+	cm.Data = cmData
+	if cm.Labels == nil {
+		cm.Labels = make(map[string]string)
+	}
+	cm.Labels[configMapLabelKey] = configMapLabelValue
+	if err := controllerutil.SetOwnerReference(owner, cm, w.k8sClient.Scheme()); err != nil {
+		return err
+	}
+	// the ConfigMap isn't rewritten if the plan is unchanged.
+	if equality.Semantic.DeepEqual(oldCM, cm) {
+		return nil
+	}
+	if err := w.k8sClient.Patch(ctx, cm, client.MergeFrom(oldCM)); err != nil {
+		return errors.Wrapf(err, "failed to write plan into configMap: %v", cmKey)
+	}
+	return nil
+}
+
+// isPlanConfigMap checks whether cm is managed by the controller to hold the plan for owner.
+// ConfigMaps written before they were labeled are recognized by their ownerReference to owner.
+func isPlanConfigMap(cm *corev1.ConfigMap, owner client.Object) bool {
+	if cm.Labels[configMapLabelKey] == configMapLabelValue {
+		return true
+	}
+	return k8s.HasOwnerReference(cm, owner)
+}
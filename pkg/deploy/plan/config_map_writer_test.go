@@ -0,0 +1,127 @@
+package plan
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultConfigMapWriter_Write(t *testing.T) {
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-svc", UID: "uid-1"}}
+	svcOwnerRefs := []metav1.OwnerReference{{APIVersion: "v1", Kind: "Service", Name: "awesome-svc", UID: "uid-1"}}
+	cmKey := types.NamespacedName{Namespace: "awesome-ns", Name: "awesome-svc-lb-plan"}
+	emptyPlan := &Plan{StackID: "awesome-ns/awesome-svc", Changes: []ResourceChange{}}
+	plan := &Plan{
+		StackID: "awesome-ns/awesome-svc",
+		Changes: []ResourceChange{
+			{
+				Action:       ChangeActionCreate,
+				ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+				ResourceID:   "awesome-ns/awesome-svc:80",
+			},
+		},
+	}
+	buildPlanConfigMap := func(plan *Plan, labels map[string]string, ownerRefs []metav1.OwnerReference) *corev1.ConfigMap {
+		payload, _ := json.MarshalIndent(plan, "", "  ")
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       cmKey.Namespace,
+				Name:            cmKey.Name,
+				Labels:          labels,
+				OwnerReferences: ownerRefs,
+			},
+			Data: map[string]string{
+				"plan.json": string(payload),
+				"plan.txt":  plan.String(),
+			},
+		}
+	}
+	managedLabels := map[string]string{"elbv2.k8s.aws/lb-plan": "true"}
+
+	tests := []struct {
+		name          string
+		existingCM    *corev1.ConfigMap
+		plan          *Plan
+		wantCM        *corev1.ConfigMap
+		wantUnchanged bool
+		wantErr       error
+	}{
+		{
+			name:   "create configMap",
+			plan:   plan,
+			wantCM: buildPlanConfigMap(plan, managedLabels, svcOwnerRefs),
+		},
+		{
+			name:       "update labeled configMap",
+			existingCM: buildPlanConfigMap(emptyPlan, managedLabels, nil),
+			plan:       plan,
+			wantCM:     buildPlanConfigMap(plan, managedLabels, svcOwnerRefs),
+		},
+		{
+			name:       "update configMap owned by owner",
+			existingCM: buildPlanConfigMap(emptyPlan, nil, svcOwnerRefs),
+			plan:       plan,
+			wantCM:     buildPlanConfigMap(plan, managedLabels, svcOwnerRefs),
+		},
+		{
+			name:          "configMap isn't rewritten with unchanged plan",
+			existingCM:    buildPlanConfigMap(plan, managedLabels, svcOwnerRefs),
+			plan:          plan,
+			wantCM:        buildPlanConfigMap(plan, managedLabels, svcOwnerRefs),
+			wantUnchanged: true,
+		},
+		{
+			name: "configMap not managed by the controller isn't overwritten",
+			existingCM: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: cmKey.Namespace, Name: cmKey.Name},
+				Data:       map[string]string{"some-key": "some-value"},
+			},
+			plan: plan,
+			wantCM: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: cmKey.Namespace, Name: cmKey.Name},
+				Data:       map[string]string{"some-key": "some-value"},
+			},
+			wantUnchanged: true,
+			wantErr:       errors.New("configMap awesome-ns/awesome-svc-lb-plan isn't managed by the controller, cannot write plan into it"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			if tt.existingCM != nil {
+				assert.NoError(t, k8sClient.Create(ctx, tt.existingCM.DeepCopy()))
+			}
+			w := NewDefaultConfigMapWriter(k8sClient)
+			err := w.Write(ctx, svc, tt.plan)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			gotCM := &corev1.ConfigMap{}
+			assert.NoError(t, k8sClient.Get(ctx, cmKey, gotCM))
+			assert.Equal(t, tt.wantCM.Labels, gotCM.Labels)
+			assert.Equal(t, tt.wantCM.Data, gotCM.Data)
+			if len(tt.wantCM.OwnerReferences) != 0 {
+				assert.Equal(t, tt.wantCM.OwnerReferences[0].UID, gotCM.OwnerReferences[0].UID)
+			} else {
+				assert.Empty(t, gotCM.OwnerReferences)
+			}
+			if tt.wantUnchanged {
+				assert.Equal(t, "1", gotCM.ResourceVersion)
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ChangeAction is the action planned for a resource.
+type ChangeAction string
+
+const (
+	ChangeActionCreate  ChangeAction = "Create"
+	ChangeActionUpdate  ChangeAction = "Update"
+	ChangeActionDelete  ChangeAction = "Delete"
+	ChangeActionReplace ChangeAction = "Replace"
+)
+
+const (
+	// plannedIdentifierPrefix is the prefix of identifiers for resources that would be created.
+	plannedIdentifierPrefix = "planned:"
+)
+
+// FieldDiff is the difference of a resource field between its current and desired state.
+type FieldDiff struct {
+	// Field is the name of the field.
+	Field string `json:"field"`
+	// Current is the current value of the field.
+	Current string `json:"current"`
+	// Desired is the desired value of the field.
+	Desired string `json:"desired"`
+}
+
+// ResourceChange is a change planned for a resource.
+type ResourceChange struct {
+	// Action is the action planned for the resource.
+	Action ChangeAction `json:"action"`
+	// ResourceType is the type of the resource, e.g. AWS::ElasticLoadBalancingV2::LoadBalancer.
+	ResourceType string `json:"resourceType"`
+	// ResourceID is the ID of the resource within the stack, if known.
+	ResourceID string `json:"resourceID,omitempty"`
+	// Identifier is the ARN or ID of the existing resource, it's empty for resources to be created.
+	Identifier string `json:"identifier,omitempty"`
+	// Description is a human readable description of the resource, e.g. the port of a listener.
+	Description string `json:"description,omitempty"`
+	// Diffs are the field level differences for updated resources.
+	Diffs []FieldDiff `json:"diffs,omitempty"`
+}
+
+// Plan is the set of changes planned to deploy a resource stack.
+type Plan struct {
+	// StackID is the ID of the planned stack.
+	StackID string `json:"stackID"`
+	// Changes are the changes planned, in the order they would be applied.
+	Changes []ResourceChange `json:"changes"`
+}
+
+// IsEmpty checks whether there are no changes planned.
+func (p *Plan) IsEmpty() bool {
+	return len(p.Changes) == 0
+}
+
+// Summary returns a one line summary of the plan.
+func (p *Plan) Summary() string {
+	countByAction := make(map[ChangeAction]int)
+	for _, change := range p.Changes {
+		countByAction[change.Action]++
+	}
+	return fmt.Sprintf("%d to create, %d to update, %d to replace, %d to delete",
+		countByAction[ChangeActionCreate], countByAction[ChangeActionUpdate],
+		countByAction[ChangeActionReplace], countByAction[ChangeActionDelete])
+}
+
+// String renders the plan in a human readable format.
+func (p *Plan) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Plan for %s: %s\n", p.StackID, p.Summary())
+	for _, change := range p.Changes {
+		fmt.Fprintf(&sb, "  %s %s", change.Action, change.ResourceType)
+		if change.ResourceID != "" {
+			fmt.Fprintf(&sb, " %s", change.ResourceID)
+		}
+		if change.Description != "" {
+			fmt.Fprintf(&sb, " (%s)", change.Description)
+		}
+		if change.Identifier != "" {
+			fmt.Fprintf(&sb, " [%s]", change.Identifier)
+		}
+		sb.WriteString("\n")
+		for _, diff := range change.Diffs {
+			fmt.Fprintf(&sb, "      %s: %s => %s\n", diff.Field, diff.Current, diff.Desired)
+		}
+	}
+	return sb.String()
+}
+
+// PlannedIdentifier returns the placeholder identifier for a resource that would be created.
+func PlannedIdentifier(resourceType string, resourceID string) string {
+	return fmt.Sprintf("%s%s/%s", plannedIdentifierPrefix, resourceType, resourceID)
+}
+
+// IsPlannedIdentifier checks whether identifier is a placeholder for a resource that would be created.
+func IsPlannedIdentifier(identifier string) bool {
+	return strings.HasPrefix(identifier, plannedIdentifierPrefix)
+}
+
+// Recorder records the changes planned for a resource stack.
+type Recorder struct {
+	changes      []ResourceChange
+	changesMutex sync.Mutex
+}
+
+// NewRecorder constructs new Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// RecordCreate records a resource to be created.
+func (r *Recorder) RecordCreate(resourceType string, resourceID string, description string) {
+	r.record(ResourceChange{
+		Action:       ChangeActionCreate,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Description:  description,
+	})
+}
+
+// RecordUpdate records a resource to be updated, nothing is recorded if there are no diffs.
+func (r *Recorder) RecordUpdate(resourceType string, resourceID string, identifier string, description string, diffs []FieldDiff) {
+	if len(diffs) == 0 {
+		return
+	}
+	r.record(ResourceChange{
+		Action:       ChangeActionUpdate,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Identifier:   identifier,
+		Description:  description,
+		Diffs:        diffs,
+	})
+}
+
+// RecordDelete records a resource to be deleted.
+func (r *Recorder) RecordDelete(resourceType string, resourceID string, identifier string, description string) {
+	r.record(ResourceChange{
+		Action:       ChangeActionDelete,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Identifier:   identifier,
+		Description:  description,
+	})
+}
+
+// Plan returns the plan of recorded changes.
+// A resource that is both deleted and created is planned to be replaced.
+func (r *Recorder) Plan(stackID string) *Plan {
+	r.changesMutex.Lock()
+	defer r.changesMutex.Unlock()
+
+	type resourceKey struct {
+		resourceType string
+		resourceID   string
+	}
+	createIdxByKey := make(map[resourceKey]int)
+	for idx, change := range r.changes {
+		if change.Action == ChangeActionCreate && change.ResourceID != "" {
+			createIdxByKey[resourceKey{change.ResourceType, change.ResourceID}] = idx
+		}
+	}
+	// the replacement is planned at the position of the first of the paired delete and create.
+	replacementByIdx := make(map[int]ResourceChange)
+	skippedIdxs := make(map[int]struct{})
+	for idx, change := range r.changes {
+		if change.Action != ChangeActionDelete || change.ResourceID == "" {
+			continue
+		}
+		key := resourceKey{change.ResourceType, change.ResourceID}
+		createIdx, exists := createIdxByKey[key]
+		if !exists {
+			continue
+		}
+		delete(createIdxByKey, key)
+		replacement := change
+		replacement.Action = ChangeActionReplace
+		if replacement.Description == "" {
+			replacement.Description = r.changes[createIdx].Description
+		}
+		firstIdx, secondIdx := idx, createIdx
+		if createIdx < idx {
+			firstIdx, secondIdx = createIdx, idx
+		}
+		replacementByIdx[firstIdx] = replacement
+		skippedIdxs[secondIdx] = struct{}{}
+	}
+
+	changes := make([]ResourceChange, 0, len(r.changes))
+	for idx, change := range r.changes {
+		if _, skipped := skippedIdxs[idx]; skipped {
+			continue
+		}
+		if replacement, replaced := replacementByIdx[idx]; replaced {
+			change = replacement
+		}
+		changes = append(changes, change)
+	}
+	return &Plan{
+		StackID: stackID,
+		Changes: changes,
+	}
+}
+
+func (r *Recorder) record(change ResourceChange) {
+	r.changesMutex.Lock()
+	defer r.changesMutex.Unlock()
+	r.changes = append(r.changes, change)
+}
+
+// DiffBuilder builds the field level differences of a resource.
+type DiffBuilder struct {
+	diffs []FieldDiff
+}
+
+// Compare records a FieldDiff if current and desired values of field differs.
+// values are compared by their rendered form, thus slices should be sorted if their order doesn't matter.
+func (b *DiffBuilder) Compare(field string, current interface{}, desired interface{}) {
+	renderedCurrent := renderValue(current)
+	renderedDesired := renderValue(desired)
+	if renderedCurrent == renderedDesired {
+		return
+	}
+	b.Add(field, renderedCurrent, renderedDesired)
+}
+
+// Add records a FieldDiff with already rendered values.
+func (b *DiffBuilder) Add(field string, current string, desired string) {
+	b.diffs = append(b.diffs, FieldDiff{
+		Field:   field,
+		Current: current,
+		Desired: desired,
+	})
+}
+
+// Diffs returns the recorded FieldDiffs.
+func (b *DiffBuilder) Diffs() []FieldDiff {
+	return b.diffs
+}
+
+func renderValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case *string:
+		if v == nil {
+			return ""
+		}
+		return *v
+	}
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	if string(payload) == "null" {
+		return ""
+	}
+	return string(payload)
+}
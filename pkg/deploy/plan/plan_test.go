@@ -0,0 +1,275 @@
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder_Plan(t *testing.T) {
+	type recordFunc func(r *Recorder)
+	tests := []struct {
+		name    string
+		records []recordFunc
+		want    *Plan
+	}{
+		{
+			name:    "no changes",
+			records: nil,
+			want: &Plan{
+				StackID: "ns/name",
+				Changes: []ResourceChange{},
+			},
+		},
+		{
+			name: "update without diffs is not recorded",
+			records: []recordFunc{
+				func(r *Recorder) {
+					r.RecordUpdate("AWS::ElasticLoadBalancingV2::LoadBalancer", "LoadBalancer", "lb-arn", "my-lb", nil)
+				},
+			},
+			want: &Plan{
+				StackID: "ns/name",
+				Changes: []ResourceChange{},
+			},
+		},
+		{
+			name: "create, update and delete",
+			records: []recordFunc{
+				func(r *Recorder) {
+					r.RecordCreate("AWS::ElasticLoadBalancingV2::TargetGroup", "ns/svc:80", "k8s-ns-svc")
+				},
+				func(r *Recorder) {
+					r.RecordUpdate("AWS::ElasticLoadBalancingV2::LoadBalancer", "LoadBalancer", "lb-arn", "my-lb", []FieldDiff{
+						{Field: "subnets", Current: `["subnet-a"]`, Desired: `["subnet-a","subnet-b"]`},
+					})
+				},
+				func(r *Recorder) {
+					r.RecordDelete("AWS::ElasticLoadBalancingV2::Listener", "", "ls-arn", "")
+				},
+			},
+			want: &Plan{
+				StackID: "ns/name",
+				Changes: []ResourceChange{
+					{
+						Action:       ChangeActionCreate,
+						ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+						ResourceID:   "ns/svc:80",
+						Description:  "k8s-ns-svc",
+					},
+					{
+						Action:       ChangeActionUpdate,
+						ResourceType: "AWS::ElasticLoadBalancingV2::LoadBalancer",
+						ResourceID:   "LoadBalancer",
+						Identifier:   "lb-arn",
+						Description:  "my-lb",
+						Diffs: []FieldDiff{
+							{Field: "subnets", Current: `["subnet-a"]`, Desired: `["subnet-a","subnet-b"]`},
+						},
+					},
+					{
+						Action:       ChangeActionDelete,
+						ResourceType: "AWS::ElasticLoadBalancingV2::Listener",
+						Identifier:   "ls-arn",
+					},
+				},
+			},
+		},
+		{
+			name: "delete then create of same resource is replace",
+			records: []recordFunc{
+				func(r *Recorder) {
+					r.RecordDelete("AWS::ElasticLoadBalancingV2::LoadBalancer", "LoadBalancer", "lb-arn", "")
+				},
+				func(r *Recorder) {
+					r.RecordCreate("AWS::ElasticLoadBalancingV2::Listener", "80", "port 80")
+				},
+				func(r *Recorder) {
+					r.RecordCreate("AWS::ElasticLoadBalancingV2::LoadBalancer", "LoadBalancer", "my-lb")
+				},
+			},
+			want: &Plan{
+				StackID: "ns/name",
+				Changes: []ResourceChange{
+					{
+						Action:       ChangeActionReplace,
+						ResourceType: "AWS::ElasticLoadBalancingV2::LoadBalancer",
+						ResourceID:   "LoadBalancer",
+						Identifier:   "lb-arn",
+						Description:  "my-lb",
+					},
+					{
+						Action:       ChangeActionCreate,
+						ResourceType: "AWS::ElasticLoadBalancingV2::Listener",
+						ResourceID:   "80",
+						Description:  "port 80",
+					},
+				},
+			},
+		},
+		{
+			name: "create then delete of same resource is replace",
+			records: []recordFunc{
+				func(r *Recorder) {
+					r.RecordCreate("AWS::ElasticLoadBalancingV2::TargetGroup", "ns/svc:80", "k8s-ns-svc-new")
+				},
+				func(r *Recorder) {
+					r.RecordDelete("AWS::ElasticLoadBalancingV2::TargetGroup", "ns/svc:80", "tg-arn", "")
+				},
+				func(r *Recorder) {
+					r.RecordDelete("AWS::ElasticLoadBalancingV2::TargetGroup", "", "tg-arn-2", "")
+				},
+			},
+			want: &Plan{
+				StackID: "ns/name",
+				Changes: []ResourceChange{
+					{
+						Action:       ChangeActionReplace,
+						ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+						ResourceID:   "ns/svc:80",
+						Identifier:   "tg-arn",
+						Description:  "k8s-ns-svc-new",
+					},
+					{
+						Action:       ChangeActionDelete,
+						ResourceType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+						Identifier:   "tg-arn-2",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRecorder()
+			for _, record := range tt.records {
+				record(r)
+			}
+			got := r.Plan("ns/name")
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPlan_Summary(t *testing.T) {
+	tests := []struct {
+		name string
+		plan *Plan
+		want string
+	}{
+		{
+			name: "empty plan",
+			plan: &Plan{},
+			want: "0 to create, 0 to update, 0 to replace, 0 to delete",
+		},
+		{
+			name: "plan with changes",
+			plan: &Plan{
+				Changes: []ResourceChange{
+					{Action: ChangeActionCreate},
+					{Action: ChangeActionCreate},
+					{Action: ChangeActionUpdate},
+					{Action: ChangeActionReplace},
+					{Action: ChangeActionDelete},
+					{Action: ChangeActionDelete},
+					{Action: ChangeActionDelete},
+				},
+			},
+			want: "2 to create, 1 to update, 1 to replace, 3 to delete",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.plan.Summary()
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPlan_String(t *testing.T) {
+	p := &Plan{
+		StackID: "ns/name",
+		Changes: []ResourceChange{
+			{
+				Action:       ChangeActionUpdate,
+				ResourceType: "AWS::ElasticLoadBalancingV2::LoadBalancer",
+				ResourceID:   "LoadBalancer",
+				Identifier:   "lb-arn",
+				Description:  "my-lb",
+				Diffs: []FieldDiff{
+					{Field: "ipAddressType", Current: "ipv4", Desired: "dualstack"},
+				},
+			},
+			{
+				Action:       ChangeActionCreate,
+				ResourceType: "AWS::ElasticLoadBalancingV2::Listener",
+				ResourceID:   "80",
+			},
+		},
+	}
+	want := "Plan for ns/name: 1 to create, 1 to update, 0 to replace, 0 to delete\n" +
+		"  Update AWS::ElasticLoadBalancingV2::LoadBalancer LoadBalancer (my-lb) [lb-arn]\n" +
+		"      ipAddressType: ipv4 => dualstack\n" +
+		"  Create AWS::ElasticLoadBalancingV2::Listener 80\n"
+	assert.Equal(t, want, p.String())
+}
+
+func TestDiffBuilder_Compare(t *testing.T) {
+	emptyString := ""
+	someString := "value"
+	type compareArgs struct {
+		field   string
+		current interface{}
+		desired interface{}
+	}
+	tests := []struct {
+		name    string
+		compare []compareArgs
+		want    []FieldDiff
+	}{
+		{
+			name: "equal values",
+			compare: []compareArgs{
+				{field: "scheme", current: "internal", desired: "internal"},
+				{field: "subnets", current: []string{"a", "b"}, desired: []string{"a", "b"}},
+				{field: "port", current: int64(80), desired: int64(80)},
+			},
+			want: nil,
+		},
+		{
+			name: "nil is equal to empty string",
+			compare: []compareArgs{
+				{field: "sslPolicy", current: (*string)(nil), desired: &emptyString},
+				{field: "certificates", current: []string(nil), desired: ""},
+			},
+			want: nil,
+		},
+		{
+			name: "different values",
+			compare: []compareArgs{
+				{field: "scheme", current: "internal", desired: "internet-facing"},
+				{field: "sslPolicy", current: (*string)(nil), desired: &someString},
+				{field: "tags", current: map[string]string{"k": "v1"}, desired: map[string]string{"k": "v2"}},
+			},
+			want: []FieldDiff{
+				{Field: "scheme", Current: "internal", Desired: "internet-facing"},
+				{Field: "sslPolicy", Current: "", Desired: "value"},
+				{Field: "tags", Current: `{"k":"v1"}`, Desired: `{"k":"v2"}`},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &DiffBuilder{}
+			for _, args := range tt.compare {
+				b.Compare(args.field, args.current, args.desired)
+			}
+			assert.Equal(t, tt.want, b.Diffs())
+		})
+	}
+}
+
+func TestIsPlannedIdentifier(t *testing.T) {
+	assert.True(t, IsPlannedIdentifier(PlannedIdentifier("AWS::EC2::SecurityGroup", "ManagedLBSecurityGroup")))
+	assert.False(t, IsPlannedIdentifier("sg-0123456789abcdef0"))
+}
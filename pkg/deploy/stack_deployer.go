@@ -5,6 +5,7 @@ import (
 	"github.com/go-logr/logr"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/acm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/ec2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/shield"
@@ -30,6 +31,7 @@ func NewDefaultStackDeployer(cloud aws.Cloud, k8sClient client.Client,
 	trackingProvider := tracking.NewDefaultProvider(tagPrefix, config.ClusterName)
 	ec2TaggingManager := ec2.NewDefaultTaggingManager(cloud.EC2(), networkingSGManager, cloud.VpcID(), logger)
 	elbv2TaggingManager := elbv2.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), config.FeatureGates, logger)
+	acmTaggingManager := acm.NewDefaultTaggingManager(cloud.ACM(), cloud.RGT(), logger)
 
 	return &defaultStackDeployer{
 		cloud:                               cloud,
@@ -38,15 +40,20 @@ func NewDefaultStackDeployer(cloud aws.Cloud, k8sClient client.Client,
 		trackingProvider:                    trackingProvider,
 		ec2TaggingManager:                   ec2TaggingManager,
 		ec2SGManager:                        ec2.NewDefaultSecurityGroupManager(cloud.EC2(), trackingProvider, ec2TaggingManager, networkingSGReconciler, cloud.VpcID(), config.ExternalManagedTags, logger),
+		acmTaggingManager:                   acmTaggingManager,
+		acmCertManager:                      acm.NewDefaultCertificateManager(cloud.ACM(), trackingProvider, acmTaggingManager, config.ExternalManagedTags, logger),
 		elbv2TaggingManager:                 elbv2TaggingManager,
 		elbv2LBManager:                      elbv2.NewDefaultLoadBalancerManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, logger),
 		elbv2LSManager:                      elbv2.NewDefaultListenerManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, config.FeatureGates, logger),
 		elbv2LRManager:                      elbv2.NewDefaultListenerRuleManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, config.FeatureGates, logger),
 		elbv2TGManager:                      elbv2.NewDefaultTargetGroupManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, cloud.VpcID(), config.ExternalManagedTags, logger),
 		elbv2TGBManager:                     elbv2.NewDefaultTargetGroupBindingManager(k8sClient, trackingProvider, logger),
+		elbv2TSManager:                      elbv2.NewDefaultTrustStoreManager(cloud.ELBV2(), cloud.S3(), config.IngressConfig.TrustStoreS3Bucket, trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, logger),
 		wafv2WebACLAssociationManager:       wafv2.NewDefaultWebACLAssociationManager(cloud.WAFv2(), logger),
 		wafRegionalWebACLAssociationManager: wafregional.NewDefaultWebACLAssociationManager(cloud.WAFRegional(), logger),
 		shieldProtectionManager:             shield.NewDefaultProtectionManager(cloud.Shield(), logger),
+		featureGates:                        config.FeatureGates,
+		trustStoreS3Bucket:                  config.IngressConfig.TrustStoreS3Bucket,
 		vpcID:                               cloud.VpcID(),
 		logger:                              logger,
 	}
@@ -62,15 +69,20 @@ type defaultStackDeployer struct {
 	trackingProvider                    tracking.Provider
 	ec2TaggingManager                   ec2.TaggingManager
 	ec2SGManager                        ec2.SecurityGroupManager
+	acmTaggingManager                   acm.TaggingManager
+	acmCertManager                      acm.CertificateManager
 	elbv2TaggingManager                 elbv2.TaggingManager
 	elbv2LBManager                      elbv2.LoadBalancerManager
 	elbv2LSManager                      elbv2.ListenerManager
 	elbv2LRManager                      elbv2.ListenerRuleManager
 	elbv2TGManager                      elbv2.TargetGroupManager
 	elbv2TGBManager                     elbv2.TargetGroupBindingManager
+	elbv2TSManager                      elbv2.TrustStoreManager
 	wafv2WebACLAssociationManager       wafv2.WebACLAssociationManager
 	wafRegionalWebACLAssociationManager wafregional.WebACLAssociationManager
 	shieldProtectionManager             shield.ProtectionManager
+	featureGates                        config.FeatureGates
+	trustStoreS3Bucket                  string
 	vpcID                               string
 
 	logger logr.Logger
@@ -88,9 +100,20 @@ func (d *defaultStackDeployer) Deploy(ctx context.Context, stack core.Stack) err
 		elbv2.NewTargetGroupSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2TGManager, d.logger, stack),
 		elbv2.NewLoadBalancerSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LBManager, d.logger, stack),
 		elbv2.NewListenerSynthesizer(d.cloud.ELBV2(), d.elbv2TaggingManager, d.elbv2LSManager, d.logger, stack),
-		elbv2.NewListenerRuleSynthesizer(d.cloud.ELBV2(), d.elbv2TaggingManager, d.elbv2LRManager, d.logger, stack),
+		elbv2.NewALBTargetSynthesizer(d.elbv2TGManager, d.logger, stack),
+		elbv2.NewListenerRuleSynthesizer(d.cloud.ELBV2(), d.elbv2TaggingManager, d.elbv2LRManager, d.featureGates, d.logger, stack),
 		elbv2.NewTargetGroupBindingSynthesizer(d.k8sClient, d.trackingProvider, d.elbv2TGBManager, d.logger, stack),
 	}
+	if d.featureGates.Enabled(config.ImportTLSSecrets) {
+		// imported certificates must exist before listeners reference them, and are deleted after listeners released them.
+		certSynthesizer := acm.NewCertificateSynthesizer(d.trackingProvider, d.acmTaggingManager, d.acmCertManager, d.logger, stack)
+		synthesizers = append([]ResourceSynthesizer{certSynthesizer}, synthesizers...)
+	}
+	if len(d.trustStoreS3Bucket) != 0 {
+		// trustStores must exist before listeners reference them, and are deleted after listeners released them.
+		tsSynthesizer := elbv2.NewTrustStoreSynthesizer(d.trackingProvider, d.elbv2TaggingManager, d.elbv2TSManager, d.logger, stack)
+		synthesizers = append([]ResourceSynthesizer{tsSynthesizer}, synthesizers...)
+	}
 
 	if d.addonsConfig.WAFV2Enabled {
 		synthesizers = append(synthesizers, wafv2.NewWebACLAssociationSynthesizer(d.wafv2WebACLAssociationManager, d.logger, stack))
@@ -0,0 +1,119 @@
+package deploy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+// GraphFormat is the format to render the resource graph of stack.
+type GraphFormat string
+
+const (
+	GraphFormatDOT     GraphFormat = "dot"
+	GraphFormatMermaid GraphFormat = "mermaid"
+)
+
+// StackGraphRenderer will render the resource graph of a stack.
+type StackGraphRenderer interface {
+	Render(stack core.Stack, format GraphFormat) (string, error)
+}
+
+// NewDefaultStackGraphRenderer constructs new defaultStackGraphRenderer.
+func NewDefaultStackGraphRenderer() *defaultStackGraphRenderer {
+	return &defaultStackGraphRenderer{}
+}
+
+var _ StackGraphRenderer = &defaultStackGraphRenderer{}
+
+// defaultStackGraphRenderer renders resources as nodes, and edges from each resource to the resources depend on it.
+// nodes and edges are sorted by resource type and ID, so that the rendered graph is stable.
+type defaultStackGraphRenderer struct{}
+
+// stackGraphEdge is an edge within the resource graph, where dst depends on src.
+type stackGraphEdge struct {
+	src int
+	dst int
+}
+
+func (r *defaultStackGraphRenderer) Render(stack core.Stack, format GraphFormat) (string, error) {
+	collector := &stackResourceCollector{}
+	if err := stack.TopologicalTraversal(collector); err != nil {
+		return "", err
+	}
+	resources := collector.resources
+	sort.Slice(resources, func(i, j int) bool {
+		return resourceGraphLabel(resources[i]) < resourceGraphLabel(resources[j])
+	})
+	resIdxByLabel := make(map[string]int, len(resources))
+	for idx, res := range resources {
+		resIdxByLabel[resourceGraphLabel(res)] = idx
+	}
+	var edges []stackGraphEdge
+	for idx, res := range resources {
+		var dstIdxs []int
+		for _, dependent := range stack.ListDependents(res) {
+			dstIdxs = append(dstIdxs, resIdxByLabel[resourceGraphLabel(dependent)])
+		}
+		sort.Ints(dstIdxs)
+		for _, dstIdx := range dstIdxs {
+			edges = append(edges, stackGraphEdge{src: idx, dst: dstIdx})
+		}
+	}
+
+	switch format {
+	case GraphFormatDOT:
+		return r.renderDOT(stack.StackID(), resources, edges), nil
+	case GraphFormatMermaid:
+		return r.renderMermaid(resources, edges), nil
+	default:
+		return "", errors.Errorf("unknown graph format: %v", format)
+	}
+}
+
+func (r *defaultStackGraphRenderer) renderDOT(stackID core.StackID, resources []core.Resource, edges []stackGraphEdge) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "digraph %q {\n", stackID.String())
+	sb.WriteString("  rankdir=LR;\n")
+	for idx, res := range resources {
+		fmt.Fprintf(&sb, "  n%d [label=%q];\n", idx, resourceGraphLabel(res))
+	}
+	for _, edge := range edges {
+		fmt.Fprintf(&sb, "  n%d -> n%d;\n", edge.src, edge.dst)
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+func (r *defaultStackGraphRenderer) renderMermaid(resources []core.Resource, edges []stackGraphEdge) string {
+	var sb strings.Builder
+	sb.WriteString("graph LR\n")
+	for idx, res := range resources {
+		label := strings.ReplaceAll(resourceGraphLabel(res), `"`, "#quot;")
+		fmt.Fprintf(&sb, "  n%d[\"%s\"]\n", idx, label)
+	}
+	for _, edge := range edges {
+		fmt.Fprintf(&sb, "  n%d --> n%d\n", edge.src, edge.dst)
+	}
+	return sb.String()
+}
+
+// resourceGraphLabel returns the label of resource within the resource graph.
+func resourceGraphLabel(res core.Resource) string {
+	return fmt.Sprintf("%s/%s", res.Type(), res.ID())
+}
+
+var _ core.ResourceVisitor = &stackResourceCollector{}
+
+// stackResourceCollector collects all resources within stack.
+type stackResourceCollector struct {
+	resources []core.Resource
+}
+
+func (c *stackResourceCollector) Visit(res core.Resource) error {
+	c.resources = append(c.resources, res)
+	return nil
+}
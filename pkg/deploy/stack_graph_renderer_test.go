@@ -0,0 +1,72 @@
+package deploy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+func Test_defaultStackGraphRenderer_Render(t *testing.T) {
+	buildStack := func() core.Stack {
+		stack := core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "name"})
+		resA := core.NewFakeResource(stack, "typeX", "resA", core.FakeResourceSpec{}, nil)
+		resB := core.NewFakeResource(stack, "typeY", "resB", core.FakeResourceSpec{}, nil)
+		resC := core.NewFakeResource(stack, "typeX", "resC", core.FakeResourceSpec{}, nil)
+		_ = stack.AddDependency(resA, resB)
+		_ = stack.AddDependency(resC, resB)
+		_ = stack.AddDependency(resA, resC)
+		return stack
+	}
+	tests := []struct {
+		name    string
+		format  GraphFormat
+		want    string
+		wantErr error
+	}{
+		{
+			name:   "dot format",
+			format: GraphFormatDOT,
+			want: `digraph "namespace/name" {
+  rankdir=LR;
+  n0 [label="typeX/resA"];
+  n1 [label="typeX/resC"];
+  n2 [label="typeY/resB"];
+  n0 -> n1;
+  n0 -> n2;
+  n1 -> n2;
+}
+`,
+		},
+		{
+			name:   "mermaid format",
+			format: GraphFormatMermaid,
+			want: `graph LR
+  n0["typeX/resA"]
+  n1["typeX/resC"]
+  n2["typeY/resB"]
+  n0 --> n1
+  n0 --> n2
+  n1 --> n2
+`,
+		},
+		{
+			name:    "unknown format",
+			format:  GraphFormat("svg"),
+			wantErr: errors.New("unknown graph format: svg"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewDefaultStackGraphRenderer()
+			got, err := r.Render(buildStack(), tt.format)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package deploy
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/acm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/ec2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/plan"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// StackPlanner plans the changes to deploy a resource stack into AWS and K8S, without applying them.
+type StackPlanner interface {
+	// Plan the changes to deploy a resource stack.
+	Plan(ctx context.Context, stack core.Stack) (*plan.Plan, error)
+}
+
+// NewDryRunStackDeployer constructs new dryRunStackDeployer.
+func NewDryRunStackDeployer(cloud aws.Cloud, k8sClient client.Client, networkingSGManager networking.SecurityGroupManager,
+	config config.ControllerConfig, tagPrefix string, logger logr.Logger) *dryRunStackDeployer {
+	trackingProvider := tracking.NewDefaultProvider(tagPrefix, config.ClusterName)
+	ec2TaggingManager := ec2.NewDefaultTaggingManager(cloud.EC2(), networkingSGManager, cloud.VpcID(), logger)
+	elbv2TaggingManager := elbv2.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), config.FeatureGates, logger)
+
+	return &dryRunStackDeployer{
+		cloud:               cloud,
+		k8sClient:           k8sClient,
+		featureGates:        config.FeatureGates,
+		trustStoreS3Bucket:  config.IngressConfig.TrustStoreS3Bucket,
+		externalManagedTags: config.ExternalManagedTags,
+		trackingProvider:    trackingProvider,
+		ec2TaggingManager:   ec2TaggingManager,
+		elbv2TaggingManager: elbv2.NewDryRunTaggingManager(elbv2TaggingManager),
+		acmTaggingManager:   acm.NewDefaultTaggingManager(cloud.ACM(), cloud.RGT(), logger),
+		vpcID:               cloud.VpcID(),
+		logger:              logger,
+	}
+}
+
+var _ StackDeployer = &dryRunStackDeployer{}
+var _ StackPlanner = &dryRunStackDeployer{}
+
+// dryRunStackDeployer runs the same synthesizers as defaultStackDeployer, with resource managers that record the changes
+// into a plan instead of applying them.
+// Addons(WAF, WAFv2 and Shield) are not included in the plan.
+type dryRunStackDeployer struct {
+	cloud               aws.Cloud
+	k8sClient           client.Client
+	featureGates        config.FeatureGates
+	trustStoreS3Bucket  string
+	externalManagedTags []string
+	trackingProvider    tracking.Provider
+	ec2TaggingManager   ec2.TaggingManager
+	elbv2TaggingManager elbv2.TaggingManager
+	acmTaggingManager   acm.TaggingManager
+	vpcID               string
+
+	logger logr.Logger
+}
+
+// Deploy plans the changes to deploy a resource stack, the plan is logged only.
+func (d *dryRunStackDeployer) Deploy(ctx context.Context, stack core.Stack) error {
+	stackPlan, err := d.Plan(ctx, stack)
+	if err != nil {
+		return err
+	}
+	d.logger.Info("planned changes", "stackID", stack.StackID(), "plan", stackPlan)
+	return nil
+}
+
+// Plan the changes to deploy a resource stack.
+func (d *dryRunStackDeployer) Plan(ctx context.Context, stack core.Stack) (*plan.Plan, error) {
+	recorder := plan.NewRecorder()
+	ec2SGManager := ec2.NewDryRunSecurityGroupManager(recorder, d.trackingProvider, d.externalManagedTags)
+	elbv2LBManager := elbv2.NewDryRunLoadBalancerManager(d.cloud.ELBV2(), recorder, d.trackingProvider, d.externalManagedTags, d.logger)
+	elbv2LSManager := elbv2.NewDryRunListenerManager(recorder, d.trackingProvider, d.externalManagedTags, d.featureGates)
+	elbv2LRManager := elbv2.NewDryRunListenerRuleManager(recorder, d.trackingProvider, d.externalManagedTags, d.featureGates)
+	elbv2TGManager := elbv2.NewDryRunTargetGroupManager(d.cloud.ELBV2(), recorder, d.trackingProvider, d.externalManagedTags, d.logger)
+	elbv2TGBManager := elbv2.NewDryRunTargetGroupBindingManager(recorder)
+
+	synthesizers := []ResourceSynthesizer{
+		ec2.NewSecurityGroupSynthesizer(d.cloud.EC2(), d.trackingProvider, d.ec2TaggingManager, ec2SGManager, d.vpcID, d.logger, stack),
+		elbv2.NewTargetGroupSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, elbv2TGManager, d.logger, stack),
+		elbv2.NewLoadBalancerSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, elbv2LBManager, d.logger, stack),
+		elbv2.NewListenerSynthesizer(d.cloud.ELBV2(), d.elbv2TaggingManager, elbv2LSManager, d.logger, stack),
+		elbv2.NewALBTargetSynthesizer(elbv2TGManager, d.logger, stack),
+		elbv2.NewListenerRuleSynthesizer(d.cloud.ELBV2(), d.elbv2TaggingManager, elbv2LRManager, d.featureGates, d.logger, stack),
+		elbv2.NewTargetGroupBindingSynthesizer(d.k8sClient, d.trackingProvider, elbv2TGBManager, d.logger, stack),
+	}
+	if d.featureGates.Enabled(config.ImportTLSSecrets) {
+		acmCertManager := acm.NewDryRunCertificateManager(recorder, d.trackingProvider, d.externalManagedTags)
+		certSynthesizer := acm.NewCertificateSynthesizer(d.trackingProvider, d.acmTaggingManager, acmCertManager, d.logger, stack)
+		synthesizers = append([]ResourceSynthesizer{certSynthesizer}, synthesizers...)
+	}
+	if len(d.trustStoreS3Bucket) != 0 {
+		elbv2TSManager := elbv2.NewDryRunTrustStoreManager(recorder, d.trackingProvider, d.externalManagedTags)
+		tsSynthesizer := elbv2.NewTrustStoreSynthesizer(d.trackingProvider, d.elbv2TaggingManager, elbv2TSManager, d.logger, stack)
+		synthesizers = append([]ResourceSynthesizer{tsSynthesizer}, synthesizers...)
+	}
+	for _, synthesizer := range synthesizers {
+		if err := synthesizer.Synthesize(ctx); err != nil {
+			return nil, err
+		}
+	}
+	for i := len(synthesizers) - 1; i >= 0; i-- {
+		if err := synthesizers[i].PostSynthesize(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return recorder.Plan(stack.StackID().String()), nil
+}
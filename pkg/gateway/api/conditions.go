@@ -0,0 +1,40 @@
+package api
+
+const (
+	// GatewayClass condition types and reasons.
+	GatewayClassConditionAccepted = "Accepted"
+	GatewayClassReasonAccepted    = "Accepted"
+
+	// Gateway condition types and reasons.
+	GatewayConditionAccepted   = "Accepted"
+	GatewayConditionProgrammed = "Programmed"
+	GatewayReasonAccepted      = "Accepted"
+	GatewayReasonProgrammed    = "Programmed"
+	GatewayReasonInvalid       = "Invalid"
+	GatewayReasonPending       = "Pending"
+
+	// Listener condition types and reasons.
+	ListenerConditionAccepted         = "Accepted"
+	ListenerConditionResolvedRefs     = "ResolvedRefs"
+	ListenerConditionProgrammed       = "Programmed"
+	ListenerReasonAccepted            = "Accepted"
+	ListenerReasonResolvedRefs        = "ResolvedRefs"
+	ListenerReasonProgrammed          = "Programmed"
+	ListenerReasonUnsupportedProtocol = "UnsupportedProtocol"
+	ListenerReasonInvalidRouteKinds   = "InvalidRouteKinds"
+	ListenerReasonInvalid             = "Invalid"
+
+	// Route condition types and reasons.
+	RouteConditionAccepted                = "Accepted"
+	RouteConditionResolvedRefs            = "ResolvedRefs"
+	RouteReasonAccepted                   = "Accepted"
+	RouteReasonNotAllowedByListeners      = "NotAllowedByListeners"
+	RouteReasonNoMatchingListenerHostname = "NoMatchingListenerHostname"
+	RouteReasonNoMatchingParent           = "NoMatchingParent"
+	RouteReasonUnsupportedValue           = "UnsupportedValue"
+	RouteReasonRouteConflict              = "RouteConflict"
+	RouteReasonResolvedRefs               = "ResolvedRefs"
+	RouteReasonRefNotPermitted            = "RefNotPermitted"
+	RouteReasonInvalidKind                = "InvalidKind"
+	RouteReasonBackendNotFound            = "BackendNotFound"
+)
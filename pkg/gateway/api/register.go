@@ -0,0 +1,61 @@
+package api
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// GroupName is the API group of Gateway API objects.
+	GroupName = "gateway.networking.k8s.io"
+
+	KindGatewayClass   = "GatewayClass"
+	KindGateway        = "Gateway"
+	KindHTTPRoute      = "HTTPRoute"
+	KindGRPCRoute      = "GRPCRoute"
+	KindTCPRoute       = "TCPRoute"
+	KindUDPRoute       = "UDPRoute"
+	KindTLSRoute       = "TLSRoute"
+	KindReferenceGrant = "ReferenceGrant"
+	KindService        = "Service"
+)
+
+var (
+	GatewayClassGVK   = schema.GroupVersionKind{Group: GroupName, Version: "v1", Kind: KindGatewayClass}
+	GatewayGVK        = schema.GroupVersionKind{Group: GroupName, Version: "v1", Kind: KindGateway}
+	HTTPRouteGVK      = schema.GroupVersionKind{Group: GroupName, Version: "v1", Kind: KindHTTPRoute}
+	GRPCRouteGVK      = schema.GroupVersionKind{Group: GroupName, Version: "v1", Kind: KindGRPCRoute}
+	TCPRouteGVK       = schema.GroupVersionKind{Group: GroupName, Version: "v1alpha2", Kind: KindTCPRoute}
+	UDPRouteGVK       = schema.GroupVersionKind{Group: GroupName, Version: "v1alpha2", Kind: KindUDPRoute}
+	TLSRouteGVK       = schema.GroupVersionKind{Group: GroupName, Version: "v1alpha2", Kind: KindTLSRoute}
+	ReferenceGrantGVK = schema.GroupVersionKind{Group: GroupName, Version: "v1beta1", Kind: KindReferenceGrant}
+)
+
+// NewUnstructured constructs an empty unstructured object of specified GVK.
+func NewUnstructured(gvk schema.GroupVersionKind) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return obj
+}
+
+// NewUnstructuredList constructs an empty unstructured list of specified GVK.
+func NewUnstructuredList(gvk schema.GroupVersionKind) *unstructured.UnstructuredList {
+	objList := &unstructured.UnstructuredList{}
+	objList.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	return objList
+}
+
+// FromUnstructured decodes an unstructured Gateway API object into the typed object.
+func FromUnstructured(u *unstructured.Unstructured, obj interface{}) error {
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), obj); err != nil {
+		return errors.Wrapf(err, "failed to decode %v %v/%v", u.GetKind(), u.GetNamespace(), u.GetName())
+	}
+	return nil
+}
+
+// ToUnstructuredContent encodes a typed value into its unstructured representation.
+func ToUnstructuredContent(obj interface{}) (map[string]interface{}, error) {
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+}
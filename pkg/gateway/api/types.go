@@ -0,0 +1,523 @@
+package api
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The types in this file mirror the subset of the Gateway API (gateway.networking.k8s.io) objects that this controller consumes.
+// Gateway API objects are read and written as unstructured objects and decoded into these types,
+// which keeps the controller independent from the Gateway API client libraries.
+
+// GatewayClass describes a class of Gateways available to the user for creating Gateway resources.
+type GatewayClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GatewayClassSpec   `json:"spec"`
+	Status GatewayClassStatus `json:"status,omitempty"`
+}
+
+// GatewayClassSpec reflects the configuration of a class of Gateways.
+type GatewayClassSpec struct {
+	// ControllerName is the name of the controller that is managing Gateways of this class.
+	ControllerName string `json:"controllerName"`
+}
+
+// GatewayClassStatus is the current status for the GatewayClass.
+type GatewayClassStatus struct {
+	// Conditions is the current status from the controller for this GatewayClass.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// Gateway represents an instance of a service-traffic handling infrastructure by binding Listeners to a set of IP addresses.
+type Gateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GatewaySpec   `json:"spec"`
+	Status GatewayStatus `json:"status,omitempty"`
+}
+
+// GatewaySpec defines the desired state of Gateway.
+type GatewaySpec struct {
+	// GatewayClassName used for this Gateway.
+	GatewayClassName string `json:"gatewayClassName"`
+
+	// Listeners associated with this Gateway.
+	Listeners []Listener `json:"listeners"`
+}
+
+// Listener embodies the concept of a logical endpoint where a Gateway accepts network connections.
+type Listener struct {
+	// Name is the name of the Listener. This name MUST be unique within a Gateway.
+	Name string `json:"name"`
+
+	// Hostname specifies the virtual hostname to match for protocol types that define this concept.
+	Hostname *string `json:"hostname,omitempty"`
+
+	// Port is the network port.
+	Port int32 `json:"port"`
+
+	// Protocol specifies the network protocol this listener expects to receive.
+	Protocol string `json:"protocol"`
+
+	// TLS is the TLS configuration for the Listener.
+	TLS *GatewayTLSConfig `json:"tls,omitempty"`
+
+	// AllowedRoutes defines the types of routes that MAY be attached to a Listener and the trusted namespaces where those Route resources MAY be present.
+	AllowedRoutes *AllowedRoutes `json:"allowedRoutes,omitempty"`
+}
+
+const (
+	ProtocolHTTP  = "HTTP"
+	ProtocolHTTPS = "HTTPS"
+	ProtocolTCP   = "TCP"
+	ProtocolUDP   = "UDP"
+	ProtocolTLS   = "TLS"
+)
+
+// GatewayTLSConfig describes a TLS configuration.
+type GatewayTLSConfig struct {
+	// Mode defines the TLS behavior for the TLS session initiated by the client.
+	Mode *string `json:"mode,omitempty"`
+
+	// Options are a list of key/value pairs to enable extended TLS configuration for each implementation.
+	Options map[string]string `json:"options,omitempty"`
+}
+
+const (
+	TLSModeTerminate   = "Terminate"
+	TLSModePassthrough = "Passthrough"
+)
+
+// AllowedRoutes defines which Routes may be attached to this Listener.
+type AllowedRoutes struct {
+	// Namespaces indicates namespaces from which Routes may be attached to this Listener.
+	Namespaces *RouteNamespaces `json:"namespaces,omitempty"`
+
+	// Kinds specifies the groups and kinds of Routes that are allowed to bind to this Gateway Listener.
+	Kinds []RouteGroupKind `json:"kinds,omitempty"`
+}
+
+const (
+	NamespacesFromAll      = "All"
+	NamespacesFromSame     = "Same"
+	NamespacesFromSelector = "Selector"
+)
+
+// RouteNamespaces indicate which namespaces Routes should be selected from.
+type RouteNamespaces struct {
+	// From indicates where Routes will be selected for this Gateway.
+	From *string `json:"from,omitempty"`
+
+	// Selector must be specified when From is set to "Selector".
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// RouteGroupKind indicates the group and kind of a Route resource.
+type RouteGroupKind struct {
+	// Group is the group of the Route.
+	Group *string `json:"group,omitempty"`
+
+	// Kind is the kind of the Route.
+	Kind string `json:"kind"`
+}
+
+// GatewayStatus defines the observed state of Gateway.
+type GatewayStatus struct {
+	// Addresses lists the network addresses that have been bound to the Gateway.
+	Addresses []GatewayStatusAddress `json:"addresses,omitempty"`
+
+	// Conditions describe the current conditions of the Gateway.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Listeners provide status for each unique listener port defined in the Spec.
+	Listeners []ListenerStatus `json:"listeners,omitempty"`
+}
+
+const (
+	AddressTypeHostname = "Hostname"
+)
+
+// GatewayStatusAddress describes a network address that is bound to a Gateway.
+type GatewayStatusAddress struct {
+	// Type of the address.
+	Type *string `json:"type,omitempty"`
+
+	// Value of the address.
+	Value string `json:"value"`
+}
+
+// ListenerStatus is the status associated with a Listener.
+type ListenerStatus struct {
+	// Name is the name of the Listener that this status corresponds to.
+	Name string `json:"name"`
+
+	// SupportedKinds is the list indicating the Kinds supported by this listener.
+	SupportedKinds []RouteGroupKind `json:"supportedKinds"`
+
+	// AttachedRoutes represents the total number of Routes that have been successfully attached to this Listener.
+	AttachedRoutes int32 `json:"attachedRoutes"`
+
+	// Conditions describe the current condition of this listener.
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+// ParentReference identifies an API object (usually a Gateway) that can be considered a parent of this resource.
+type ParentReference struct {
+	// Group is the group of the referent.
+	Group *string `json:"group,omitempty"`
+
+	// Kind is kind of the referent.
+	Kind *string `json:"kind,omitempty"`
+
+	// Namespace is the namespace of the referent.
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Name is the name of the referent.
+	Name string `json:"name"`
+
+	// SectionName is the name of a section within the target resource.
+	SectionName *string `json:"sectionName,omitempty"`
+
+	// Port is the network port this Route targets.
+	Port *int32 `json:"port,omitempty"`
+}
+
+// BackendRef defines how a Route should forward a request to a Kubernetes resource.
+type BackendRef struct {
+	// Group is the group of the referent.
+	Group *string `json:"group,omitempty"`
+
+	// Kind is the Kubernetes resource kind of the referent.
+	Kind *string `json:"kind,omitempty"`
+
+	// Name is the name of the referent.
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the backend.
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Port specifies the destination port number to use for this resource.
+	Port *int32 `json:"port,omitempty"`
+
+	// Weight specifies the proportion of requests forwarded to the referenced backend.
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// RouteStatus defines the common attributes that all Routes MUST include within their status.
+type RouteStatus struct {
+	// Parents is a list of parent resources (usually Gateways) that are associated with the route, and the status of the route with respect to each parent.
+	Parents []RouteParentStatus `json:"parents"`
+}
+
+// RouteParentStatus describes the status of a route with respect to an associated Parent.
+type RouteParentStatus struct {
+	// ParentRef corresponds with a ParentRef in the spec that this RouteParentStatus struct describes the status of.
+	ParentRef ParentReference `json:"parentRef"`
+
+	// ControllerName is a domain/path string that indicates the name of the controller that wrote this status.
+	ControllerName string `json:"controllerName"`
+
+	// Conditions describes the status of the route with respect to the Gateway.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// HTTPRoute provides a way to route HTTP requests.
+type HTTPRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HTTPRouteSpec `json:"spec"`
+	Status RouteStatus   `json:"status,omitempty"`
+}
+
+// HTTPRouteSpec defines the desired state of HTTPRoute.
+type HTTPRouteSpec struct {
+	// ParentRefs references the resources (usually Gateways) that a Route wants to be attached to.
+	ParentRefs []ParentReference `json:"parentRefs,omitempty"`
+
+	// Hostnames defines a set of hostnames that should match against the HTTP Host header.
+	Hostnames []string `json:"hostnames,omitempty"`
+
+	// Rules are a list of HTTP matchers, filters and actions.
+	Rules []HTTPRouteRule `json:"rules,omitempty"`
+}
+
+// HTTPRouteRule defines semantics for matching an HTTP request based on conditions (matches), processing it (filters), and forwarding the request to an API object (backendRefs).
+type HTTPRouteRule struct {
+	// Matches define conditions used for matching the rule against incoming HTTP requests.
+	Matches []HTTPRouteMatch `json:"matches,omitempty"`
+
+	// Filters define the filters that are applied to requests that match this rule.
+	Filters []HTTPRouteFilter `json:"filters,omitempty"`
+
+	// BackendRefs defines the backend(s) where matching requests should be sent.
+	BackendRefs []BackendRef `json:"backendRefs,omitempty"`
+}
+
+const (
+	PathMatchExact             = "Exact"
+	PathMatchPathPrefix        = "PathPrefix"
+	PathMatchRegularExpression = "RegularExpression"
+
+	HeaderMatchExact             = "Exact"
+	HeaderMatchRegularExpression = "RegularExpression"
+
+	QueryParamMatchExact             = "Exact"
+	QueryParamMatchRegularExpression = "RegularExpression"
+)
+
+// HTTPRouteMatch defines the predicate used to match requests to a given action.
+type HTTPRouteMatch struct {
+	// Path specifies a HTTP request path matcher.
+	Path *HTTPPathMatch `json:"path,omitempty"`
+
+	// Headers specifies HTTP request header matchers.
+	Headers []HTTPHeaderMatch `json:"headers,omitempty"`
+
+	// QueryParams specifies HTTP query parameter matchers.
+	QueryParams []HTTPQueryParamMatch `json:"queryParams,omitempty"`
+
+	// Method specifies HTTP method matcher.
+	Method *string `json:"method,omitempty"`
+}
+
+// HTTPPathMatch describes how to select a HTTP route by matching the HTTP request path.
+type HTTPPathMatch struct {
+	// Type specifies how to match against the path Value.
+	Type *string `json:"type,omitempty"`
+
+	// Value of the HTTP path to match against.
+	Value *string `json:"value,omitempty"`
+}
+
+// HTTPHeaderMatch describes how to select a HTTP route by matching HTTP request headers.
+type HTTPHeaderMatch struct {
+	// Type specifies how to match against the value of the header.
+	Type *string `json:"type,omitempty"`
+
+	// Name is the name of the HTTP Header to be matched.
+	Name string `json:"name"`
+
+	// Value is the value of HTTP Header to be matched.
+	Value string `json:"value"`
+}
+
+// HTTPQueryParamMatch describes how to select a HTTP route by matching HTTP query parameters.
+type HTTPQueryParamMatch struct {
+	// Type specifies how to match against the value of the query parameter.
+	Type *string `json:"type,omitempty"`
+
+	// Name is the name of the HTTP query param to be matched.
+	Name string `json:"name"`
+
+	// Value is the value of HTTP query param to be matched.
+	Value string `json:"value"`
+}
+
+const (
+	HTTPRouteFilterRequestRedirect = "RequestRedirect"
+
+	FullPathHTTPPathModifier    = "ReplaceFullPath"
+	PrefixMatchHTTPPathModifier = "ReplacePrefixMatch"
+)
+
+// HTTPRouteFilter defines processing steps that must be completed during the request or response lifecycle.
+type HTTPRouteFilter struct {
+	// Type identifies the type of filter to apply.
+	Type string `json:"type"`
+
+	// RequestRedirect defines a schema for a filter that responds to the request with an HTTP redirection.
+	RequestRedirect *HTTPRequestRedirectFilter `json:"requestRedirect,omitempty"`
+}
+
+// HTTPRequestRedirectFilter defines a filter that redirects a request.
+type HTTPRequestRedirectFilter struct {
+	// Scheme is the scheme to be used in the value of the `Location` header in the response.
+	Scheme *string `json:"scheme,omitempty"`
+
+	// Hostname is the hostname to be used in the value of the `Location` header in the response.
+	Hostname *string `json:"hostname,omitempty"`
+
+	// Path defines parameters used to modify the path of the incoming request.
+	Path *HTTPPathModifier `json:"path,omitempty"`
+
+	// Port is the port to be used in the value of the `Location` header in the response.
+	Port *int32 `json:"port,omitempty"`
+
+	// StatusCode is the HTTP status code to be used in response.
+	StatusCode *int `json:"statusCode,omitempty"`
+}
+
+// HTTPPathModifier defines configuration for path modifiers.
+type HTTPPathModifier struct {
+	// Type defines the type of path modifier.
+	Type string `json:"type"`
+
+	// ReplaceFullPath specifies the value with which to replace the full path of a request during a rewrite or redirect.
+	ReplaceFullPath *string `json:"replaceFullPath,omitempty"`
+
+	// ReplacePrefixMatch specifies the value with which to replace the prefix match of a request during a rewrite or redirect.
+	ReplacePrefixMatch *string `json:"replacePrefixMatch,omitempty"`
+}
+
+// GRPCRoute provides a way to route gRPC requests.
+type GRPCRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GRPCRouteSpec `json:"spec"`
+	Status RouteStatus   `json:"status,omitempty"`
+}
+
+// GRPCRouteSpec defines the desired state of GRPCRoute.
+type GRPCRouteSpec struct {
+	// ParentRefs references the resources (usually Gateways) that a Route wants to be attached to.
+	ParentRefs []ParentReference `json:"parentRefs,omitempty"`
+
+	// Hostnames defines a set of hostnames to match against the GRPC Host header to select a GRPCRoute to process the request.
+	Hostnames []string `json:"hostnames,omitempty"`
+
+	// Rules are a list of GRPC matchers, filters and actions.
+	Rules []GRPCRouteRule `json:"rules,omitempty"`
+}
+
+// GRPCRouteRule defines the semantics for matching a gRPC request based on conditions (matches), processing it (filters), and forwarding the request to an API object (backendRefs).
+type GRPCRouteRule struct {
+	// Matches define conditions used for matching the rule against incoming gRPC requests.
+	Matches []GRPCRouteMatch `json:"matches,omitempty"`
+
+	// BackendRefs defines the backend(s) where matching requests should be sent.
+	BackendRefs []BackendRef `json:"backendRefs,omitempty"`
+}
+
+const (
+	GRPCMethodMatchExact             = "Exact"
+	GRPCMethodMatchRegularExpression = "RegularExpression"
+)
+
+// GRPCRouteMatch defines the predicate used to match requests to a given action.
+type GRPCRouteMatch struct {
+	// Method specifies a gRPC request service/method matcher.
+	Method *GRPCMethodMatch `json:"method,omitempty"`
+
+	// Headers specifies gRPC request header matchers.
+	Headers []HTTPHeaderMatch `json:"headers,omitempty"`
+}
+
+// GRPCMethodMatch describes how to select a gRPC route by matching the gRPC request service and/or method.
+type GRPCMethodMatch struct {
+	// Type specifies how to match against the service and/or method.
+	Type *string `json:"type,omitempty"`
+
+	// Value of the service to match against.
+	Service *string `json:"service,omitempty"`
+
+	// Value of the method to match against.
+	Method *string `json:"method,omitempty"`
+}
+
+// TCPRoute provides a way to route TCP requests.
+type TCPRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TCPRouteSpec `json:"spec"`
+	Status RouteStatus  `json:"status,omitempty"`
+}
+
+// TCPRouteSpec defines the desired state of TCPRoute.
+type TCPRouteSpec struct {
+	// ParentRefs references the resources (usually Gateways) that a Route wants to be attached to.
+	ParentRefs []ParentReference `json:"parentRefs,omitempty"`
+
+	// Rules are a list of TCP matchers and actions.
+	Rules []L4RouteRule `json:"rules,omitempty"`
+}
+
+// UDPRoute provides a way to route UDP traffic.
+type UDPRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UDPRouteSpec `json:"spec"`
+	Status RouteStatus  `json:"status,omitempty"`
+}
+
+// UDPRouteSpec defines the desired state of UDPRoute.
+type UDPRouteSpec struct {
+	// ParentRefs references the resources (usually Gateways) that a Route wants to be attached to.
+	ParentRefs []ParentReference `json:"parentRefs,omitempty"`
+
+	// Rules are a list of UDP matchers and actions.
+	Rules []L4RouteRule `json:"rules,omitempty"`
+}
+
+// TLSRoute provides a way to route TLS requests based on the SNI.
+type TLSRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TLSRouteSpec `json:"spec"`
+	Status RouteStatus  `json:"status,omitempty"`
+}
+
+// TLSRouteSpec defines the desired state of TLSRoute.
+type TLSRouteSpec struct {
+	// ParentRefs references the resources (usually Gateways) that a Route wants to be attached to.
+	ParentRefs []ParentReference `json:"parentRefs,omitempty"`
+
+	// Hostnames defines a set of SNI names that should match against the SNI attribute of TLS ClientHello message in TLS handshake.
+	Hostnames []string `json:"hostnames,omitempty"`
+
+	// Rules are a list of TLS matchers and actions.
+	Rules []L4RouteRule `json:"rules,omitempty"`
+}
+
+// L4RouteRule is the configuration for a given TCPRoute, UDPRoute or TLSRoute rule.
+type L4RouteRule struct {
+	// BackendRefs defines the backend(s) where matching requests should be sent.
+	BackendRefs []BackendRef `json:"backendRefs,omitempty"`
+}
+
+// ReferenceGrant identifies kinds of resources in other namespaces that are trusted to reference the specified kinds of resources in the same namespace as the policy.
+type ReferenceGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ReferenceGrantSpec `json:"spec"`
+}
+
+// ReferenceGrantSpec identifies a cross namespace relationship that is trusted for Gateway API.
+type ReferenceGrantSpec struct {
+	// From describes the trusted namespaces and kinds that can reference the resources described in "To".
+	From []ReferenceGrantFrom `json:"from"`
+
+	// To describes the resources that may be referenced by the resources described in "From".
+	To []ReferenceGrantTo `json:"to"`
+}
+
+// ReferenceGrantFrom describes trusted namespaces and kinds.
+type ReferenceGrantFrom struct {
+	// Group is the group of the referent.
+	Group string `json:"group"`
+
+	// Kind is the kind of the referent.
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the referent.
+	Namespace string `json:"namespace"`
+}
+
+// ReferenceGrantTo describes what Kinds are allowed as targets of the references.
+type ReferenceGrantTo struct {
+	// Group is the group of the referent.
+	Group string `json:"group"`
+
+	// Kind is the kind of the referent.
+	Kind string `json:"kind"`
+
+	// Name is the name of the referent. When unspecified, this policy refers to all resources of the specified Group and Kind in the local namespace.
+	Name *string `json:"name,omitempty"`
+}
@@ -0,0 +1,109 @@
+package gateway
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+)
+
+const (
+	// ControllerNameALB is the GatewayClass controllerName for Gateways implemented with Application LoadBalancers.
+	ControllerNameALB = "gateway.k8s.aws/alb"
+)
+
+// Gateway is a Gateway together with its GatewayClass and the routes attached to it.
+type Gateway struct {
+	// Gateway is the decoded Gateway object.
+	Gateway *gwapi.Gateway
+	// Object is the raw Gateway object.
+	Object *unstructured.Unstructured
+	// Class is the GatewayClass of the Gateway.
+	Class *gwapi.GatewayClass
+
+	// Listeners contains the evaluation result of each Gateway listener, in spec order.
+	Listeners []ListenerResult
+	// Routes contains the routes attached to at least one accepted listener.
+	Routes []AttachedRoute
+	// RouteParentResults contains the evaluation result of each route parentRef that targets the Gateway.
+	RouteParentResults []RouteParentResult
+	// StaleRoutes contains routes that carry status for the Gateway but don't reference it anymore.
+	StaleRoutes []*Route
+}
+
+// Key returns the namespaced name of the Gateway.
+func (g *Gateway) Key() types.NamespacedName {
+	return types.NamespacedName{Namespace: g.Gateway.Namespace, Name: g.Gateway.Name}
+}
+
+// AcceptedListeners returns the accepted listeners of the Gateway.
+func (g *Gateway) AcceptedListeners() []gwapi.Listener {
+	var listeners []gwapi.Listener
+	for _, result := range g.Listeners {
+		if result.Accepted {
+			listeners = append(listeners, result.Listener)
+		}
+	}
+	return listeners
+}
+
+// ListenerResult is the evaluation result of a Gateway listener.
+type ListenerResult struct {
+	// Listener is the Gateway listener.
+	Listener gwapi.Listener
+	// SupportedKinds are the route kinds that can attach to the listener.
+	SupportedKinds []gwapi.RouteGroupKind
+	// AttachedRoutes is the number of routes attached to the listener.
+	AttachedRoutes int32
+
+	// Accepted is whether the listener is valid and will be programmed.
+	Accepted bool
+	// ResolvedRefs is false if the listener references unsupported route kinds.
+	ResolvedRefs bool
+	// Reason for a negative Accepted or ResolvedRefs.
+	Reason string
+	// Message for a negative Accepted or ResolvedRefs.
+	Message string
+}
+
+// AttachedRoute is a route attached to a Gateway.
+type AttachedRoute struct {
+	// Route is the attached route.
+	Route *Route
+	// Listeners are the Gateway listeners the route is attached to.
+	Listeners []gwapi.Listener
+}
+
+// RouteParentResult is the evaluation result of a route parentRef.
+type RouteParentResult struct {
+	// Route that contains the parentRef.
+	Route *Route
+	// ParentRef is the evaluated parentRef.
+	ParentRef gwapi.ParentReference
+
+	// Accepted is whether the route is attached via this parentRef.
+	Accepted bool
+	// AcceptedReason is the reason for the Accepted condition.
+	AcceptedReason string
+	// AcceptedMessage is the message for the Accepted condition.
+	AcceptedMessage string
+
+	// ResolvedRefs is whether all backendRefs of the route are resolved.
+	ResolvedRefs bool
+	// ResolvedRefsReason is the reason for the ResolvedRefs condition.
+	ResolvedRefsReason string
+	// ResolvedRefsMessage is the message for the ResolvedRefs condition.
+	ResolvedRefsMessage string
+
+	// listenerIdxes are the index of Gateway listeners the route is attached to via this parentRef.
+	listenerIdxes []int
+}
+
+// attachedToListener checks whether the route is attached to the Gateway listener with listenerName.
+func (r *AttachedRoute) attachedToListener(listenerName string) bool {
+	for _, listener := range r.Listeners {
+		if listener.Name == listenerName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package gateway
+
+import (
+	"strings"
+)
+
+// intersectHostname computes the intersection between a listener hostname and a route hostname.
+// Both hostnames may contain a leading wildcard label. It returns the most specific hostname that matches both, or false if they don't intersect.
+func intersectHostname(listenerHostname string, routeHostname string) (string, bool) {
+	if listenerHostname == routeHostname {
+		return listenerHostname, true
+	}
+	if hostnameMatchesWildcard(routeHostname, listenerHostname) {
+		return routeHostname, true
+	}
+	if hostnameMatchesWildcard(listenerHostname, routeHostname) {
+		return listenerHostname, true
+	}
+	return "", false
+}
+
+// hostnameMatchesWildcard checks whether hostname is matched by the wildcard hostname pattern.
+// Per Gateway API, a wildcard label matches one or more DNS labels.
+func hostnameMatchesWildcard(hostname string, pattern string) bool {
+	if !strings.HasPrefix(pattern, "*.") {
+		return false
+	}
+	suffix := pattern[1:]
+	if strings.HasPrefix(hostname, "*.") {
+		return strings.HasSuffix(hostname[1:], suffix) && len(hostname) > len(pattern)
+	}
+	return strings.HasSuffix(hostname, suffix) && len(hostname) > len(suffix)
+}
+
+// computeEffectiveHostnames computes the hostnames a route serves via a listener.
+// matchAll will be true if neither the listener nor the route restricts hostnames.
+func computeEffectiveHostnames(listenerHostname *string, routeHostnames []string) (hostnames []string, matchAll bool) {
+	if listenerHostname == nil || len(*listenerHostname) == 0 {
+		if len(routeHostnames) == 0 {
+			return nil, true
+		}
+		return routeHostnames, false
+	}
+	if len(routeHostnames) == 0 {
+		return []string{*listenerHostname}, false
+	}
+	for _, routeHostname := range routeHostnames {
+		if hostname, ok := intersectHostname(*listenerHostname, routeHostname); ok {
+			hostnames = append(hostnames, hostname)
+		}
+	}
+	return hostnames, false
+}
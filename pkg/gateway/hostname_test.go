@@ -0,0 +1,110 @@
+package gateway
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_intersectHostname(t *testing.T) {
+	tests := []struct {
+		name             string
+		listenerHostname string
+		routeHostname    string
+		want             string
+		wantOK           bool
+	}{
+		{
+			name:             "identical hostnames",
+			listenerHostname: "foo.example.com",
+			routeHostname:    "foo.example.com",
+			want:             "foo.example.com",
+			wantOK:           true,
+		},
+		{
+			name:             "route hostname matched by listener wildcard",
+			listenerHostname: "*.example.com",
+			routeHostname:    "foo.example.com",
+			want:             "foo.example.com",
+			wantOK:           true,
+		},
+		{
+			name:             "listener hostname matched by route wildcard",
+			listenerHostname: "foo.bar.example.com",
+			routeHostname:    "*.example.com",
+			want:             "foo.bar.example.com",
+			wantOK:           true,
+		},
+		{
+			name:             "narrower route wildcard",
+			listenerHostname: "*.example.com",
+			routeHostname:    "*.foo.example.com",
+			want:             "*.foo.example.com",
+			wantOK:           true,
+		},
+		{
+			name:             "wildcard doesn't match the apex domain",
+			listenerHostname: "*.example.com",
+			routeHostname:    "example.com",
+			wantOK:           false,
+		},
+		{
+			name:             "unrelated hostnames",
+			listenerHostname: "foo.example.com",
+			routeHostname:    "bar.example.com",
+			wantOK:           false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotOK := intersectHostname(tt.listenerHostname, tt.routeHostname)
+			assert.Equal(t, tt.wantOK, gotOK)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_computeEffectiveHostnames(t *testing.T) {
+	tests := []struct {
+		name             string
+		listenerHostname *string
+		routeHostnames   []string
+		wantHostnames    []string
+		wantMatchAll     bool
+	}{
+		{
+			name:         "no hostnames",
+			wantMatchAll: true,
+		},
+		{
+			name:             "empty listener hostname",
+			listenerHostname: awssdk.String(""),
+			routeHostnames:   []string{"foo.example.com"},
+			wantHostnames:    []string{"foo.example.com"},
+		},
+		{
+			name:             "listener hostname only",
+			listenerHostname: awssdk.String("*.example.com"),
+			wantHostnames:    []string{"*.example.com"},
+		},
+		{
+			name:             "intersecting hostnames",
+			listenerHostname: awssdk.String("*.example.com"),
+			routeHostnames:   []string{"foo.example.com", "foo.example.org", "*.bar.example.com"},
+			wantHostnames:    []string{"foo.example.com", "*.bar.example.com"},
+		},
+		{
+			name:             "no intersecting hostnames",
+			listenerHostname: awssdk.String("*.example.com"),
+			routeHostnames:   []string{"foo.example.org"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotHostnames, gotMatchAll := computeEffectiveHostnames(tt.listenerHostname, tt.routeHostnames)
+			assert.Equal(t, tt.wantHostnames, gotHostnames)
+			assert.Equal(t, tt.wantMatchAll, gotMatchAll)
+		})
+	}
+}
@@ -0,0 +1,578 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GatewayLoader loads the routes attached to a Gateway.
+type GatewayLoader interface {
+	// Load evaluates the listeners of a Gateway and loads the routes attached to it.
+	Load(ctx context.Context, gwObj *unstructured.Unstructured, gwClass *gwapi.GatewayClass) (Gateway, error)
+}
+
+// NewDefaultGatewayLoader constructs new GatewayLoader for Application LoadBalancer backed Gateways.
+func NewDefaultGatewayLoader(k8sClient client.Client, logger logr.Logger) *defaultGatewayLoader {
+	return &defaultGatewayLoader{
+		k8sClient: k8sClient,
+		routeGVKs: []schema.GroupVersionKind{gwapi.HTTPRouteGVK, gwapi.GRPCRouteGVK},
+		supportedKindsByProtocol: map[string][]string{
+			gwapi.ProtocolHTTP:  {gwapi.KindHTTPRoute},
+			gwapi.ProtocolHTTPS: {gwapi.KindHTTPRoute, gwapi.KindGRPCRoute},
+		},
+		allowedTLSModes: []string{gwapi.TLSModeTerminate},
+		logger:          logger,
+	}
+}
+
+// NewNLBGatewayLoader constructs new GatewayLoader for Network LoadBalancer backed Gateways.
+func NewNLBGatewayLoader(k8sClient client.Client, logger logr.Logger) *defaultGatewayLoader {
+	return &defaultGatewayLoader{
+		k8sClient: k8sClient,
+		routeGVKs: []schema.GroupVersionKind{gwapi.TCPRouteGVK, gwapi.UDPRouteGVK, gwapi.TLSRouteGVK},
+		supportedKindsByProtocol: map[string][]string{
+			gwapi.ProtocolTCP: {gwapi.KindTCPRoute},
+			gwapi.ProtocolUDP: {gwapi.KindUDPRoute},
+			gwapi.ProtocolTLS: {gwapi.KindTLSRoute, gwapi.KindTCPRoute},
+		},
+		allowedTLSModes:    []string{gwapi.TLSModeTerminate, gwapi.TLSModePassthrough},
+		exclusivePortRoute: true,
+		logger:             logger,
+	}
+}
+
+var _ GatewayLoader = &defaultGatewayLoader{}
+
+// default implementation for GatewayLoader
+type defaultGatewayLoader struct {
+	k8sClient client.Client
+	// routeGVKs are the route kinds to load.
+	routeGVKs []schema.GroupVersionKind
+	// supportedKindsByProtocol are the route kinds supported by each listener protocol.
+	supportedKindsByProtocol map[string][]string
+	// allowedTLSModes are the supported listener TLS modes.
+	allowedTLSModes []string
+	// exclusivePortRoute is whether a single route serves each listener port, i.e. routes cannot share a port.
+	exclusivePortRoute bool
+	logger             logr.Logger
+}
+
+func (l *defaultGatewayLoader) Load(ctx context.Context, gwObj *unstructured.Unstructured, gwClass *gwapi.GatewayClass) (Gateway, error) {
+	gw := &gwapi.Gateway{}
+	if err := gwapi.FromUnstructured(gwObj, gw); err != nil {
+		return Gateway{}, err
+	}
+	result := Gateway{
+		Gateway:   gw,
+		Object:    gwObj,
+		Class:     gwClass,
+		Listeners: l.evaluateListeners(gw.Spec.Listeners),
+	}
+
+	routes, err := l.listRoutes(ctx)
+	if err != nil {
+		return Gateway{}, err
+	}
+	nsLabelsCache := make(map[string]labels.Set)
+	for _, route := range routes {
+		if err := l.attachRoute(ctx, &result, route, nsLabelsCache); err != nil {
+			return Gateway{}, err
+		}
+	}
+	if l.exclusivePortRoute {
+		detachConflictingRoutes(&result)
+	}
+	return result, nil
+}
+
+// evaluateListeners validates the Gateway listeners.
+func (l *defaultGatewayLoader) evaluateListeners(listeners []gwapi.Listener) []ListenerResult {
+	protocolByPort := make(map[int32]string)
+	for _, listener := range listeners {
+		if _, ok := l.supportedKindsByProtocol[listener.Protocol]; !ok {
+			continue
+		}
+		if _, exists := protocolByPort[listener.Port]; !exists {
+			protocolByPort[listener.Port] = listener.Protocol
+		}
+	}
+
+	results := make([]ListenerResult, 0, len(listeners))
+	for _, listener := range listeners {
+		result := ListenerResult{
+			Listener:     listener,
+			Accepted:     true,
+			ResolvedRefs: true,
+		}
+		supportedKinds, ok := l.supportedKindsByProtocol[listener.Protocol]
+		if !ok {
+			result.Accepted = false
+			result.Reason = gwapi.ListenerReasonUnsupportedProtocol
+			result.Message = fmt.Sprintf("unsupported protocol: %v", listener.Protocol)
+			results = append(results, result)
+			continue
+		}
+		if protocolByPort[listener.Port] != listener.Protocol {
+			result.Accepted = false
+			result.Reason = gwapi.ListenerReasonInvalid
+			result.Message = fmt.Sprintf("conflicting protocol on port %v: %v | %v", listener.Port, protocolByPort[listener.Port], listener.Protocol)
+			results = append(results, result)
+			continue
+		}
+		if listener.TLS != nil && listener.TLS.Mode != nil && !containsString(l.allowedTLSModes, *listener.TLS.Mode) {
+			result.Accepted = false
+			result.Reason = gwapi.ListenerReasonInvalid
+			result.Message = fmt.Sprintf("unsupported TLS mode: %v", *listener.TLS.Mode)
+			results = append(results, result)
+			continue
+		}
+
+		allowedKinds := supportedKinds
+		if listener.AllowedRoutes != nil && len(listener.AllowedRoutes.Kinds) != 0 {
+			allowedKinds = nil
+			var invalidKinds []string
+			for _, kind := range listener.AllowedRoutes.Kinds {
+				if (kind.Group == nil || *kind.Group == gwapi.GroupName) && containsString(supportedKinds, kind.Kind) {
+					allowedKinds = append(allowedKinds, kind.Kind)
+				} else {
+					invalidKinds = append(invalidKinds, kind.Kind)
+				}
+			}
+			if len(invalidKinds) != 0 {
+				result.ResolvedRefs = false
+				result.Reason = gwapi.ListenerReasonInvalidRouteKinds
+				result.Message = fmt.Sprintf("unsupported route kinds: %v", strings.Join(invalidKinds, ","))
+			}
+		}
+		for _, kind := range allowedKinds {
+			group := gwapi.GroupName
+			result.SupportedKinds = append(result.SupportedKinds, gwapi.RouteGroupKind{Group: &group, Kind: kind})
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// listRoutes lists all routes of supported kinds.
+// Route kinds whose CRDs are not installed are ignored.
+func (l *defaultGatewayLoader) listRoutes(ctx context.Context) ([]*unstructured.Unstructured, error) {
+	var routes []*unstructured.Unstructured
+	for _, gvk := range l.routeGVKs {
+		routeList := gwapi.NewUnstructuredList(gvk)
+		if err := l.k8sClient.List(ctx, routeList); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to list %v", gvk.Kind)
+		}
+		for i := range routeList.Items {
+			route := &routeList.Items[i]
+			route.SetGroupVersionKind(gvk)
+			routes = append(routes, route)
+		}
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].GetKind() != routes[j].GetKind() {
+			return routes[i].GetKind() < routes[j].GetKind()
+		}
+		if routes[i].GetNamespace() != routes[j].GetNamespace() {
+			return routes[i].GetNamespace() < routes[j].GetNamespace()
+		}
+		return routes[i].GetName() < routes[j].GetName()
+	})
+	return routes, nil
+}
+
+// attachRoute evaluates each parentRef of route that targets the Gateway, and attaches the route to the Gateway if accepted.
+func (l *defaultGatewayLoader) attachRoute(ctx context.Context, gw *Gateway, routeObj *unstructured.Unstructured, nsLabelsCache map[string]labels.Set) error {
+	route, buildErr := NewRouteFromUnstructured(routeObj)
+	if buildErr != nil && route.Kind == "" {
+		l.logger.Error(buildErr, "failed to decode route", "kind", routeObj.GetKind(), "route", types.NamespacedName{Namespace: routeObj.GetNamespace(), Name: routeObj.GetName()})
+		return nil
+	}
+
+	var parentRefs []gwapi.ParentReference
+	for _, parentRef := range route.ParentRefs {
+		if parentRefTargetsGateway(parentRef, route.ObjectMeta.Namespace, gw.Key()) {
+			parentRefs = append(parentRefs, parentRef)
+		}
+	}
+	if len(parentRefs) == 0 {
+		if hasParentStatusForGateway(route.Status, gw.Class.Spec.ControllerName, route.ObjectMeta.Namespace, gw.Key()) {
+			gw.StaleRoutes = append(gw.StaleRoutes, &route)
+		}
+		return nil
+	}
+	if !route.ObjectMeta.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	nsLabels, err := l.loadNamespaceLabels(ctx, route.ObjectMeta.Namespace, nsLabelsCache)
+	if err != nil {
+		return err
+	}
+	attachedListenerIdxes := make(map[int]struct{})
+	var parentResults []RouteParentResult
+	for _, parentRef := range parentRefs {
+		parentResult := RouteParentResult{
+			Route:     &route,
+			ParentRef: parentRef,
+		}
+		listenerIdxes, reason, message := l.matchListeners(gw, &route, parentRef, nsLabels)
+		switch {
+		case len(listenerIdxes) == 0:
+			parentResult.AcceptedReason = reason
+			parentResult.AcceptedMessage = message
+		case buildErr != nil:
+			parentResult.AcceptedReason = gwapi.RouteReasonUnsupportedValue
+			parentResult.AcceptedMessage = buildErr.Error()
+		default:
+			parentResult.Accepted = true
+			parentResult.AcceptedReason = gwapi.RouteReasonAccepted
+			parentResult.AcceptedMessage = "Route is accepted"
+			parentResult.listenerIdxes = listenerIdxes
+			for _, idx := range listenerIdxes {
+				attachedListenerIdxes[idx] = struct{}{}
+			}
+		}
+		parentResults = append(parentResults, parentResult)
+	}
+
+	resolvedRefs, resolvedRefsReason, resolvedRefsMessage := true, gwapi.RouteReasonResolvedRefs, "All references are resolved"
+	if len(attachedListenerIdxes) != 0 {
+		var err error
+		resolvedRefs, resolvedRefsReason, resolvedRefsMessage, err = l.resolveRouteBackends(ctx, &route)
+		if err != nil {
+			return err
+		}
+		attachedRoute := AttachedRoute{Route: &route}
+		for idx := range gw.Listeners {
+			if _, ok := attachedListenerIdxes[idx]; ok {
+				gw.Listeners[idx].AttachedRoutes++
+				attachedRoute.Listeners = append(attachedRoute.Listeners, gw.Listeners[idx].Listener)
+			}
+		}
+		gw.Routes = append(gw.Routes, attachedRoute)
+	}
+	for i := range parentResults {
+		parentResults[i].ResolvedRefs = resolvedRefs
+		parentResults[i].ResolvedRefsReason = resolvedRefsReason
+		parentResults[i].ResolvedRefsMessage = resolvedRefsMessage
+	}
+	gw.RouteParentResults = append(gw.RouteParentResults, parentResults...)
+	return nil
+}
+
+// detachConflictingRoutes detaches routes from listener ports that are claimed by an older route.
+// the oldest route attached to any listener on a port wins the port, the other routes are not accepted via parentRefs
+// that only attach them to lost ports.
+func detachConflictingRoutes(gw *Gateway) {
+	attachedRoutesByAge := append([]AttachedRoute(nil), gw.Routes...)
+	sort.SliceStable(attachedRoutesByAge, func(i, j int) bool {
+		return isOlderRoute(attachedRoutesByAge[i].Route, attachedRoutesByAge[j].Route)
+	})
+	winnerByPort := make(map[int32]*Route)
+	for _, attachedRoute := range attachedRoutesByAge {
+		for _, listener := range attachedRoute.Listeners {
+			if _, exists := winnerByPort[listener.Port]; !exists {
+				winnerByPort[listener.Port] = attachedRoute.Route
+			}
+		}
+	}
+
+	var attachedRoutes []AttachedRoute
+	for _, attachedRoute := range gw.Routes {
+		var listeners []gwapi.Listener
+		for _, listener := range attachedRoute.Listeners {
+			if winnerByPort[listener.Port] == attachedRoute.Route {
+				listeners = append(listeners, listener)
+				continue
+			}
+			for idx := range gw.Listeners {
+				if gw.Listeners[idx].Listener.Name == listener.Name {
+					gw.Listeners[idx].AttachedRoutes--
+				}
+			}
+		}
+		if len(listeners) != 0 {
+			attachedRoutes = append(attachedRoutes, AttachedRoute{Route: attachedRoute.Route, Listeners: listeners})
+		}
+	}
+	gw.Routes = attachedRoutes
+
+	for i := range gw.RouteParentResults {
+		parentResult := &gw.RouteParentResults[i]
+		if !parentResult.Accepted {
+			continue
+		}
+		var conflictingRoute *Route
+		var conflictingPort int32
+		won := false
+		for _, idx := range parentResult.listenerIdxes {
+			port := gw.Listeners[idx].Listener.Port
+			if winnerByPort[port] == parentResult.Route {
+				won = true
+				break
+			}
+			if conflictingRoute == nil {
+				conflictingRoute, conflictingPort = winnerByPort[port], port
+			}
+		}
+		if won || conflictingRoute == nil {
+			continue
+		}
+		parentResult.Accepted = false
+		parentResult.AcceptedReason = gwapi.RouteReasonRouteConflict
+		parentResult.AcceptedMessage = fmt.Sprintf("port %v is claimed by older route %v", conflictingPort, conflictingRoute)
+	}
+}
+
+// isOlderRoute checks whether route lhs is older than route rhs, routes with same age are ordered by kind and namespaced name.
+func isOlderRoute(lhs *Route, rhs *Route) bool {
+	if !lhs.ObjectMeta.CreationTimestamp.Equal(&rhs.ObjectMeta.CreationTimestamp) {
+		return lhs.ObjectMeta.CreationTimestamp.Before(&rhs.ObjectMeta.CreationTimestamp)
+	}
+	return lhs.String() < rhs.String()
+}
+
+// matchListeners returns the index of Gateway listeners that the route can attach to via parentRef.
+// if none matches, the reason and message will be returned.
+func (l *defaultGatewayLoader) matchListeners(gw *Gateway, route *Route, parentRef gwapi.ParentReference, nsLabels labels.Set) ([]int, string, string) {
+	var listenerIdxes []int
+	parentMatched, kindAllowed := false, false
+	for idx, result := range gw.Listeners {
+		if !result.Accepted {
+			continue
+		}
+		listener := result.Listener
+		if parentRef.SectionName != nil && *parentRef.SectionName != listener.Name {
+			continue
+		}
+		if parentRef.Port != nil && *parentRef.Port != listener.Port {
+			continue
+		}
+		parentMatched = true
+		if !listenerAllowsRoute(result, gw.Gateway.Namespace, route, nsLabels) {
+			continue
+		}
+		kindAllowed = true
+		if hostnames, matchAll := computeEffectiveHostnames(listener.Hostname, route.Hostnames); !matchAll && len(hostnames) == 0 {
+			continue
+		}
+		listenerIdxes = append(listenerIdxes, idx)
+	}
+	switch {
+	case len(listenerIdxes) != 0:
+		return listenerIdxes, "", ""
+	case !parentMatched:
+		return nil, gwapi.RouteReasonNoMatchingParent, "No listener matches the parentRef"
+	case !kindAllowed:
+		return nil, gwapi.RouteReasonNotAllowedByListeners, "Route is not allowed by listeners"
+	default:
+		return nil, gwapi.RouteReasonNoMatchingListenerHostname, "No listener hostname matches the route hostnames"
+	}
+}
+
+// resolveRouteBackends resolves the backendRefs of route.
+// unresolvable backendRefs are excluded, and reported via the returned reason and message.
+func (l *defaultGatewayLoader) resolveRouteBackends(ctx context.Context, route *Route) (bool, string, string, error) {
+	resolvedRefs, reason, message := true, gwapi.RouteReasonResolvedRefs, "All references are resolved"
+	setUnresolved := func(unresolvedReason string, unresolvedMessage string) {
+		if resolvedRefs {
+			resolvedRefs, reason, message = false, unresolvedReason, unresolvedMessage
+		}
+	}
+
+	for ruleIdx := range route.Rules {
+		rule := &route.Rules[ruleIdx]
+		rule.Backends = nil
+		for _, backendRef := range rule.BackendRefs {
+			if (backendRef.Group != nil && *backendRef.Group != "") || (backendRef.Kind != nil && *backendRef.Kind != gwapi.KindService) {
+				setUnresolved(gwapi.RouteReasonInvalidKind, fmt.Sprintf("unsupported backend kind: %v", awssdk.StringValue(backendRef.Kind)))
+				continue
+			}
+			svcKey := types.NamespacedName{Namespace: route.ObjectMeta.Namespace, Name: backendRef.Name}
+			if backendRef.Namespace != nil && *backendRef.Namespace != route.ObjectMeta.Namespace {
+				svcKey.Namespace = *backendRef.Namespace
+				permitted, err := l.isBackendRefPermitted(ctx, route, svcKey)
+				if err != nil {
+					return false, "", "", err
+				}
+				if !permitted {
+					setUnresolved(gwapi.RouteReasonRefNotPermitted, fmt.Sprintf("reference to service %v not permitted by any ReferenceGrant", svcKey))
+					continue
+				}
+			}
+			if backendRef.Port == nil {
+				setUnresolved(gwapi.RouteReasonBackendNotFound, fmt.Sprintf("port must be specified for service %v", svcKey))
+				continue
+			}
+			svc := &corev1.Service{}
+			if err := l.k8sClient.Get(ctx, svcKey, svc); err != nil {
+				if apierrors.IsNotFound(err) {
+					setUnresolved(gwapi.RouteReasonBackendNotFound, fmt.Sprintf("service not found: %v", svcKey))
+					continue
+				}
+				return false, "", "", err
+			}
+			weight := int64(1)
+			if backendRef.Weight != nil {
+				weight = int64(*backendRef.Weight)
+			}
+			rule.Backends = append(rule.Backends, RouteBackend{
+				Service: svc,
+				Port:    intstr.FromInt(int(*backendRef.Port)),
+				Weight:  weight,
+			})
+		}
+	}
+	return resolvedRefs, reason, message, nil
+}
+
+// isBackendRefPermitted checks whether a ReferenceGrant in the service's namespace permits the route to reference the service.
+func (l *defaultGatewayLoader) isBackendRefPermitted(ctx context.Context, route *Route, svcKey types.NamespacedName) (bool, error) {
+	refGrantList := gwapi.NewUnstructuredList(gwapi.ReferenceGrantGVK)
+	if err := l.k8sClient.List(ctx, refGrantList, client.InNamespace(svcKey.Namespace)); err != nil {
+		if meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "failed to list ReferenceGrants")
+	}
+	for i := range refGrantList.Items {
+		refGrant := &gwapi.ReferenceGrant{}
+		if err := gwapi.FromUnstructured(&refGrantList.Items[i], refGrant); err != nil {
+			l.logger.Error(err, "ignoring invalid ReferenceGrant")
+			continue
+		}
+		if referenceGrantPermits(refGrant, route.Kind, route.ObjectMeta.Namespace, svcKey.Name) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (l *defaultGatewayLoader) loadNamespaceLabels(ctx context.Context, namespace string, nsLabelsCache map[string]labels.Set) (labels.Set, error) {
+	if nsLabels, ok := nsLabelsCache[namespace]; ok {
+		return nsLabels, nil
+	}
+	ns := &corev1.Namespace{}
+	if err := l.k8sClient.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	nsLabels := labels.Set(ns.Labels)
+	nsLabelsCache[namespace] = nsLabels
+	return nsLabels, nil
+}
+
+// referenceGrantPermits checks whether refGrant permits a route of routeKind in routeNamespace to reference service with svcName.
+func referenceGrantPermits(refGrant *gwapi.ReferenceGrant, routeKind string, routeNamespace string, svcName string) bool {
+	fromMatched := false
+	for _, from := range refGrant.Spec.From {
+		if from.Group == gwapi.GroupName && from.Kind == routeKind && from.Namespace == routeNamespace {
+			fromMatched = true
+			break
+		}
+	}
+	if !fromMatched {
+		return false
+	}
+	for _, to := range refGrant.Spec.To {
+		if to.Group == "" && to.Kind == gwapi.KindService && (to.Name == nil || *to.Name == svcName) {
+			return true
+		}
+	}
+	return false
+}
+
+// listenerAllowsRoute checks whether the listener's allowedRoutes allows route to attach.
+func listenerAllowsRoute(result ListenerResult, gwNamespace string, route *Route, nsLabels labels.Set) bool {
+	kindAllowed := false
+	for _, kind := range result.SupportedKinds {
+		if kind.Kind == route.Kind {
+			kindAllowed = true
+			break
+		}
+	}
+	if !kindAllowed {
+		return false
+	}
+
+	from := gwapi.NamespacesFromSame
+	var selector *metav1.LabelSelector
+	if result.Listener.AllowedRoutes != nil && result.Listener.AllowedRoutes.Namespaces != nil {
+		if result.Listener.AllowedRoutes.Namespaces.From != nil {
+			from = *result.Listener.AllowedRoutes.Namespaces.From
+		}
+		selector = result.Listener.AllowedRoutes.Namespaces.Selector
+	}
+	switch from {
+	case gwapi.NamespacesFromAll:
+		return true
+	case gwapi.NamespacesFromSame:
+		return route.ObjectMeta.Namespace == gwNamespace
+	case gwapi.NamespacesFromSelector:
+		if selector == nil {
+			return false
+		}
+		nsSelector, err := metav1.LabelSelectorAsSelector(selector)
+		if err != nil {
+			return false
+		}
+		return nsSelector.Matches(nsLabels)
+	default:
+		return false
+	}
+}
+
+// parentRefTargetsGateway checks whether parentRef of a route in routeNamespace refers to the Gateway.
+func parentRefTargetsGateway(parentRef gwapi.ParentReference, routeNamespace string, gwKey types.NamespacedName) bool {
+	if parentRef.Group != nil && *parentRef.Group != gwapi.GroupName {
+		return false
+	}
+	if parentRef.Kind != nil && *parentRef.Kind != gwapi.KindGateway {
+		return false
+	}
+	namespace := routeNamespace
+	if parentRef.Namespace != nil {
+		namespace = *parentRef.Namespace
+	}
+	return namespace == gwKey.Namespace && parentRef.Name == gwKey.Name
+}
+
+// hasParentStatusForGateway checks whether route status contains a parent status written by controllerName for the Gateway.
+func hasParentStatusForGateway(status gwapi.RouteStatus, controllerName string, routeNamespace string, gwKey types.NamespacedName) bool {
+	for _, parentStatus := range status.Parents {
+		if parentStatus.ControllerName == controllerName && parentRefTargetsGateway(parentStatus.ParentRef, routeNamespace, gwKey) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
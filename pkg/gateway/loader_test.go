@@ -0,0 +1,364 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultGatewayLoader_evaluateListeners(t *testing.T) {
+	httpRouteGroup := gwapi.GroupName
+	passthrough := gwapi.TLSModePassthrough
+	tests := []struct {
+		name      string
+		listeners []gwapi.Listener
+		want      []ListenerResult
+	}{
+		{
+			name: "valid listeners",
+			listeners: []gwapi.Listener{
+				{Name: "http", Port: 80, Protocol: gwapi.ProtocolHTTP},
+				{Name: "https", Port: 443, Protocol: gwapi.ProtocolHTTPS},
+			},
+			want: []ListenerResult{
+				{
+					Listener:       gwapi.Listener{Name: "http", Port: 80, Protocol: gwapi.ProtocolHTTP},
+					SupportedKinds: []gwapi.RouteGroupKind{{Group: &httpRouteGroup, Kind: gwapi.KindHTTPRoute}},
+					Accepted:       true,
+					ResolvedRefs:   true,
+				},
+				{
+					Listener: gwapi.Listener{Name: "https", Port: 443, Protocol: gwapi.ProtocolHTTPS},
+					SupportedKinds: []gwapi.RouteGroupKind{
+						{Group: &httpRouteGroup, Kind: gwapi.KindHTTPRoute},
+						{Group: &httpRouteGroup, Kind: gwapi.KindGRPCRoute},
+					},
+					Accepted:     true,
+					ResolvedRefs: true,
+				},
+			},
+		},
+		{
+			name: "invalid listeners",
+			listeners: []gwapi.Listener{
+				{Name: "tcp", Port: 80, Protocol: "TCP"},
+				{Name: "http", Port: 80, Protocol: gwapi.ProtocolHTTP},
+				{Name: "https", Port: 80, Protocol: gwapi.ProtocolHTTPS},
+				{Name: "tls", Port: 443, Protocol: gwapi.ProtocolHTTPS, TLS: &gwapi.GatewayTLSConfig{Mode: &passthrough}},
+				{Name: "kinds", Port: 8080, Protocol: gwapi.ProtocolHTTP, AllowedRoutes: &gwapi.AllowedRoutes{
+					Kinds: []gwapi.RouteGroupKind{{Kind: gwapi.KindHTTPRoute}, {Kind: "TCPRoute"}},
+				}},
+			},
+			want: []ListenerResult{
+				{
+					Listener:     gwapi.Listener{Name: "tcp", Port: 80, Protocol: "TCP"},
+					ResolvedRefs: true,
+					Reason:       gwapi.ListenerReasonUnsupportedProtocol,
+					Message:      "unsupported protocol: TCP",
+				},
+				{
+					Listener:       gwapi.Listener{Name: "http", Port: 80, Protocol: gwapi.ProtocolHTTP},
+					SupportedKinds: []gwapi.RouteGroupKind{{Group: &httpRouteGroup, Kind: gwapi.KindHTTPRoute}},
+					Accepted:       true,
+					ResolvedRefs:   true,
+				},
+				{
+					Listener:     gwapi.Listener{Name: "https", Port: 80, Protocol: gwapi.ProtocolHTTPS},
+					ResolvedRefs: true,
+					Reason:       gwapi.ListenerReasonInvalid,
+					Message:      "conflicting protocol on port 80: HTTP | HTTPS",
+				},
+				{
+					Listener:     gwapi.Listener{Name: "tls", Port: 443, Protocol: gwapi.ProtocolHTTPS, TLS: &gwapi.GatewayTLSConfig{Mode: &passthrough}},
+					ResolvedRefs: true,
+					Reason:       gwapi.ListenerReasonInvalid,
+					Message:      "unsupported TLS mode: Passthrough",
+				},
+				{
+					Listener: gwapi.Listener{Name: "kinds", Port: 8080, Protocol: gwapi.ProtocolHTTP, AllowedRoutes: &gwapi.AllowedRoutes{
+						Kinds: []gwapi.RouteGroupKind{{Kind: gwapi.KindHTTPRoute}, {Kind: "TCPRoute"}},
+					}},
+					SupportedKinds: []gwapi.RouteGroupKind{{Group: &httpRouteGroup, Kind: gwapi.KindHTTPRoute}},
+					Accepted:       true,
+					Reason:         gwapi.ListenerReasonInvalidRouteKinds,
+					Message:        "unsupported route kinds: TCPRoute",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewDefaultGatewayLoader(nil, &log.NullLogger{})
+			got := l.evaluateListeners(tt.listeners)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_NLBGatewayLoader_evaluateListeners(t *testing.T) {
+	routeGroup := gwapi.GroupName
+	passthrough := gwapi.TLSModePassthrough
+	listeners := []gwapi.Listener{
+		{Name: "tcp", Port: 5432, Protocol: gwapi.ProtocolTCP},
+		{Name: "udp", Port: 53, Protocol: gwapi.ProtocolUDP},
+		{Name: "tls", Port: 443, Protocol: gwapi.ProtocolTLS, TLS: &gwapi.GatewayTLSConfig{Mode: &passthrough}},
+		{Name: "http", Port: 80, Protocol: gwapi.ProtocolHTTP},
+		{Name: "udp-conflict", Port: 5432, Protocol: gwapi.ProtocolUDP},
+	}
+	want := []ListenerResult{
+		{
+			Listener:       listeners[0],
+			SupportedKinds: []gwapi.RouteGroupKind{{Group: &routeGroup, Kind: gwapi.KindTCPRoute}},
+			Accepted:       true,
+			ResolvedRefs:   true,
+		},
+		{
+			Listener:       listeners[1],
+			SupportedKinds: []gwapi.RouteGroupKind{{Group: &routeGroup, Kind: gwapi.KindUDPRoute}},
+			Accepted:       true,
+			ResolvedRefs:   true,
+		},
+		{
+			Listener: listeners[2],
+			SupportedKinds: []gwapi.RouteGroupKind{
+				{Group: &routeGroup, Kind: gwapi.KindTLSRoute},
+				{Group: &routeGroup, Kind: gwapi.KindTCPRoute},
+			},
+			Accepted:     true,
+			ResolvedRefs: true,
+		},
+		{
+			Listener:     listeners[3],
+			ResolvedRefs: true,
+			Reason:       gwapi.ListenerReasonUnsupportedProtocol,
+			Message:      "unsupported protocol: HTTP",
+		},
+		{
+			Listener:     listeners[4],
+			ResolvedRefs: true,
+			Reason:       gwapi.ListenerReasonInvalid,
+			Message:      "conflicting protocol on port 5432: TCP | UDP",
+		},
+	}
+	l := NewNLBGatewayLoader(nil, &log.NullLogger{})
+	assert.Equal(t, want, l.evaluateListeners(listeners))
+}
+
+func Test_defaultGatewayLoader_Load(t *testing.T) {
+	gwClass := &gwapi.GatewayClass{
+		Spec: gwapi.GatewayClassSpec{ControllerName: ControllerNameALB},
+	}
+	gw := &gwapi.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "gw"},
+		Spec: gwapi.GatewaySpec{
+			GatewayClassName: "alb",
+			Listeners: []gwapi.Listener{
+				{
+					Name:     "http",
+					Port:     80,
+					Protocol: gwapi.ProtocolHTTP,
+					Hostname: awssdk.String("*.example.com"),
+					AllowedRoutes: &gwapi.AllowedRoutes{
+						Namespaces: &gwapi.RouteNamespaces{From: awssdk.String(gwapi.NamespacesFromAll)},
+					},
+				},
+			},
+		},
+	}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "svc"},
+	}
+	otherSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "svc"},
+	}
+	gwParentRef := gwapi.ParentReference{Name: "gw", Namespace: awssdk.String("infra")}
+	port := int32(80)
+	routes := []*gwapi.HTTPRoute{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "accepted"},
+			Spec: gwapi.HTTPRouteSpec{
+				ParentRefs: []gwapi.ParentReference{gwParentRef},
+				Hostnames:  []string{"foo.example.com"},
+				Rules: []gwapi.HTTPRouteRule{
+					{
+						BackendRefs: []gwapi.BackendRef{
+							{Name: "svc", Port: &port},
+							{Name: "svc", Namespace: awssdk.String("other"), Port: &port},
+						},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "hostname-mismatch"},
+			Spec: gwapi.HTTPRouteSpec{
+				ParentRefs: []gwapi.ParentReference{gwParentRef},
+				Hostnames:  []string{"foo.example.org"},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "unsupported"},
+			Spec: gwapi.HTTPRouteSpec{
+				ParentRefs: []gwapi.ParentReference{gwParentRef},
+				Rules: []gwapi.HTTPRouteRule{
+					{
+						Matches: []gwapi.HTTPRouteMatch{
+							{Path: &gwapi.HTTPPathMatch{Type: awssdk.String(gwapi.PathMatchRegularExpression), Value: awssdk.String("/.*")}},
+						},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "stale"},
+			Status: gwapi.RouteStatus{
+				Parents: []gwapi.RouteParentStatus{
+					{ParentRef: gwParentRef, ControllerName: ControllerNameALB},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "other-gateway"},
+			Spec: gwapi.HTTPRouteSpec{
+				ParentRefs: []gwapi.ParentReference{{Name: "gw"}},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	k8sClient := testclient.NewClientBuilder().WithScheme(newTestScheme()).Build()
+	assert.NoError(t, k8sClient.Create(ctx, svc.DeepCopy()))
+	assert.NoError(t, k8sClient.Create(ctx, otherSvc.DeepCopy()))
+	for _, route := range routes {
+		assert.NoError(t, k8sClient.Create(ctx, newTestUnstructured(t, gwapi.HTTPRouteGVK, route)))
+	}
+
+	l := NewDefaultGatewayLoader(k8sClient, &log.NullLogger{})
+	got, err := l.Load(ctx, newTestUnstructured(t, gwapi.GatewayGVK, gw), gwClass)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int32(1), got.Listeners[0].AttachedRoutes)
+	assert.Len(t, got.Routes, 1)
+	assert.Equal(t, "accepted", got.Routes[0].Route.ObjectMeta.Name)
+	backends := got.Routes[0].Route.Rules[0].Backends
+	assert.Len(t, backends, 1)
+	assert.Equal(t, "app", backends[0].Service.Namespace)
+	assert.Equal(t, int64(1), backends[0].Weight)
+
+	type parentResult struct {
+		route              string
+		accepted           bool
+		acceptedReason     string
+		resolvedRefsReason string
+	}
+	var gotParentResults []parentResult
+	for _, result := range got.RouteParentResults {
+		gotParentResults = append(gotParentResults, parentResult{
+			route:              result.Route.ObjectMeta.Name,
+			accepted:           result.Accepted,
+			acceptedReason:     result.AcceptedReason,
+			resolvedRefsReason: result.ResolvedRefsReason,
+		})
+	}
+	assert.Equal(t, []parentResult{
+		{route: "accepted", accepted: true, acceptedReason: gwapi.RouteReasonAccepted, resolvedRefsReason: gwapi.RouteReasonRefNotPermitted},
+		{route: "hostname-mismatch", acceptedReason: gwapi.RouteReasonNoMatchingListenerHostname, resolvedRefsReason: gwapi.RouteReasonResolvedRefs},
+		{route: "unsupported", acceptedReason: gwapi.RouteReasonUnsupportedValue, resolvedRefsReason: gwapi.RouteReasonResolvedRefs},
+	}, gotParentResults)
+	assert.Len(t, got.StaleRoutes, 1)
+	assert.Equal(t, "stale", got.StaleRoutes[0].ObjectMeta.Name)
+
+	// permitting the cross namespace reference resolves the backend.
+	refGrant := &gwapi.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "grant"},
+		Spec: gwapi.ReferenceGrantSpec{
+			From: []gwapi.ReferenceGrantFrom{{Group: gwapi.GroupName, Kind: gwapi.KindHTTPRoute, Namespace: "app"}},
+			To:   []gwapi.ReferenceGrantTo{{Kind: gwapi.KindService}},
+		},
+	}
+	assert.NoError(t, k8sClient.Create(ctx, newTestUnstructured(t, gwapi.ReferenceGrantGVK, refGrant)))
+	got, err = l.Load(ctx, newTestUnstructured(t, gwapi.GatewayGVK, gw), gwClass)
+	assert.NoError(t, err)
+	assert.Len(t, got.Routes[0].Route.Rules[0].Backends, 2)
+	assert.Equal(t, gwapi.RouteReasonResolvedRefs, got.RouteParentResults[0].ResolvedRefsReason)
+}
+
+// newTestScheme returns a scheme that registers Gateway API kinds as unstructured objects.
+func newTestScheme() *runtime.Scheme {
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	for _, gvk := range []schema.GroupVersionKind{gwapi.GatewayGVK, gwapi.HTTPRouteGVK, gwapi.GRPCRouteGVK, gwapi.ReferenceGrantGVK} {
+		k8sSchema.AddKnownTypeWithName(gvk, &unstructured.Unstructured{})
+		k8sSchema.AddKnownTypeWithName(gvk.GroupVersion().WithKind(gvk.Kind+"List"), &unstructured.UnstructuredList{})
+	}
+	return k8sSchema
+}
+
+func newTestUnstructured(t *testing.T, gvk schema.GroupVersionKind, obj interface{}) *unstructured.Unstructured {
+	content, err := gwapi.ToUnstructuredContent(obj)
+	assert.NoError(t, err)
+	u := &unstructured.Unstructured{Object: content}
+	u.SetGroupVersionKind(gvk)
+	return u
+}
+
+func Test_detachConflictingRoutes(t *testing.T) {
+	tcpListener := gwapi.Listener{Name: "tcp", Port: 80, Protocol: gwapi.ProtocolTCP}
+	tlsListener := gwapi.Listener{Name: "tls", Port: 443, Protocol: gwapi.ProtocolTLS}
+	newRoute := func(name string, creationTime time.Time) *Route {
+		return &Route{
+			Kind: gwapi.KindTCPRoute,
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "app",
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(creationTime),
+			},
+		}
+	}
+	now := time.Now()
+	oldest := newRoute("oldest", now.Add(-2*time.Hour))
+	multiPort := newRoute("multi-port", now.Add(-1*time.Hour))
+	newest := newRoute("newest", now)
+	gw := Gateway{
+		Listeners: []ListenerResult{
+			{Listener: tcpListener, Accepted: true, AttachedRoutes: 3},
+			{Listener: tlsListener, Accepted: true, AttachedRoutes: 1},
+		},
+		Routes: []AttachedRoute{
+			{Route: newest, Listeners: []gwapi.Listener{tcpListener}},
+			{Route: multiPort, Listeners: []gwapi.Listener{tcpListener, tlsListener}},
+			{Route: oldest, Listeners: []gwapi.Listener{tcpListener}},
+		},
+		RouteParentResults: []RouteParentResult{
+			{Route: newest, Accepted: true, AcceptedReason: gwapi.RouteReasonAccepted, listenerIdxes: []int{0}},
+			{Route: multiPort, Accepted: true, AcceptedReason: gwapi.RouteReasonAccepted, listenerIdxes: []int{0, 1}},
+			{Route: oldest, Accepted: true, AcceptedReason: gwapi.RouteReasonAccepted, listenerIdxes: []int{0}},
+		},
+	}
+
+	detachConflictingRoutes(&gw)
+
+	assert.Equal(t, int32(1), gw.Listeners[0].AttachedRoutes)
+	assert.Equal(t, int32(1), gw.Listeners[1].AttachedRoutes)
+	assert.Equal(t, []AttachedRoute{
+		{Route: multiPort, Listeners: []gwapi.Listener{tlsListener}},
+		{Route: oldest, Listeners: []gwapi.Listener{tcpListener}},
+	}, gw.Routes)
+	assert.False(t, gw.RouteParentResults[0].Accepted)
+	assert.Equal(t, gwapi.RouteReasonRouteConflict, gw.RouteParentResults[0].AcceptedReason)
+	assert.Equal(t, "port 80 is claimed by older route TCPRoute app/oldest", gw.RouteParentResults[0].AcceptedMessage)
+	assert.True(t, gw.RouteParentResults[1].Accepted)
+	assert.True(t, gw.RouteParentResults[2].Accepted)
+}
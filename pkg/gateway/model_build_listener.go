@@ -0,0 +1,145 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certs"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	// TLSOptionCertificateARN is the listener TLS option that specifies comma separated ACM certificate ARNs.
+	TLSOptionCertificateARN = "gateway.k8s.aws/certificate-arn"
+	// TLSOptionSSLPolicy is the listener TLS option that specifies the SSL policy.
+	TLSOptionSSLPolicy = "gateway.k8s.aws/ssl-policy"
+)
+
+func (t *defaultModelBuildTask) buildListener(ctx context.Context, lbARN core.StringToken, port int64, listeners []gwapi.Listener) (*elbv2model.Listener, error) {
+	lsSpec, err := t.buildListenerSpec(ctx, lbARN, port, listeners)
+	if err != nil {
+		return nil, err
+	}
+	lsResID := fmt.Sprintf("%v", port)
+	ls := elbv2model.NewListener(t.stack, lsResID, lsSpec)
+	return ls, nil
+}
+
+func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, lbARN core.StringToken, port int64, listeners []gwapi.Listener) (elbv2model.ListenerSpec, error) {
+	tags, err := t.buildGatewayResourceTags(ctx)
+	if err != nil {
+		return elbv2model.ListenerSpec{}, err
+	}
+	lsSpec := elbv2model.ListenerSpec{
+		LoadBalancerARN: lbARN,
+		Port:            port,
+		Protocol:        elbv2model.Protocol(listeners[0].Protocol),
+		DefaultActions:  []elbv2model.Action{elbv2model.NewFixedResponseAction("404")},
+		Tags:            tags,
+	}
+	if lsSpec.Protocol == elbv2model.ProtocolHTTPS {
+		certARNs, err := buildListenerCertificateARNs(ctx, t.certDiscovery, t.gw, port, listeners)
+		if err != nil {
+			return elbv2model.ListenerSpec{}, err
+		}
+		for _, certARN := range certARNs {
+			lsSpec.Certificates = append(lsSpec.Certificates, elbv2model.Certificate{
+				CertificateARN: core.LiteralStringToken(certARN),
+			})
+		}
+		sslPolicy, err := t.buildListenerSSLPolicy(ctx, port, listeners)
+		if err != nil {
+			return elbv2model.ListenerSpec{}, err
+		}
+		lsSpec.SSLPolicy = sslPolicy
+	}
+	return lsSpec, nil
+}
+
+// buildListenerCertificateARNs computes the certificates for a HTTPS or TLS listener port.
+// the first certificate will be used as default certificate.
+// certificates are specified explicitly via TLS options, or discovered from ACM by listener and route hostnames.
+func buildListenerCertificateARNs(ctx context.Context, certDiscovery certs.CertDiscovery, gw Gateway, port int64, listeners []gwapi.Listener) ([]string, error) {
+	var certARNs []string
+	explicitCertARNs := sets.NewString()
+	for _, listener := range listeners {
+		if listener.TLS == nil {
+			continue
+		}
+		rawCertARNs, exists := listener.TLS.Options[TLSOptionCertificateARN]
+		if !exists {
+			continue
+		}
+		for _, certARN := range strings.Split(rawCertARNs, ",") {
+			certARN = strings.TrimSpace(certARN)
+			if len(certARN) == 0 || explicitCertARNs.Has(certARN) {
+				continue
+			}
+			explicitCertARNs.Insert(certARN)
+			certARNs = append(certARNs, certARN)
+		}
+	}
+	if len(certARNs) != 0 {
+		return certARNs, nil
+	}
+
+	hosts := sets.NewString()
+	for _, listener := range listeners {
+		if listener.Hostname != nil && len(*listener.Hostname) != 0 {
+			hosts.Insert(*listener.Hostname)
+		}
+		for _, attachedRoute := range gw.Routes {
+			if !attachedRoute.attachedToListener(listener.Name) {
+				continue
+			}
+			hostnames, _ := computeEffectiveHostnames(listener.Hostname, attachedRoute.Route.Hostnames)
+			hosts.Insert(hostnames...)
+		}
+	}
+	if len(hosts) == 0 {
+		return nil, errors.Errorf("no certificate specified or discoverable for %v listener port %v", listeners[0].Protocol, port)
+	}
+	selections, err := certDiscovery.Discover(ctx, hosts.List(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return certs.CertSelectionARNs(selections), nil
+}
+
+func (t *defaultModelBuildTask) buildListenerSSLPolicy(_ context.Context, port int64, listeners []gwapi.Listener) (*string, error) {
+	sslPolicy, err := buildExplicitListenerSSLPolicy(port, listeners)
+	if err != nil {
+		return nil, err
+	}
+	if sslPolicy == nil {
+		return awssdk.String(t.defaultSSLPolicy), nil
+	}
+	return sslPolicy, nil
+}
+
+// buildExplicitListenerSSLPolicy computes the SSL policy specified via TLS options for a listener port, nil is returned if none is specified.
+func buildExplicitListenerSSLPolicy(port int64, listeners []gwapi.Listener) (*string, error) {
+	explicitSSLPolicies := sets.NewString()
+	for _, listener := range listeners {
+		if listener.TLS == nil {
+			continue
+		}
+		if sslPolicy, exists := listener.TLS.Options[TLSOptionSSLPolicy]; exists {
+			explicitSSLPolicies.Insert(sslPolicy)
+		}
+	}
+	if len(explicitSSLPolicies) == 0 {
+		return nil, nil
+	}
+	if len(explicitSSLPolicies) > 1 {
+		return nil, errors.Errorf("conflicting sslPolicy on listener port %v: %v", port, explicitSSLPolicies.List())
+	}
+	sslPolicy, _ := explicitSSLPolicies.PopAny()
+	return &sslPolicy, nil
+}
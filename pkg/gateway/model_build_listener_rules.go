@@ -0,0 +1,292 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// hostname specificity of a rule, more specific rules are evaluated first.
+const (
+	hostnameRankExact = iota
+	hostnameRankWildcard
+	hostnameRankAny
+)
+
+// ruleCandidate is a single route match that will be translated into a listener rule.
+type ruleCandidate struct {
+	route     *Route
+	rule      *RouteRule
+	ruleIdx   int
+	matchIdx  int
+	match     RouteMatch
+	hostnames []string
+}
+
+func (c *ruleCandidate) hostnameRank() int {
+	if len(c.hostnames) == 0 {
+		return hostnameRankAny
+	}
+	for _, hostname := range c.hostnames {
+		if strings.HasPrefix(hostname, "*.") {
+			return hostnameRankWildcard
+		}
+	}
+	return hostnameRankExact
+}
+
+func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN core.StringToken, port int64, listeners []gwapi.Listener) error {
+	candidates := t.buildRuleCandidates(listeners)
+	sortRuleCandidates(candidates)
+
+	tags, err := t.buildGatewayResourceTags(ctx)
+	if err != nil {
+		return err
+	}
+	priority := int64(0)
+	for _, candidate := range candidates {
+		conditions := buildRuleConditions(candidate.match, candidate.hostnames)
+		actions, err := t.buildRuleActions(ctx, candidate)
+		if err != nil {
+			return errors.Wrapf(err, "%v", candidate.route)
+		}
+		// routes with many hostnames are split into adjacent rules to stay within the condition values limits of ALB.
+		for _, ruleConditions := range elbv2model.SplitRuleConditions(conditions) {
+			priority++
+			lrResID := fmt.Sprintf("%v:%v", port, priority)
+			_ = elbv2model.NewListenerRule(t.stack, lrResID, elbv2model.ListenerRuleSpec{
+				ListenerARN: lsARN,
+				Priority:    priority,
+				Conditions:  ruleConditions,
+				Actions:     actions,
+				Tags:        tags,
+			})
+		}
+	}
+	return nil
+}
+
+// buildRuleCandidates flattens the matches of routes attached to listeners into rule candidates.
+func (t *defaultModelBuildTask) buildRuleCandidates(listeners []gwapi.Listener) []ruleCandidate {
+	var candidates []ruleCandidate
+	for _, attachedRoute := range t.gw.Routes {
+		hostnames, matchAll, attached := computeRouteHostnamesForListeners(attachedRoute, listeners)
+		if !attached {
+			continue
+		}
+		if matchAll {
+			hostnames = nil
+		}
+		route := attachedRoute.Route
+		for ruleIdx := range route.Rules {
+			rule := &route.Rules[ruleIdx]
+			matches := rule.Matches
+			if len(matches) == 0 {
+				matches = []RouteMatch{{PathType: gwapi.PathMatchPathPrefix, Path: "/"}}
+			}
+			for matchIdx, match := range matches {
+				candidates = append(candidates, ruleCandidate{
+					route:     route,
+					rule:      rule,
+					ruleIdx:   ruleIdx,
+					matchIdx:  matchIdx,
+					match:     match,
+					hostnames: hostnames,
+				})
+			}
+		}
+	}
+	return candidates
+}
+
+// computeRouteHostnamesForListeners computes the hostnames a route serves via the listeners it attached to among listeners.
+func computeRouteHostnamesForListeners(attachedRoute AttachedRoute, listeners []gwapi.Listener) ([]string, bool, bool) {
+	hostnames := sets.NewString()
+	attached, matchAll := false, false
+	for _, listener := range listeners {
+		if !attachedRoute.attachedToListener(listener.Name) {
+			continue
+		}
+		attached = true
+		listenerHostnames, listenerMatchAll := computeEffectiveHostnames(listener.Hostname, attachedRoute.Route.Hostnames)
+		if listenerMatchAll {
+			matchAll = true
+		}
+		hostnames.Insert(listenerHostnames...)
+	}
+	return hostnames.List(), matchAll, attached
+}
+
+// sortRuleCandidates sorts rule candidates by Gateway API precedence:
+//  1. more specific hostnames
+//  2. exact path matches over prefix matches
+//  3. longer paths
+//  4. method matches
+//  5. larger number of header matches
+//  6. larger number of query param matches
+//  7. oldest route, then route namespace/name
+//  8. rule and match order within a route
+func sortRuleCandidates(candidates []ruleCandidate) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		ci, cj := &candidates[i], &candidates[j]
+		if ci.hostnameRank() != cj.hostnameRank() {
+			return ci.hostnameRank() < cj.hostnameRank()
+		}
+		iExact, jExact := ci.match.PathType == gwapi.PathMatchExact, cj.match.PathType == gwapi.PathMatchExact
+		if iExact != jExact {
+			return iExact
+		}
+		if len(ci.match.Path) != len(cj.match.Path) {
+			return len(ci.match.Path) > len(cj.match.Path)
+		}
+		iMethod, jMethod := ci.match.Method != nil, cj.match.Method != nil
+		if iMethod != jMethod {
+			return iMethod
+		}
+		if len(ci.match.Headers) != len(cj.match.Headers) {
+			return len(ci.match.Headers) > len(cj.match.Headers)
+		}
+		if len(ci.match.QueryParams) != len(cj.match.QueryParams) {
+			return len(ci.match.QueryParams) > len(cj.match.QueryParams)
+		}
+		if ci.route != cj.route {
+			iCreation, jCreation := ci.route.ObjectMeta.CreationTimestamp, cj.route.ObjectMeta.CreationTimestamp
+			if !iCreation.Equal(&jCreation) {
+				return iCreation.Before(&jCreation)
+			}
+			if ci.route.ObjectMeta.Namespace != cj.route.ObjectMeta.Namespace {
+				return ci.route.ObjectMeta.Namespace < cj.route.ObjectMeta.Namespace
+			}
+			if ci.route.ObjectMeta.Name != cj.route.ObjectMeta.Name {
+				return ci.route.ObjectMeta.Name < cj.route.ObjectMeta.Name
+			}
+			return ci.route.Kind < cj.route.Kind
+		}
+		if ci.ruleIdx != cj.ruleIdx {
+			return ci.ruleIdx < cj.ruleIdx
+		}
+		return ci.matchIdx < cj.matchIdx
+	})
+}
+
+func buildRuleConditions(match RouteMatch, hostnames []string) []elbv2model.RuleCondition {
+	var conditions []elbv2model.RuleCondition
+	if len(hostnames) != 0 {
+		conditions = append(conditions, elbv2model.NewHostHeaderCondition(hostnames))
+	}
+	conditions = append(conditions, elbv2model.NewPathPatternCondition(buildPathPatterns(match.PathType, match.Path)))
+	for _, header := range match.Headers {
+		conditions = append(conditions, elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldHTTPHeader,
+			HTTPHeaderConfig: &elbv2model.HTTPHeaderConditionConfig{
+				HTTPHeaderName: header.Name,
+				Values:         []string{header.Value},
+			},
+		})
+	}
+	// values within a single query-string condition are ORed, so each query param gets its own condition.
+	for _, queryParam := range match.QueryParams {
+		conditions = append(conditions, elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldQueryString,
+			QueryStringConfig: &elbv2model.QueryStringConditionConfig{
+				Values: []elbv2model.QueryStringKeyValuePair{
+					{
+						Key:   awssdk.String(queryParam.Name),
+						Value: queryParam.Value,
+					},
+				},
+			},
+		})
+	}
+	if match.Method != nil {
+		conditions = append(conditions, elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldHTTPRequestMethod,
+			HTTPRequestMethodConfig: &elbv2model.HTTPRequestMethodConditionConfig{
+				Values: []string{*match.Method},
+			},
+		})
+	}
+	return conditions
+}
+
+// buildPathPatterns builds ELBv2 path patterns for a path match.
+// a prefix match covers the path itself and any sub-path by path element.
+func buildPathPatterns(pathType string, path string) []string {
+	switch pathType {
+	case gwapi.PathMatchExact, pathTypeWildcard:
+		return []string{path}
+	default:
+		trimmedPath := strings.TrimSuffix(path, "/")
+		if len(trimmedPath) == 0 {
+			return []string{"/*"}
+		}
+		return []string{trimmedPath, trimmedPath + "/*"}
+	}
+}
+
+func (t *defaultModelBuildTask) buildRuleActions(ctx context.Context, candidate ruleCandidate) ([]elbv2model.Action, error) {
+	if candidate.rule.Redirect != nil {
+		return []elbv2model.Action{buildRedirectAction(*candidate.rule.Redirect)}, nil
+	}
+	var tgTuples []elbv2model.TargetGroupTuple
+	for _, backend := range candidate.rule.Backends {
+		if backend.Weight == 0 {
+			continue
+		}
+		tg, err := t.buildTargetGroup(ctx, candidate.route, backend)
+		if err != nil {
+			return nil, err
+		}
+		tgTuples = append(tgTuples, elbv2model.TargetGroupTuple{
+			TargetGroupARN: tg.TargetGroupARN(),
+			Weight:         awssdk.Int64(backend.Weight),
+		})
+	}
+	// requests that would be routed to invalid or absent backends must receive a 500 response.
+	if len(tgTuples) == 0 {
+		return []elbv2model.Action{elbv2model.NewFixedResponseAction("500")}, nil
+	}
+	return []elbv2model.Action{
+		{
+			Type: elbv2model.ActionTypeForward,
+			ForwardConfig: &elbv2model.ForwardActionConfig{
+				TargetGroups: tgTuples,
+			},
+		},
+	}, nil
+}
+
+func buildRedirectAction(redirect gwapi.HTTPRequestRedirectFilter) elbv2model.Action {
+	statusCode := "HTTP_302"
+	if redirect.StatusCode != nil {
+		statusCode = "HTTP_" + strconv.Itoa(*redirect.StatusCode)
+	}
+	redirectConfig := &elbv2model.RedirectActionConfig{
+		StatusCode: statusCode,
+	}
+	if redirect.Scheme != nil {
+		redirectConfig.Protocol = awssdk.String(strings.ToUpper(*redirect.Scheme))
+	}
+	if redirect.Hostname != nil {
+		redirectConfig.Host = redirect.Hostname
+	}
+	if redirect.Port != nil {
+		redirectConfig.Port = awssdk.String(strconv.Itoa(int(*redirect.Port)))
+	}
+	if redirect.Path != nil && redirect.Path.ReplaceFullPath != nil {
+		redirectConfig.Path = redirect.Path.ReplaceFullPath
+	}
+	return elbv2model.Action{
+		Type:           elbv2model.ActionTypeRedirect,
+		RedirectConfig: redirectConfig,
+	}
+}
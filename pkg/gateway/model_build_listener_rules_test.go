@@ -0,0 +1,278 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_sortRuleCandidates(t *testing.T) {
+	now := time.Now()
+	oldRoute := &Route{
+		Kind: gwapi.KindHTTPRoute,
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "ns",
+			Name:              "old",
+			CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+		},
+	}
+	newRoute := &Route{
+		Kind: gwapi.KindHTTPRoute,
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "ns",
+			Name:              "new",
+			CreationTimestamp: metav1.NewTime(now),
+		},
+	}
+	candidates := []ruleCandidate{
+		{
+			route: newRoute,
+			match: RouteMatch{PathType: gwapi.PathMatchPathPrefix, Path: "/"},
+		},
+		{
+			route: oldRoute,
+			match: RouteMatch{PathType: gwapi.PathMatchPathPrefix, Path: "/"},
+		},
+		{
+			route: newRoute,
+			match: RouteMatch{PathType: gwapi.PathMatchPathPrefix, Path: "/api"},
+		},
+		{
+			route: newRoute,
+			match: RouteMatch{PathType: gwapi.PathMatchExact, Path: "/"},
+		},
+		{
+			route: newRoute,
+			match: RouteMatch{
+				PathType: gwapi.PathMatchPathPrefix,
+				Path:     "/api",
+				Headers:  []gwapi.HTTPHeaderMatch{{Name: "x-env", Value: "canary"}},
+			},
+		},
+		{
+			route: newRoute,
+			match: RouteMatch{PathType: gwapi.PathMatchPathPrefix, Path: "/api", Method: awssdk.String("GET")},
+		},
+		{
+			route:     newRoute,
+			match:     RouteMatch{PathType: gwapi.PathMatchPathPrefix, Path: "/"},
+			hostnames: []string{"*.example.com"},
+		},
+		{
+			route:     newRoute,
+			match:     RouteMatch{PathType: gwapi.PathMatchPathPrefix, Path: "/"},
+			hostnames: []string{"foo.example.com"},
+		},
+	}
+	sortRuleCandidates(candidates)
+
+	var got []string
+	for _, candidate := range candidates {
+		desc := candidate.route.ObjectMeta.Name + " " + candidate.match.PathType + " " + candidate.match.Path
+		if len(candidate.hostnames) != 0 {
+			desc += " " + candidate.hostnames[0]
+		}
+		if candidate.match.Method != nil {
+			desc += " method"
+		}
+		if len(candidate.match.Headers) != 0 {
+			desc += " headers"
+		}
+		got = append(got, desc)
+	}
+	assert.Equal(t, []string{
+		"new PathPrefix / foo.example.com",
+		"new PathPrefix / *.example.com",
+		"new Exact /",
+		"new PathPrefix /api method",
+		"new PathPrefix /api headers",
+		"new PathPrefix /api",
+		"old PathPrefix /",
+		"new PathPrefix /",
+	}, got)
+}
+
+func Test_buildPathPatterns(t *testing.T) {
+	tests := []struct {
+		name     string
+		pathType string
+		path     string
+		want     []string
+	}{
+		{
+			name:     "prefix root",
+			pathType: gwapi.PathMatchPathPrefix,
+			path:     "/",
+			want:     []string{"/*"},
+		},
+		{
+			name:     "prefix",
+			pathType: gwapi.PathMatchPathPrefix,
+			path:     "/api",
+			want:     []string{"/api", "/api/*"},
+		},
+		{
+			name:     "prefix with trailing slash",
+			pathType: gwapi.PathMatchPathPrefix,
+			path:     "/api/",
+			want:     []string{"/api", "/api/*"},
+		},
+		{
+			name:     "exact",
+			pathType: gwapi.PathMatchExact,
+			path:     "/api",
+			want:     []string{"/api"},
+		},
+		{
+			name:     "wildcard",
+			pathType: pathTypeWildcard,
+			path:     "/helloworld.Greeter/*",
+			want:     []string{"/helloworld.Greeter/*"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, buildPathPatterns(tt.pathType, tt.path))
+		})
+	}
+}
+
+func Test_buildRuleConditions(t *testing.T) {
+	tests := []struct {
+		name      string
+		match     RouteMatch
+		hostnames []string
+		want      []elbv2model.RuleCondition
+	}{
+		{
+			name:  "path only",
+			match: RouteMatch{PathType: gwapi.PathMatchExact, Path: "/api"},
+			want: []elbv2model.RuleCondition{
+				{
+					Field: elbv2model.RuleConditionFieldPathPattern,
+					PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+						Values: []string{"/api"},
+					},
+				},
+			},
+		},
+		{
+			name: "all conditions",
+			match: RouteMatch{
+				PathType: gwapi.PathMatchPathPrefix,
+				Path:     "/",
+				Headers: []gwapi.HTTPHeaderMatch{
+					{Name: "x-env", Value: "canary"},
+				},
+				QueryParams: []gwapi.HTTPQueryParamMatch{
+					{Name: "a", Value: "1"},
+					{Name: "b", Value: "2"},
+				},
+				Method: awssdk.String("POST"),
+			},
+			hostnames: []string{"foo.example.com"},
+			want: []elbv2model.RuleCondition{
+				{
+					Field: elbv2model.RuleConditionFieldHostHeader,
+					HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+						Values: []string{"foo.example.com"},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldPathPattern,
+					PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+						Values: []string{"/*"},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldHTTPHeader,
+					HTTPHeaderConfig: &elbv2model.HTTPHeaderConditionConfig{
+						HTTPHeaderName: "x-env",
+						Values:         []string{"canary"},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldQueryString,
+					QueryStringConfig: &elbv2model.QueryStringConditionConfig{
+						Values: []elbv2model.QueryStringKeyValuePair{
+							{Key: awssdk.String("a"), Value: "1"},
+						},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldQueryString,
+					QueryStringConfig: &elbv2model.QueryStringConditionConfig{
+						Values: []elbv2model.QueryStringKeyValuePair{
+							{Key: awssdk.String("b"), Value: "2"},
+						},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldHTTPRequestMethod,
+					HTTPRequestMethodConfig: &elbv2model.HTTPRequestMethodConditionConfig{
+						Values: []string{"POST"},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, buildRuleConditions(tt.match, tt.hostnames))
+		})
+	}
+}
+
+func Test_buildRedirectAction(t *testing.T) {
+	statusCode := 301
+	port := int32(443)
+	tests := []struct {
+		name     string
+		redirect gwapi.HTTPRequestRedirectFilter
+		want     elbv2model.Action
+	}{
+		{
+			name:     "default redirect",
+			redirect: gwapi.HTTPRequestRedirectFilter{},
+			want: elbv2model.Action{
+				Type: elbv2model.ActionTypeRedirect,
+				RedirectConfig: &elbv2model.RedirectActionConfig{
+					StatusCode: "HTTP_302",
+				},
+			},
+		},
+		{
+			name: "https redirect",
+			redirect: gwapi.HTTPRequestRedirectFilter{
+				Scheme:     awssdk.String("https"),
+				Hostname:   awssdk.String("www.example.com"),
+				Port:       &port,
+				StatusCode: &statusCode,
+				Path: &gwapi.HTTPPathModifier{
+					Type:            gwapi.FullPathHTTPPathModifier,
+					ReplaceFullPath: awssdk.String("/new"),
+				},
+			},
+			want: elbv2model.Action{
+				Type: elbv2model.ActionTypeRedirect,
+				RedirectConfig: &elbv2model.RedirectActionConfig{
+					Host:       awssdk.String("www.example.com"),
+					Path:       awssdk.String("/new"),
+					Port:       awssdk.String("443"),
+					Protocol:   awssdk.String("HTTPS"),
+					StatusCode: "HTTP_301",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, buildRedirectAction(tt.redirect))
+		})
+	}
+}
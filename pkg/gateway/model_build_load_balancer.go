@@ -0,0 +1,306 @@
+package gateway
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+const (
+	resourceIDLoadBalancer         = "LoadBalancer"
+	resourceIDManagedSecurityGroup = "ManagedLBSecurityGroup"
+	minimalAvailableIPAddressCount = int64(8)
+)
+
+func (t *defaultModelBuildTask) buildLoadBalancer(ctx context.Context, listenersByPort map[int64][]gwapi.Listener) (*elbv2model.LoadBalancer, error) {
+	lbSpec, err := t.buildLoadBalancerSpec(ctx, listenersByPort)
+	if err != nil {
+		return nil, err
+	}
+	lb := elbv2model.NewLoadBalancer(t.stack, resourceIDLoadBalancer, lbSpec)
+	t.loadBalancer = lb
+	return lb, nil
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, listenersByPort map[int64][]gwapi.Listener) (elbv2model.LoadBalancerSpec, error) {
+	scheme, err := t.buildLoadBalancerScheme(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	ipAddressType, err := t.buildLoadBalancerIPAddressType(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	subnetMappings, err := t.buildLoadBalancerSubnetMappings(ctx, scheme)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	managedSG, err := t.buildManagedSecurityGroup(ctx, listenersByPort, ipAddressType)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	loadBalancerAttributes, err := t.buildLoadBalancerAttributes(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	tags, err := t.buildGatewayResourceTags(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	name := t.buildLoadBalancerName(ctx, scheme)
+	return elbv2model.LoadBalancerSpec{
+		Name:                   name,
+		Type:                   elbv2model.LoadBalancerTypeApplication,
+		Scheme:                 &scheme,
+		IPAddressType:          &ipAddressType,
+		SubnetMappings:         subnetMappings,
+		SecurityGroups:         []core.StringToken{managedSG.GroupID()},
+		LoadBalancerAttributes: loadBalancerAttributes,
+		Tags:                   tags,
+	}, nil
+}
+
+var invalidLoadBalancerNamePattern = regexp.MustCompile("[[:^alnum:]]")
+
+func (t *defaultModelBuildTask) buildLoadBalancerName(_ context.Context, scheme elbv2model.LoadBalancerScheme) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.gw.Key().String()))
+	_, _ = uuidHash.Write([]byte(scheme))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	sanitizedNamespace := invalidLoadBalancerNamePattern.ReplaceAllString(t.gw.Gateway.Namespace, "")
+	sanitizedName := invalidLoadBalancerNamePattern.ReplaceAllString(t.gw.Gateway.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerScheme(_ context.Context) (elbv2model.LoadBalancerScheme, error) {
+	rawScheme := string(t.defaultScheme)
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixScheme, &rawScheme, t.gw.Gateway.Annotations)
+	switch rawScheme {
+	case string(elbv2model.LoadBalancerSchemeInternetFacing):
+		return elbv2model.LoadBalancerSchemeInternetFacing, nil
+	case string(elbv2model.LoadBalancerSchemeInternal):
+		return elbv2model.LoadBalancerSchemeInternal, nil
+	default:
+		return "", errors.Errorf("unknown scheme: %v", rawScheme)
+	}
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerIPAddressType(_ context.Context) (elbv2model.IPAddressType, error) {
+	rawIPAddressType := string(t.defaultIPAddressType)
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixIPAddressType, &rawIPAddressType, t.gw.Gateway.Annotations)
+	switch rawIPAddressType {
+	case string(elbv2model.IPAddressTypeIPV4):
+		return elbv2model.IPAddressTypeIPV4, nil
+	case string(elbv2model.IPAddressTypeDualStack):
+		return elbv2model.IPAddressTypeDualStack, nil
+	default:
+		return "", errors.Errorf("unknown IPAddressType: %v", rawIPAddressType)
+	}
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Context, scheme elbv2model.LoadBalancerScheme) ([]elbv2model.SubnetMapping, error) {
+	var rawSubnetNameOrIDs []string
+	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSubnets, &rawSubnetNameOrIDs, t.gw.Gateway.Annotations); exists {
+		chosenSubnets, err := t.subnetsResolver.ResolveViaNameOrIDSlice(ctx, rawSubnetNameOrIDs,
+			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeApplication),
+			networking.WithSubnetsResolveLBScheme(scheme),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return buildLoadBalancerSubnetMappingsWithSubnets(chosenSubnets), nil
+	}
+
+	stackTags := t.trackingProvider.StackTags(t.stack)
+	sdkLBs, err := t.elbv2TaggingManager.ListLoadBalancers(ctx, tracking.TagsAsTagFilter(stackTags))
+	if err != nil {
+		return nil, err
+	}
+	if len(sdkLBs) == 0 || (string(scheme) != awssdk.StringValue(sdkLBs[0].LoadBalancer.Scheme)) {
+		chosenSubnets, err := t.subnetsResolver.ResolveViaDiscovery(ctx,
+			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeApplication),
+			networking.WithSubnetsResolveLBScheme(scheme),
+			networking.WithSubnetsResolveAvailableIPAddressCount(minimalAvailableIPAddressCount),
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't auto-discover subnets")
+		}
+		return buildLoadBalancerSubnetMappingsWithSubnets(chosenSubnets), nil
+	}
+
+	availabilityZones := sdkLBs[0].LoadBalancer.AvailabilityZones
+	subnetIDs := make([]string, 0, len(availabilityZones))
+	for _, availabilityZone := range availabilityZones {
+		subnetIDs = append(subnetIDs, awssdk.StringValue(availabilityZone.SubnetId))
+	}
+	return buildLoadBalancerSubnetMappingsWithSubnetIDs(subnetIDs), nil
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context) ([]elbv2model.LoadBalancerAttribute, error) {
+	var rawAttributes map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixLoadBalancerAttributes, &rawAttributes, t.gw.Gateway.Annotations); err != nil {
+		return nil, err
+	}
+	attributes := make([]elbv2model.LoadBalancerAttribute, 0, len(rawAttributes))
+	for attrKey, attrValue := range rawAttributes {
+		attributes = append(attributes, elbv2model.LoadBalancerAttribute{
+			Key:   attrKey,
+			Value: attrValue,
+		})
+	}
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].Key < attributes[j].Key
+	})
+	return attributes, nil
+}
+
+// buildGatewayResourceTags builds the AWS Tags used for resources of a Gateway. e.g. LoadBalancer, SecurityGroup, Listener, ListenerRule
+func (t *defaultModelBuildTask) buildGatewayResourceTags(_ context.Context) (map[string]string, error) {
+	var annotationTags map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTags, &annotationTags, t.gw.Gateway.Annotations); err != nil {
+		return nil, err
+	}
+	if err := t.validateTagCollisionWithExternalManagedTags(annotationTags); err != nil {
+		return nil, errors.Wrapf(err, "failed build tags for Gateway %v", t.gw.Key())
+	}
+	return algorithm.MergeStringMap(t.defaultTags, annotationTags), nil
+}
+
+func (t *defaultModelBuildTask) validateTagCollisionWithExternalManagedTags(tags map[string]string) error {
+	for tagKey := range tags {
+		if t.externalManagedTags.Has(tagKey) {
+			return errors.Errorf("external managed tag key %v cannot be specified", tagKey)
+		}
+	}
+	return nil
+}
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroup(ctx context.Context, listenersByPort map[int64][]gwapi.Listener, ipAddressType elbv2model.IPAddressType) (*ec2model.SecurityGroup, error) {
+	tags, err := t.buildGatewayResourceTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ingressPermissions, err := t.buildManagedSecurityGroupIngressPermissions(ctx, listenersByPort, ipAddressType)
+	if err != nil {
+		return nil, err
+	}
+	sgSpec := ec2model.SecurityGroupSpec{
+		GroupName:   t.buildManagedSecurityGroupName(ctx),
+		Description: "[k8s] Managed SecurityGroup for LoadBalancer",
+		Tags:        tags,
+		Ingress:     ingressPermissions,
+	}
+	sg := ec2model.NewSecurityGroup(t.stack, resourceIDManagedSecurityGroup, sgSpec)
+	t.managedSG = sg
+	// the managed SecurityGroup is also used as backend SecurityGroup, since the shared backend SecurityGroup is tied to Ingress lifecycle.
+	t.backendSGIDToken = sg.GroupID()
+	return sg, nil
+}
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroupName(_ context.Context) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.gw.Key().String()))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	sanitizedNamespace := invalidLoadBalancerNamePattern.ReplaceAllString(t.gw.Gateway.Namespace, "")
+	sanitizedName := invalidLoadBalancerNamePattern.ReplaceAllString(t.gw.Gateway.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroupIngressPermissions(_ context.Context, listenersByPort map[int64][]gwapi.Listener, ipAddressType elbv2model.IPAddressType) ([]ec2model.IPPermission, error) {
+	cidrs := []string{"0.0.0.0/0"}
+	if ipAddressType == elbv2model.IPAddressTypeDualStack {
+		cidrs = append(cidrs, "::/0")
+	}
+	_ = t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixInboundCIDRs, &cidrs, t.gw.Gateway.Annotations)
+	var cidrV4s, cidrV6s []string
+	for _, cidr := range cidrs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return nil, errors.Wrapf(err, "invalid %v settings on Gateway: %v", annotations.IngressSuffixInboundCIDRs, t.gw.Key())
+		}
+		if strings.Contains(cidr, ":") {
+			if ipAddressType != elbv2model.IPAddressTypeDualStack {
+				return nil, errors.Errorf("unsupported v6 CIDR %v when IPAddressType is %v", cidr, ipAddressType)
+			}
+			cidrV6s = append(cidrV6s, cidr)
+		} else {
+			cidrV4s = append(cidrV4s, cidr)
+		}
+	}
+
+	ports := make([]int64, 0, len(listenersByPort))
+	for port := range listenersByPort {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool {
+		return ports[i] < ports[j]
+	})
+	var permissions []ec2model.IPPermission
+	for _, port := range ports {
+		for _, cidr := range cidrV4s {
+			permissions = append(permissions, ec2model.IPPermission{
+				IPProtocol: "tcp",
+				FromPort:   awssdk.Int64(port),
+				ToPort:     awssdk.Int64(port),
+				IPRanges: []ec2model.IPRange{
+					{
+						CIDRIP: cidr,
+					},
+				},
+			})
+		}
+		for _, cidr := range cidrV6s {
+			permissions = append(permissions, ec2model.IPPermission{
+				IPProtocol: "tcp",
+				FromPort:   awssdk.Int64(port),
+				ToPort:     awssdk.Int64(port),
+				IPv6Range: []ec2model.IPv6Range{
+					{
+						CIDRIPv6: cidr,
+					},
+				},
+			})
+		}
+	}
+	return permissions, nil
+}
+
+func buildLoadBalancerSubnetMappingsWithSubnets(subnets []*ec2sdk.Subnet) []elbv2model.SubnetMapping {
+	subnetMappings := make([]elbv2model.SubnetMapping, 0, len(subnets))
+	for _, subnet := range subnets {
+		subnetMappings = append(subnetMappings, elbv2model.SubnetMapping{
+			SubnetID: awssdk.StringValue(subnet.SubnetId),
+		})
+	}
+	return subnetMappings
+}
+
+func buildLoadBalancerSubnetMappingsWithSubnetIDs(subnetIDs []string) []elbv2model.SubnetMapping {
+	subnetMappings := make([]elbv2model.SubnetMapping, 0, len(subnetIDs))
+	for _, subnetID := range subnetIDs {
+		subnetMappings = append(subnetMappings, elbv2model.SubnetMapping{
+			SubnetID: subnetID,
+		})
+	}
+	return subnetMappings
+}
@@ -0,0 +1,318 @@
+package gateway
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	healthCheckPortTrafficPort = "traffic-port"
+)
+
+// buildTargetGroup builds the TargetGroup and TargetGroupBinding for a route backend.
+// TargetGroups are shared by all routes of the Gateway that reference the same service port with the same protocolVersion.
+func (t *defaultModelBuildTask) buildTargetGroup(ctx context.Context, route *Route, backend RouteBackend) (*elbv2model.TargetGroup, error) {
+	svc := backend.Service
+	svcAndGWAnnotations := algorithm.MergeStringMap(svc.Annotations, t.gw.Gateway.Annotations)
+	tgProtocolVersion, err := t.buildTargetGroupProtocolVersion(ctx, route, svcAndGWAnnotations)
+	if err != nil {
+		return nil, err
+	}
+	tgResID := fmt.Sprintf("%s/%s:%s:%s", svc.Namespace, svc.Name, backend.Port.String(), tgProtocolVersion)
+	if tg, exists := t.tgByResID[tgResID]; exists {
+		return tg, nil
+	}
+	svcPort, err := k8s.LookupServicePort(svc, backend.Port)
+	if err != nil {
+		return nil, err
+	}
+	tgSpec, err := t.buildTargetGroupSpec(ctx, svc, backend.Port, svcPort, tgProtocolVersion, svcAndGWAnnotations)
+	if err != nil {
+		return nil, err
+	}
+	tg := elbv2model.NewTargetGroup(t.stack, tgResID, tgSpec)
+	t.tgByResID[tgResID] = tg
+	_ = t.buildTargetGroupBinding(ctx, tg, svc, backend.Port, svcPort)
+	return tg, nil
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupBinding(ctx context.Context, tg *elbv2model.TargetGroup, svc *corev1.Service, port intstr.IntOrString, svcPort corev1.ServicePort) *elbv2model.TargetGroupBindingResource {
+	targetType := elbv2api.TargetType(tg.Spec.TargetType)
+	targetPort := svcPort.TargetPort
+	if targetType == elbv2api.TargetTypeInstance {
+		targetPort = intstr.FromInt(int(svcPort.NodePort))
+	}
+	tgbSpec := elbv2model.TargetGroupBindingResourceSpec{
+		Template: elbv2model.TargetGroupBindingTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: svc.Namespace,
+				Name:      tg.Spec.Name,
+			},
+			Spec: elbv2model.TargetGroupBindingSpec{
+				TargetGroupARN: tg.TargetGroupARN(),
+				TargetType:     &targetType,
+				ServiceRef: elbv2api.ServiceReference{
+					Name: svc.Name,
+					Port: port,
+				},
+				Networking:    t.buildTargetGroupBindingNetworking(ctx, targetPort),
+				IPAddressType: (*elbv2api.TargetGroupIPAddressType)(tg.Spec.IPAddressType),
+			},
+		},
+	}
+	return elbv2model.NewTargetGroupBindingResource(t.stack, tg.ID(), tgbSpec)
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(_ context.Context, targetPort intstr.IntOrString) *elbv2model.TargetGroupBindingNetworking {
+	protocolTCP := elbv2api.NetworkingProtocolTCP
+	networkingPort := elbv2api.NetworkingPort{
+		Protocol: &protocolTCP,
+		Port:     &targetPort,
+	}
+	if t.disableRestrictedSGRules {
+		networkingPort.Port = nil
+	}
+	return &elbv2model.TargetGroupBindingNetworking{
+		Ingress: []elbv2model.NetworkingIngressRule{
+			{
+				From: []elbv2model.NetworkingPeer{
+					{
+						SecurityGroup: &elbv2model.SecurityGroup{
+							GroupID: t.backendSGIDToken,
+						},
+					},
+				},
+				Ports: []elbv2api.NetworkingPort{networkingPort},
+			},
+		},
+	}
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context, svc *corev1.Service, port intstr.IntOrString, svcPort corev1.ServicePort,
+	tgProtocolVersion elbv2model.ProtocolVersion, svcAndGWAnnotations map[string]string) (elbv2model.TargetGroupSpec, error) {
+	targetType, err := t.buildTargetGroupTargetType(ctx, svcAndGWAnnotations)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	tgProtocol, err := t.buildTargetGroupProtocol(ctx, svcAndGWAnnotations)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	healthCheckConfig, err := t.buildTargetGroupHealthCheckConfig(ctx, svcAndGWAnnotations, tgProtocol, tgProtocolVersion)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	tgAttributes, err := t.buildTargetGroupAttributes(ctx, svcAndGWAnnotations)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	tags, err := t.buildTargetGroupTags(ctx, svc)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	ipAddressType, err := t.buildTargetGroupIPAddressType(ctx, svc)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	tgPort := buildTargetGroupPort(targetType, svcPort)
+	name := t.buildTargetGroupName(ctx, svc, port, tgPort, targetType, tgProtocol, tgProtocolVersion)
+	return elbv2model.TargetGroupSpec{
+		Name:                  name,
+		TargetType:            targetType,
+		Port:                  tgPort,
+		Protocol:              tgProtocol,
+		ProtocolVersion:       &tgProtocolVersion,
+		IPAddressType:         &ipAddressType,
+		HealthCheckConfig:     &healthCheckConfig,
+		TargetGroupAttributes: tgAttributes,
+		Tags:                  tags,
+	}, nil
+}
+
+var invalidTargetGroupNamePattern = regexp.MustCompile("[[:^alnum:]]")
+
+// buildTargetGroupName will calculate the targetGroup's name.
+func (t *defaultModelBuildTask) buildTargetGroupName(_ context.Context, svc *corev1.Service, port intstr.IntOrString, tgPort int64,
+	targetType elbv2model.TargetType, tgProtocol elbv2model.Protocol, tgProtocolVersion elbv2model.ProtocolVersion) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.gw.Key().String()))
+	_, _ = uuidHash.Write([]byte(svc.UID))
+	_, _ = uuidHash.Write([]byte(port.String()))
+	_, _ = uuidHash.Write([]byte(strconv.Itoa(int(tgPort))))
+	_, _ = uuidHash.Write([]byte(targetType))
+	_, _ = uuidHash.Write([]byte(tgProtocol))
+	_, _ = uuidHash.Write([]byte(tgProtocolVersion))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	sanitizedNamespace := invalidTargetGroupNamePattern.ReplaceAllString(svc.Namespace, "")
+	sanitizedName := invalidTargetGroupNamePattern.ReplaceAllString(svc.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupTargetType(_ context.Context, svcAndGWAnnotations map[string]string) (elbv2model.TargetType, error) {
+	rawTargetType := string(t.defaultTargetType)
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixTargetType, &rawTargetType, svcAndGWAnnotations)
+	switch rawTargetType {
+	case string(elbv2model.TargetTypeInstance):
+		return elbv2model.TargetTypeInstance, nil
+	case string(elbv2model.TargetTypeIP):
+		return elbv2model.TargetTypeIP, nil
+	default:
+		return "", errors.Errorf("unknown targetType: %v", rawTargetType)
+	}
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupProtocol(_ context.Context, svcAndGWAnnotations map[string]string) (elbv2model.Protocol, error) {
+	rawBackendProtocol := string(t.defaultBackendProtocol)
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixBackendProtocol, &rawBackendProtocol, svcAndGWAnnotations)
+	switch rawBackendProtocol {
+	case string(elbv2model.ProtocolHTTP):
+		return elbv2model.ProtocolHTTP, nil
+	case string(elbv2model.ProtocolHTTPS):
+		return elbv2model.ProtocolHTTPS, nil
+	default:
+		return "", errors.Errorf("backend protocol must be within [%v, %v]: %v", elbv2model.ProtocolHTTP, elbv2model.ProtocolHTTPS, rawBackendProtocol)
+	}
+}
+
+// buildTargetGroupProtocolVersion builds the protocolVersion for route backends.
+// GRPCRoute backends always use GRPC, while HTTPRoute backends default to HTTP1.
+func (t *defaultModelBuildTask) buildTargetGroupProtocolVersion(_ context.Context, route *Route, svcAndGWAnnotations map[string]string) (elbv2model.ProtocolVersion, error) {
+	if route.Kind == gwapi.KindGRPCRoute {
+		return elbv2model.ProtocolVersionGRPC, nil
+	}
+	rawBackendProtocolVersion := string(elbv2model.ProtocolVersionHTTP1)
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixBackendProtocolVersion, &rawBackendProtocolVersion, svcAndGWAnnotations)
+	switch rawBackendProtocolVersion {
+	case string(elbv2model.ProtocolVersionHTTP1):
+		return elbv2model.ProtocolVersionHTTP1, nil
+	case string(elbv2model.ProtocolVersionHTTP2):
+		return elbv2model.ProtocolVersionHTTP2, nil
+	default:
+		return "", errors.Errorf("backend protocol version must be within [%v, %v]: %v", elbv2model.ProtocolVersionHTTP1, elbv2model.ProtocolVersionHTTP2, rawBackendProtocolVersion)
+	}
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfig(_ context.Context, svcAndGWAnnotations map[string]string,
+	tgProtocol elbv2model.Protocol, tgProtocolVersion elbv2model.ProtocolVersion) (elbv2model.TargetGroupHealthCheckConfig, error) {
+	healthCheckPort := intstr.FromString(healthCheckPortTrafficPort)
+	healthCheckPath := t.defaultHealthCheckPathHTTP
+	healthCheckMatcherCode := t.defaultHealthCheckMatcherHTTPCode
+	if tgProtocolVersion == elbv2model.ProtocolVersionGRPC {
+		healthCheckPath = t.defaultHealthCheckPathGRPC
+		healthCheckMatcherCode = t.defaultHealthCheckMatcherGRPCCode
+	}
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixHealthCheckPath, &healthCheckPath, svcAndGWAnnotations)
+	_ = t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixSuccessCodes, &healthCheckMatcherCode, svcAndGWAnnotations)
+	healthCheckMatcher := elbv2model.HealthCheckMatcher{
+		HTTPCode: &healthCheckMatcherCode,
+	}
+	if tgProtocolVersion == elbv2model.ProtocolVersionGRPC {
+		healthCheckMatcher = elbv2model.HealthCheckMatcher{
+			GRPCCode: &healthCheckMatcherCode,
+		}
+	}
+
+	intervalSeconds := t.defaultHealthCheckIntervalSeconds
+	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixHealthCheckIntervalSeconds, &intervalSeconds, svcAndGWAnnotations); err != nil {
+		return elbv2model.TargetGroupHealthCheckConfig{}, err
+	}
+	timeoutSeconds := t.defaultHealthCheckTimeoutSeconds
+	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixHealthCheckTimeoutSeconds, &timeoutSeconds, svcAndGWAnnotations); err != nil {
+		return elbv2model.TargetGroupHealthCheckConfig{}, err
+	}
+	healthyThresholdCount := t.defaultHealthCheckHealthyThresholdCount
+	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixHealthyThresholdCount, &healthyThresholdCount, svcAndGWAnnotations); err != nil {
+		return elbv2model.TargetGroupHealthCheckConfig{}, err
+	}
+	unhealthyThresholdCount := t.defaultHealthCheckUnhealthyThresholdCount
+	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixUnhealthyThresholdCount, &unhealthyThresholdCount, svcAndGWAnnotations); err != nil {
+		return elbv2model.TargetGroupHealthCheckConfig{}, err
+	}
+	return elbv2model.TargetGroupHealthCheckConfig{
+		Port:                    &healthCheckPort,
+		Protocol:                &tgProtocol,
+		Path:                    &healthCheckPath,
+		Matcher:                 &healthCheckMatcher,
+		IntervalSeconds:         &intervalSeconds,
+		TimeoutSeconds:          &timeoutSeconds,
+		HealthyThresholdCount:   &healthyThresholdCount,
+		UnhealthyThresholdCount: &unhealthyThresholdCount,
+	}, nil
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context, svcAndGWAnnotations map[string]string) ([]elbv2model.TargetGroupAttribute, error) {
+	var rawAttributes map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTargetGroupAttributes, &rawAttributes, svcAndGWAnnotations); err != nil {
+		return nil, err
+	}
+	attributes := make([]elbv2model.TargetGroupAttribute, 0, len(rawAttributes))
+	for attrKey, attrValue := range rawAttributes {
+		attributes = append(attributes, elbv2model.TargetGroupAttribute{
+			Key:   attrKey,
+			Value: attrValue,
+		})
+	}
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].Key < attributes[j].Key
+	})
+	return attributes, nil
+}
+
+// buildTargetGroupTags builds the AWS Tags for the TargetGroup of a service.
+// the tags annotation of Service takes higher priority if there is conflict between the tags of Gateway and Service.
+func (t *defaultModelBuildTask) buildTargetGroupTags(ctx context.Context, svc *corev1.Service) (map[string]string, error) {
+	var svcTags map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTags, &svcTags, svc.Annotations); err != nil {
+		return nil, err
+	}
+	if err := t.validateTagCollisionWithExternalManagedTags(svcTags); err != nil {
+		return nil, errors.Wrapf(err, "failed build tags for Service %v", k8s.NamespacedName(svc))
+	}
+	gwTags, err := t.buildGatewayResourceTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return algorithm.MergeStringMap(t.defaultTags, svcTags, gwTags), nil
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupIPAddressType(_ context.Context, svc *corev1.Service) (elbv2model.TargetGroupIPAddressType, error) {
+	for _, ipFamily := range svc.Spec.IPFamilies {
+		if ipFamily == corev1.IPv6Protocol {
+			if *t.loadBalancer.Spec.IPAddressType != elbv2model.IPAddressTypeDualStack {
+				return "", errors.New("unsupported IPv6 configuration, lb not dual-stack")
+			}
+			return elbv2model.TargetGroupIPAddressTypeIPv6, nil
+		}
+	}
+	return elbv2model.TargetGroupIPAddressTypeIPv4, nil
+}
+
+// buildTargetGroupPort constructs the TargetGroup's port.
+// Note: TargetGroup's port is not in the data path as we always register targets with port specified.
+func buildTargetGroupPort(targetType elbv2model.TargetType, svcPort corev1.ServicePort) int64 {
+	if targetType == elbv2model.TargetTypeInstance {
+		return int64(svcPort.NodePort)
+	}
+	if svcPort.TargetPort.Type == intstr.Int {
+		return int64(svcPort.TargetPort.IntValue())
+	}
+	return 1
+}
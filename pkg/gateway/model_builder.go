@@ -0,0 +1,182 @@
+package gateway
+
+import (
+	"context"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certs"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+// ModelBuilder is responsible for build mode stack for a Gateway.
+type ModelBuilder interface {
+	// build mode stack for a Gateway.
+	Build(ctx context.Context, gw Gateway) (core.Stack, *elbv2model.LoadBalancer, error)
+}
+
+// NewDefaultModelBuilder constructs new defaultModelBuilder.
+func NewDefaultModelBuilder(annotationParser annotations.Parser, subnetsResolver networkingpkg.SubnetsResolver,
+	certDiscovery certs.CertDiscovery, trackingProvider tracking.Provider, elbv2TaggingManager elbv2deploy.TaggingManager,
+	clusterName string, defaultTags map[string]string, externalManagedTags []string, defaultSSLPolicy string,
+	disableRestrictedSGRules bool, logger logr.Logger) *defaultModelBuilder {
+	return &defaultModelBuilder{
+		annotationParser:         annotationParser,
+		subnetsResolver:          subnetsResolver,
+		certDiscovery:            certDiscovery,
+		trackingProvider:         trackingProvider,
+		elbv2TaggingManager:      elbv2TaggingManager,
+		clusterName:              clusterName,
+		defaultTags:              defaultTags,
+		externalManagedTags:      sets.NewString(externalManagedTags...),
+		defaultSSLPolicy:         defaultSSLPolicy,
+		disableRestrictedSGRules: disableRestrictedSGRules,
+		logger:                   logger,
+	}
+}
+
+var _ ModelBuilder = &defaultModelBuilder{}
+
+// default implementation for ModelBuilder
+type defaultModelBuilder struct {
+	annotationParser         annotations.Parser
+	subnetsResolver          networkingpkg.SubnetsResolver
+	certDiscovery            certs.CertDiscovery
+	trackingProvider         tracking.Provider
+	elbv2TaggingManager      elbv2deploy.TaggingManager
+	clusterName              string
+	defaultTags              map[string]string
+	externalManagedTags      sets.String
+	defaultSSLPolicy         string
+	disableRestrictedSGRules bool
+
+	logger logr.Logger
+}
+
+// build mode stack for a Gateway.
+func (b *defaultModelBuilder) Build(ctx context.Context, gw Gateway) (core.Stack, *elbv2model.LoadBalancer, error) {
+	stack := core.NewDefaultStack(core.StackID(gw.Key()))
+	task := &defaultModelBuildTask{
+		annotationParser:         b.annotationParser,
+		subnetsResolver:          b.subnetsResolver,
+		certDiscovery:            b.certDiscovery,
+		trackingProvider:         b.trackingProvider,
+		elbv2TaggingManager:      b.elbv2TaggingManager,
+		clusterName:              b.clusterName,
+		disableRestrictedSGRules: b.disableRestrictedSGRules,
+		logger:                   b.logger,
+
+		gw:    gw,
+		stack: stack,
+
+		defaultTags:                               b.defaultTags,
+		externalManagedTags:                       b.externalManagedTags,
+		defaultIPAddressType:                      elbv2model.IPAddressTypeIPV4,
+		defaultScheme:                             elbv2model.LoadBalancerSchemeInternal,
+		defaultSSLPolicy:                          b.defaultSSLPolicy,
+		defaultTargetType:                         elbv2model.TargetTypeInstance,
+		defaultBackendProtocol:                    elbv2model.ProtocolHTTP,
+		defaultHealthCheckPathHTTP:                "/",
+		defaultHealthCheckPathGRPC:                "/AWS.ALB/healthcheck",
+		defaultHealthCheckIntervalSeconds:         15,
+		defaultHealthCheckTimeoutSeconds:          5,
+		defaultHealthCheckHealthyThresholdCount:   2,
+		defaultHealthCheckUnhealthyThresholdCount: 2,
+		defaultHealthCheckMatcherHTTPCode:         "200",
+		defaultHealthCheckMatcherGRPCCode:         "12",
+
+		tgByResID: make(map[string]*elbv2model.TargetGroup),
+	}
+	if err := task.run(ctx); err != nil {
+		return nil, nil, err
+	}
+	return task.stack, task.loadBalancer, nil
+}
+
+// the default model build task
+type defaultModelBuildTask struct {
+	annotationParser         annotations.Parser
+	subnetsResolver          networkingpkg.SubnetsResolver
+	certDiscovery            certs.CertDiscovery
+	trackingProvider         tracking.Provider
+	elbv2TaggingManager      elbv2deploy.TaggingManager
+	clusterName              string
+	disableRestrictedSGRules bool
+	logger                   logr.Logger
+
+	gw               Gateway
+	stack            core.Stack
+	backendSGIDToken core.StringToken
+
+	defaultTags                               map[string]string
+	externalManagedTags                       sets.String
+	defaultIPAddressType                      elbv2model.IPAddressType
+	defaultScheme                             elbv2model.LoadBalancerScheme
+	defaultSSLPolicy                          string
+	defaultTargetType                         elbv2model.TargetType
+	defaultBackendProtocol                    elbv2model.Protocol
+	defaultHealthCheckPathHTTP                string
+	defaultHealthCheckPathGRPC                string
+	defaultHealthCheckTimeoutSeconds          int64
+	defaultHealthCheckIntervalSeconds         int64
+	defaultHealthCheckHealthyThresholdCount   int64
+	defaultHealthCheckUnhealthyThresholdCount int64
+	defaultHealthCheckMatcherHTTPCode         string
+	defaultHealthCheckMatcherGRPCCode         string
+
+	loadBalancer *elbv2model.LoadBalancer
+	managedSG    *ec2model.SecurityGroup
+	tgByResID    map[string]*elbv2model.TargetGroup
+}
+
+func (t *defaultModelBuildTask) run(ctx context.Context) error {
+	if !t.gw.Gateway.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	listenersByPort := t.groupAcceptedListenersByPort()
+	if len(listenersByPort) == 0 {
+		return errors.New("gateway has no valid listeners")
+	}
+
+	lb, err := t.buildLoadBalancer(ctx, listenersByPort)
+	if err != nil {
+		return err
+	}
+	ports := make([]int64, 0, len(listenersByPort))
+	for port := range listenersByPort {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool {
+		return ports[i] < ports[j]
+	})
+	for _, port := range ports {
+		ls, err := t.buildListener(ctx, lb.LoadBalancerARN(), port, listenersByPort[port])
+		if err != nil {
+			return err
+		}
+		if err := t.buildListenerRules(ctx, ls.ListenerARN(), port, listenersByPort[port]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// groupAcceptedListenersByPort groups the accepted Gateway listeners by port.
+// listeners on the same port are served by a single ELBv2 listener.
+func (t *defaultModelBuildTask) groupAcceptedListenersByPort() map[int64][]gwapi.Listener {
+	listenersByPort := make(map[int64][]gwapi.Listener)
+	for _, listener := range t.gw.AcceptedListeners() {
+		port := int64(listener.Port)
+		listenersByPort[port] = append(listenersByPort[port], listener)
+	}
+	return listenersByPort
+}
@@ -0,0 +1,160 @@
+package gateway
+
+import (
+	"context"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certs"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+)
+
+const (
+	// ControllerNameNLB is the GatewayClass controllerName for Gateways implemented with Network LoadBalancers.
+	ControllerNameNLB = "gateway.k8s.aws/nlb"
+)
+
+// NewNLBModelBuilder constructs new ModelBuilder for Network LoadBalancer backed Gateways.
+// The load balancer and target groups are built by the service model builder, thus they are configured with
+// the Service annotations on the Gateway and the backend Services respectively.
+func NewNLBModelBuilder(sharedLBBuilder service.SharedLoadBalancerBuilder, certDiscovery certs.CertDiscovery, logger logr.Logger) *nlbModelBuilder {
+	return &nlbModelBuilder{
+		sharedLBBuilder: sharedLBBuilder,
+		certDiscovery:   certDiscovery,
+		logger:          logger,
+	}
+}
+
+var _ ModelBuilder = &nlbModelBuilder{}
+
+// nlbModelBuilder is the ModelBuilder for Network LoadBalancer backed Gateways.
+type nlbModelBuilder struct {
+	sharedLBBuilder service.SharedLoadBalancerBuilder
+	certDiscovery   certs.CertDiscovery
+	logger          logr.Logger
+}
+
+func (b *nlbModelBuilder) Build(ctx context.Context, gw Gateway) (core.Stack, *elbv2model.LoadBalancer, error) {
+	stackID := core.StackID(gw.Key())
+	if !gw.Gateway.DeletionTimestamp.IsZero() {
+		return core.NewDefaultStack(stackID), nil, nil
+	}
+	listenersByPort := make(map[int64][]gwapi.Listener)
+	for _, listener := range gw.AcceptedListeners() {
+		port := int64(listener.Port)
+		listenersByPort[port] = append(listenersByPort[port], listener)
+	}
+	if len(listenersByPort) == 0 {
+		return nil, nil, errors.New("gateway has no valid listeners")
+	}
+
+	ports := make([]int64, 0, len(listenersByPort))
+	for port := range listenersByPort {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool {
+		return ports[i] < ports[j]
+	})
+	var sharedListeners []service.SharedListener
+	for _, port := range ports {
+		sharedListener, ok, err := b.buildSharedListener(ctx, gw, port, listenersByPort[port])
+		if err != nil {
+			return nil, nil, err
+		}
+		if ok {
+			sharedListeners = append(sharedListeners, sharedListener)
+		}
+	}
+	return b.sharedLBBuilder.BuildSharedLoadBalancer(ctx, stackID, gw.Gateway.ObjectMeta, sharedListeners)
+}
+
+// buildSharedListener builds the NLB listener for Gateway listeners on the same port.
+// A NLB listener forwards to a single target group, so the backend of the oldest route attached to these Gateway listeners is used.
+// false is returned if there is no resolved backend for the port.
+func (b *nlbModelBuilder) buildSharedListener(ctx context.Context, gw Gateway, port int64, listeners []gwapi.Listener) (service.SharedListener, bool, error) {
+	backend, ok := b.findListenerBackend(gw, listeners)
+	if !ok {
+		b.logger.V(1).Info("ignoring listener port without backend", "gateway", gw.Key(), "port", port)
+		return service.SharedListener{}, false, nil
+	}
+
+	protocol := elbv2model.Protocol(listeners[0].Protocol)
+	if listeners[0].TLS != nil && listeners[0].TLS.Mode != nil && *listeners[0].TLS.Mode == gwapi.TLSModePassthrough {
+		protocol = elbv2model.ProtocolTCP
+	}
+	svcPortProtocol := corev1.ProtocolTCP
+	if protocol == elbv2model.ProtocolUDP {
+		svcPortProtocol = corev1.ProtocolUDP
+	}
+	svcPort, ok := findServicePort(backend.Service, backend.Port.IntValue(), svcPortProtocol)
+	if !ok {
+		b.logger.V(1).Info("ignoring listener port without matching service port", "gateway", gw.Key(), "port", port,
+			"service", backend.Service.Name, "servicePort", backend.Port.IntValue())
+		return service.SharedListener{}, false, nil
+	}
+
+	sharedListener := service.SharedListener{
+		Port:        port,
+		Protocol:    protocol,
+		Service:     backend.Service,
+		ServicePort: svcPort,
+	}
+	if protocol == elbv2model.ProtocolTLS {
+		certARNs, err := buildListenerCertificateARNs(ctx, b.certDiscovery, gw, port, listeners)
+		if err != nil {
+			return service.SharedListener{}, false, err
+		}
+		sslPolicy, err := buildExplicitListenerSSLPolicy(port, listeners)
+		if err != nil {
+			return service.SharedListener{}, false, err
+		}
+		sharedListener.CertificateARNs = certARNs
+		sharedListener.SSLPolicy = sslPolicy
+	}
+	return sharedListener, true, nil
+}
+
+// findListenerBackend finds the first weighted backend of the oldest route attached to any of listeners.
+func (b *nlbModelBuilder) findListenerBackend(gw Gateway, listeners []gwapi.Listener) (RouteBackend, bool) {
+	var routes []*Route
+	for _, attachedRoute := range gw.Routes {
+		for _, listener := range listeners {
+			if attachedRoute.attachedToListener(listener.Name) {
+				routes = append(routes, attachedRoute.Route)
+				break
+			}
+		}
+	}
+	sort.SliceStable(routes, func(i, j int) bool {
+		return isOlderRoute(routes[i], routes[j])
+	})
+	for _, route := range routes {
+		for _, rule := range route.Rules {
+			for _, backend := range rule.Backends {
+				if backend.Weight > 0 {
+					return backend, true
+				}
+			}
+		}
+	}
+	return RouteBackend{}, false
+}
+
+// findServicePort finds the port of svc with specified port number and protocol.
+func findServicePort(svc *corev1.Service, port int, protocol corev1.Protocol) (corev1.ServicePort, bool) {
+	for _, svcPort := range svc.Spec.Ports {
+		svcPortProtocol := svcPort.Protocol
+		if svcPortProtocol == "" {
+			svcPortProtocol = corev1.ProtocolTCP
+		}
+		if int(svcPort.Port) == port && svcPortProtocol == protocol {
+			return svcPort, true
+		}
+	}
+	return corev1.ServicePort{}, false
+}
@@ -0,0 +1,162 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// stubSharedLoadBalancerBuilder records the listeners it is asked to build.
+type stubSharedLoadBalancerBuilder struct {
+	listeners []service.SharedListener
+}
+
+func (b *stubSharedLoadBalancerBuilder) BuildSharedLoadBalancer(_ context.Context, stackID core.StackID, _ metav1.ObjectMeta,
+	listeners []service.SharedListener) (core.Stack, *elbv2model.LoadBalancer, error) {
+	b.listeners = listeners
+	return core.NewDefaultStack(stackID), nil, nil
+}
+
+func Test_nlbModelBuilder_Build(t *testing.T) {
+	dbSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "db", Name: "postgres"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "pg", Port: 5432},
+			},
+		},
+	}
+	dnsSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "dns", Name: "coredns"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "dns-tcp", Port: 53, Protocol: corev1.ProtocolTCP},
+				{Name: "dns-udp", Port: 53, Protocol: corev1.ProtocolUDP},
+			},
+		},
+	}
+	newRoute := func(kind string, name string, created time.Time, svc *corev1.Service, port int, weight int64) *Route {
+		return &Route{
+			Kind:       kind,
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, CreationTimestamp: metav1.NewTime(created)},
+			Rules: []RouteRule{
+				{Backends: []RouteBackend{{Service: svc, Port: intstr.FromInt(port), Weight: weight}}},
+			},
+		}
+	}
+	now := time.Now()
+	passthrough := gwapi.TLSModePassthrough
+	terminate := gwapi.TLSModeTerminate
+
+	tcpListener := gwapi.Listener{Name: "tcp", Port: 5432, Protocol: gwapi.ProtocolTCP}
+	udpListener := gwapi.Listener{Name: "udp", Port: 53, Protocol: gwapi.ProtocolUDP}
+	passthroughListener := gwapi.Listener{Name: "passthrough", Port: 8443, Protocol: gwapi.ProtocolTLS,
+		TLS: &gwapi.GatewayTLSConfig{Mode: &passthrough}}
+	terminateListener := gwapi.Listener{Name: "terminate", Port: 443, Protocol: gwapi.ProtocolTLS,
+		TLS: &gwapi.GatewayTLSConfig{Mode: &terminate, Options: map[string]string{
+			TLSOptionCertificateARN: "cert-1,cert-2",
+			TLSOptionSSLPolicy:      "ELBSecurityPolicy-TLS13-1-2-2021-06",
+		}}}
+	emptyListener := gwapi.Listener{Name: "empty", Port: 9000, Protocol: gwapi.ProtocolTCP}
+
+	tests := []struct {
+		name    string
+		gw      Gateway
+		want    []service.SharedListener
+		wantErr string
+	}{
+		{
+			name: "listeners of each protocol",
+			gw: Gateway{
+				Listeners: []ListenerResult{
+					{Listener: tcpListener, Accepted: true},
+					{Listener: udpListener, Accepted: true},
+					{Listener: passthroughListener, Accepted: true},
+					{Listener: terminateListener, Accepted: true},
+					{Listener: emptyListener, Accepted: true},
+				},
+				Routes: []AttachedRoute{
+					{Route: newRoute(gwapi.KindTCPRoute, "db", now, dbSvc, 5432, 1),
+						Listeners: []gwapi.Listener{tcpListener, terminateListener}},
+					{Route: newRoute(gwapi.KindUDPRoute, "dns", now, dnsSvc, 53, 1),
+						Listeners: []gwapi.Listener{udpListener}},
+					{Route: newRoute(gwapi.KindTLSRoute, "tls", now, dbSvc, 5432, 1),
+						Listeners: []gwapi.Listener{passthroughListener}},
+				},
+			},
+			want: []service.SharedListener{
+				{Port: 53, Protocol: elbv2model.ProtocolUDP, Service: dnsSvc, ServicePort: dnsSvc.Spec.Ports[1]},
+				{Port: 443, Protocol: elbv2model.ProtocolTLS, CertificateARNs: []string{"cert-1", "cert-2"},
+					SSLPolicy: awssdk.String("ELBSecurityPolicy-TLS13-1-2-2021-06"), Service: dbSvc, ServicePort: dbSvc.Spec.Ports[0]},
+				{Port: 5432, Protocol: elbv2model.ProtocolTCP, Service: dbSvc, ServicePort: dbSvc.Spec.Ports[0]},
+				{Port: 8443, Protocol: elbv2model.ProtocolTCP, Service: dbSvc, ServicePort: dbSvc.Spec.Ports[0]},
+			},
+		},
+		{
+			name: "oldest route with a weighted backend wins",
+			gw: Gateway{
+				Listeners: []ListenerResult{
+					{Listener: tcpListener, Accepted: true},
+				},
+				Routes: []AttachedRoute{
+					{Route: newRoute(gwapi.KindTCPRoute, "newer", now, dnsSvc, 53, 1),
+						Listeners: []gwapi.Listener{tcpListener}},
+					{Route: newRoute(gwapi.KindTCPRoute, "older", now.Add(-time.Hour), dbSvc, 5432, 1),
+						Listeners: []gwapi.Listener{tcpListener}},
+					{Route: newRoute(gwapi.KindTCPRoute, "oldest", now.Add(-2*time.Hour), dbSvc, 5432, 0),
+						Listeners: []gwapi.Listener{tcpListener}},
+				},
+			},
+			want: []service.SharedListener{
+				{Port: 5432, Protocol: elbv2model.ProtocolTCP, Service: dbSvc, ServicePort: dbSvc.Spec.Ports[0]},
+			},
+		},
+		{
+			name: "backend without service port of listener protocol",
+			gw: Gateway{
+				Listeners: []ListenerResult{
+					{Listener: udpListener, Accepted: true},
+				},
+				Routes: []AttachedRoute{
+					{Route: newRoute(gwapi.KindUDPRoute, "db", now, dbSvc, 53, 1),
+						Listeners: []gwapi.Listener{udpListener}},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "no accepted listeners",
+			gw: Gateway{
+				Listeners: []ListenerResult{
+					{Listener: gwapi.Listener{Name: "http", Port: 80, Protocol: gwapi.ProtocolHTTP}},
+				},
+			},
+			wantErr: "gateway has no valid listeners",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.gw.Gateway = &gwapi.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "l4"}}
+			sharedLBBuilder := &stubSharedLoadBalancerBuilder{}
+			b := NewNLBModelBuilder(sharedLBBuilder, nil, &log.NullLogger{})
+			_, _, err := b.Build(context.Background(), tt.gw)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, sharedLBBuilder.listeners)
+		})
+	}
+}
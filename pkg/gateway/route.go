@@ -0,0 +1,404 @@
+package gateway
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+)
+
+const (
+	// pathTypeWildcard denotes a path that is already an ELBv2 path pattern and should be used verbatim.
+	pathTypeWildcard = "Wildcard"
+)
+
+// Route is a kind agnostic view of a Gateway API xRoute.
+type Route struct {
+	// Kind of the route, e.g. HTTPRoute.
+	Kind string
+	// ObjectMeta of the route.
+	ObjectMeta metav1.ObjectMeta
+	// ParentRefs of the route.
+	ParentRefs []gwapi.ParentReference
+	// Hostnames of the route.
+	Hostnames []string
+	// Rules of the route, normalized across route kinds.
+	Rules []RouteRule
+	// Status of the route.
+	Status gwapi.RouteStatus
+	// Object is the raw route object.
+	Object *unstructured.Unstructured
+}
+
+// Key returns the namespaced name of the route.
+func (r *Route) Key() types.NamespacedName {
+	return types.NamespacedName{Namespace: r.ObjectMeta.Namespace, Name: r.ObjectMeta.Name}
+}
+
+// String returns a human-readable identifier for the route.
+func (r *Route) String() string {
+	return fmt.Sprintf("%v %v", r.Kind, r.Key())
+}
+
+// RouteRule is a normalized route rule.
+type RouteRule struct {
+	// Matches of the rule, an empty list matches all requests.
+	Matches []RouteMatch
+	// Redirect responds to matching requests with a redirection if set.
+	Redirect *gwapi.HTTPRequestRedirectFilter
+	// BackendRefs of the rule as specified on the route.
+	BackendRefs []gwapi.BackendRef
+	// Backends are the resolved BackendRefs, filled by the GatewayLoader.
+	Backends []RouteBackend
+}
+
+// RouteMatch is a normalized route match.
+type RouteMatch struct {
+	// PathType is one of Exact, PathPrefix or Wildcard.
+	PathType string
+	// Path to match against.
+	Path string
+	// Headers that must all match.
+	Headers []gwapi.HTTPHeaderMatch
+	// QueryParams that must all match.
+	QueryParams []gwapi.HTTPQueryParamMatch
+	// Method to match if set.
+	Method *string
+}
+
+// RouteBackend is a resolved backend of a route rule.
+type RouteBackend struct {
+	// Service is the referenced service.
+	Service *corev1.Service
+	// Port is the referenced service port.
+	Port intstr.IntOrString
+	// Weight is the weight of this backend within the rule.
+	Weight int64
+}
+
+// NewRouteFromUnstructured decodes a supported xRoute from unstructured object.
+func NewRouteFromUnstructured(u *unstructured.Unstructured) (Route, error) {
+	switch u.GetKind() {
+	case gwapi.KindHTTPRoute:
+		httpRoute := &gwapi.HTTPRoute{}
+		if err := gwapi.FromUnstructured(u, httpRoute); err != nil {
+			return Route{}, err
+		}
+		route := Route{
+			Kind:       gwapi.KindHTTPRoute,
+			ObjectMeta: httpRoute.ObjectMeta,
+			ParentRefs: httpRoute.Spec.ParentRefs,
+			Hostnames:  httpRoute.Spec.Hostnames,
+			Status:     httpRoute.Status,
+			Object:     u,
+		}
+		rules, err := buildHTTPRouteRules(httpRoute.Spec.Rules)
+		route.Rules = rules
+		return route, err
+	case gwapi.KindGRPCRoute:
+		grpcRoute := &gwapi.GRPCRoute{}
+		if err := gwapi.FromUnstructured(u, grpcRoute); err != nil {
+			return Route{}, err
+		}
+		route := Route{
+			Kind:       gwapi.KindGRPCRoute,
+			ObjectMeta: grpcRoute.ObjectMeta,
+			ParentRefs: grpcRoute.Spec.ParentRefs,
+			Hostnames:  grpcRoute.Spec.Hostnames,
+			Status:     grpcRoute.Status,
+			Object:     u,
+		}
+		rules, err := buildGRPCRouteRules(grpcRoute.Spec.Rules)
+		route.Rules = rules
+		return route, err
+	case gwapi.KindTCPRoute:
+		tcpRoute := &gwapi.TCPRoute{}
+		if err := gwapi.FromUnstructured(u, tcpRoute); err != nil {
+			return Route{}, err
+		}
+		route := Route{
+			Kind:       gwapi.KindTCPRoute,
+			ObjectMeta: tcpRoute.ObjectMeta,
+			ParentRefs: tcpRoute.Spec.ParentRefs,
+			Status:     tcpRoute.Status,
+			Object:     u,
+		}
+		rules, err := buildL4RouteRules(tcpRoute.Spec.Rules)
+		route.Rules = rules
+		return route, err
+	case gwapi.KindUDPRoute:
+		udpRoute := &gwapi.UDPRoute{}
+		if err := gwapi.FromUnstructured(u, udpRoute); err != nil {
+			return Route{}, err
+		}
+		route := Route{
+			Kind:       gwapi.KindUDPRoute,
+			ObjectMeta: udpRoute.ObjectMeta,
+			ParentRefs: udpRoute.Spec.ParentRefs,
+			Status:     udpRoute.Status,
+			Object:     u,
+		}
+		rules, err := buildL4RouteRules(udpRoute.Spec.Rules)
+		route.Rules = rules
+		return route, err
+	case gwapi.KindTLSRoute:
+		tlsRoute := &gwapi.TLSRoute{}
+		if err := gwapi.FromUnstructured(u, tlsRoute); err != nil {
+			return Route{}, err
+		}
+		route := Route{
+			Kind:       gwapi.KindTLSRoute,
+			ObjectMeta: tlsRoute.ObjectMeta,
+			ParentRefs: tlsRoute.Spec.ParentRefs,
+			Hostnames:  tlsRoute.Spec.Hostnames,
+			Status:     tlsRoute.Status,
+			Object:     u,
+		}
+		rules, err := buildL4RouteRules(tlsRoute.Spec.Rules)
+		route.Rules = rules
+		return route, err
+	default:
+		return Route{}, errors.Errorf("unsupported route kind: %v", u.GetKind())
+	}
+}
+
+// buildHTTPRouteRules normalizes HTTPRoute rules.
+// An error is returned for features that cannot be expressed with ELBv2 listener rules, the successfully normalized rules are returned regardless.
+func buildHTTPRouteRules(httpRules []gwapi.HTTPRouteRule) ([]RouteRule, error) {
+	rules := make([]RouteRule, 0, len(httpRules))
+	for ruleIdx, httpRule := range httpRules {
+		rule := RouteRule{
+			BackendRefs: httpRule.BackendRefs,
+		}
+		for _, httpMatch := range httpRule.Matches {
+			match, err := buildHTTPRouteMatch(httpMatch)
+			if err != nil {
+				return rules, errors.Wrapf(err, "rules[%d]", ruleIdx)
+			}
+			rule.Matches = append(rule.Matches, match)
+		}
+		for _, filter := range httpRule.Filters {
+			switch filter.Type {
+			case gwapi.HTTPRouteFilterRequestRedirect:
+				if filter.RequestRedirect == nil {
+					return rules, errors.Errorf("rules[%d]: requestRedirect must be specified for filter type %v", ruleIdx, filter.Type)
+				}
+				if filter.RequestRedirect.Path != nil && filter.RequestRedirect.Path.Type != gwapi.FullPathHTTPPathModifier {
+					return rules, errors.Errorf("rules[%d]: unsupported redirect path modifier: %v", ruleIdx, filter.RequestRedirect.Path.Type)
+				}
+				rule.Redirect = filter.RequestRedirect
+			default:
+				return rules, errors.Errorf("rules[%d]: unsupported filter type: %v", ruleIdx, filter.Type)
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func buildHTTPRouteMatch(httpMatch gwapi.HTTPRouteMatch) (RouteMatch, error) {
+	match := RouteMatch{
+		PathType: gwapi.PathMatchPathPrefix,
+		Path:     "/",
+		Method:   httpMatch.Method,
+	}
+	if httpMatch.Path != nil {
+		if httpMatch.Path.Type != nil {
+			match.PathType = *httpMatch.Path.Type
+		}
+		if httpMatch.Path.Value != nil {
+			match.Path = *httpMatch.Path.Value
+		}
+	}
+	switch match.PathType {
+	case gwapi.PathMatchExact, gwapi.PathMatchPathPrefix:
+	default:
+		return RouteMatch{}, errors.Errorf("unsupported path match type: %v", match.PathType)
+	}
+	if !strings.HasPrefix(match.Path, "/") {
+		return RouteMatch{}, errors.Errorf("path must start with '/': %v", match.Path)
+	}
+	for _, header := range httpMatch.Headers {
+		if header.Type != nil && *header.Type != gwapi.HeaderMatchExact {
+			return RouteMatch{}, errors.Errorf("unsupported header match type: %v", *header.Type)
+		}
+		match.Headers = append(match.Headers, header)
+	}
+	for _, queryParam := range httpMatch.QueryParams {
+		if queryParam.Type != nil && *queryParam.Type != gwapi.QueryParamMatchExact {
+			return RouteMatch{}, errors.Errorf("unsupported queryParam match type: %v", *queryParam.Type)
+		}
+		match.QueryParams = append(match.QueryParams, queryParam)
+	}
+	return match, nil
+}
+
+// buildGRPCRouteRules normalizes GRPCRoute rules.
+// gRPC requests are matched by their HTTP/2 path, which is "/<service>/<method>".
+func buildGRPCRouteRules(grpcRules []gwapi.GRPCRouteRule) ([]RouteRule, error) {
+	rules := make([]RouteRule, 0, len(grpcRules))
+	for ruleIdx, grpcRule := range grpcRules {
+		rule := RouteRule{
+			BackendRefs: grpcRule.BackendRefs,
+		}
+		for _, grpcMatch := range grpcRule.Matches {
+			match, err := buildGRPCRouteMatch(grpcMatch)
+			if err != nil {
+				return rules, errors.Wrapf(err, "rules[%d]", ruleIdx)
+			}
+			rule.Matches = append(rule.Matches, match)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func buildGRPCRouteMatch(grpcMatch gwapi.GRPCRouteMatch) (RouteMatch, error) {
+	match := RouteMatch{
+		PathType: gwapi.PathMatchPathPrefix,
+		Path:     "/",
+	}
+	if grpcMatch.Method != nil {
+		if grpcMatch.Method.Type != nil && *grpcMatch.Method.Type != gwapi.GRPCMethodMatchExact {
+			return RouteMatch{}, errors.Errorf("unsupported method match type: %v", *grpcMatch.Method.Type)
+		}
+		service := "*"
+		if grpcMatch.Method.Service != nil {
+			service = *grpcMatch.Method.Service
+		}
+		method := "*"
+		if grpcMatch.Method.Method != nil {
+			method = *grpcMatch.Method.Method
+		}
+		if service == "*" && method == "*" {
+			return RouteMatch{}, errors.New("one of method.service or method.method must be specified")
+		}
+		match.Path = fmt.Sprintf("/%s/%s", service, method)
+		match.PathType = gwapi.PathMatchExact
+		if service == "*" || method == "*" {
+			match.PathType = pathTypeWildcard
+		}
+	}
+	for _, header := range grpcMatch.Headers {
+		if header.Type != nil && *header.Type != gwapi.HeaderMatchExact {
+			return RouteMatch{}, errors.Errorf("unsupported header match type: %v", *header.Type)
+		}
+		match.Headers = append(match.Headers, header)
+	}
+	return match, nil
+}
+
+// buildL4RouteRules normalizes TCPRoute, UDPRoute and TLSRoute rules.
+// NLB listeners forward to a single target group, so only a single backendRef is supported per route.
+func buildL4RouteRules(l4Rules []gwapi.L4RouteRule) ([]RouteRule, error) {
+	rules := make([]RouteRule, 0, len(l4Rules))
+	backendRefCount := 0
+	for _, l4Rule := range l4Rules {
+		backendRefCount += len(l4Rule.BackendRefs)
+		rules = append(rules, RouteRule{
+			BackendRefs: l4Rule.BackendRefs,
+		})
+	}
+	if backendRefCount > 1 {
+		return rules, errors.Errorf("Network LoadBalancer listeners support a single backendRef, got %d", backendRefCount)
+	}
+	return rules, nil
+}
+
+// routeReferences contains the fields common to all xRoutes that reference other objects.
+type routeReferences struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              struct {
+		ParentRefs []gwapi.ParentReference `json:"parentRefs,omitempty"`
+		Rules      []struct {
+			BackendRefs []gwapi.BackendRef `json:"backendRefs,omitempty"`
+		} `json:"rules,omitempty"`
+	} `json:"spec"`
+	Status gwapi.RouteStatus `json:"status,omitempty"`
+}
+
+// RouteParentGateways returns the Gateways referenced by an xRoute, via parentRefs or parent statuses.
+func RouteParentGateways(u *unstructured.Unstructured) []types.NamespacedName {
+	refs := routeReferences{}
+	if err := gwapi.FromUnstructured(u, &refs); err != nil {
+		return nil
+	}
+	parentRefs := append([]gwapi.ParentReference(nil), refs.Spec.ParentRefs...)
+	for _, parentStatus := range refs.Status.Parents {
+		parentRefs = append(parentRefs, parentStatus.ParentRef)
+	}
+	var gwKeys []types.NamespacedName
+	seen := make(map[types.NamespacedName]bool)
+	for _, parentRef := range parentRefs {
+		if parentRef.Group != nil && *parentRef.Group != gwapi.GroupName {
+			continue
+		}
+		if parentRef.Kind != nil && *parentRef.Kind != gwapi.KindGateway {
+			continue
+		}
+		gwKey := types.NamespacedName{Namespace: u.GetNamespace(), Name: parentRef.Name}
+		if parentRef.Namespace != nil {
+			gwKey.Namespace = *parentRef.Namespace
+		}
+		if seen[gwKey] {
+			continue
+		}
+		seen[gwKey] = true
+		gwKeys = append(gwKeys, gwKey)
+	}
+	return gwKeys
+}
+
+// RouteReferencesService checks whether an xRoute references the service with svcKey as backend.
+func RouteReferencesService(u *unstructured.Unstructured, svcKey types.NamespacedName) bool {
+	for _, backendSvcKey := range routeBackendServiceKeys(u) {
+		if backendSvcKey == svcKey {
+			return true
+		}
+	}
+	return false
+}
+
+// RouteReferencesNamespace checks whether an xRoute references any service in namespace from another namespace.
+func RouteReferencesNamespace(u *unstructured.Unstructured, namespace string) bool {
+	if u.GetNamespace() == namespace {
+		return false
+	}
+	for _, backendSvcKey := range routeBackendServiceKeys(u) {
+		if backendSvcKey.Namespace == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// routeBackendServiceKeys returns the services referenced by an xRoute as backend.
+func routeBackendServiceKeys(u *unstructured.Unstructured) []types.NamespacedName {
+	refs := routeReferences{}
+	if err := gwapi.FromUnstructured(u, &refs); err != nil {
+		return nil
+	}
+	var svcKeys []types.NamespacedName
+	for _, rule := range refs.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			if backendRef.Group != nil && len(*backendRef.Group) != 0 {
+				continue
+			}
+			if backendRef.Kind != nil && *backendRef.Kind != gwapi.KindService {
+				continue
+			}
+			namespace := u.GetNamespace()
+			if backendRef.Namespace != nil {
+				namespace = *backendRef.Namespace
+			}
+			svcKeys = append(svcKeys, types.NamespacedName{Namespace: namespace, Name: backendRef.Name})
+		}
+	}
+	return svcKeys
+}
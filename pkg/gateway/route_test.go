@@ -0,0 +1,332 @@
+package gateway
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+)
+
+func Test_buildHTTPRouteMatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		httpMatch gwapi.HTTPRouteMatch
+		want      RouteMatch
+		wantErr   error
+	}{
+		{
+			name:      "default match",
+			httpMatch: gwapi.HTTPRouteMatch{},
+			want: RouteMatch{
+				PathType: gwapi.PathMatchPathPrefix,
+				Path:     "/",
+			},
+		},
+		{
+			name: "exact path with header, query param and method",
+			httpMatch: gwapi.HTTPRouteMatch{
+				Path: &gwapi.HTTPPathMatch{
+					Type:  awssdk.String(gwapi.PathMatchExact),
+					Value: awssdk.String("/api"),
+				},
+				Headers: []gwapi.HTTPHeaderMatch{
+					{Name: "x-env", Value: "canary"},
+				},
+				QueryParams: []gwapi.HTTPQueryParamMatch{
+					{Type: awssdk.String(gwapi.QueryParamMatchExact), Name: "debug", Value: "true"},
+				},
+				Method: awssdk.String("GET"),
+			},
+			want: RouteMatch{
+				PathType: gwapi.PathMatchExact,
+				Path:     "/api",
+				Headers: []gwapi.HTTPHeaderMatch{
+					{Name: "x-env", Value: "canary"},
+				},
+				QueryParams: []gwapi.HTTPQueryParamMatch{
+					{Type: awssdk.String(gwapi.QueryParamMatchExact), Name: "debug", Value: "true"},
+				},
+				Method: awssdk.String("GET"),
+			},
+		},
+		{
+			name: "regular expression path",
+			httpMatch: gwapi.HTTPRouteMatch{
+				Path: &gwapi.HTTPPathMatch{
+					Type:  awssdk.String(gwapi.PathMatchRegularExpression),
+					Value: awssdk.String("/api/.*"),
+				},
+			},
+			wantErr: errors.New("unsupported path match type: RegularExpression"),
+		},
+		{
+			name: "regular expression header",
+			httpMatch: gwapi.HTTPRouteMatch{
+				Headers: []gwapi.HTTPHeaderMatch{
+					{Type: awssdk.String(gwapi.HeaderMatchRegularExpression), Name: "x-env", Value: ".*"},
+				},
+			},
+			wantErr: errors.New("unsupported header match type: RegularExpression"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildHTTPRouteMatch(tt.httpMatch)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_buildGRPCRouteMatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		grpcMatch gwapi.GRPCRouteMatch
+		want      RouteMatch
+		wantErr   error
+	}{
+		{
+			name:      "default match",
+			grpcMatch: gwapi.GRPCRouteMatch{},
+			want: RouteMatch{
+				PathType: gwapi.PathMatchPathPrefix,
+				Path:     "/",
+			},
+		},
+		{
+			name: "service and method",
+			grpcMatch: gwapi.GRPCRouteMatch{
+				Method: &gwapi.GRPCMethodMatch{
+					Service: awssdk.String("helloworld.Greeter"),
+					Method:  awssdk.String("SayHello"),
+				},
+			},
+			want: RouteMatch{
+				PathType: gwapi.PathMatchExact,
+				Path:     "/helloworld.Greeter/SayHello",
+			},
+		},
+		{
+			name: "service only",
+			grpcMatch: gwapi.GRPCRouteMatch{
+				Method: &gwapi.GRPCMethodMatch{
+					Service: awssdk.String("helloworld.Greeter"),
+				},
+			},
+			want: RouteMatch{
+				PathType: pathTypeWildcard,
+				Path:     "/helloworld.Greeter/*",
+			},
+		},
+		{
+			name: "neither service nor method",
+			grpcMatch: gwapi.GRPCRouteMatch{
+				Method: &gwapi.GRPCMethodMatch{},
+			},
+			wantErr: errors.New("one of method.service or method.method must be specified"),
+		},
+		{
+			name: "regular expression method",
+			grpcMatch: gwapi.GRPCRouteMatch{
+				Method: &gwapi.GRPCMethodMatch{
+					Type:    awssdk.String(gwapi.GRPCMethodMatchRegularExpression),
+					Service: awssdk.String("helloworld.*"),
+				},
+			},
+			wantErr: errors.New("unsupported method match type: RegularExpression"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildGRPCRouteMatch(tt.grpcMatch)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_buildHTTPRouteRules(t *testing.T) {
+	tests := []struct {
+		name      string
+		httpRules []gwapi.HTTPRouteRule
+		want      []RouteRule
+		wantErr   error
+	}{
+		{
+			name: "redirect filter",
+			httpRules: []gwapi.HTTPRouteRule{
+				{
+					Filters: []gwapi.HTTPRouteFilter{
+						{
+							Type: gwapi.HTTPRouteFilterRequestRedirect,
+							RequestRedirect: &gwapi.HTTPRequestRedirectFilter{
+								Scheme: awssdk.String("https"),
+							},
+						},
+					},
+				},
+			},
+			want: []RouteRule{
+				{
+					Redirect: &gwapi.HTTPRequestRedirectFilter{
+						Scheme: awssdk.String("https"),
+					},
+				},
+			},
+		},
+		{
+			name: "redirect with prefix replacement",
+			httpRules: []gwapi.HTTPRouteRule{
+				{
+					Filters: []gwapi.HTTPRouteFilter{
+						{
+							Type: gwapi.HTTPRouteFilterRequestRedirect,
+							RequestRedirect: &gwapi.HTTPRequestRedirectFilter{
+								Path: &gwapi.HTTPPathModifier{
+									Type:               gwapi.PrefixMatchHTTPPathModifier,
+									ReplacePrefixMatch: awssdk.String("/v2"),
+								},
+							},
+						},
+					},
+				},
+			},
+			want:    []RouteRule{},
+			wantErr: errors.New("rules[0]: unsupported redirect path modifier: ReplacePrefixMatch"),
+		},
+		{
+			name: "unsupported filter",
+			httpRules: []gwapi.HTTPRouteRule{
+				{
+					BackendRefs: []gwapi.BackendRef{{Name: "svc"}},
+				},
+				{
+					Filters: []gwapi.HTTPRouteFilter{
+						{
+							Type: "URLRewrite",
+						},
+					},
+				},
+			},
+			want: []RouteRule{
+				{
+					BackendRefs: []gwapi.BackendRef{{Name: "svc"}},
+				},
+			},
+			wantErr: errors.New("rules[1]: unsupported filter type: URLRewrite"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildHTTPRouteRules(tt.httpRules)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_buildL4RouteRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		l4Rules []gwapi.L4RouteRule
+		want    []RouteRule
+		wantErr error
+	}{
+		{
+			name: "single backendRef",
+			l4Rules: []gwapi.L4RouteRule{
+				{BackendRefs: []gwapi.BackendRef{{Name: "svc"}}},
+			},
+			want: []RouteRule{
+				{BackendRefs: []gwapi.BackendRef{{Name: "svc"}}},
+			},
+		},
+		{
+			name: "multiple backendRefs across rules",
+			l4Rules: []gwapi.L4RouteRule{
+				{BackendRefs: []gwapi.BackendRef{{Name: "svc-1"}}},
+				{BackendRefs: []gwapi.BackendRef{{Name: "svc-2"}}},
+			},
+			want: []RouteRule{
+				{BackendRefs: []gwapi.BackendRef{{Name: "svc-1"}}},
+				{BackendRefs: []gwapi.BackendRef{{Name: "svc-2"}}},
+			},
+			wantErr: errors.New("Network LoadBalancer listeners support a single backendRef, got 2"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildL4RouteRules(tt.l4Rules)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_RouteParentGateways_RouteReferencesService(t *testing.T) {
+	route := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "gateway.networking.k8s.io/v1",
+			"kind":       "HTTPRoute",
+			"metadata": map[string]interface{}{
+				"namespace": "app",
+				"name":      "route",
+			},
+			"spec": map[string]interface{}{
+				"parentRefs": []interface{}{
+					map[string]interface{}{"name": "gw"},
+					map[string]interface{}{"name": "shared-gw", "namespace": "infra"},
+					map[string]interface{}{"name": "svc", "kind": "Service", "group": ""},
+				},
+				"rules": []interface{}{
+					map[string]interface{}{
+						"backendRefs": []interface{}{
+							map[string]interface{}{"name": "svc-a", "port": int64(80)},
+							map[string]interface{}{"name": "svc-b", "namespace": "other", "port": int64(80)},
+						},
+					},
+				},
+			},
+			"status": map[string]interface{}{
+				"parents": []interface{}{
+					map[string]interface{}{
+						"parentRef":      map[string]interface{}{"name": "old-gw"},
+						"controllerName": ControllerNameALB,
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []types.NamespacedName{
+		{Namespace: "app", Name: "gw"},
+		{Namespace: "infra", Name: "shared-gw"},
+		{Namespace: "app", Name: "old-gw"},
+	}, RouteParentGateways(route))
+	assert.True(t, RouteReferencesService(route, types.NamespacedName{Namespace: "app", Name: "svc-a"}))
+	assert.True(t, RouteReferencesService(route, types.NamespacedName{Namespace: "other", Name: "svc-b"}))
+	assert.False(t, RouteReferencesService(route, types.NamespacedName{Namespace: "app", Name: "svc-b"}))
+	assert.True(t, RouteReferencesNamespace(route, "other"))
+	assert.False(t, RouteReferencesNamespace(route, "app"))
+	assert.False(t, RouteReferencesNamespace(route, "unrelated"))
+}
@@ -0,0 +1,193 @@
+package gateway
+
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+)
+
+// ProgrammedResult describes whether the Gateway has been programmed on AWS.
+type ProgrammedResult struct {
+	// Programmed is whether the LoadBalancer is deployed.
+	Programmed bool
+	// Reason for the Programmed condition.
+	Reason string
+	// Message for the Programmed condition.
+	Message string
+	// DNSName is the DNS name of the LoadBalancer.
+	DNSName string
+}
+
+// BuildGatewayStatus computes the Gateway status based on evaluated listeners and the deployment result.
+func BuildGatewayStatus(gw Gateway, programmed ProgrammedResult) gwapi.GatewayStatus {
+	status := gwapi.GatewayStatus{
+		Conditions: copyConditions(gw.Gateway.Status.Conditions),
+		Listeners:  gw.Gateway.Status.Listeners,
+	}
+	generation := gw.Gateway.Generation
+
+	acceptedStatus, acceptedReason, acceptedMessage := metav1.ConditionTrue, gwapi.GatewayReasonAccepted, "Gateway is accepted"
+	if len(gw.AcceptedListeners()) == 0 {
+		acceptedStatus, acceptedReason, acceptedMessage = metav1.ConditionFalse, gwapi.GatewayReasonInvalid, "Gateway has no valid listeners"
+	}
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               gwapi.GatewayConditionAccepted,
+		Status:             acceptedStatus,
+		ObservedGeneration: generation,
+		Reason:             acceptedReason,
+		Message:            acceptedMessage,
+	})
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               gwapi.GatewayConditionProgrammed,
+		Status:             conditionStatus(programmed.Programmed),
+		ObservedGeneration: generation,
+		Reason:             programmed.Reason,
+		Message:            programmed.Message,
+	})
+
+	status.Addresses = nil
+	if programmed.Programmed && len(programmed.DNSName) != 0 {
+		addressType := gwapi.AddressTypeHostname
+		status.Addresses = []gwapi.GatewayStatusAddress{
+			{
+				Type:  &addressType,
+				Value: programmed.DNSName,
+			},
+		}
+	}
+
+	existingListenerStatuses := make(map[string]gwapi.ListenerStatus, len(status.Listeners))
+	for _, listenerStatus := range status.Listeners {
+		existingListenerStatuses[listenerStatus.Name] = listenerStatus
+	}
+	status.Listeners = make([]gwapi.ListenerStatus, 0, len(gw.Listeners))
+	for _, result := range gw.Listeners {
+		listenerStatus := gwapi.ListenerStatus{
+			Name:           result.Listener.Name,
+			SupportedKinds: result.SupportedKinds,
+			AttachedRoutes: result.AttachedRoutes,
+			Conditions:     copyConditions(existingListenerStatuses[result.Listener.Name].Conditions),
+		}
+		if listenerStatus.SupportedKinds == nil {
+			listenerStatus.SupportedKinds = []gwapi.RouteGroupKind{}
+		}
+
+		acceptedReason, acceptedMessage := gwapi.ListenerReasonAccepted, "Listener is accepted"
+		if !result.Accepted {
+			acceptedReason, acceptedMessage = result.Reason, result.Message
+		}
+		meta.SetStatusCondition(&listenerStatus.Conditions, metav1.Condition{
+			Type:               gwapi.ListenerConditionAccepted,
+			Status:             conditionStatus(result.Accepted),
+			ObservedGeneration: generation,
+			Reason:             acceptedReason,
+			Message:            acceptedMessage,
+		})
+		resolvedRefsReason, resolvedRefsMessage := gwapi.ListenerReasonResolvedRefs, "All references are resolved"
+		if !result.ResolvedRefs {
+			resolvedRefsReason, resolvedRefsMessage = result.Reason, result.Message
+		}
+		meta.SetStatusCondition(&listenerStatus.Conditions, metav1.Condition{
+			Type:               gwapi.ListenerConditionResolvedRefs,
+			Status:             conditionStatus(result.ResolvedRefs),
+			ObservedGeneration: generation,
+			Reason:             resolvedRefsReason,
+			Message:            resolvedRefsMessage,
+		})
+		listenerProgrammed := result.Accepted && programmed.Programmed
+		programmedReason, programmedMessage := gwapi.ListenerReasonProgrammed, "Listener is programmed"
+		if !result.Accepted {
+			programmedReason, programmedMessage = gwapi.ListenerReasonInvalid, result.Message
+		} else if !programmed.Programmed {
+			programmedReason, programmedMessage = programmed.Reason, programmed.Message
+		}
+		meta.SetStatusCondition(&listenerStatus.Conditions, metav1.Condition{
+			Type:               gwapi.ListenerConditionProgrammed,
+			Status:             conditionStatus(listenerProgrammed),
+			ObservedGeneration: generation,
+			Reason:             programmedReason,
+			Message:            programmedMessage,
+		})
+		status.Listeners = append(status.Listeners, listenerStatus)
+	}
+	return status
+}
+
+// BuildRouteStatus computes the status of route with respect to the Gateway.
+// parent statuses written by other controllers or for other Gateways are preserved.
+func BuildRouteStatus(gw Gateway, route *Route, results []RouteParentResult) gwapi.RouteStatus {
+	controllerName := gw.Class.Spec.ControllerName
+	existingStatus := route.Status
+	status := gwapi.RouteStatus{
+		Parents: []gwapi.RouteParentStatus{},
+	}
+	for _, parentStatus := range existingStatus.Parents {
+		if parentStatus.ControllerName == controllerName && parentRefTargetsGateway(parentStatus.ParentRef, route.ObjectMeta.Namespace, gw.Key()) {
+			continue
+		}
+		status.Parents = append(status.Parents, parentStatus)
+	}
+
+	for _, result := range results {
+		parentStatus := gwapi.RouteParentStatus{
+			ParentRef:      result.ParentRef,
+			ControllerName: controllerName,
+		}
+		for _, existingParentStatus := range existingStatus.Parents {
+			if existingParentStatus.ControllerName == controllerName && equality.Semantic.DeepEqual(existingParentStatus.ParentRef, result.ParentRef) {
+				parentStatus.Conditions = copyConditions(existingParentStatus.Conditions)
+				break
+			}
+		}
+		meta.SetStatusCondition(&parentStatus.Conditions, metav1.Condition{
+			Type:               gwapi.RouteConditionAccepted,
+			Status:             conditionStatus(result.Accepted),
+			ObservedGeneration: route.ObjectMeta.Generation,
+			Reason:             result.AcceptedReason,
+			Message:            result.AcceptedMessage,
+		})
+		meta.SetStatusCondition(&parentStatus.Conditions, metav1.Condition{
+			Type:               gwapi.RouteConditionResolvedRefs,
+			Status:             conditionStatus(result.ResolvedRefs),
+			ObservedGeneration: route.ObjectMeta.Generation,
+			Reason:             result.ResolvedRefsReason,
+			Message:            result.ResolvedRefsMessage,
+		})
+		status.Parents = append(status.Parents, parentStatus)
+	}
+	return status
+}
+
+// GroupRouteParentResultsByRoute groups the RouteParentResults of a Gateway by route.
+func GroupRouteParentResultsByRoute(gw Gateway) ([]*Route, map[*Route][]RouteParentResult) {
+	var routes []*Route
+	resultsByRoute := make(map[*Route][]RouteParentResult)
+	for _, result := range gw.RouteParentResults {
+		if _, exists := resultsByRoute[result.Route]; !exists {
+			routes = append(routes, result.Route)
+		}
+		resultsByRoute[result.Route] = append(resultsByRoute[result.Route], result)
+	}
+	for _, route := range gw.StaleRoutes {
+		routes = append(routes, route)
+		resultsByRoute[route] = nil
+	}
+	return routes, resultsByRoute
+}
+
+func conditionStatus(value bool) metav1.ConditionStatus {
+	if value {
+		return metav1.ConditionTrue
+	}
+	return metav1.ConditionFalse
+}
+
+func copyConditions(conditions []metav1.Condition) []metav1.Condition {
+	if conditions == nil {
+		return nil
+	}
+	copied := make([]metav1.Condition, len(conditions))
+	copy(copied, conditions)
+	return copied
+}
@@ -0,0 +1,100 @@
+package gateway
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+)
+
+func Test_BuildRouteStatus(t *testing.T) {
+	gw := Gateway{
+		Gateway: &gwapi.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gw"},
+		},
+		Class: &gwapi.GatewayClass{
+			Spec: gwapi.GatewayClassSpec{ControllerName: ControllerNameALB},
+		},
+	}
+	gwParentRef := gwapi.ParentReference{Name: "gw"}
+	otherParentStatus := gwapi.RouteParentStatus{
+		ParentRef:      gwapi.ParentReference{Name: "gw"},
+		ControllerName: "example.com/other",
+	}
+	listenerParentRef := gwapi.ParentReference{Name: "gw", SectionName: awssdk.String("http")}
+	route := &Route{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "route", Generation: 2},
+		Status: gwapi.RouteStatus{
+			Parents: []gwapi.RouteParentStatus{
+				otherParentStatus,
+				{
+					ParentRef:      listenerParentRef,
+					ControllerName: ControllerNameALB,
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		results []RouteParentResult
+		want    []gwapi.RouteParentStatus
+	}{
+		{
+			name: "parent status replaced",
+			results: []RouteParentResult{
+				{
+					Route:               route,
+					ParentRef:           gwParentRef,
+					Accepted:            true,
+					AcceptedReason:      gwapi.RouteReasonAccepted,
+					AcceptedMessage:     "Route is accepted",
+					ResolvedRefsReason:  gwapi.RouteReasonBackendNotFound,
+					ResolvedRefsMessage: "service not found: ns/svc",
+				},
+			},
+			want: []gwapi.RouteParentStatus{
+				otherParentStatus,
+				{
+					ParentRef:      gwParentRef,
+					ControllerName: ControllerNameALB,
+					Conditions: []metav1.Condition{
+						{
+							Type:               gwapi.RouteConditionAccepted,
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							Reason:             gwapi.RouteReasonAccepted,
+							Message:            "Route is accepted",
+						},
+						{
+							Type:               gwapi.RouteConditionResolvedRefs,
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 2,
+							Reason:             gwapi.RouteReasonBackendNotFound,
+							Message:            "service not found: ns/svc",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "stale parent status removed",
+			want: []gwapi.RouteParentStatus{
+				otherParentStatus,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildRouteStatus(gw, route, tt.results)
+			for i := range got.Parents {
+				for j := range got.Parents[i].Conditions {
+					got.Parents[i].Conditions[j].LastTransitionTime = metav1.Time{}
+				}
+			}
+			assert.Equal(t, tt.want, got.Parents)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package ingress
 
 import (
 	"context"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"testing"
@@ -133,6 +134,15 @@ func Test_defaultAuthConfigBuilder_Build(t *testing.T) {
 				SessionTimeout:           86400,
 			},
 		},
+		{
+			name: "unknown auth type",
+			args: args{
+				svcAndIngAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/auth-type": "saml",
+				},
+			},
+			wantErr: errors.New("unknown authType: saml"),
+		},
 		{
 			name: "no auth annotation",
 			args: args{
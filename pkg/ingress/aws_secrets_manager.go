@@ -0,0 +1,266 @@
+package ingress
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// the values of secrets will be cached for 10 minutes.
+	defaultAWSSecretValuesCacheTTL = 10 * time.Minute
+	// the version of monitored secrets will be checked every minute.
+	defaultAWSSecretVersionPollInterval = 1 * time.Minute
+	// the staging label of the current version of a secret.
+	awsSecretVersionStageCurrent = "AWSCURRENT"
+
+	// AWSSecretTagKeyNamespace is the AWS tag naming the namespace whose Ingresses are allowed to reference the secret.
+	AWSSecretTagKeyNamespace = "ingress.k8s.aws/namespace"
+)
+
+// AWSSecretsManager loads and monitors the AWS Secrets Manager secrets referenced by Ingresses.
+type AWSSecretsManager interface {
+	// GetSecretValues returns the key/value pairs in the JSON secret string of the secret with secretARN.
+	GetSecretValues(ctx context.Context, secretARN string) (map[string]string, error)
+
+	// GetSecretTags returns the AWS tags of the secret with secretARN.
+	GetSecretTags(ctx context.Context, secretARN string) (map[string]string, error)
+
+	// MonitorSecrets monitors the version of secrets referenced by the IngressGroup,
+	// the IngressGroup is requeued when the current version of any of them changes.
+	MonitorSecrets(groupID GroupID, secretARNs []string)
+}
+
+// NewDefaultAWSSecretsManager constructs new defaultAWSSecretsManager.
+// a GenericEvent for the IngressGroup is sent to groupEventChan when its secrets changes, with IngressGroup ID as namespace/name.
+func NewDefaultAWSSecretsManager(secretsManagerClient services.SecretsManager, groupEventChan chan<- event.GenericEvent, logger logr.Logger) *defaultAWSSecretsManager {
+	return &defaultAWSSecretsManager{
+		secretsManagerClient: secretsManagerClient,
+		groupEventChan:       groupEventChan,
+		logger:               logger,
+		secretValuesCache:    cache.NewExpiring(),
+		secretTagsCache:      cache.NewExpiring(),
+		secretValuesCacheTTL: defaultAWSSecretValuesCacheTTL,
+		secretItemByARN:      make(map[string]*awsSecretItem),
+		pollInterval:         defaultAWSSecretVersionPollInterval,
+	}
+}
+
+var _ AWSSecretsManager = &defaultAWSSecretsManager{}
+var _ manager.Runnable = &defaultAWSSecretsManager{}
+
+// default implementation for AWSSecretsManager.
+type defaultAWSSecretsManager struct {
+	secretsManagerClient services.SecretsManager
+	groupEventChan       chan<- event.GenericEvent
+	logger               logr.Logger
+
+	secretValuesCache    *cache.Expiring
+	secretTagsCache      *cache.Expiring
+	secretValuesCacheTTL time.Duration
+
+	// mutex protects secretItemByARN
+	mutex           sync.Mutex
+	secretItemByARN map[string]*awsSecretItem
+	pollInterval    time.Duration
+}
+
+// awsSecretValues is the cached values of a secret.
+type awsSecretValues struct {
+	versionID string
+	values    map[string]string
+}
+
+// awsSecretItem is a monitored secret.
+type awsSecretItem struct {
+	// the last known current versionID of secret.
+	versionID string
+	// the IngressGroups referencing secret.
+	groupIDs map[GroupID]struct{}
+}
+
+func (m *defaultAWSSecretsManager) GetSecretValues(ctx context.Context, secretARN string) (map[string]string, error) {
+	if rawCacheItem, exists := m.secretValuesCache.Get(secretARN); exists {
+		return rawCacheItem.(awsSecretValues).values, nil
+	}
+	req := &secretsmanager.GetSecretValueInput{
+		SecretId: awssdk.String(secretARN),
+	}
+	resp, err := m.secretsManagerClient.GetSecretValueWithContext(ctx, req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get value of secret: %v", secretARN)
+	}
+	values, err := parseAWSSecretString(resp.SecretString)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid value of secret: %v", secretARN)
+	}
+	m.secretValuesCache.Set(secretARN, awsSecretValues{
+		versionID: awssdk.StringValue(resp.VersionId),
+		values:    values,
+	}, m.secretValuesCacheTTL)
+	return values, nil
+}
+
+func (m *defaultAWSSecretsManager) GetSecretTags(ctx context.Context, secretARN string) (map[string]string, error) {
+	if rawCacheItem, exists := m.secretTagsCache.Get(secretARN); exists {
+		return rawCacheItem.(map[string]string), nil
+	}
+	req := &secretsmanager.DescribeSecretInput{
+		SecretId: awssdk.String(secretARN),
+	}
+	resp, err := m.secretsManagerClient.DescribeSecretWithContext(ctx, req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe secret: %v", secretARN)
+	}
+	tags := convertAWSSecretTags(resp.Tags)
+	m.secretTagsCache.Set(secretARN, tags, m.secretValuesCacheTTL)
+	return tags, nil
+}
+
+func (m *defaultAWSSecretsManager) MonitorSecrets(groupID GroupID, secretARNs []string) {
+	m.logger.V(1).Info("Monitoring AWS secrets", "groupID", groupID, "secretARNs", secretARNs)
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	inputSecretARNs := sets.NewString(secretARNs...)
+	for _, secretARN := range inputSecretARNs.List() {
+		item, exists := m.secretItemByARN[secretARN]
+		if !exists {
+			item = &awsSecretItem{groupIDs: make(map[GroupID]struct{})}
+			// the version that values were loaded from is the baseline to detect changes.
+			if rawCacheItem, exists := m.secretValuesCache.Get(secretARN); exists {
+				item.versionID = rawCacheItem.(awsSecretValues).versionID
+			}
+			m.secretItemByARN[secretARN] = item
+		}
+		item.groupIDs[groupID] = struct{}{}
+	}
+
+	for secretARN, item := range m.secretItemByARN {
+		if inputSecretARNs.Has(secretARN) {
+			continue
+		}
+		delete(item.groupIDs, groupID)
+		if len(item.groupIDs) == 0 {
+			delete(m.secretItemByARN, secretARN)
+		}
+	}
+}
+
+// Start polls the version of monitored secrets until ctx is done.
+func (m *defaultAWSSecretsManager) Start(ctx context.Context) error {
+	wait.Until(func() {
+		m.pollSecretVersions(ctx)
+	}, m.pollInterval, ctx.Done())
+	return nil
+}
+
+// pollSecretVersions checks the current version of monitored secrets and requeue IngressGroups referencing changed ones.
+func (m *defaultAWSSecretsManager) pollSecretVersions(ctx context.Context) {
+	for _, secretARN := range m.monitoredSecretARNs() {
+		req := &secretsmanager.DescribeSecretInput{
+			SecretId: awssdk.String(secretARN),
+		}
+		resp, err := m.secretsManagerClient.DescribeSecretWithContext(ctx, req)
+		if err != nil {
+			m.logger.Error(err, "failed to describe secret", "secretARN", secretARN)
+			continue
+		}
+		m.secretTagsCache.Set(secretARN, convertAWSSecretTags(resp.Tags), m.secretValuesCacheTTL)
+		for _, groupID := range m.updateSecretVersion(secretARN, findAWSSecretCurrentVersionID(resp.VersionIdsToStages)) {
+			m.logger.V(1).Info("enqueue ingressGroup for AWS secret change", "groupID", groupID, "secretARN", secretARN)
+			groupEvent := event.GenericEvent{
+				Object: &metav1.PartialObjectMetadata{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: groupID.Namespace,
+						Name:      groupID.Name,
+					},
+				},
+			}
+			select {
+			case m.groupEventChan <- groupEvent:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// monitoredSecretARNs returns the ARN of monitored secrets.
+func (m *defaultAWSSecretsManager) monitoredSecretARNs() []string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	secretARNs := make([]string, 0, len(m.secretItemByARN))
+	for secretARN := range m.secretItemByARN {
+		secretARNs = append(secretARNs, secretARN)
+	}
+	return secretARNs
+}
+
+// updateSecretVersion records the current versionID of monitored secret.
+// the cached values are invalidated and IngressGroups referencing the secret are returned if it's changed.
+func (m *defaultAWSSecretsManager) updateSecretVersion(secretARN string, versionID string) []GroupID {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	item, exists := m.secretItemByARN[secretARN]
+	if !exists || len(versionID) == 0 || item.versionID == versionID {
+		return nil
+	}
+	previousVersionID := item.versionID
+	item.versionID = versionID
+	m.secretValuesCache.Delete(secretARN)
+	if len(previousVersionID) == 0 {
+		return nil
+	}
+	groupIDs := make([]GroupID, 0, len(item.groupIDs))
+	for groupID := range item.groupIDs {
+		groupIDs = append(groupIDs, groupID)
+	}
+	return groupIDs
+}
+
+// convertAWSSecretTags converts the AWS tags of secret into a map.
+func convertAWSSecretTags(sdkTags []*secretsmanager.Tag) map[string]string {
+	tags := make(map[string]string, len(sdkTags))
+	for _, tag := range sdkTags {
+		tags[awssdk.StringValue(tag.Key)] = awssdk.StringValue(tag.Value)
+	}
+	return tags
+}
+
+// parseAWSSecretString parses the key/value pairs in JSON secret string.
+func parseAWSSecretString(secretString *string) (map[string]string, error) {
+	if secretString == nil {
+		return nil, errors.New("secret string is required")
+	}
+	var values map[string]string
+	if err := json.Unmarshal([]byte(*secretString), &values); err != nil {
+		return nil, errors.Wrap(err, "secret string must be a JSON object with string values")
+	}
+	return values, nil
+}
+
+// findAWSSecretCurrentVersionID finds the versionID of the current version from versionIDs to staging labels.
+func findAWSSecretCurrentVersionID(versionIDsToStages map[string][]*string) string {
+	for versionID, stages := range versionIDsToStages {
+		for _, stage := range stages {
+			if awssdk.StringValue(stage) == awsSecretVersionStageCurrent {
+				return versionID
+			}
+		}
+	}
+	return ""
+}
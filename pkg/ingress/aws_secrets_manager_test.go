@@ -0,0 +1,232 @@
+package ingress
+
+import (
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultAWSSecretsManager_MonitorSecrets(t *testing.T) {
+	groupA := GroupID{Namespace: "", Name: "group-a"}
+	groupB := GroupID{Namespace: "ns", Name: "ing-b"}
+	type monitorCall struct {
+		groupID    GroupID
+		secretARNs []string
+	}
+	tests := []struct {
+		name                 string
+		cachedVersionIDByARN map[string]string
+		calls                []monitorCall
+		want                 map[string]*awsSecretItem
+	}{
+		{
+			name: "monitor secrets of single group",
+			cachedVersionIDByARN: map[string]string{
+				"arn-1": "v1",
+			},
+			calls: []monitorCall{
+				{groupID: groupA, secretARNs: []string{"arn-1", "arn-2"}},
+			},
+			want: map[string]*awsSecretItem{
+				"arn-1": {versionID: "v1", groupIDs: map[GroupID]struct{}{groupA: {}}},
+				"arn-2": {versionID: "", groupIDs: map[GroupID]struct{}{groupA: {}}},
+			},
+		},
+		{
+			name: "monitor secrets of multiple groups",
+			calls: []monitorCall{
+				{groupID: groupA, secretARNs: []string{"arn-1"}},
+				{groupID: groupB, secretARNs: []string{"arn-1", "arn-2"}},
+			},
+			want: map[string]*awsSecretItem{
+				"arn-1": {groupIDs: map[GroupID]struct{}{groupA: {}, groupB: {}}},
+				"arn-2": {groupIDs: map[GroupID]struct{}{groupB: {}}},
+			},
+		},
+		{
+			name: "secrets no longer referenced are not monitored",
+			calls: []monitorCall{
+				{groupID: groupA, secretARNs: []string{"arn-1", "arn-2"}},
+				{groupID: groupB, secretARNs: []string{"arn-1"}},
+				{groupID: groupA, secretARNs: nil},
+			},
+			want: map[string]*awsSecretItem{
+				"arn-1": {groupIDs: map[GroupID]struct{}{groupB: {}}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewDefaultAWSSecretsManager(nil, nil, &log.NullLogger{})
+			for secretARN, versionID := range tt.cachedVersionIDByARN {
+				m.secretValuesCache.Set(secretARN, awsSecretValues{versionID: versionID}, time.Hour)
+			}
+			for _, call := range tt.calls {
+				m.MonitorSecrets(call.groupID, call.secretARNs)
+			}
+			assert.Equal(t, tt.want, m.secretItemByARN)
+		})
+	}
+}
+
+func Test_defaultAWSSecretsManager_updateSecretVersion(t *testing.T) {
+	groupA := GroupID{Namespace: "", Name: "group-a"}
+	tests := []struct {
+		name              string
+		monitoredItem     *awsSecretItem
+		versionID         string
+		wantGroupIDs      []GroupID
+		wantVersionID     string
+		wantCacheRetained bool
+	}{
+		{
+			name:              "version unchanged",
+			monitoredItem:     &awsSecretItem{versionID: "v1", groupIDs: map[GroupID]struct{}{groupA: {}}},
+			versionID:         "v1",
+			wantGroupIDs:      nil,
+			wantVersionID:     "v1",
+			wantCacheRetained: true,
+		},
+		{
+			name:              "version changed",
+			monitoredItem:     &awsSecretItem{versionID: "v1", groupIDs: map[GroupID]struct{}{groupA: {}}},
+			versionID:         "v2",
+			wantGroupIDs:      []GroupID{groupA},
+			wantVersionID:     "v2",
+			wantCacheRetained: false,
+		},
+		{
+			name:              "version recorded for the first time",
+			monitoredItem:     &awsSecretItem{groupIDs: map[GroupID]struct{}{groupA: {}}},
+			versionID:         "v2",
+			wantGroupIDs:      nil,
+			wantVersionID:     "v2",
+			wantCacheRetained: false,
+		},
+		{
+			name:              "current version unknown",
+			monitoredItem:     &awsSecretItem{versionID: "v1", groupIDs: map[GroupID]struct{}{groupA: {}}},
+			versionID:         "",
+			wantGroupIDs:      nil,
+			wantVersionID:     "v1",
+			wantCacheRetained: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewDefaultAWSSecretsManager(nil, nil, &log.NullLogger{})
+			m.secretItemByARN["arn-1"] = tt.monitoredItem
+			m.secretValuesCache.Set("arn-1", awsSecretValues{versionID: "v1"}, time.Hour)
+
+			gotGroupIDs := m.updateSecretVersion("arn-1", tt.versionID)
+			assert.Equal(t, tt.wantGroupIDs, gotGroupIDs)
+			assert.Equal(t, tt.wantVersionID, m.secretItemByARN["arn-1"].versionID)
+			_, cacheRetained := m.secretValuesCache.Get("arn-1")
+			assert.Equal(t, tt.wantCacheRetained, cacheRetained)
+		})
+	}
+}
+
+func Test_convertAWSSecretTags(t *testing.T) {
+	tests := []struct {
+		name    string
+		sdkTags []*secretsmanager.Tag
+		want    map[string]string
+	}{
+		{
+			name:    "no tags",
+			sdkTags: nil,
+			want:    map[string]string{},
+		},
+		{
+			name: "multiple tags",
+			sdkTags: []*secretsmanager.Tag{
+				{Key: awssdk.String("elbv2.k8s.aws/cluster"), Value: awssdk.String("my-cluster")},
+				{Key: awssdk.String("ingress.k8s.aws/namespace"), Value: awssdk.String("my-ns")},
+			},
+			want: map[string]string{
+				"elbv2.k8s.aws/cluster":     "my-cluster",
+				"ingress.k8s.aws/namespace": "my-ns",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := convertAWSSecretTags(tt.sdkTags)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseAWSSecretString(t *testing.T) {
+	tests := []struct {
+		name         string
+		secretString *string
+		want         map[string]string
+		wantErr      error
+	}{
+		{
+			name:         "JSON object",
+			secretString: awssdk.String(`{"clientID":"my-client-id","clientSecret":"my-client-secret"}`),
+			want: map[string]string{
+				"clientID":     "my-client-id",
+				"clientSecret": "my-client-secret",
+			},
+		},
+		{
+			name:         "binary secret",
+			secretString: nil,
+			wantErr:      errors.New("secret string is required"),
+		},
+		{
+			name:         "plain text",
+			secretString: awssdk.String("my-client-secret"),
+			wantErr:      errors.New("secret string must be a JSON object with string values: invalid character 'm' looking for beginning of value"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseAWSSecretString(tt.secretString)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_findAWSSecretCurrentVersionID(t *testing.T) {
+	tests := []struct {
+		name               string
+		versionIDsToStages map[string][]*string
+		want               string
+	}{
+		{
+			name: "current version exists",
+			versionIDsToStages: map[string][]*string{
+				"v1": {awssdk.String("AWSPREVIOUS")},
+				"v2": {awssdk.String("AWSCURRENT"), awssdk.String("custom")},
+				"v3": {awssdk.String("AWSPENDING")},
+			},
+			want: "v2",
+		},
+		{
+			name:               "no versions",
+			versionIDsToStages: nil,
+			want:               "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findAWSSecretCurrentVersionID(tt.versionIDsToStages)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
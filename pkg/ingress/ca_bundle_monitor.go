@@ -0,0 +1,205 @@
+package ingress
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+// CABundleMonitor watches the ConfigMaps and Secrets holding the CA certificates bundles referenced by IngressGroups.
+type CABundleMonitor interface {
+	// MonitorCABundles watches the CA certificates bundles in configMaps and secrets referenced by the IngressGroup,
+	// the IngressGroup is requeued when any of them changes.
+	MonitorCABundles(groupID GroupID, configMaps []types.NamespacedName, secrets []types.NamespacedName)
+}
+
+// NewDefaultCABundleMonitor constructs new defaultCABundleMonitor.
+// a GenericEvent for the IngressGroup is sent to groupEventChan when its CA certificates bundles changes, with IngressGroup ID as namespace/name.
+func NewDefaultCABundleMonitor(clientSet kubernetes.Interface, groupEventChan chan<- event.GenericEvent, logger logr.Logger) *defaultCABundleMonitor {
+	return &defaultCABundleMonitor{
+		clientSet:      clientSet,
+		groupEventChan: groupEventChan,
+		logger:         logger,
+		itemBySource:   make(map[caBundleSource]*caBundleItem),
+	}
+}
+
+var _ CABundleMonitor = &defaultCABundleMonitor{}
+
+// default implementation for CABundleMonitor.
+// each ConfigMap or Secret is watched individually, as they are not cached by the controller.
+type defaultCABundleMonitor struct {
+	clientSet      kubernetes.Interface
+	groupEventChan chan<- event.GenericEvent
+	logger         logr.Logger
+
+	// mutex protects itemBySource
+	mutex        sync.Mutex
+	itemBySource map[caBundleSource]*caBundleItem
+}
+
+// caBundleSource is the ConfigMap or Secret holding CA certificates bundles.
+type caBundleSource struct {
+	kind string
+	key  types.NamespacedName
+}
+
+// caBundleItem is a watched ConfigMap or Secret.
+type caBundleItem struct {
+	// the IngressGroups referencing the ConfigMap or Secret.
+	groupIDs map[GroupID]struct{}
+	stopCh   chan struct{}
+}
+
+func (m *defaultCABundleMonitor) MonitorCABundles(groupID GroupID, configMaps []types.NamespacedName, secrets []types.NamespacedName) {
+	m.logger.V(1).Info("Monitoring CA bundles", "groupID", groupID, "configMaps", configMaps, "secrets", secrets)
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	inputSources := make(map[caBundleSource]struct{}, len(configMaps)+len(secrets))
+	for _, key := range configMaps {
+		inputSources[caBundleSource{kind: string(elbv2api.CABundleSourceKindConfigMap), key: key}] = struct{}{}
+	}
+	for _, key := range secrets {
+		inputSources[caBundleSource{kind: string(elbv2api.CABundleSourceKindSecret), key: key}] = struct{}{}
+	}
+	for source := range inputSources {
+		item, exists := m.itemBySource[source]
+		if !exists {
+			m.logger.V(1).Info("CA bundle is not being monitored, adding watch", "kind", source.kind, "item", source.key)
+			item = m.newCABundleItem(source)
+			m.itemBySource[source] = item
+		}
+		item.groupIDs[groupID] = struct{}{}
+	}
+
+	for source, item := range m.itemBySource {
+		if _, exists := inputSources[source]; exists {
+			continue
+		}
+		delete(item.groupIDs, groupID)
+		if len(item.groupIDs) == 0 {
+			m.logger.V(1).Info("CA bundle no longer needs monitoring, stopping the watch", "kind", source.kind, "item", source.key)
+			close(item.stopCh)
+			delete(m.itemBySource, source)
+		}
+	}
+}
+
+// newCABundleItem starts the watch on source.
+// changes after the initial listing requeue the IngressGroups referencing source.
+func (m *defaultCABundleMonitor) newCABundleItem(source caBundleSource) *caBundleItem {
+	item := &caBundleItem{
+		groupIDs: make(map[GroupID]struct{}),
+		stopCh:   make(chan struct{}),
+	}
+	// HasSynced of informer cannot be called from event handlers, as it locks the queue that is locked while handling events.
+	var synced int32
+	_, informer := cache.NewInformer(m.newListWatch(source), m.newObject(source), 0, cache.ResourceEventHandlerFuncs{
+		AddFunc: func(_ interface{}) {
+			if atomic.LoadInt32(&synced) == 1 {
+				m.enqueueGroups(source)
+			}
+		},
+		UpdateFunc: func(oldObj interface{}, newObj interface{}) {
+			if !isCABundleDataChanged(oldObj, newObj) {
+				return
+			}
+			m.enqueueGroups(source)
+		},
+		DeleteFunc: func(_ interface{}) {
+			m.enqueueGroups(source)
+		},
+	})
+	go informer.Run(item.stopCh)
+	go func() {
+		if cache.WaitForCacheSync(item.stopCh, informer.HasSynced) {
+			atomic.StoreInt32(&synced, 1)
+		}
+	}()
+	return item
+}
+
+func (m *defaultCABundleMonitor) newListWatch(source caBundleSource) *cache.ListWatch {
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", source.key.Name).String()
+	if source.kind == string(elbv2api.CABundleSourceKindSecret) {
+		return &cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				options.FieldSelector = fieldSelector
+				return m.clientSet.CoreV1().Secrets(source.key.Namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				options.FieldSelector = fieldSelector
+				return m.clientSet.CoreV1().Secrets(source.key.Namespace).Watch(context.TODO(), options)
+			},
+		}
+	}
+	return &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.FieldSelector = fieldSelector
+			return m.clientSet.CoreV1().ConfigMaps(source.key.Namespace).List(context.TODO(), options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = fieldSelector
+			return m.clientSet.CoreV1().ConfigMaps(source.key.Namespace).Watch(context.TODO(), options)
+		},
+	}
+}
+
+func (m *defaultCABundleMonitor) newObject(source caBundleSource) runtime.Object {
+	if source.kind == string(elbv2api.CABundleSourceKindSecret) {
+		return &corev1.Secret{}
+	}
+	return &corev1.ConfigMap{}
+}
+
+// enqueueGroups requeues the IngressGroups referencing source.
+func (m *defaultCABundleMonitor) enqueueGroups(source caBundleSource) {
+	m.mutex.Lock()
+	var groupIDs []GroupID
+	if item, exists := m.itemBySource[source]; exists {
+		for groupID := range item.groupIDs {
+			groupIDs = append(groupIDs, groupID)
+		}
+	}
+	m.mutex.Unlock()
+
+	for _, groupID := range groupIDs {
+		m.logger.V(1).Info("enqueue ingressGroup for CA bundle change", "groupID", groupID,
+			"kind", source.kind, "item", source.key)
+		m.groupEventChan <- event.GenericEvent{
+			Object: &metav1.PartialObjectMetadata{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: groupID.Namespace,
+					Name:      groupID.Name,
+				},
+			},
+		}
+	}
+}
+
+// isCABundleDataChanged checks whether the data of ConfigMap or Secret is changed.
+func isCABundleDataChanged(oldObj interface{}, newObj interface{}) bool {
+	oldConfigMap, isConfigMap := oldObj.(*corev1.ConfigMap)
+	if isConfigMap {
+		return !equality.Semantic.DeepEqual(oldConfigMap.Data, newObj.(*corev1.ConfigMap).Data)
+	}
+	oldSecret, isSecret := oldObj.(*corev1.Secret)
+	if isSecret {
+		return !equality.Semantic.DeepEqual(oldSecret.Data, newObj.(*corev1.Secret).Data)
+	}
+	return true
+}
@@ -0,0 +1,179 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultCABundleMonitor_MonitorCABundles(t *testing.T) {
+	type monitorCABundlesCall struct {
+		groupID    GroupID
+		configMaps []types.NamespacedName
+		secrets    []types.NamespacedName
+	}
+	tests := []struct {
+		name                  string
+		monitorCABundlesCalls []monitorCABundlesCall
+		wantGroupIDsBySource  map[caBundleSource][]GroupID
+	}{
+		{
+			name:                 "no CA bundles",
+			wantGroupIDsBySource: map[caBundleSource][]GroupID{},
+		},
+		{
+			name: "ConfigMap and Secret with same name",
+			monitorCABundlesCalls: []monitorCABundlesCall{
+				{
+					groupID:    GroupID{Name: "group-1"},
+					configMaps: []types.NamespacedName{{Namespace: "ns-1", Name: "ca"}},
+					secrets:    []types.NamespacedName{{Namespace: "ns-1", Name: "ca"}},
+				},
+			},
+			wantGroupIDsBySource: map[caBundleSource][]GroupID{
+				{kind: "ConfigMap", key: types.NamespacedName{Namespace: "ns-1", Name: "ca"}}: {{Name: "group-1"}},
+				{kind: "Secret", key: types.NamespacedName{Namespace: "ns-1", Name: "ca"}}:    {{Name: "group-1"}},
+			},
+		},
+		{
+			name: "multiple groups, overlapping CA bundles",
+			monitorCABundlesCalls: []monitorCABundlesCall{
+				{
+					groupID:    GroupID{Name: "group-1"},
+					configMaps: []types.NamespacedName{{Namespace: "ns-1", Name: "ca-1"}, {Namespace: "ns-1", Name: "ca-2"}},
+				},
+				{
+					groupID:    GroupID{Namespace: "ns-2", Name: "ing-1"},
+					configMaps: []types.NamespacedName{{Namespace: "ns-1", Name: "ca-2"}},
+				},
+			},
+			wantGroupIDsBySource: map[caBundleSource][]GroupID{
+				{kind: "ConfigMap", key: types.NamespacedName{Namespace: "ns-1", Name: "ca-1"}}: {{Name: "group-1"}},
+				{kind: "ConfigMap", key: types.NamespacedName{Namespace: "ns-1", Name: "ca-2"}}: {{Name: "group-1"}, {Namespace: "ns-2", Name: "ing-1"}},
+			},
+		},
+		{
+			name: "CA bundles no longer referenced are not monitored",
+			monitorCABundlesCalls: []monitorCABundlesCall{
+				{
+					groupID:    GroupID{Name: "group-1"},
+					configMaps: []types.NamespacedName{{Namespace: "ns-1", Name: "ca-1"}, {Namespace: "ns-1", Name: "ca-2"}},
+				},
+				{
+					groupID:    GroupID{Namespace: "ns-2", Name: "ing-1"},
+					configMaps: []types.NamespacedName{{Namespace: "ns-1", Name: "ca-2"}},
+				},
+				{
+					groupID:    GroupID{Name: "group-1"},
+					configMaps: nil,
+				},
+			},
+			wantGroupIDsBySource: map[caBundleSource][]GroupID{
+				{kind: "ConfigMap", key: types.NamespacedName{Namespace: "ns-1", Name: "ca-2"}}: {{Namespace: "ns-2", Name: "ing-1"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewDefaultCABundleMonitor(fake.NewSimpleClientset(), make(chan event.GenericEvent), &log.NullLogger{})
+			for _, call := range tt.monitorCABundlesCalls {
+				m.MonitorCABundles(call.groupID, call.configMaps, call.secrets)
+			}
+			gotGroupIDsBySource := make(map[caBundleSource][]GroupID, len(m.itemBySource))
+			for source, item := range m.itemBySource {
+				for groupID := range item.groupIDs {
+					gotGroupIDsBySource[source] = append(gotGroupIDsBySource[source], groupID)
+				}
+			}
+			for source := range tt.wantGroupIDsBySource {
+				assert.ElementsMatch(t, tt.wantGroupIDsBySource[source], gotGroupIDsBySource[source])
+			}
+			assert.Equal(t, len(tt.wantGroupIDsBySource), len(gotGroupIDsBySource))
+			m.MonitorCABundles(GroupID{Name: "group-1"}, nil, nil)
+			m.MonitorCABundles(GroupID{Namespace: "ns-2", Name: "ing-1"}, nil, nil)
+		})
+	}
+}
+
+func Test_defaultCABundleMonitor_enqueueGroupsOnChange(t *testing.T) {
+	ctx := context.Background()
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ca"},
+		Data:       map[string]string{"ca.crt": "v0"},
+	}
+	clientSet := fake.NewSimpleClientset(configMap)
+	groupEventChan := make(chan event.GenericEvent)
+	m := NewDefaultCABundleMonitor(clientSet, groupEventChan, &log.NullLogger{})
+	m.MonitorCABundles(GroupID{Namespace: "ns-1", Name: "ing-1"}, []types.NamespacedName{{Namespace: "ns-1", Name: "ca"}}, nil)
+	defer m.MonitorCABundles(GroupID{Namespace: "ns-1", Name: "ing-1"}, nil, nil)
+
+	// the watch is established asynchronously, so the ConfigMap is changed until the change is observed.
+	var gotEvent *event.GenericEvent
+	for i := 1; i <= 50 && gotEvent == nil; i++ {
+		updatedConfigMap := configMap.DeepCopy()
+		updatedConfigMap.Data["ca.crt"] = fmt.Sprintf("v%d", i)
+		_, err := clientSet.CoreV1().ConfigMaps("ns-1").Update(ctx, updatedConfigMap, metav1.UpdateOptions{})
+		assert.NoError(t, err)
+		select {
+		case e := <-groupEventChan:
+			gotEvent = &e
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	if assert.NotNil(t, gotEvent) {
+		assert.Equal(t, "ns-1", gotEvent.Object.GetNamespace())
+		assert.Equal(t, "ing-1", gotEvent.Object.GetName())
+	}
+}
+
+func Test_isCABundleDataChanged(t *testing.T) {
+	tests := []struct {
+		name   string
+		oldObj interface{}
+		newObj interface{}
+		want   bool
+	}{
+		{
+			name:   "ConfigMap data changed",
+			oldObj: &corev1.ConfigMap{Data: map[string]string{"ca.crt": "v1"}},
+			newObj: &corev1.ConfigMap{Data: map[string]string{"ca.crt": "v2"}},
+			want:   true,
+		},
+		{
+			name:   "ConfigMap labels changed",
+			oldObj: &corev1.ConfigMap{Data: map[string]string{"ca.crt": "v1"}},
+			newObj: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "my-app"}},
+				Data:       map[string]string{"ca.crt": "v1"},
+			},
+			want: false,
+		},
+		{
+			name:   "Secret data changed",
+			oldObj: &corev1.Secret{Data: map[string][]byte{"ca.crt": []byte("v1")}},
+			newObj: &corev1.Secret{Data: map[string][]byte{"ca.crt": []byte("v2")}},
+			want:   true,
+		},
+		{
+			name:   "Secret data unchanged",
+			oldObj: &corev1.Secret{Data: map[string][]byte{"ca.crt": []byte("v1")}},
+			newObj: &corev1.Secret{Data: map[string][]byte{"ca.crt": []byte("v1")}},
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isCABundleDataChanged(tt.oldObj, tt.newObj)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
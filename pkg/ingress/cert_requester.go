@@ -0,0 +1,439 @@
+package ingress
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/acm"
+	rgtsdk "github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+const (
+	// the TTL for DNS validation records.
+	defaultValidationRecordTTL = 300
+	// ACM only allows idempotency tokens up to 32 characters.
+	maxIdempotencyTokenLength = 32
+	// the Ingress is requeued until the requested certificates are discovered, which are listed every minute.
+	defaultCertRequestRequeueDuration = 1 * time.Minute
+	// the resource type of ACM certificates in resource groups tagging API.
+	resourceTypeFilterCertificate = "acm:certificate"
+)
+
+// CertRequest is the status of the certificate requested for a tlsHost.
+type CertRequest struct {
+	// the tlsHost.
+	Host string
+	// the requested certificateARN.
+	CertARN string
+	// the ACM status of the requested certificate.
+	Status string
+	// Requested is whether the certificate is newly requested.
+	Requested bool
+}
+
+// CertRequester is responsible for requesting TLS certificates for tls hosts without certificates.
+// the certificates are requested on behalf of a stack, and only the certificates requested for the stack are reused or cleaned up.
+type CertRequester interface {
+	// Request will request certificates for each tlsHost unless already requested, and DNS-validate the pending ones.
+	Request(ctx context.Context, stackID core.StackID, tlsHosts []string) ([]CertRequest, error)
+
+	// Cleanup deletes the certificates requested for tlsHosts other than the given ones, along with their DNS validation records.
+	// certificates that are still in use are kept until a later cleanup.
+	Cleanup(ctx context.Context, stackID core.StackID, tlsHosts []string) error
+}
+
+// NewACMCertRequester constructs new acmCertRequester
+func NewACMCertRequester(acmClient services.ACM, rgtClient services.RGT, route53Client services.Route53, hostedZoneIDs []string,
+	trackingProvider tracking.Provider, defaultTags map[string]string, logger logr.Logger) *acmCertRequester {
+	return &acmCertRequester{
+		acmClient:        acmClient,
+		rgtClient:        rgtClient,
+		route53Client:    route53Client,
+		hostedZoneIDs:    hostedZoneIDs,
+		trackingProvider: trackingProvider,
+		defaultTags:      defaultTags,
+		logger:           logger,
+
+		loadHostedZonesMutex: sync.Mutex{},
+	}
+}
+
+var _ CertRequester = &acmCertRequester{}
+
+// CertRequester implementation for ACM certificates validated via DNS records in Route53 hosted zones.
+type acmCertRequester struct {
+	acmClient        services.ACM
+	rgtClient        services.RGT
+	route53Client    services.Route53
+	hostedZoneIDs    []string
+	trackingProvider tracking.Provider
+	defaultTags      map[string]string
+	logger           logr.Logger
+
+	// mutex to serialize the call to loadHostedZoneIDByName
+	loadHostedZonesMutex sync.Mutex
+	hostedZoneIDByName   map[string]string
+}
+
+// requestedCert is a certificate requested for stack.
+type requestedCert struct {
+	certARN string
+	host    string
+	status  string
+	inUse   bool
+	// the DNS validation records of certificate.
+	domainValidationOptions []*acm.DomainValidation
+}
+
+func (r *acmCertRequester) Request(ctx context.Context, stackID core.StackID, tlsHosts []string) ([]CertRequest, error) {
+	stackTags := r.trackingProvider.StackTags(core.NewDefaultStack(stackID))
+	requestedCerts, err := r.listRequestedCertificates(ctx, stackTags)
+	if err != nil {
+		return nil, err
+	}
+	requestedCertByHost := make(map[string]requestedCert, len(requestedCerts))
+	for _, cert := range requestedCerts {
+		if cert.status != acm.CertificateStatusIssued && cert.status != acm.CertificateStatusPendingValidation {
+			continue
+		}
+		// issued certificates are preferred over pending ones.
+		if existing, exists := requestedCertByHost[cert.host]; exists && existing.status == acm.CertificateStatusIssued {
+			continue
+		}
+		requestedCertByHost[cert.host] = cert
+	}
+
+	certRequests := make([]CertRequest, 0, len(tlsHosts))
+	for _, host := range tlsHosts {
+		certRequest := CertRequest{Host: host}
+		if cert, exists := requestedCertByHost[host]; exists {
+			certRequest.CertARN = cert.certARN
+			certRequest.Status = cert.status
+			if cert.status == acm.CertificateStatusPendingValidation {
+				if err := r.writeValidationRecords(ctx, cert.certARN, cert.domainValidationOptions); err != nil {
+					return nil, err
+				}
+			}
+		} else {
+			certARN, err := r.requestCertificate(ctx, stackID, algorithm.MergeStringMap(stackTags, r.defaultTags), host)
+			if err != nil {
+				return nil, err
+			}
+			certRequest.CertARN = certARN
+			certRequest.Status = acm.CertificateStatusPendingValidation
+			certRequest.Requested = true
+			// ACM populates the validation records asynchronously, they are written on a later call.
+		}
+		certRequests = append(certRequests, certRequest)
+	}
+	return certRequests, nil
+}
+
+func (r *acmCertRequester) Cleanup(ctx context.Context, stackID core.StackID, tlsHosts []string) error {
+	stackTags := r.trackingProvider.StackTags(core.NewDefaultStack(stackID))
+	requestedCerts, err := r.listRequestedCertificates(ctx, stackTags)
+	if err != nil {
+		return err
+	}
+	keptHosts := sets.NewString(tlsHosts...)
+	for _, cert := range requestedCerts {
+		if keptHosts.Has(cert.host) {
+			continue
+		}
+		if cert.inUse {
+			r.logger.V(1).Info("keeping requested certificate in use", "host", cert.host, "certARN", cert.certARN)
+			continue
+		}
+		if err := r.deleteCertificate(ctx, cert); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listRequestedCertificates lists the certificates requested by the controller, i.e. the certificates with all of stackTags.
+func (r *acmCertRequester) listRequestedCertificates(ctx context.Context, stackTags map[string]string) ([]requestedCert, error) {
+	req := &rgtsdk.GetResourcesInput{
+		ResourceTypeFilters: aws.StringSlice([]string{resourceTypeFilterCertificate}),
+	}
+	for _, tagKey := range sets.StringKeySet(stackTags).List() {
+		req.TagFilters = append(req.TagFilters, &rgtsdk.TagFilter{
+			Key:    aws.String(tagKey),
+			Values: aws.StringSlice([]string{stackTags[tagKey]}),
+		})
+	}
+	var certARNs []string
+	if err := r.rgtClient.GetResourcesPagesWithContext(ctx, req, func(output *rgtsdk.GetResourcesOutput, _ bool) bool {
+		for _, mapping := range output.ResourceTagMappingList {
+			certARNs = append(certARNs, aws.StringValue(mapping.ResourceARN))
+		}
+		return true
+	}); err != nil {
+		return nil, err
+	}
+
+	requestedCerts := make([]requestedCert, 0, len(certARNs))
+	for _, certARN := range certARNs {
+		resp, err := r.acmClient.DescribeCertificateWithContext(ctx, &acm.DescribeCertificateInput{
+			CertificateArn: aws.String(certARN),
+		})
+		if err != nil {
+			// the tags of deleted certificates can be listed for a while.
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == acm.ErrCodeResourceNotFoundException {
+				continue
+			}
+			return nil, err
+		}
+		requestedCerts = append(requestedCerts, requestedCert{
+			certARN:                 certARN,
+			host:                    aws.StringValue(resp.Certificate.DomainName),
+			status:                  aws.StringValue(resp.Certificate.Status),
+			inUse:                   len(resp.Certificate.InUseBy) != 0,
+			domainValidationOptions: resp.Certificate.DomainValidationOptions,
+		})
+	}
+	return requestedCerts, nil
+}
+
+func (r *acmCertRequester) requestCertificate(ctx context.Context, stackID core.StackID, tags map[string]string, host string) (string, error) {
+	req := &acm.RequestCertificateInput{
+		DomainName:       aws.String(host),
+		ValidationMethod: aws.String(acm.ValidationMethodDns),
+		IdempotencyToken: aws.String(buildCertRequestIdempotencyToken(stackID, host)),
+		Tags:             convertTagsToACMTags(tags),
+	}
+	r.logger.Info("requesting certificate", "host", host)
+	resp, err := r.acmClient.RequestCertificateWithContext(ctx, req)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to request certificate for host: %v", host)
+	}
+	certARN := aws.StringValue(resp.CertificateArn)
+	r.logger.Info("requested certificate", "host", host, "certARN", certARN)
+	return certARN, nil
+}
+
+// deleteCertificate deletes the requested certificate, along with its DNS validation records unless they are shared with other certificates.
+func (r *acmCertRequester) deleteCertificate(ctx context.Context, cert requestedCert) error {
+	shared, err := r.isValidationRecordShared(ctx, cert)
+	if err != nil {
+		return err
+	}
+	if !shared {
+		if err := r.deleteValidationRecords(ctx, cert.certARN, cert.domainValidationOptions); err != nil {
+			return err
+		}
+	}
+	r.logger.Info("deleting requested certificate", "host", cert.host, "certARN", cert.certARN)
+	if _, err := r.acmClient.DeleteCertificateWithContext(ctx, &acm.DeleteCertificateInput{
+		CertificateArn: aws.String(cert.certARN),
+	}); err != nil {
+		// the certificate might be associated since it's described, it's deleted on a later cleanup.
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == acm.ErrCodeResourceInUseException {
+			r.logger.V(1).Info("keeping requested certificate in use", "host", cert.host, "certARN", cert.certARN)
+			return nil
+		}
+		return errors.Wrapf(err, "failed to delete requested certificate: %v", cert.certARN)
+	}
+	r.logger.Info("deleted requested certificate", "host", cert.host, "certARN", cert.certARN)
+	return nil
+}
+
+// isValidationRecordShared checks whether other certificates in ACM share the DNS validation records of cert.
+// ACM uses the same validation record for certificates of the same domain in an account, e.g. certificates not requested by the controller.
+func (r *acmCertRequester) isValidationRecordShared(ctx context.Context, cert requestedCert) (bool, error) {
+	certSummaries, err := r.acmClient.ListCertificatesAsList(ctx, &acm.ListCertificatesInput{})
+	if err != nil {
+		return false, err
+	}
+	validationDomain := buildValidationRecordDomain(cert.host)
+	for _, certSummary := range certSummaries {
+		if aws.StringValue(certSummary.CertificateArn) == cert.certARN {
+			continue
+		}
+		if buildValidationRecordDomain(aws.StringValue(certSummary.DomainName)) == validationDomain {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// writeValidationRecords writes the pending DNS validation records of certificate into the hosted zones.
+func (r *acmCertRequester) writeValidationRecords(ctx context.Context, certARN string, domainValidationOptions []*acm.DomainValidation) error {
+	changes := buildValidationRecordChanges(domainValidationOptions, route53.ChangeActionUpsert)
+	if err := r.changeValidationRecords(ctx, certARN, changes); err != nil {
+		return errors.Wrapf(err, "failed to write DNS validation records for certificate: %v", certARN)
+	}
+	return nil
+}
+
+// deleteValidationRecords deletes the DNS validation records of certificate from the hosted zones.
+func (r *acmCertRequester) deleteValidationRecords(ctx context.Context, certARN string, domainValidationOptions []*acm.DomainValidation) error {
+	changes := buildValidationRecordChanges(domainValidationOptions, route53.ChangeActionDelete)
+	if err := r.changeValidationRecords(ctx, certARN, changes); err != nil {
+		// the validation records are already deleted or modified outside the controller.
+		if awsErr, ok := errors.Cause(err).(awserr.Error); ok && awsErr.Code() == route53.ErrCodeInvalidChangeBatch {
+			r.logger.V(1).Info("skipped deleting DNS validation records", "certARN", certARN, "reason", awsErr.Message())
+			return nil
+		}
+		return errors.Wrapf(err, "failed to delete DNS validation records for certificate: %v", certARN)
+	}
+	return nil
+}
+
+// changeValidationRecords applies the changes of DNS validation records, grouped by the hosted zone of each record.
+func (r *acmCertRequester) changeValidationRecords(ctx context.Context, certARN string, changes []*route53.Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	hostedZoneIDByName, err := r.loadHostedZoneIDByName(ctx)
+	if err != nil {
+		return err
+	}
+	var hostedZoneIDs []string
+	changesByHostedZoneID := make(map[string][]*route53.Change)
+	for _, change := range changes {
+		recordName := aws.StringValue(change.ResourceRecordSet.Name)
+		hostedZoneID, ok := findHostedZoneID(hostedZoneIDByName, recordName)
+		if !ok {
+			return errors.Errorf("no hosted zone found for DNS validation record: %v", recordName)
+		}
+		if _, exists := changesByHostedZoneID[hostedZoneID]; !exists {
+			hostedZoneIDs = append(hostedZoneIDs, hostedZoneID)
+		}
+		changesByHostedZoneID[hostedZoneID] = append(changesByHostedZoneID[hostedZoneID], change)
+	}
+	for _, hostedZoneID := range hostedZoneIDs {
+		changeReq := &route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: aws.String(hostedZoneID),
+			ChangeBatch: &route53.ChangeBatch{
+				Comment: aws.String(certARN),
+				Changes: changesByHostedZoneID[hostedZoneID],
+			},
+		}
+		if _, err := r.route53Client.ChangeResourceRecordSetsWithContext(ctx, changeReq); err != nil {
+			return err
+		}
+		r.logger.V(1).Info("changed DNS validation records", "certARN", certARN, "hostedZoneID", hostedZoneID)
+	}
+	return nil
+}
+
+// loadHostedZoneIDByName loads the names of hosted zones, which are cached since they never change.
+func (r *acmCertRequester) loadHostedZoneIDByName(ctx context.Context) (map[string]string, error) {
+	r.loadHostedZonesMutex.Lock()
+	defer r.loadHostedZonesMutex.Unlock()
+
+	if r.hostedZoneIDByName != nil {
+		return r.hostedZoneIDByName, nil
+	}
+	hostedZoneIDByName := make(map[string]string, len(r.hostedZoneIDs))
+	for _, hostedZoneID := range r.hostedZoneIDs {
+		resp, err := r.route53Client.GetHostedZoneWithContext(ctx, &route53.GetHostedZoneInput{
+			Id: aws.String(hostedZoneID),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get hosted zone: %v", hostedZoneID)
+		}
+		hostedZoneIDByName[normalizeDNSName(aws.StringValue(resp.HostedZone.Name))] = hostedZoneID
+	}
+	r.hostedZoneIDByName = hostedZoneIDByName
+	return hostedZoneIDByName, nil
+}
+
+// findHostedZoneID finds the hosted zone for record, which is the hosted zone with the longest name that contains the record.
+func findHostedZoneID(hostedZoneIDByName map[string]string, recordName string) (string, bool) {
+	recordName = normalizeDNSName(recordName)
+	matchedName := ""
+	matchedID := ""
+	for name, id := range hostedZoneIDByName {
+		if recordName != name && !strings.HasSuffix(recordName, "."+name) {
+			continue
+		}
+		if len(name) > len(matchedName) {
+			matchedName = name
+			matchedID = id
+		}
+	}
+	return matchedID, len(matchedID) != 0
+}
+
+// normalizeDNSName normalizes DNS name to lower case without the trailing dot.
+func normalizeDNSName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
+
+// buildValidationRecordDomain returns the domain that the DNS validation record of a certificate for host is derived from.
+// ACM validates wildcard domains with the same record as their base domain.
+func buildValidationRecordDomain(host string) string {
+	return strings.TrimPrefix(normalizeDNSName(host), "*.")
+}
+
+// buildValidationRecordChanges builds the Route53 changes of the DNS validation records.
+// only the records of pending domains are upserted, while all records are deleted.
+func buildValidationRecordChanges(domainValidationOptions []*acm.DomainValidation, action string) []*route53.Change {
+	var changes []*route53.Change
+	changedRecordNames := make(map[string]struct{}, len(domainValidationOptions))
+	for _, option := range domainValidationOptions {
+		if option.ResourceRecord == nil {
+			continue
+		}
+		if action == route53.ChangeActionUpsert && aws.StringValue(option.ValidationStatus) != acm.DomainStatusPendingValidation {
+			continue
+		}
+		// domains of a certificate can share the same validation record.
+		recordName := aws.StringValue(option.ResourceRecord.Name)
+		if _, changed := changedRecordNames[recordName]; changed {
+			continue
+		}
+		changedRecordNames[recordName] = struct{}{}
+		changes = append(changes, &route53.Change{
+			Action: aws.String(action),
+			ResourceRecordSet: &route53.ResourceRecordSet{
+				Name: option.ResourceRecord.Name,
+				Type: option.ResourceRecord.Type,
+				TTL:  aws.Int64(defaultValidationRecordTTL),
+				ResourceRecords: []*route53.ResourceRecord{
+					{
+						Value: option.ResourceRecord.Value,
+					},
+				},
+			},
+		})
+	}
+	return changes
+}
+
+// buildCertRequestIdempotencyToken builds the idempotency token to request certificate for host of stack,
+// so that concurrent requests for the same host within an hour result in a single certificate.
+func buildCertRequestIdempotencyToken(stackID core.StackID, host string) string {
+	checksum := sha256.Sum256([]byte(stackID.String() + ":" + host))
+	return hex.EncodeToString(checksum[:])[:maxIdempotencyTokenLength]
+}
+
+func convertTagsToACMTags(tags map[string]string) []*acm.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	acmTags := make([]*acm.Tag, 0, len(tags))
+	for _, key := range sets.StringKeySet(tags).List() {
+		acmTags = append(acmTags, &acm.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(tags[key]),
+		})
+	}
+	return acmTags
+}
@@ -0,0 +1,312 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certs"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+)
+
+// stubCertRequester is a CertRequester that records the requested tlsHosts.
+type stubCertRequester struct {
+	requestedStackID core.StackID
+	requestedHosts   []string
+}
+
+func (r *stubCertRequester) Request(_ context.Context, stackID core.StackID, tlsHosts []string) ([]CertRequest, error) {
+	r.requestedStackID = stackID
+	r.requestedHosts = tlsHosts
+	certRequests := make([]CertRequest, 0, len(tlsHosts))
+	for _, host := range tlsHosts {
+		certRequests = append(certRequests, CertRequest{Host: host, CertARN: "arn-" + host, Status: acm.CertificateStatusPendingValidation, Requested: true})
+	}
+	return certRequests, nil
+}
+
+func (r *stubCertRequester) Cleanup(_ context.Context, _ core.StackID, _ []string) error {
+	return nil
+}
+
+func Test_buildValidationRecordChanges(t *testing.T) {
+	tests := []struct {
+		name                    string
+		domainValidationOptions []*acm.DomainValidation
+		action                  string
+		want                    []*route53.Change
+	}{
+		{
+			name:                    "no domain validation options",
+			domainValidationOptions: nil,
+			action:                  route53.ChangeActionUpsert,
+			want:                    nil,
+		},
+		{
+			name:   "validation record not populated yet",
+			action: route53.ChangeActionUpsert,
+			domainValidationOptions: []*acm.DomainValidation{
+				{
+					DomainName:       awssdk.String("www.example.com"),
+					ValidationStatus: awssdk.String(acm.DomainStatusPendingValidation),
+				},
+			},
+			want: nil,
+		},
+		{
+			name:   "pending validation records are upserted once",
+			action: route53.ChangeActionUpsert,
+			domainValidationOptions: []*acm.DomainValidation{
+				{
+					DomainName:       awssdk.String("www.example.com"),
+					ValidationStatus: awssdk.String(acm.DomainStatusPendingValidation),
+					ResourceRecord: &acm.ResourceRecord{
+						Name:  awssdk.String("_x1.www.example.com."),
+						Type:  awssdk.String(acm.RecordTypeCname),
+						Value: awssdk.String("_y1.acm-validations.aws."),
+					},
+				},
+				{
+					DomainName:       awssdk.String("www.example.com"),
+					ValidationStatus: awssdk.String(acm.DomainStatusPendingValidation),
+					ResourceRecord: &acm.ResourceRecord{
+						Name:  awssdk.String("_x1.www.example.com."),
+						Type:  awssdk.String(acm.RecordTypeCname),
+						Value: awssdk.String("_y1.acm-validations.aws."),
+					},
+				},
+				{
+					DomainName:       awssdk.String("api.example.com"),
+					ValidationStatus: awssdk.String(acm.DomainStatusSuccess),
+					ResourceRecord: &acm.ResourceRecord{
+						Name:  awssdk.String("_x2.api.example.com."),
+						Type:  awssdk.String(acm.RecordTypeCname),
+						Value: awssdk.String("_y2.acm-validations.aws."),
+					},
+				},
+			},
+			want: []*route53.Change{
+				{
+					Action: awssdk.String(route53.ChangeActionUpsert),
+					ResourceRecordSet: &route53.ResourceRecordSet{
+						Name: awssdk.String("_x1.www.example.com."),
+						Type: awssdk.String("CNAME"),
+						TTL:  awssdk.Int64(300),
+						ResourceRecords: []*route53.ResourceRecord{
+							{
+								Value: awssdk.String("_y1.acm-validations.aws."),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "all validation records are deleted",
+			action: route53.ChangeActionDelete,
+			domainValidationOptions: []*acm.DomainValidation{
+				{
+					DomainName:       awssdk.String("www.example.com"),
+					ValidationStatus: awssdk.String(acm.DomainStatusPendingValidation),
+					ResourceRecord: &acm.ResourceRecord{
+						Name:  awssdk.String("_x1.www.example.com."),
+						Type:  awssdk.String(acm.RecordTypeCname),
+						Value: awssdk.String("_y1.acm-validations.aws."),
+					},
+				},
+				{
+					DomainName:       awssdk.String("api.example.com"),
+					ValidationStatus: awssdk.String(acm.DomainStatusSuccess),
+					ResourceRecord: &acm.ResourceRecord{
+						Name:  awssdk.String("_x2.api.example.com."),
+						Type:  awssdk.String(acm.RecordTypeCname),
+						Value: awssdk.String("_y2.acm-validations.aws."),
+					},
+				},
+			},
+			want: []*route53.Change{
+				{
+					Action: awssdk.String(route53.ChangeActionDelete),
+					ResourceRecordSet: &route53.ResourceRecordSet{
+						Name: awssdk.String("_x1.www.example.com."),
+						Type: awssdk.String("CNAME"),
+						TTL:  awssdk.Int64(300),
+						ResourceRecords: []*route53.ResourceRecord{
+							{
+								Value: awssdk.String("_y1.acm-validations.aws."),
+							},
+						},
+					},
+				},
+				{
+					Action: awssdk.String(route53.ChangeActionDelete),
+					ResourceRecordSet: &route53.ResourceRecordSet{
+						Name: awssdk.String("_x2.api.example.com."),
+						Type: awssdk.String("CNAME"),
+						TTL:  awssdk.Int64(300),
+						ResourceRecords: []*route53.ResourceRecord{
+							{
+								Value: awssdk.String("_y2.acm-validations.aws."),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildValidationRecordChanges(tt.domainValidationOptions, tt.action)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_buildCertRequestIdempotencyToken(t *testing.T) {
+	stackID := core.StackID{Name: "awesome-group"}
+	token := buildCertRequestIdempotencyToken(stackID, "www.example.com")
+	assert.Len(t, token, 32)
+	assert.Regexp(t, "^\\w+$", token)
+	assert.Equal(t, token, buildCertRequestIdempotencyToken(stackID, "www.example.com"))
+	assert.NotEqual(t, token, buildCertRequestIdempotencyToken(stackID, "api.example.com"))
+	assert.NotEqual(t, token, buildCertRequestIdempotencyToken(core.StackID{Namespace: "awesome-ns", Name: "awesome-ing"}, "www.example.com"))
+}
+
+func Test_findHostedZoneID(t *testing.T) {
+	hostedZoneIDByName := map[string]string{
+		"example.com":     "Z1",
+		"dev.example.com": "Z2",
+		"example.org":     "Z3",
+	}
+	tests := []struct {
+		name       string
+		recordName string
+		wantID     string
+		wantFound  bool
+	}{
+		{
+			name:       "record in parent hosted zone",
+			recordName: "_x1.www.example.com.",
+			wantID:     "Z1",
+			wantFound:  true,
+		},
+		{
+			name:       "record in the most specific hosted zone",
+			recordName: "_x2.api.dev.example.com.",
+			wantID:     "Z2",
+			wantFound:  true,
+		},
+		{
+			name:       "record name is matched case-insensitively",
+			recordName: "_X3.WWW.Example.ORG",
+			wantID:     "Z3",
+			wantFound:  true,
+		},
+		{
+			name:       "record outside hosted zones",
+			recordName: "_x4.www.badexample.com.",
+			wantFound:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotID, gotFound := findHostedZoneID(hostedZoneIDByName, tt.recordName)
+			assert.Equal(t, tt.wantFound, gotFound)
+			assert.Equal(t, tt.wantID, gotID)
+		})
+	}
+}
+
+func Test_buildValidationRecordDomain(t *testing.T) {
+	assert.Equal(t, "example.com", buildValidationRecordDomain("*.example.com"))
+	assert.Equal(t, "www.example.com", buildValidationRecordDomain("WWW.example.com."))
+}
+
+func Test_defaultModelBuildTask_computeIngressInferredTLSCertARNs_certRequest(t *testing.T) {
+	member := ClassifiedIngress{
+		Ing: &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "ing-1"},
+			Spec: networking.IngressSpec{
+				Rules: []networking.IngressRule{{Host: "www.example.com"}},
+			},
+		},
+	}
+	tests := []struct {
+		name          string
+		certRequester *stubCertRequester
+		wantRequeue   bool
+		wantHosts     []string
+	}{
+		{
+			name:          "certificates are requested for hosts without certificate",
+			certRequester: &stubCertRequester{},
+			wantRequeue:   true,
+			wantHosts:     []string{"www.example.com"},
+		},
+		{
+			name:          "certificates aren't requested without CertRequester, e.g. in dry-run",
+			certRequester: nil,
+			wantRequeue:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			certDiscovery := certs.NewMockCertDiscovery(ctrl)
+			certDiscovery.EXPECT().Discover(gomock.Any(), []string{"www.example.com"}, gomock.Any()).
+				Return(nil, &certs.CertificateNotFoundError{Hosts: []string{"www.example.com"}})
+			stackID := core.StackID{Name: "awesome-group"}
+			task := &defaultModelBuildTask{
+				eventRecorder: record.NewFakeRecorder(10),
+				certDiscovery: certDiscovery,
+				stack:         core.NewDefaultStack(stackID),
+			}
+			if tt.certRequester != nil {
+				task.certRequester = tt.certRequester
+			}
+			_, err := task.computeIngressInferredTLSCertARNs(context.Background(), member)
+			var requeueNeededAfter *runtime.RequeueNeededAfter
+			assert.Equal(t, tt.wantRequeue, errors.As(err, &requeueNeededAfter))
+			if tt.certRequester != nil {
+				assert.Equal(t, stackID, tt.certRequester.requestedStackID)
+				assert.Equal(t, tt.wantHosts, tt.certRequester.requestedHosts)
+			} else {
+				var notFoundErr *certs.CertificateNotFoundError
+				assert.True(t, errors.As(err, &notFoundErr))
+			}
+		})
+	}
+}
+
+func TestComputeGroupTLSHosts(t *testing.T) {
+	ingGroup := Group{
+		Members: []ClassifiedIngress{
+			{
+				Ing: &networking.Ingress{
+					Spec: networking.IngressSpec{
+						Rules: []networking.IngressRule{{Host: "www.example.com"}, {}},
+					},
+				},
+			},
+			{
+				Ing: &networking.Ingress{
+					Spec: networking.IngressSpec{
+						TLS:   []networking.IngressTLS{{Hosts: []string{"api.example.com", "www.example.com"}}},
+						Rules: []networking.IngressRule{{Host: "api.example.com"}},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, []string{"api.example.com", "www.example.com"}, ComputeGroupTLSHosts(ingGroup))
+}
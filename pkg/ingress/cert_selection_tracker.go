@@ -0,0 +1,42 @@
+package ingress
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certs"
+)
+
+const (
+	// the certificate selections will be remembered for 24 hours since they are last seen.
+	defaultCertSelectionTTL = 24 * time.Hour
+)
+
+// newCertSelectionTracker constructs new certSelectionTracker.
+func newCertSelectionTracker() *certSelectionTracker {
+	return &certSelectionTracker{
+		selections:   cache.NewExpiring(),
+		selectionTTL: defaultCertSelectionTTL,
+	}
+}
+
+// certSelectionTracker remembers the certificate selected for each tlsHost of Ingresses,
+// so that a selection is only reported when it changes instead of on every reconcile.
+type certSelectionTracker struct {
+	selections   *cache.Expiring
+	selectionTTL time.Duration
+}
+
+// Changed remembers the selection for Ingress with ingKey, and returns whether it differs from the previous selection for the same tlsHost.
+// a nil certSelectionTracker considers every selection changed.
+func (t *certSelectionTracker) Changed(ingKey types.NamespacedName, selection certs.CertSelection) bool {
+	if t == nil {
+		return true
+	}
+	key := fmt.Sprintf("%v/%v", ingKey, selection.Host)
+	rawCacheItem, ok := t.selections.Get(key)
+	t.selections.Set(key, selection, t.selectionTTL)
+	return !ok || rawCacheItem.(certs.CertSelection) != selection
+}
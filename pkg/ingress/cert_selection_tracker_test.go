@@ -0,0 +1,26 @@
+package ingress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certs"
+)
+
+func Test_certSelectionTracker_Changed(t *testing.T) {
+	ingKey := types.NamespacedName{Namespace: "awesome-ns", Name: "ing-1"}
+	otherIngKey := types.NamespacedName{Namespace: "awesome-ns", Name: "ing-2"}
+	selection := certs.CertSelection{Host: "www.example.com", CertARN: "arn-1", Decision: "preferred exact match"}
+	reselection := certs.CertSelection{Host: "www.example.com", CertARN: "arn-2", Decision: "preferred latest expiry"}
+
+	tracker := newCertSelectionTracker()
+	assert.True(t, tracker.Changed(ingKey, selection))
+	assert.False(t, tracker.Changed(ingKey, selection))
+	assert.True(t, tracker.Changed(otherIngKey, selection))
+	assert.True(t, tracker.Changed(ingKey, reselection))
+	assert.False(t, tracker.Changed(ingKey, reselection))
+
+	var nilTracker *certSelectionTracker
+	assert.True(t, nilTracker.Changed(ingKey, selection))
+}
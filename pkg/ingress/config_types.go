@@ -4,6 +4,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"strings"
 )
 
 // NOTE: these types are user-facing data structures.
@@ -70,6 +71,7 @@ type TargetGroupTuple struct {
 	TargetGroupARN *string `json:"targetGroupARN"`
 
 	// the K8s service Name
+	// it can be in format of `namespace/name` to reference a service in another namespace, which must be permitted by a ReferenceGrant.
 	ServiceName *string `json:"serviceName"`
 
 	// the K8s service port
@@ -88,6 +90,12 @@ func (t *TargetGroupTuple) validate() error {
 	if t.ServiceName != nil && t.ServicePort == nil {
 		return errors.New("missing servicePort")
 	}
+	if t.ServiceName != nil && strings.Contains(*t.ServiceName, "/") {
+		parts := strings.Split(*t.ServiceName, "/")
+		if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+			return errors.Errorf("serviceName must be in format of name or namespace/name: %v", *t.ServiceName)
+		}
+	}
 	return nil
 }
 
@@ -370,6 +378,61 @@ func (c *RuleCondition) validate() error {
 	return nil
 }
 
+const (
+	// the header or cookie value that routes requests to canary Service by default.
+	canaryValueAlways = "always"
+	// the maximum weight of canary Service.
+	maxCanaryWeight = 100
+)
+
+// Information about canary routing to a canary Service for a backend Service.
+type CanaryConfig struct {
+	// The name of canary Service.
+	ServiceName string `json:"serviceName"`
+
+	// The port of canary Service, defaults to the port of backend Service.
+	// +optional
+	ServicePort *intstr.IntOrString `json:"servicePort,omitempty"`
+
+	// The name of HTTP header that routes requests to canary Service.
+	// +optional
+	Header *string `json:"header,omitempty"`
+
+	// The value of HTTP header that routes requests to canary Service, defaults to always.
+	// +optional
+	HeaderValue *string `json:"headerValue,omitempty"`
+
+	// The name of cookie that routes requests to canary Service when its value is always.
+	// +optional
+	Cookie *string `json:"cookie,omitempty"`
+
+	// The percentage (0-100) of other requests that are routed to canary Service.
+	// +optional
+	Weight *int64 `json:"weight,omitempty"`
+}
+
+func (c *CanaryConfig) validate() error {
+	if len(c.ServiceName) == 0 {
+		return errors.New("missing serviceName")
+	}
+	if c.Header == nil && c.Cookie == nil && c.Weight == nil {
+		return errors.New("at least one of header, cookie and weight must be specified")
+	}
+	if c.Header != nil && len(*c.Header) == 0 {
+		return errors.New("header cannot be empty")
+	}
+	if c.HeaderValue != nil && c.Header == nil {
+		return errors.New("headerValue requires header")
+	}
+	if c.Cookie != nil && len(*c.Cookie) == 0 {
+		return errors.New("cookie cannot be empty")
+	}
+	if c.Weight != nil && (*c.Weight < 0 || *c.Weight > maxCanaryWeight) {
+		return errors.Errorf("weight must be within [0, %v]: %v", maxCanaryWeight, *c.Weight)
+	}
+	return nil
+}
+
 type AuthType string
 
 const (
@@ -407,9 +470,14 @@ type AuthIDPConfigOIDC struct {
 	// The user info endpoint of the IdP.
 	UserInfoEndpoint string `json:"userInfoEndpoint"`
 
-	// The k8s secretName.
+	// The k8s secretName, exclusive with SecretARN.
 	SecretName string `json:"secretName"`
 
+	// The ARN of AWS Secrets Manager secret, exclusive with SecretName.
+	// its secret string is a JSON object with clientID and clientSecret.
+	// +optional
+	SecretARN string `json:"secretARN,omitempty"`
+
 	// The query parameters (up to 10) to include in the redirect request to the authorization endpoint.
 	// +optional
 	AuthenticationRequestExtraParams map[string]string `json:"authenticationRequestExtraParams,omitempty"`
@@ -3,22 +3,28 @@ package ingress
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
 	magicServicePortUseAnnotation = "use-annotation"
+	// the Kind for Ingress, which is used as the referent in ReferenceGrant.
+	ingressKind = "Ingress"
 
 	// the message body of fixed 503 response used when referencing a non-existent Kubernetes service as backend.
 	nonExistentBackendServiceMessageBody = "Backend service does not exist"
@@ -37,6 +43,19 @@ type EnhancedBackend struct {
 	Conditions []RuleCondition
 	Action     Action
 	AuthConfig AuthConfig
+	// Canary is the canary routing of backend service, it's nil unless configured via canary annotation.
+	Canary *CanaryBackend
+}
+
+// CanaryBackend is the canary routing of an EnhancedBackend.
+// when configured, the Action of EnhancedBackend splits requests between the backend Service and canary Service by weight,
+// and requests matching any of the MatchConditions should be routed by the Action of CanaryBackend instead.
+type CanaryBackend struct {
+	// MatchConditions are alternative conditions to route requests to canary Service,
+	// each of them should be combined with the conditions of EnhancedBackend into a separate rule.
+	MatchConditions []RuleCondition
+	// Action forwards requests to canary Service.
+	Action Action
 }
 
 type EnhancedBackendBuildOptions struct {
@@ -49,6 +68,9 @@ type EnhancedBackendBuildOptions struct {
 
 	// whether to load auth configuration. when load authConfiguration, LoadBackendServices must be enabled as well.
 	LoadAuthConfig bool
+
+	// whether to load TrafficShifts that manage the weights of backend action.
+	LoadTrafficShifts bool
 }
 
 type EnhancedBackendBuildOption func(opts *EnhancedBackendBuildOptions)
@@ -74,6 +96,13 @@ func WithLoadAuthConfig(loadAuthConfig bool) EnhancedBackendBuildOption {
 	}
 }
 
+// WithLoadTrafficShifts is a option that sets the LoadTrafficShifts.
+func WithLoadTrafficShifts(loadTrafficShifts bool) EnhancedBackendBuildOption {
+	return func(opts *EnhancedBackendBuildOptions) {
+		opts.LoadTrafficShifts = loadTrafficShifts
+	}
+}
+
 // EnhancedBackendBuilder is capable of build EnhancedBackend for Ingress backend.
 type EnhancedBackendBuilder interface {
 	Build(ctx context.Context, ing *networking.Ingress, backend networking.IngressBackend, opts ...EnhancedBackendBuildOption) (EnhancedBackend, error)
@@ -125,15 +154,24 @@ func (b *defaultEnhancedBackendBuilder) Build(ctx context.Context, ing *networki
 	}
 
 	var action Action
+	var svcPort intstr.IntOrString
 	if backend.Service.Port.Name == magicServicePortUseAnnotation {
 		action, err = b.buildActionViaAnnotation(ctx, ing.Annotations, backend.Service.Name)
 		if err != nil {
 			return EnhancedBackend{}, err
 		}
-	} else if backend.Service.Port.Name != "" {
-		action = b.buildActionViaServiceAndServicePort(ctx, backend.Service.Name, intstr.FromString(backend.Service.Port.Name))
+		if buildOpts.LoadTrafficShifts {
+			if err := b.applyTrafficShift(ctx, ing, backend.Service.Name, &action); err != nil {
+				return EnhancedBackend{}, err
+			}
+		}
 	} else {
-		action = b.buildActionViaServiceAndServicePort(ctx, backend.Service.Name, intstr.FromInt(int(backend.Service.Port.Number)))
+		if backend.Service.Port.Name != "" {
+			svcPort = intstr.FromString(backend.Service.Port.Name)
+		} else {
+			svcPort = intstr.FromInt(int(backend.Service.Port.Number))
+		}
+		action = b.buildActionViaServiceAndServicePort(ctx, backend.Service.Name, svcPort)
 	}
 
 	var authCfg AuthConfig
@@ -150,10 +188,28 @@ func (b *defaultEnhancedBackendBuilder) Build(ctx context.Context, ing *networki
 		}
 	}
 
+	// canary only applies to backend that forwards to a Service, which is replaced by fixed 503 response if non-existent.
+	var canary *CanaryBackend
+	if backend.Service.Port.Name != magicServicePortUseAnnotation && action.Type == ActionTypeForward {
+		canary, err = b.buildCanary(ctx, ing.Annotations, backend.Service.Name, svcPort, &action)
+		if err != nil {
+			return EnhancedBackend{}, err
+		}
+		// the canary Service must exist, canary rules are never replaced by fixed 503 response.
+		if canary != nil && buildOpts.LoadBackendServices {
+			for _, tgt := range canary.Action.ForwardConfig.TargetGroups {
+				if err := b.loadBackendService(ctx, awssdk.StringValue(tgt.ServiceName), ing.Namespace, buildOpts.BackendServices); err != nil {
+					return EnhancedBackend{}, err
+				}
+			}
+		}
+	}
+
 	return EnhancedBackend{
 		Conditions: conditions,
 		Action:     action,
 		AuthConfig: authCfg,
+		Canary:     canary,
 	}, nil
 }
 
@@ -172,6 +228,92 @@ func (b *defaultEnhancedBackendBuilder) buildConditions(_ context.Context, ingAn
 	return conditions, nil
 }
 
+// buildCanary will build the canary routing specified via canary annotation for backend Service.
+// when canary is configured with weight, action is changed to split requests between backend Service and canary Service by weight.
+func (b *defaultEnhancedBackendBuilder) buildCanary(ctx context.Context, ingAnnotation map[string]string, svcName string, svcPort intstr.IntOrString, action *Action) (*CanaryBackend, error) {
+	canaryCfg := CanaryConfig{}
+	annotationKey := fmt.Sprintf("canary.%v", svcName)
+	exists, err := b.annotationParser.ParseJSONAnnotation(annotationKey, &canaryCfg, ingAnnotation)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+	if err := canaryCfg.validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid %v configuration", annotationKey)
+	}
+
+	canarySvcPort := svcPort
+	if canaryCfg.ServicePort != nil {
+		canarySvcPort = intstr.Parse(canaryCfg.ServicePort.String())
+	}
+	// without weight, the canary Service only receives requests matching the header or cookie,
+	// so the action of backend Service stays a plain forward.
+	if canaryCfg.Weight != nil {
+		canaryWeight := *canaryCfg.Weight
+		svcWeight := maxCanaryWeight - canaryWeight
+		*action = Action{
+			Type: ActionTypeForward,
+			ForwardConfig: &ForwardActionConfig{
+				TargetGroups: []TargetGroupTuple{
+					{
+						ServiceName: awssdk.String(svcName),
+						ServicePort: &svcPort,
+						Weight:      &svcWeight,
+					},
+					{
+						ServiceName: awssdk.String(canaryCfg.ServiceName),
+						ServicePort: &canarySvcPort,
+						Weight:      &canaryWeight,
+					},
+				},
+			},
+		}
+	}
+
+	var matchConditions []RuleCondition
+	if canaryCfg.Header != nil {
+		headerValue := canaryValueAlways
+		if canaryCfg.HeaderValue != nil {
+			headerValue = *canaryCfg.HeaderValue
+		}
+		matchConditions = append(matchConditions, RuleCondition{
+			Field: RuleConditionFieldHTTPHeader,
+			HTTPHeaderConfig: &HTTPHeaderConditionConfig{
+				HTTPHeaderName: *canaryCfg.Header,
+				Values:         []string{headerValue},
+			},
+		})
+	}
+	if canaryCfg.Cookie != nil {
+		matchConditions = append(matchConditions, RuleCondition{
+			Field: RuleConditionFieldHTTPHeader,
+			HTTPHeaderConfig: &HTTPHeaderConditionConfig{
+				HTTPHeaderName: "Cookie",
+				Values:         buildCanaryCookieValues(*canaryCfg.Cookie),
+			},
+		})
+	}
+	return &CanaryBackend{
+		MatchConditions: matchConditions,
+		Action:          b.buildActionViaServiceAndServicePort(ctx, canaryCfg.ServiceName, canarySvcPort),
+	}, nil
+}
+
+// buildCanaryCookieValues builds the Cookie header values that match cookie with value "always".
+// the cookie pair is anchored on the "; " delimiters of Cookie header, so that cookies whose name or value
+// merely contains the cookie pair, e.g. "my-canary=always" or "canary=always-not", don't match.
+func buildCanaryCookieValues(cookie string) []string {
+	cookiePair := fmt.Sprintf("%v=%v", cookie, canaryValueAlways)
+	return []string{
+		cookiePair,
+		fmt.Sprintf("%v;*", cookiePair),
+		fmt.Sprintf("*; %v", cookiePair),
+		fmt.Sprintf("*; %v;*", cookiePair),
+	}
+}
+
 // buildActionViaAnnotation will build the backend action specified via actions annotation.
 func (b *defaultEnhancedBackendBuilder) buildActionViaAnnotation(ctx context.Context, ingAnnotation map[string]string, svcName string) (Action, error) {
 	action := Action{}
@@ -194,6 +336,55 @@ func (b *defaultEnhancedBackendBuilder) buildActionViaAnnotation(ctx context.Con
 	return action, nil
 }
 
+// applyTrafficShift will apply the canary weight of TrafficShift that manages the backend action.
+// the forward action will be split between the stable and canary Service of TrafficShift once its canary weight is known.
+func (b *defaultEnhancedBackendBuilder) applyTrafficShift(ctx context.Context, ing *networking.Ingress, actionName string, action *Action) error {
+	if action.Type != ActionTypeForward || action.ForwardConfig == nil {
+		return nil
+	}
+	tsList := &elbv2api.TrafficShiftList{}
+	if err := b.k8sClient.List(ctx, tsList, client.InNamespace(ing.Namespace)); err != nil {
+		// without the TrafficShift CRD installed, there are no trafficShifts to apply.
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to fetch trafficShifts")
+	}
+	var tsNames []string
+	var trafficShift *elbv2api.TrafficShift
+	for i := range tsList.Items {
+		ts := &tsList.Items[i]
+		if ts.Spec.IngressName == ing.Name && ts.Spec.ActionName == actionName {
+			tsNames = append(tsNames, ts.Name)
+			trafficShift = ts
+		}
+	}
+	if len(tsNames) > 1 {
+		return errors.Errorf("conflicting trafficShifts for action %v: %v", actionName, tsNames)
+	}
+	if trafficShift == nil || trafficShift.Status.CanaryWeight == nil {
+		return nil
+	}
+
+	canaryWeight := awssdk.Int64Value(trafficShift.Status.CanaryWeight)
+	stableWeight := maxCanaryWeight - canaryWeight
+	stableSvc := trafficShift.Spec.StableService
+	canarySvc := trafficShift.Spec.CanaryService
+	action.ForwardConfig.TargetGroups = []TargetGroupTuple{
+		{
+			ServiceName: awssdk.String(stableSvc.Name),
+			ServicePort: &stableSvc.Port,
+			Weight:      awssdk.Int64(stableWeight),
+		},
+		{
+			ServiceName: awssdk.String(canarySvc.Name),
+			ServicePort: &canarySvc.Port,
+			Weight:      awssdk.Int64(canaryWeight),
+		},
+	}
+	return nil
+}
+
 // buildActionViaServiceAndServicePort will build the backend Action that forward to specified Kubernetes Service.
 func (b *defaultEnhancedBackendBuilder) buildActionViaServiceAndServicePort(_ context.Context, svcName string, svcPort intstr.IntOrString) Action {
 	action := Action{
@@ -254,25 +445,65 @@ func (b *defaultEnhancedBackendBuilder) loadBackendServices(ctx context.Context,
 		forwardToSingleSvc := (len(action.ForwardConfig.TargetGroups) == 1) && (svcNames.Len() == 1)
 		tolerateNonExistentBackendService := b.tolerateNonExistentBackendService && forwardToSingleSvc
 		for svcName := range svcNames {
-			svcKey := types.NamespacedName{Namespace: namespace, Name: svcName}
-			if _, ok := backendServices[svcKey]; ok {
-				continue
-			}
-
-			svc := &corev1.Service{}
-			if err := b.k8sClient.Get(ctx, svcKey, svc); err != nil {
+			if err := b.loadBackendService(ctx, svcName, namespace, backendServices); err != nil {
 				if apierrors.IsNotFound(err) && tolerateNonExistentBackendService {
 					*action = b.build503ResponseAction(nonExistentBackendServiceMessageBody)
 					return nil
 				}
 				return err
 			}
-			backendServices[svcKey] = svc
 		}
 	}
 	return nil
 }
 
+// loadBackendService will load the backend service referenced by svcName into backendServices.
+func (b *defaultEnhancedBackendBuilder) loadBackendService(ctx context.Context, svcName string, namespace string,
+	backendServices map[types.NamespacedName]*corev1.Service) error {
+	svcKey := backendServiceKey(namespace, svcName)
+	// cross-namespace reference must be checked even if the service is loaded already by another Ingress.
+	if svcKey.Namespace != namespace {
+		permitted, err := b.isCrossNamespaceServiceRefPermitted(ctx, namespace, svcKey)
+		if err != nil {
+			return err
+		}
+		if !permitted {
+			return errors.Errorf("reference to service %v not permitted by any ReferenceGrant", svcKey)
+		}
+	}
+	if _, ok := backendServices[svcKey]; ok {
+		return nil
+	}
+
+	svc := &corev1.Service{}
+	if err := b.k8sClient.Get(ctx, svcKey, svc); err != nil {
+		return err
+	}
+	backendServices[svcKey] = svc
+	return nil
+}
+
+// isCrossNamespaceServiceRefPermitted checks whether a ReferenceGrant in the service's namespace permits Ingresses in namespace to reference the service.
+func (b *defaultEnhancedBackendBuilder) isCrossNamespaceServiceRefPermitted(ctx context.Context, namespace string, svcKey types.NamespacedName) (bool, error) {
+	refGrantList := gwapi.NewUnstructuredList(gwapi.ReferenceGrantGVK)
+	if err := b.k8sClient.List(ctx, refGrantList, client.InNamespace(svcKey.Namespace)); err != nil {
+		if meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "failed to list ReferenceGrants")
+	}
+	for i := range refGrantList.Items {
+		refGrant := &gwapi.ReferenceGrant{}
+		if err := gwapi.FromUnstructured(&refGrantList.Items[i], refGrant); err != nil {
+			continue
+		}
+		if referenceGrantPermitsIngress(refGrant, namespace, svcKey.Name) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (b *defaultEnhancedBackendBuilder) buildAuthConfig(ctx context.Context, action Action, namespace string, ingAnnotation map[string]string, backendServices map[types.NamespacedName]*corev1.Service) (AuthConfig, error) {
 	svcAndIngAnnotations := ingAnnotation
 	// when forward to a single Service, the auth annotations on that Service will be merged in.
@@ -281,7 +512,7 @@ func (b *defaultEnhancedBackendBuilder) buildAuthConfig(ctx context.Context, act
 		len(action.ForwardConfig.TargetGroups) == 1 &&
 		action.ForwardConfig.TargetGroups[0].ServiceName != nil {
 		svcName := awssdk.StringValue(action.ForwardConfig.TargetGroups[0].ServiceName)
-		svcKey := types.NamespacedName{Namespace: namespace, Name: svcName}
+		svcKey := backendServiceKey(namespace, svcName)
 		svc := backendServices[svcKey]
 		svcAndIngAnnotations = algorithm.MergeStringMap(svc.Annotations, svcAndIngAnnotations)
 	}
@@ -300,3 +531,32 @@ func (b *defaultEnhancedBackendBuilder) build503ResponseAction(messageBody strin
 		},
 	}
 }
+
+// backendServiceKey returns the key of backend service referenced by svcName from namespace.
+// svcName can be in format of `namespace/name` to reference a service in another namespace.
+func backendServiceKey(namespace string, svcName string) types.NamespacedName {
+	if idx := strings.Index(svcName, "/"); idx >= 0 {
+		return types.NamespacedName{Namespace: svcName[:idx], Name: svcName[idx+1:]}
+	}
+	return types.NamespacedName{Namespace: namespace, Name: svcName}
+}
+
+// referenceGrantPermitsIngress checks whether refGrant permits Ingresses in ingNamespace to reference service with svcName.
+func referenceGrantPermitsIngress(refGrant *gwapi.ReferenceGrant, ingNamespace string, svcName string) bool {
+	fromMatched := false
+	for _, from := range refGrant.Spec.From {
+		if from.Group == networking.GroupName && from.Kind == ingressKind && from.Namespace == ingNamespace {
+			fromMatched = true
+			break
+		}
+	}
+	if !fromMatched {
+		return false
+	}
+	for _, to := range refGrant.Spec.To {
+		if to.Group == "" && to.Kind == gwapi.KindService && (to.Name == nil || *to.Name == svcName) {
+			return true
+		}
+	}
+	return false
+}
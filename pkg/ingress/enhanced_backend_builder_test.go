@@ -10,13 +10,19 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
+	gwapi "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -43,7 +49,14 @@ func Test_defaultEnhancedBackendBuilder_Build(t *testing.T) {
 			Name:      "svc-1",
 		},
 	}
+	svc1Canary := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "svc-1-canary",
+		},
+	}
 	portHTTP := intstr.FromString("http")
+	port8080 := intstr.FromInt(8080)
 	backendPortHTTP := networking.ServiceBackendPort{Name: "http"}
 	tests := []struct {
 		name                string
@@ -104,6 +117,229 @@ func Test_defaultEnhancedBackendBuilder_Build(t *testing.T) {
 				types.NamespacedName{Namespace: "awesome-ns", Name: "svc-1"}: svc1,
 			},
 		},
+		{
+			name: "serviceBackend with canary",
+			env: env{
+				svcs: []*corev1.Service{svc1, svc1Canary},
+			},
+			fields: fields{
+				tolerateNonExistentBackendService: true,
+				tolerateNonExistentBackendAction:  true,
+			},
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/canary.svc-1": `{"serviceName":"svc-1-canary","header":"X-Canary","cookie":"canary","weight":20}`,
+						},
+					},
+				},
+				backend: networking.IngressBackend{
+					Service: &networking.IngressServiceBackend{
+						Name: "svc-1",
+						Port: backendPortHTTP,
+					},
+				},
+				loadBackendServices: true,
+				loadAuthConfig:      true,
+				backendServices:     map[types.NamespacedName]*corev1.Service{},
+			},
+			want: EnhancedBackend{
+				Action: Action{
+					Type: ActionTypeForward,
+					ForwardConfig: &ForwardActionConfig{
+						TargetGroups: []TargetGroupTuple{
+							{
+								ServiceName: awssdk.String("svc-1"),
+								ServicePort: &portHTTP,
+								Weight:      awssdk.Int64(80),
+							},
+							{
+								ServiceName: awssdk.String("svc-1-canary"),
+								ServicePort: &portHTTP,
+								Weight:      awssdk.Int64(20),
+							},
+						},
+					},
+				},
+				AuthConfig: AuthConfig{
+					Type:                     AuthTypeNone,
+					OnUnauthenticatedRequest: "authenticate",
+					Scope:                    "openid",
+					SessionCookieName:        "AWSELBAuthSessionCookie",
+					SessionTimeout:           604800,
+				},
+				Canary: &CanaryBackend{
+					MatchConditions: []RuleCondition{
+						{
+							Field: RuleConditionFieldHTTPHeader,
+							HTTPHeaderConfig: &HTTPHeaderConditionConfig{
+								HTTPHeaderName: "X-Canary",
+								Values:         []string{"always"},
+							},
+						},
+						{
+							Field: RuleConditionFieldHTTPHeader,
+							HTTPHeaderConfig: &HTTPHeaderConditionConfig{
+								HTTPHeaderName: "Cookie",
+								Values:         []string{"canary=always", "canary=always;*", "*; canary=always", "*; canary=always;*"},
+							},
+						},
+					},
+					Action: Action{
+						Type: ActionTypeForward,
+						ForwardConfig: &ForwardActionConfig{
+							TargetGroups: []TargetGroupTuple{
+								{
+									ServiceName: awssdk.String("svc-1-canary"),
+									ServicePort: &portHTTP,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantBackendServices: map[types.NamespacedName]*corev1.Service{
+				types.NamespacedName{Namespace: "awesome-ns", Name: "svc-1"}:        svc1,
+				types.NamespacedName{Namespace: "awesome-ns", Name: "svc-1-canary"}: svc1Canary,
+			},
+		},
+		{
+			name: "serviceBackend with canary by header value on different port",
+			env: env{
+				svcs: []*corev1.Service{svc1, svc1Canary},
+			},
+			fields: fields{
+				tolerateNonExistentBackendService: true,
+				tolerateNonExistentBackendAction:  true,
+			},
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/canary.svc-1": `{"serviceName":"svc-1-canary","servicePort":"8080","header":"X-Canary","headerValue":"v2"}`,
+						},
+					},
+				},
+				backend: networking.IngressBackend{
+					Service: &networking.IngressServiceBackend{
+						Name: "svc-1",
+						Port: backendPortHTTP,
+					},
+				},
+				loadBackendServices: true,
+				loadAuthConfig:      true,
+				backendServices:     map[types.NamespacedName]*corev1.Service{},
+			},
+			want: EnhancedBackend{
+				Action: Action{
+					Type: ActionTypeForward,
+					ForwardConfig: &ForwardActionConfig{
+						TargetGroups: []TargetGroupTuple{
+							{
+								ServiceName: awssdk.String("svc-1"),
+								ServicePort: &portHTTP,
+							},
+						},
+					},
+				},
+				AuthConfig: AuthConfig{
+					Type:                     AuthTypeNone,
+					OnUnauthenticatedRequest: "authenticate",
+					Scope:                    "openid",
+					SessionCookieName:        "AWSELBAuthSessionCookie",
+					SessionTimeout:           604800,
+				},
+				Canary: &CanaryBackend{
+					MatchConditions: []RuleCondition{
+						{
+							Field: RuleConditionFieldHTTPHeader,
+							HTTPHeaderConfig: &HTTPHeaderConditionConfig{
+								HTTPHeaderName: "X-Canary",
+								Values:         []string{"v2"},
+							},
+						},
+					},
+					Action: Action{
+						Type: ActionTypeForward,
+						ForwardConfig: &ForwardActionConfig{
+							TargetGroups: []TargetGroupTuple{
+								{
+									ServiceName: awssdk.String("svc-1-canary"),
+									ServicePort: &port8080,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantBackendServices: map[types.NamespacedName]*corev1.Service{
+				types.NamespacedName{Namespace: "awesome-ns", Name: "svc-1"}:        svc1,
+				types.NamespacedName{Namespace: "awesome-ns", Name: "svc-1-canary"}: svc1Canary,
+			},
+		},
+		{
+			name: "serviceBackend with canary to non-existent service",
+			env: env{
+				svcs: []*corev1.Service{svc1},
+			},
+			fields: fields{
+				tolerateNonExistentBackendService: true,
+				tolerateNonExistentBackendAction:  true,
+			},
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/canary.svc-1": `{"serviceName":"svc-1-canary","weight":20}`,
+						},
+					},
+				},
+				backend: networking.IngressBackend{
+					Service: &networking.IngressServiceBackend{
+						Name: "svc-1",
+						Port: backendPortHTTP,
+					},
+				},
+				loadBackendServices: true,
+				loadAuthConfig:      true,
+				backendServices:     map[types.NamespacedName]*corev1.Service{},
+			},
+			wantErr: errors.New("services \"svc-1-canary\" not found"),
+		},
+		{
+			name: "serviceBackend with invalid canary",
+			env: env{
+				svcs: []*corev1.Service{svc1, svc1Canary},
+			},
+			fields: fields{
+				tolerateNonExistentBackendService: true,
+				tolerateNonExistentBackendAction:  true,
+			},
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/canary.svc-1": `{"serviceName":"svc-1-canary","weight":120}`,
+						},
+					},
+				},
+				backend: networking.IngressBackend{
+					Service: &networking.IngressServiceBackend{
+						Name: "svc-1",
+						Port: backendPortHTTP,
+					},
+				},
+				loadBackendServices: true,
+				loadAuthConfig:      true,
+				backendServices:     map[types.NamespacedName]*corev1.Service{},
+			},
+			wantErr: errors.New("invalid canary.svc-1 configuration: weight must be within [0, 100]: 120"),
+		},
 		{
 			name: "vanilla serviceBackend with additional conditions",
 			env: env{
@@ -1325,9 +1561,36 @@ func Test_defaultEnhancedBackendBuilder_loadBackendServices(t *testing.T) {
 			Name:      "svc-2",
 		},
 	}
+	svc3 := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "other-ns",
+			Name:      "svc-3",
+		},
+	}
+	refGrantForIngress := &gwapi.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "other-ns",
+			Name:      "allow-ingress",
+		},
+		Spec: gwapi.ReferenceGrantSpec{
+			From: []gwapi.ReferenceGrantFrom{{Group: networking.GroupName, Kind: "Ingress", Namespace: "awesome-ns"}},
+			To:   []gwapi.ReferenceGrantTo{{Kind: gwapi.KindService, Name: awssdk.String("svc-3")}},
+		},
+	}
+	refGrantForHTTPRoute := &gwapi.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "other-ns",
+			Name:      "allow-httproute",
+		},
+		Spec: gwapi.ReferenceGrantSpec{
+			From: []gwapi.ReferenceGrantFrom{{Group: gwapi.GroupName, Kind: gwapi.KindHTTPRoute, Namespace: "awesome-ns"}},
+			To:   []gwapi.ReferenceGrantTo{{Kind: gwapi.KindService}},
+		},
+	}
 
 	type env struct {
-		svcs []*corev1.Service
+		svcs      []*corev1.Service
+		refGrants []*gwapi.ReferenceGrant
 	}
 	type fields struct {
 		tolerateNonExistentBackendService bool
@@ -1567,6 +1830,98 @@ func Test_defaultEnhancedBackendBuilder_loadBackendServices(t *testing.T) {
 			},
 			wantErr: errors.New("services \"svc-2\" not found"),
 		},
+		{
+			name: "forward to service in another namespace permitted by referenceGrant",
+			env: env{
+				svcs:      []*corev1.Service{svc1, svc3},
+				refGrants: []*gwapi.ReferenceGrant{refGrantForIngress},
+			},
+			fields: fields{
+				tolerateNonExistentBackendService: true,
+			},
+			args: args{
+				action: &Action{
+					Type: ActionTypeForward,
+					ForwardConfig: &ForwardActionConfig{
+						TargetGroups: []TargetGroupTuple{
+							{
+								ServiceName: awssdk.String("other-ns/svc-3"),
+								ServicePort: &port80,
+							},
+						},
+					},
+				},
+				namespace:       "awesome-ns",
+				backendServices: map[types.NamespacedName]*corev1.Service{},
+			},
+			wantAction: Action{
+				Type: ActionTypeForward,
+				ForwardConfig: &ForwardActionConfig{
+					TargetGroups: []TargetGroupTuple{
+						{
+							ServiceName: awssdk.String("other-ns/svc-3"),
+							ServicePort: &port80,
+						},
+					},
+				},
+			},
+			wantBackendServices: map[types.NamespacedName]*corev1.Service{
+				types.NamespacedName{Namespace: "other-ns", Name: "svc-3"}: svc3,
+			},
+		},
+		{
+			name: "forward to service in another namespace without referenceGrant",
+			env: env{
+				svcs: []*corev1.Service{svc1, svc3},
+			},
+			fields: fields{
+				tolerateNonExistentBackendService: true,
+			},
+			args: args{
+				action: &Action{
+					Type: ActionTypeForward,
+					ForwardConfig: &ForwardActionConfig{
+						TargetGroups: []TargetGroupTuple{
+							{
+								ServiceName: awssdk.String("other-ns/svc-3"),
+								ServicePort: &port80,
+							},
+						},
+					},
+				},
+				namespace:       "awesome-ns",
+				backendServices: map[types.NamespacedName]*corev1.Service{},
+			},
+			wantErr: errors.New("reference to service other-ns/svc-3 not permitted by any ReferenceGrant"),
+		},
+		{
+			name: "forward to service in another namespace with referenceGrant for other kinds",
+			env: env{
+				svcs:      []*corev1.Service{svc1, svc3},
+				refGrants: []*gwapi.ReferenceGrant{refGrantForHTTPRoute},
+			},
+			fields: fields{
+				tolerateNonExistentBackendService: true,
+			},
+			args: args{
+				action: &Action{
+					Type: ActionTypeForward,
+					ForwardConfig: &ForwardActionConfig{
+						TargetGroups: []TargetGroupTuple{
+							{
+								ServiceName: awssdk.String("other-ns/svc-3"),
+								ServicePort: &port80,
+							},
+						},
+					},
+				},
+				namespace: "awesome-ns",
+				backendServices: map[types.NamespacedName]*corev1.Service{
+					types.NamespacedName{Namespace: "other-ns", Name: "svc-3"}: svc3,
+				},
+			},
+			wantErr: errors.New("reference to service other-ns/svc-3 not permitted by any ReferenceGrant"),
+		},
 		{
 			name: "load for fixed response action is noop",
 			fields: fields{
@@ -1598,10 +1953,19 @@ func Test_defaultEnhancedBackendBuilder_loadBackendServices(t *testing.T) {
 			ctx := context.Background()
 			k8sSchema := runtime.NewScheme()
 			clientgoscheme.AddToScheme(k8sSchema)
+			k8sSchema.AddKnownTypeWithName(gwapi.ReferenceGrantGVK, &unstructured.Unstructured{})
+			k8sSchema.AddKnownTypeWithName(gwapi.ReferenceGrantGVK.GroupVersion().WithKind(gwapi.KindReferenceGrant+"List"), &unstructured.UnstructuredList{})
 			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
 			for _, svc := range tt.env.svcs {
 				assert.NoError(t, k8sClient.Create(ctx, svc.DeepCopy()))
 			}
+			for _, refGrant := range tt.env.refGrants {
+				content, err := gwapi.ToUnstructuredContent(refGrant)
+				assert.NoError(t, err)
+				u := &unstructured.Unstructured{Object: content}
+				u.SetGroupVersionKind(gwapi.ReferenceGrantGVK)
+				assert.NoError(t, k8sClient.Create(ctx, u))
+			}
 
 			b := &defaultEnhancedBackendBuilder{
 				k8sClient:                         k8sClient,
@@ -1804,3 +2168,305 @@ func Test_defaultEnhancedBackendBuilder_build503ResponseAction(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultEnhancedBackendBuilder_applyTrafficShift(t *testing.T) {
+	port80 := intstr.FromInt(80)
+	port8080 := intstr.FromInt(8080)
+	ing := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "ing-1",
+		},
+	}
+	newTrafficShift := func(name string, actionName string, canaryWeight *int64) *elbv2api.TrafficShift {
+		return &elbv2api.TrafficShift{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      name,
+			},
+			Spec: elbv2api.TrafficShiftSpec{
+				IngressName:   "ing-1",
+				ActionName:    actionName,
+				StableService: elbv2api.ServiceReference{Name: "svc-stable", Port: port80},
+				CanaryService: elbv2api.ServiceReference{Name: "svc-canary", Port: port8080},
+				Steps:         []elbv2api.TrafficShiftStep{{Weight: 5}, {Weight: 100}},
+			},
+			Status: elbv2api.TrafficShiftStatus{
+				CanaryWeight: canaryWeight,
+			},
+		}
+	}
+	newForwardAction := func() Action {
+		return Action{
+			Type: ActionTypeForward,
+			ForwardConfig: &ForwardActionConfig{
+				TargetGroups: []TargetGroupTuple{
+					{
+						ServiceName: awssdk.String("svc-stable"),
+						ServicePort: &port80,
+					},
+				},
+				TargetGroupStickinessConfig: &TargetGroupStickinessConfig{
+					Enabled: awssdk.Bool(true),
+				},
+			},
+		}
+	}
+	type env struct {
+		trafficShifts               []*elbv2api.TrafficShift
+		trafficShiftCRDNotInstalled bool
+	}
+	type args struct {
+		actionName string
+		action     Action
+	}
+	tests := []struct {
+		name       string
+		env        env
+		args       args
+		wantAction Action
+		wantErr    error
+	}{
+		{
+			name: "action managed by trafficShift",
+			env: env{
+				trafficShifts: []*elbv2api.TrafficShift{
+					newTrafficShift("ts-1", "weighted-routing", awssdk.Int64(25)),
+				},
+			},
+			args: args{
+				actionName: "weighted-routing",
+				action:     newForwardAction(),
+			},
+			wantAction: Action{
+				Type: ActionTypeForward,
+				ForwardConfig: &ForwardActionConfig{
+					TargetGroups: []TargetGroupTuple{
+						{
+							ServiceName: awssdk.String("svc-stable"),
+							ServicePort: &port80,
+							Weight:      awssdk.Int64(75),
+						},
+						{
+							ServiceName: awssdk.String("svc-canary"),
+							ServicePort: &port8080,
+							Weight:      awssdk.Int64(25),
+						},
+					},
+					TargetGroupStickinessConfig: &TargetGroupStickinessConfig{
+						Enabled: awssdk.Bool(true),
+					},
+				},
+			},
+		},
+		{
+			name: "trafficShift without canary weight yet",
+			env: env{
+				trafficShifts: []*elbv2api.TrafficShift{
+					newTrafficShift("ts-1", "weighted-routing", nil),
+				},
+			},
+			args: args{
+				actionName: "weighted-routing",
+				action:     newForwardAction(),
+			},
+			wantAction: newForwardAction(),
+		},
+		{
+			name: "action not managed by trafficShift",
+			env: env{
+				trafficShifts: []*elbv2api.TrafficShift{
+					newTrafficShift("ts-1", "other-routing", awssdk.Int64(25)),
+				},
+			},
+			args: args{
+				actionName: "weighted-routing",
+				action:     newForwardAction(),
+			},
+			wantAction: newForwardAction(),
+		},
+		{
+			name: "action managed by multiple trafficShifts",
+			env: env{
+				trafficShifts: []*elbv2api.TrafficShift{
+					newTrafficShift("ts-1", "weighted-routing", awssdk.Int64(25)),
+					newTrafficShift("ts-2", "weighted-routing", awssdk.Int64(50)),
+				},
+			},
+			args: args{
+				actionName: "weighted-routing",
+				action:     newForwardAction(),
+			},
+			wantErr: errors.New("conflicting trafficShifts for action weighted-routing: [ts-1 ts-2]"),
+		},
+		{
+			name: "trafficShift CRD not installed",
+			env: env{
+				trafficShiftCRDNotInstalled: true,
+			},
+			args: args{
+				actionName: "weighted-routing",
+				action:     newForwardAction(),
+			},
+			wantAction: newForwardAction(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			for _, ts := range tt.env.trafficShifts {
+				assert.NoError(t, k8sClient.Create(ctx, ts.DeepCopy()))
+			}
+
+			b := &defaultEnhancedBackendBuilder{
+				k8sClient: k8sClient,
+			}
+			if tt.env.trafficShiftCRDNotInstalled {
+				b.k8sClient = &noKindMatchClient{Client: k8sClient}
+			}
+			action := tt.args.action
+			err := b.applyTrafficShift(ctx, ing, tt.args.actionName, &action)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantAction, action)
+			}
+		})
+	}
+}
+
+// noKindMatchClient mimics an API server without the CRDs of listed kinds installed.
+type noKindMatchClient struct {
+	client.Client
+}
+
+func (c *noKindMatchClient) List(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+	gvk, err := apiutil.GVKForObject(list, c.Scheme())
+	if err != nil {
+		return err
+	}
+	return &meta.NoKindMatchError{GroupKind: gvk.GroupKind(), SearchedVersions: []string{gvk.Version}}
+}
+
+func Test_backendServiceKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		svcName   string
+		want      types.NamespacedName
+	}{
+		{
+			name:      "service in same namespace",
+			namespace: "awesome-ns",
+			svcName:   "svc-1",
+			want:      types.NamespacedName{Namespace: "awesome-ns", Name: "svc-1"},
+		},
+		{
+			name:      "service in another namespace",
+			namespace: "awesome-ns",
+			svcName:   "other-ns/svc-1",
+			want:      types.NamespacedName{Namespace: "other-ns", Name: "svc-1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := backendServiceKey(tt.namespace, tt.svcName)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_referenceGrantPermitsIngress(t *testing.T) {
+	type args struct {
+		refGrant     *gwapi.ReferenceGrant
+		ingNamespace string
+		svcName      string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "permits all services",
+			args: args{
+				refGrant: &gwapi.ReferenceGrant{
+					Spec: gwapi.ReferenceGrantSpec{
+						From: []gwapi.ReferenceGrantFrom{{Group: networking.GroupName, Kind: "Ingress", Namespace: "awesome-ns"}},
+						To:   []gwapi.ReferenceGrantTo{{Kind: gwapi.KindService}},
+					},
+				},
+				ingNamespace: "awesome-ns",
+				svcName:      "svc-1",
+			},
+			want: true,
+		},
+		{
+			name: "permits named service",
+			args: args{
+				refGrant: &gwapi.ReferenceGrant{
+					Spec: gwapi.ReferenceGrantSpec{
+						From: []gwapi.ReferenceGrantFrom{{Group: networking.GroupName, Kind: "Ingress", Namespace: "awesome-ns"}},
+						To:   []gwapi.ReferenceGrantTo{{Kind: gwapi.KindService, Name: awssdk.String("svc-1")}},
+					},
+				},
+				ingNamespace: "awesome-ns",
+				svcName:      "svc-1",
+			},
+			want: true,
+		},
+		{
+			name: "doesn't permit other service",
+			args: args{
+				refGrant: &gwapi.ReferenceGrant{
+					Spec: gwapi.ReferenceGrantSpec{
+						From: []gwapi.ReferenceGrantFrom{{Group: networking.GroupName, Kind: "Ingress", Namespace: "awesome-ns"}},
+						To:   []gwapi.ReferenceGrantTo{{Kind: gwapi.KindService, Name: awssdk.String("svc-2")}},
+					},
+				},
+				ingNamespace: "awesome-ns",
+				svcName:      "svc-1",
+			},
+			want: false,
+		},
+		{
+			name: "doesn't permit ingress from other namespace",
+			args: args{
+				refGrant: &gwapi.ReferenceGrant{
+					Spec: gwapi.ReferenceGrantSpec{
+						From: []gwapi.ReferenceGrantFrom{{Group: networking.GroupName, Kind: "Ingress", Namespace: "other-ns"}},
+						To:   []gwapi.ReferenceGrantTo{{Kind: gwapi.KindService}},
+					},
+				},
+				ingNamespace: "awesome-ns",
+				svcName:      "svc-1",
+			},
+			want: false,
+		},
+		{
+			name: "doesn't permit other kinds",
+			args: args{
+				refGrant: &gwapi.ReferenceGrant{
+					Spec: gwapi.ReferenceGrantSpec{
+						From: []gwapi.ReferenceGrantFrom{{Group: gwapi.GroupName, Kind: gwapi.KindHTTPRoute, Namespace: "awesome-ns"}},
+						To:   []gwapi.ReferenceGrantTo{{Kind: gwapi.KindService}},
+					},
+				},
+				ingNamespace: "awesome-ns",
+				svcName:      "svc-1",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := referenceGrantPermitsIngress(tt.args.refGrant, tt.args.ingNamespace, tt.args.svcName)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
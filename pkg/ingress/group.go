@@ -64,4 +64,7 @@ type Group struct {
 
 	// InactiveMembers are Ingresses that no longer belong to this group, but still hold the finalizers.
 	InactiveMembers []*networking.Ingress
+
+	// RetainedMembers are member Ingresses dropped due to build errors, whose existing rules are kept as is.
+	RetainedMembers []types.NamespacedName
 }
@@ -106,10 +106,7 @@ func (t *defaultModelBuildTask) buildForwardAction(ctx context.Context, ing Clas
 		if tgt.TargetGroupARN != nil {
 			tgARN = core.LiteralStringToken(*tgt.TargetGroupARN)
 		} else {
-			svcKey := types.NamespacedName{
-				Namespace: ing.Ing.Namespace,
-				Name:      awssdk.StringValue(tgt.ServiceName),
-			}
+			svcKey := backendServiceKey(ing.Ing.Namespace, awssdk.StringValue(tgt.ServiceName))
 			svc := t.backendServices[svcKey]
 			tg, err := t.buildTargetGroup(ctx, ing, svc, *tgt.ServicePort)
 			if err != nil {
@@ -164,13 +161,44 @@ func (t *defaultModelBuildTask) buildAuthenticateOIDCAction(ctx context.Context,
 		return elbv2model.Action{}, errors.New("missing IDPConfigOIDC")
 	}
 	onUnauthenticatedRequest := elbv2model.AuthenticateOIDCActionConditionalBehavior(authCfg.OnUnauthenticatedRequest)
+	clientID, clientSecret, err := t.loadOIDCClientCredentials(ctx, namespace, *authCfg.IDPConfigOIDC)
+	if err != nil {
+		return elbv2model.Action{}, err
+	}
+	return elbv2model.Action{
+		Type: elbv2model.ActionTypeAuthenticateOIDC,
+		AuthenticateOIDCConfig: &elbv2model.AuthenticateOIDCActionConfig{
+			Issuer:                           authCfg.IDPConfigOIDC.Issuer,
+			AuthorizationEndpoint:            authCfg.IDPConfigOIDC.AuthorizationEndpoint,
+			TokenEndpoint:                    authCfg.IDPConfigOIDC.TokenEndpoint,
+			UserInfoEndpoint:                 authCfg.IDPConfigOIDC.UserInfoEndpoint,
+			ClientID:                         clientID,
+			ClientSecret:                     clientSecret,
+			AuthenticationRequestExtraParams: authCfg.IDPConfigOIDC.AuthenticationRequestExtraParams,
+			OnUnauthenticatedRequest:         &onUnauthenticatedRequest,
+			Scope:                            &authCfg.Scope,
+			SessionCookieName:                &authCfg.SessionCookieName,
+			SessionTimeout:                   &authCfg.SessionTimeout,
+		},
+	}, nil
+}
+
+// loadOIDCClientCredentials loads the clientID and clientSecret of OIDC IdP from either k8s secret or AWS Secrets Manager secret.
+func (t *defaultModelBuildTask) loadOIDCClientCredentials(ctx context.Context, namespace string, idpCfg AuthIDPConfigOIDC) (string, string, error) {
+	if len(idpCfg.SecretARN) != 0 {
+		if len(idpCfg.SecretName) != 0 {
+			return "", "", errors.New("secretName and secretARN are mutually exclusive")
+		}
+		return t.loadOIDCClientCredentialsFromAWSSecret(ctx, namespace, idpCfg.SecretARN)
+	}
+
 	secretKey := types.NamespacedName{
 		Namespace: namespace,
-		Name:      authCfg.IDPConfigOIDC.SecretName,
+		Name:      idpCfg.SecretName,
 	}
 	secret := &corev1.Secret{}
 	if err := t.k8sClient.Get(ctx, secretKey, secret); err != nil {
-		return elbv2model.Action{}, err
+		return "", "", err
 	}
 	rawClientID, ok := secret.Data["clientID"]
 	// AWSALBIngressController looks for clientId, we should be backwards-compatible here.
@@ -178,42 +206,51 @@ func (t *defaultModelBuildTask) buildAuthenticateOIDCAction(ctx context.Context,
 		rawClientID, ok = secret.Data["clientId"]
 	}
 	if !ok {
-		return elbv2model.Action{}, errors.Errorf("missing clientID, secret: %v", secretKey)
+		return "", "", errors.Errorf("missing clientID, secret: %v", secretKey)
 	}
 	rawClientSecret, ok := secret.Data["clientSecret"]
 	if !ok {
-		return elbv2model.Action{}, errors.Errorf("missing clientSecret, secret: %v", secretKey)
+		return "", "", errors.Errorf("missing clientSecret, secret: %v", secretKey)
 	}
 
 	t.secretKeys = append(t.secretKeys, secretKey)
 	clientID := strings.TrimRightFunc(string(rawClientID), unicode.IsSpace)
 	clientSecret := string(rawClientSecret)
-	return elbv2model.Action{
-		Type: elbv2model.ActionTypeAuthenticateOIDC,
-		AuthenticateOIDCConfig: &elbv2model.AuthenticateOIDCActionConfig{
-			Issuer:                           authCfg.IDPConfigOIDC.Issuer,
-			AuthorizationEndpoint:            authCfg.IDPConfigOIDC.AuthorizationEndpoint,
-			TokenEndpoint:                    authCfg.IDPConfigOIDC.TokenEndpoint,
-			UserInfoEndpoint:                 authCfg.IDPConfigOIDC.UserInfoEndpoint,
-			ClientID:                         clientID,
-			ClientSecret:                     clientSecret,
-			AuthenticationRequestExtraParams: authCfg.IDPConfigOIDC.AuthenticationRequestExtraParams,
-			OnUnauthenticatedRequest:         &onUnauthenticatedRequest,
-			Scope:                            &authCfg.Scope,
-			SessionCookieName:                &authCfg.SessionCookieName,
-			SessionTimeout:                   &authCfg.SessionTimeout,
-		},
-	}, nil
+	return clientID, clientSecret, nil
 }
 
-func (t *defaultModelBuildTask) build404Action(_ context.Context) elbv2model.Action {
-	return elbv2model.Action{
-		Type: elbv2model.ActionTypeFixedResponse,
-		FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
-			ContentType: awssdk.String("text/plain"),
-			StatusCode:  "404",
-		},
+// loadOIDCClientCredentialsFromAWSSecret loads the clientID and clientSecret of OIDC IdP from AWS Secrets Manager secret.
+// the secret must be tagged with the namespace of Ingress, so that Ingresses cannot reference secrets meant for other namespaces.
+func (t *defaultModelBuildTask) loadOIDCClientCredentialsFromAWSSecret(ctx context.Context, namespace string, secretARN string) (string, string, error) {
+	if t.awsSecretsManager == nil {
+		return "", "", errors.Errorf("AWS Secrets Manager secret is not supported, secret: %v", secretARN)
+	}
+	tags, err := t.awsSecretsManager.GetSecretTags(ctx, secretARN)
+	if err != nil {
+		return "", "", err
+	}
+	if tags[AWSSecretTagKeyNamespace] != namespace {
+		return "", "", errors.Errorf("secret must be tagged with %v: %v to be referenced from namespace %v, secret: %v",
+			AWSSecretTagKeyNamespace, namespace, namespace, secretARN)
+	}
+	values, err := t.awsSecretsManager.GetSecretValues(ctx, secretARN)
+	if err != nil {
+		return "", "", err
 	}
+	clientID, ok := values["clientID"]
+	if !ok {
+		clientID, ok = values["clientId"]
+	}
+	if !ok {
+		return "", "", errors.Errorf("missing clientID, secret: %v", secretARN)
+	}
+	clientSecret, ok := values["clientSecret"]
+	if !ok {
+		return "", "", errors.Errorf("missing clientSecret, secret: %v", secretARN)
+	}
+
+	t.awsSecretARNs = append(t.awsSecretARNs, secretARN)
+	return strings.TrimRightFunc(clientID, unicode.IsSpace), clientSecret, nil
 }
 
 func (t *defaultModelBuildTask) buildSSLRedirectAction(_ context.Context, sslRedirectConfig SSLRedirectConfig) elbv2model.Action {
@@ -11,12 +11,16 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 	"testing"
+	"time"
 )
 
 func Test_defaultModelBuildTask_buildAuthenticateOIDCAction(t *testing.T) {
 	type env struct {
-		secrets []*corev1.Secret
+		secrets         []*corev1.Secret
+		awsSecretValues map[string]map[string]string
+		awsSecretTags   map[string]map[string]string
 	}
 	type args struct {
 		authCfg   AuthConfig
@@ -224,6 +228,177 @@ func Test_defaultModelBuildTask_buildAuthenticateOIDCAction(t *testing.T) {
 			},
 			wantErr: errors.New("missing clientSecret, secret: my-ns/my-k8s-secret"),
 		},
+		{
+			name: "clientID & clientSecret configured in AWS secret",
+			env: env{
+				awsSecretValues: map[string]map[string]string{
+					"arn:aws:secretsmanager:us-west-2:123456789012:secret:my-idp-secret-a1b2c3": {
+						"clientID":     "my-client-id",
+						"clientSecret": "my-client-secret",
+					},
+				},
+				awsSecretTags: map[string]map[string]string{
+					"arn:aws:secretsmanager:us-west-2:123456789012:secret:my-idp-secret-a1b2c3": {
+						"ingress.k8s.aws/namespace": "my-ns",
+					},
+				},
+			},
+			args: args{
+				authCfg: AuthConfig{
+					Type: AuthTypeOIDC,
+					IDPConfigOIDC: &AuthIDPConfigOIDC{
+						Issuer:                "https://example.com",
+						AuthorizationEndpoint: "https://authorization.example.com",
+						TokenEndpoint:         "https://token.example.com",
+						UserInfoEndpoint:      "https://userinfo.example.co",
+						SecretARN:             "arn:aws:secretsmanager:us-west-2:123456789012:secret:my-idp-secret-a1b2c3",
+					},
+					OnUnauthenticatedRequest: "authenticate",
+					Scope:                    "email",
+					SessionCookieName:        "my-session-cookie",
+					SessionTimeout:           65536,
+				},
+				namespace: "my-ns",
+			},
+			want: elbv2model.Action{
+				Type: elbv2model.ActionTypeAuthenticateOIDC,
+				AuthenticateOIDCConfig: &elbv2model.AuthenticateOIDCActionConfig{
+					Issuer:                   "https://example.com",
+					AuthorizationEndpoint:    "https://authorization.example.com",
+					TokenEndpoint:            "https://token.example.com",
+					UserInfoEndpoint:         "https://userinfo.example.co",
+					ClientID:                 "my-client-id",
+					ClientSecret:             "my-client-secret",
+					OnUnauthenticatedRequest: &authBehaviorAuthenticate,
+					Scope:                    awssdk.String("email"),
+					SessionCookieName:        awssdk.String("my-session-cookie"),
+					SessionTimeout:           awssdk.Int64(65536),
+				},
+			},
+		},
+		{
+			name: "missing clientSecret in AWS secret",
+			env: env{
+				awsSecretValues: map[string]map[string]string{
+					"arn:aws:secretsmanager:us-west-2:123456789012:secret:my-idp-secret-a1b2c3": {
+						"clientID": "my-client-id",
+					},
+				},
+				awsSecretTags: map[string]map[string]string{
+					"arn:aws:secretsmanager:us-west-2:123456789012:secret:my-idp-secret-a1b2c3": {
+						"ingress.k8s.aws/namespace": "my-ns",
+					},
+				},
+			},
+			args: args{
+				authCfg: AuthConfig{
+					Type: AuthTypeOIDC,
+					IDPConfigOIDC: &AuthIDPConfigOIDC{
+						Issuer:                "https://example.com",
+						AuthorizationEndpoint: "https://authorization.example.com",
+						TokenEndpoint:         "https://token.example.com",
+						UserInfoEndpoint:      "https://userinfo.example.co",
+						SecretARN:             "arn:aws:secretsmanager:us-west-2:123456789012:secret:my-idp-secret-a1b2c3",
+					},
+					OnUnauthenticatedRequest: "authenticate",
+					Scope:                    "email",
+					SessionCookieName:        "my-session-cookie",
+					SessionTimeout:           65536,
+				},
+				namespace: "my-ns",
+			},
+			wantErr: errors.New("missing clientSecret, secret: arn:aws:secretsmanager:us-west-2:123456789012:secret:my-idp-secret-a1b2c3"),
+		},
+		{
+			name: "AWS secret not tagged with the namespace of Ingress",
+			env: env{
+				awsSecretValues: map[string]map[string]string{
+					"arn:aws:secretsmanager:us-west-2:123456789012:secret:my-idp-secret-a1b2c3": {
+						"clientID":     "my-client-id",
+						"clientSecret": "my-client-secret",
+					},
+				},
+				awsSecretTags: map[string]map[string]string{
+					"arn:aws:secretsmanager:us-west-2:123456789012:secret:my-idp-secret-a1b2c3": {
+						"ingress.k8s.aws/namespace": "other-ns",
+					},
+				},
+			},
+			args: args{
+				authCfg: AuthConfig{
+					Type: AuthTypeOIDC,
+					IDPConfigOIDC: &AuthIDPConfigOIDC{
+						Issuer:                "https://example.com",
+						AuthorizationEndpoint: "https://authorization.example.com",
+						TokenEndpoint:         "https://token.example.com",
+						UserInfoEndpoint:      "https://userinfo.example.co",
+						SecretARN:             "arn:aws:secretsmanager:us-west-2:123456789012:secret:my-idp-secret-a1b2c3",
+					},
+					OnUnauthenticatedRequest: "authenticate",
+					Scope:                    "email",
+					SessionCookieName:        "my-session-cookie",
+					SessionTimeout:           65536,
+				},
+				namespace: "my-ns",
+			},
+			wantErr: errors.New("secret must be tagged with ingress.k8s.aws/namespace: my-ns to be referenced from namespace my-ns, secret: arn:aws:secretsmanager:us-west-2:123456789012:secret:my-idp-secret-a1b2c3"),
+		},
+		{
+			name: "AWS secret without namespace tag",
+			env: env{
+				awsSecretValues: map[string]map[string]string{
+					"arn:aws:secretsmanager:us-west-2:123456789012:secret:my-idp-secret-a1b2c3": {
+						"clientID":     "my-client-id",
+						"clientSecret": "my-client-secret",
+					},
+				},
+				awsSecretTags: map[string]map[string]string{
+					"arn:aws:secretsmanager:us-west-2:123456789012:secret:my-idp-secret-a1b2c3": {
+						"elbv2.k8s.aws/cluster": "my-cluster",
+					},
+				},
+			},
+			args: args{
+				authCfg: AuthConfig{
+					Type: AuthTypeOIDC,
+					IDPConfigOIDC: &AuthIDPConfigOIDC{
+						Issuer:                "https://example.com",
+						AuthorizationEndpoint: "https://authorization.example.com",
+						TokenEndpoint:         "https://token.example.com",
+						UserInfoEndpoint:      "https://userinfo.example.co",
+						SecretARN:             "arn:aws:secretsmanager:us-west-2:123456789012:secret:my-idp-secret-a1b2c3",
+					},
+					OnUnauthenticatedRequest: "authenticate",
+					Scope:                    "email",
+					SessionCookieName:        "my-session-cookie",
+					SessionTimeout:           65536,
+				},
+				namespace: "my-ns",
+			},
+			wantErr: errors.New("secret must be tagged with ingress.k8s.aws/namespace: my-ns to be referenced from namespace my-ns, secret: arn:aws:secretsmanager:us-west-2:123456789012:secret:my-idp-secret-a1b2c3"),
+		},
+		{
+			name: "both secretName and secretARN configured",
+			args: args{
+				authCfg: AuthConfig{
+					Type: AuthTypeOIDC,
+					IDPConfigOIDC: &AuthIDPConfigOIDC{
+						Issuer:                "https://example.com",
+						AuthorizationEndpoint: "https://authorization.example.com",
+						TokenEndpoint:         "https://token.example.com",
+						UserInfoEndpoint:      "https://userinfo.example.co",
+						SecretName:            "my-k8s-secret",
+						SecretARN:             "arn:aws:secretsmanager:us-west-2:123456789012:secret:my-idp-secret-a1b2c3",
+					},
+					OnUnauthenticatedRequest: "authenticate",
+					Scope:                    "email",
+					SessionCookieName:        "my-session-cookie",
+					SessionTimeout:           65536,
+				},
+				namespace: "my-ns",
+			},
+			wantErr: errors.New("secretName and secretARN are mutually exclusive"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -235,8 +410,17 @@ func Test_defaultModelBuildTask_buildAuthenticateOIDCAction(t *testing.T) {
 				assert.NoError(t, err)
 			}
 
+			awsSecretsManager := NewDefaultAWSSecretsManager(nil, nil, &log.NullLogger{})
+			for secretARN, values := range tt.env.awsSecretValues {
+				awsSecretsManager.secretValuesCache.Set(secretARN, awsSecretValues{versionID: "v1", values: values}, time.Hour)
+			}
+			for secretARN, tags := range tt.env.awsSecretTags {
+				awsSecretsManager.secretTagsCache.Set(secretARN, tags, time.Hour)
+			}
+
 			task := &defaultModelBuildTask{
-				k8sClient: k8sClient,
+				k8sClient:         k8sClient,
+				awsSecretsManager: awsSecretsManager,
 			}
 			got, err := task.buildAuthenticateOIDCAction(context.Background(), tt.args.namespace, tt.args.authCfg)
 			if tt.wantErr != nil {
@@ -0,0 +1,88 @@
+package ingress
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	acmmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/acm"
+)
+
+const (
+	pemBlockTypeCertificate = "CERTIFICATE"
+)
+
+// buildImportedCertificate builds the ACM Certificate imported from a Kubernetes TLS Secret.
+// the Certificate is shared by all listeners referencing the same Secret.
+func (t *defaultModelBuildTask) buildImportedCertificate(ctx context.Context, secretKey types.NamespacedName) (*acmmodel.Certificate, error) {
+	if cert, exists := t.certByTLSSecretKey[secretKey]; exists {
+		return cert, nil
+	}
+	certSpec, err := t.buildImportedCertificateSpec(ctx, secretKey)
+	if err != nil {
+		return nil, err
+	}
+	cert := acmmodel.NewCertificate(t.stack, secretKey.String(), certSpec)
+	t.certByTLSSecretKey[secretKey] = cert
+	return cert, nil
+}
+
+func (t *defaultModelBuildTask) buildImportedCertificateSpec(ctx context.Context, secretKey types.NamespacedName) (acmmodel.CertificateSpec, error) {
+	secret := &corev1.Secret{}
+	if err := t.k8sClient.Get(ctx, secretKey, secret); err != nil {
+		return acmmodel.CertificateSpec{}, err
+	}
+	rawCert, ok := secret.Data[corev1.TLSCertKey]
+	if !ok {
+		return acmmodel.CertificateSpec{}, errors.Errorf("missing %v, secret: %v", corev1.TLSCertKey, secretKey)
+	}
+	rawKey, ok := secret.Data[corev1.TLSPrivateKeyKey]
+	if !ok {
+		return acmmodel.CertificateSpec{}, errors.Errorf("missing %v, secret: %v", corev1.TLSPrivateKeyKey, secretKey)
+	}
+	leafCert, certChain, err := splitPEMCertificateChain(rawCert)
+	if err != nil {
+		return acmmodel.CertificateSpec{}, errors.Wrapf(err, "invalid %v, secret: %v", corev1.TLSCertKey, secretKey)
+	}
+
+	t.secretKeys = append(t.secretKeys, secretKey)
+	return acmmodel.CertificateSpec{
+		SecretRef:        secretKey.String(),
+		Certificate:      leafCert,
+		CertificateChain: certChain,
+		PrivateKey:       string(rawKey),
+		Tags:             t.defaultTags,
+	}, nil
+}
+
+// splitPEMCertificateChain splits PEM encoded certificates into the leaf certificate and the intermediate chain.
+// the leaf certificate is expected to be the first one, as required for Kubernetes TLS Secrets.
+func splitPEMCertificateChain(rawCerts []byte) (string, string, error) {
+	var leafCert []byte
+	var certChain bytes.Buffer
+	rest := rawCerts
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != pemBlockTypeCertificate {
+			continue
+		}
+		if leafCert == nil {
+			leafCert = pem.EncodeToMemory(block)
+			continue
+		}
+		if err := pem.Encode(&certChain, block); err != nil {
+			return "", "", err
+		}
+	}
+	if leafCert == nil {
+		return "", "", errors.New("no PEM encoded certificate found")
+	}
+	return string(leafCert), certChain.String(), nil
+}
@@ -0,0 +1,178 @@
+package ingress
+
+import (
+	"context"
+	"encoding/pem"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	acmmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/acm"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"testing"
+)
+
+func encodeTestPEMBlock(blockType string, content string) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: []byte(content)}))
+}
+
+func Test_splitPEMCertificateChain(t *testing.T) {
+	leafCert := encodeTestPEMBlock("CERTIFICATE", "leaf")
+	intermediateCert1 := encodeTestPEMBlock("CERTIFICATE", "intermediate-1")
+	intermediateCert2 := encodeTestPEMBlock("CERTIFICATE", "intermediate-2")
+	privateKey := encodeTestPEMBlock("RSA PRIVATE KEY", "key")
+	tests := []struct {
+		name          string
+		rawCerts      string
+		wantLeafCert  string
+		wantCertChain string
+		wantErr       error
+	}{
+		{
+			name:          "leaf certificate only",
+			rawCerts:      leafCert,
+			wantLeafCert:  leafCert,
+			wantCertChain: "",
+		},
+		{
+			name:          "leaf certificate with intermediates",
+			rawCerts:      leafCert + intermediateCert1 + intermediateCert2,
+			wantLeafCert:  leafCert,
+			wantCertChain: intermediateCert1 + intermediateCert2,
+		},
+		{
+			name:          "non-certificate blocks are ignored",
+			rawCerts:      privateKey + leafCert + "\n" + intermediateCert1,
+			wantLeafCert:  leafCert,
+			wantCertChain: intermediateCert1,
+		},
+		{
+			name:     "no certificate",
+			rawCerts: "not a certificate",
+			wantErr:  errors.New("no PEM encoded certificate found"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotLeafCert, gotCertChain, err := splitPEMCertificateChain([]byte(tt.rawCerts))
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantLeafCert, gotLeafCert)
+				assert.Equal(t, tt.wantCertChain, gotCertChain)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildImportedCertificateSpec(t *testing.T) {
+	leafCert := encodeTestPEMBlock("CERTIFICATE", "leaf")
+	intermediateCert := encodeTestPEMBlock("CERTIFICATE", "intermediate")
+	privateKey := encodeTestPEMBlock("RSA PRIVATE KEY", "key")
+	secretKey := types.NamespacedName{Namespace: "my-ns", Name: "my-tls"}
+	type env struct {
+		secrets []*corev1.Secret
+	}
+	tests := []struct {
+		name           string
+		env            env
+		want           acmmodel.CertificateSpec
+		wantSecretKeys []types.NamespacedName
+		wantErr        error
+	}{
+		{
+			name: "TLS secret with certificate chain",
+			env: env{
+				secrets: []*corev1.Secret{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "my-ns",
+							Name:      "my-tls",
+						},
+						Type: corev1.SecretTypeTLS,
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       []byte(leafCert + intermediateCert),
+							corev1.TLSPrivateKeyKey: []byte(privateKey),
+						},
+					},
+				},
+			},
+			want: acmmodel.CertificateSpec{
+				SecretRef:        "my-ns/my-tls",
+				Certificate:      leafCert,
+				CertificateChain: intermediateCert,
+				PrivateKey:       privateKey,
+				Tags:             map[string]string{"k1": "v1"},
+			},
+			wantSecretKeys: []types.NamespacedName{secretKey},
+		},
+		{
+			name: "TLS secret without private key",
+			env: env{
+				secrets: []*corev1.Secret{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "my-ns",
+							Name:      "my-tls",
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey: []byte(leafCert),
+						},
+					},
+				},
+			},
+			wantErr: errors.New("missing tls.key, secret: my-ns/my-tls"),
+		},
+		{
+			name: "TLS secret with invalid certificate",
+			env: env{
+				secrets: []*corev1.Secret{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "my-ns",
+							Name:      "my-tls",
+						},
+						Data: map[string][]byte{
+							corev1.TLSCertKey:       []byte("garbage"),
+							corev1.TLSPrivateKeyKey: []byte(privateKey),
+						},
+					},
+				},
+			},
+			wantErr: errors.New("invalid tls.crt, secret: my-ns/my-tls: no PEM encoded certificate found"),
+		},
+		{
+			name:    "TLS secret not found",
+			env:     env{},
+			wantErr: errors.New("secrets \"my-tls\" not found"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			for _, secret := range tt.env.secrets {
+				err := k8sClient.Create(context.Background(), secret.DeepCopy())
+				assert.NoError(t, err)
+			}
+
+			task := &defaultModelBuildTask{
+				k8sClient:   k8sClient,
+				defaultTags: map[string]string{"k1": "v1"},
+			}
+			got, err := task.buildImportedCertificateSpec(context.Background(), secretKey)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Equal(t, tt.wantSecretKeys, task.secretKeys)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package ingress
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	resourceIDFrontendNLB = "FrontendNLB"
+
+	// the health check of frontend NLB targetGroups checks the ALB is reachable, rather than the health of its backends.
+	frontendNLBHealthCheckPath            = "/"
+	frontendNLBHealthCheckMatcherHTTPCode = "200-499"
+)
+
+// frontendNLBConfig is the configuration of the Network Load Balancer in front of the Application Load Balancer.
+type frontendNLBConfig struct {
+	// the allocation IDs of Elastic IP addresses, one for each subnet of the Application Load Balancer.
+	eipAllocations []string
+}
+
+// FindFrontendNLB returns the Network Load Balancer in stack that fronts the Application Load Balancer alb, or nil if there is none.
+func FindFrontendNLB(stack core.Stack, alb *elbv2model.LoadBalancer) (*elbv2model.LoadBalancer, error) {
+	var lbs []*elbv2model.LoadBalancer
+	if err := stack.ListResources(&lbs); err != nil {
+		return nil, err
+	}
+	frontendNLBResID := strings.TrimSuffix(alb.ID(), resourceIDLoadBalancer) + resourceIDFrontendNLB
+	for _, lb := range lbs {
+		if lb.ID() == frontendNLBResID {
+			return lb, nil
+		}
+	}
+	return nil, nil
+}
+
+// buildFrontendNLB builds the Network Load Balancer in front of alb if it's enabled for current shard, which forwards each listen port to alb.
+func (t *defaultModelBuildTask) buildFrontendNLB(ctx context.Context, alb *elbv2model.LoadBalancer, listenPortConfigByPort map[int64]listenPortConfig) (*elbv2model.LoadBalancer, error) {
+	cfg, err := t.buildFrontendNLBConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, nil
+	}
+	nlbSpec, err := t.buildFrontendNLBSpec(ctx, alb, *cfg)
+	if err != nil {
+		return nil, err
+	}
+	nlb := elbv2model.NewLoadBalancer(t.stack, t.buildShardResourceID(resourceIDFrontendNLB), nlbSpec)
+
+	ports := make([]int64, 0, len(listenPortConfigByPort))
+	for port := range listenPortConfigByPort {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool {
+		return ports[i] < ports[j]
+	})
+	for _, port := range ports {
+		resID := t.buildShardResourceID(fmt.Sprintf("%v:%v", resourceIDFrontendNLB, port))
+		tgSpec := t.buildFrontendNLBTargetGroupSpec(ctx, alb, port, listenPortConfigByPort[port].protocol)
+		tg := elbv2model.NewTargetGroup(t.stack, resID, tgSpec)
+		elbv2model.NewListener(t.stack, resID, elbv2model.ListenerSpec{
+			LoadBalancerARN: nlb.LoadBalancerARN(),
+			Port:            port,
+			Protocol:        elbv2model.ProtocolTCP,
+			DefaultActions: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeForward,
+					ForwardConfig: &elbv2model.ForwardActionConfig{
+						TargetGroups: []elbv2model.TargetGroupTuple{
+							{
+								TargetGroupARN: tg.TargetGroupARN(),
+							},
+						},
+					},
+				},
+			},
+			Tags: alb.Spec.Tags,
+		})
+	}
+	return nlb, nil
+}
+
+// buildFrontendNLBConfig builds the frontend NLB configuration of current shard, it's nil if no member Ingress enables the frontend NLB.
+func (t *defaultModelBuildTask) buildFrontendNLBConfig(_ context.Context) (*frontendNLBConfig, error) {
+	var chosenCfg *frontendNLBConfig
+	var chosenCfgProvider types.NamespacedName
+	for _, member := range t.ingGroup.Members {
+		ingKey := k8s.NamespacedName(member.Ing)
+		cfg, err := t.buildFrontendNLBConfigForIngress(member)
+		if err != nil {
+			return nil, errors.Wrapf(err, "ingress: %v", ingKey)
+		}
+		if cfg == nil {
+			continue
+		}
+		if chosenCfg == nil {
+			chosenCfg = cfg
+			chosenCfgProvider = ingKey
+			continue
+		}
+		if !equality.Semantic.DeepEqual(chosenCfg.eipAllocations, cfg.eipAllocations) {
+			return nil, errors.Errorf("conflicting frontend NLB eipAllocations, %v: %v | %v: %v",
+				chosenCfgProvider, chosenCfg.eipAllocations, ingKey, cfg.eipAllocations)
+		}
+	}
+	return chosenCfg, nil
+}
+
+// buildFrontendNLBConfigForIngress builds the frontend NLB configuration of a member Ingress.
+// the configuration from IngressClassParams takes precedence over annotations on Ingress.
+func (t *defaultModelBuildTask) buildFrontendNLBConfigForIngress(member ClassifiedIngress) (*frontendNLBConfig, error) {
+	if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.FrontendNLB != nil {
+		return &frontendNLBConfig{
+			eipAllocations: member.IngClassConfig.IngClassParams.Spec.FrontendNLB.EIPAllocations,
+		}, nil
+	}
+	enabled := false
+	if _, err := t.annotationParser.ParseBoolAnnotation(annotations.IngressSuffixEnableFrontendNLB, &enabled, member.Ing.Annotations); err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, nil
+	}
+	var eipAllocations []string
+	_ = t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixFrontendNLBEIPAllocations, &eipAllocations, member.Ing.Annotations)
+	return &frontendNLBConfig{
+		eipAllocations: eipAllocations,
+	}, nil
+}
+
+func (t *defaultModelBuildTask) buildFrontendNLBSpec(_ context.Context, alb *elbv2model.LoadBalancer, cfg frontendNLBConfig) (elbv2model.LoadBalancerSpec, error) {
+	scheme := *alb.Spec.Scheme
+	if len(cfg.eipAllocations) != 0 {
+		if scheme != elbv2model.LoadBalancerSchemeInternetFacing {
+			return elbv2model.LoadBalancerSpec{}, errors.New("frontend NLB eipAllocations can only be used with internet-facing scheme")
+		}
+		if len(cfg.eipAllocations) != len(alb.Spec.SubnetMappings) {
+			return elbv2model.LoadBalancerSpec{}, errors.Errorf("count of frontend NLB eipAllocations (%d) and subnets (%d) must match",
+				len(cfg.eipAllocations), len(alb.Spec.SubnetMappings))
+		}
+	}
+	subnetMappings := make([]elbv2model.SubnetMapping, 0, len(alb.Spec.SubnetMappings))
+	for idx, albSubnetMapping := range alb.Spec.SubnetMappings {
+		subnetMapping := elbv2model.SubnetMapping{
+			SubnetID: albSubnetMapping.SubnetID,
+		}
+		if len(cfg.eipAllocations) != 0 {
+			subnetMapping.AllocationID = awssdk.String(cfg.eipAllocations[idx])
+		}
+		subnetMappings = append(subnetMappings, subnetMapping)
+	}
+	// the frontend NLB is deliberately built without security groups, the security groups of alb must allow traffic from its subnets instead.
+	// security groups can only be attached to an NLB on creation, so they can't be added to existing frontend NLBs without replacing them and their IP addresses.
+	return elbv2model.LoadBalancerSpec{
+		Name:           t.buildFrontendNLBResourceName(string(scheme)),
+		Type:           elbv2model.LoadBalancerTypeNetwork,
+		Scheme:         &scheme,
+		IPAddressType:  alb.Spec.IPAddressType,
+		SubnetMappings: subnetMappings,
+		Tags:           alb.Spec.Tags,
+	}, nil
+}
+
+// buildFrontendNLBTargetGroupSpec builds the targetGroup that forwards traffic on port to alb.
+func (t *defaultModelBuildTask) buildFrontendNLBTargetGroupSpec(_ context.Context, alb *elbv2model.LoadBalancer, port int64, albProtocol elbv2model.Protocol) elbv2model.TargetGroupSpec {
+	healthCheckPort := intstr.FromString(healthCheckPortTrafficPort)
+	healthCheckProtocol := elbv2model.ProtocolHTTP
+	if albProtocol == elbv2model.ProtocolHTTPS {
+		healthCheckProtocol = elbv2model.ProtocolHTTPS
+	}
+	return elbv2model.TargetGroupSpec{
+		Name:       t.buildFrontendNLBResourceName(strconv.FormatInt(port, 10)),
+		TargetType: elbv2model.TargetTypeALB,
+		Port:       port,
+		Protocol:   elbv2model.ProtocolTCP,
+		HealthCheckConfig: &elbv2model.TargetGroupHealthCheckConfig{
+			Port:     &healthCheckPort,
+			Protocol: &healthCheckProtocol,
+			Path:     awssdk.String(frontendNLBHealthCheckPath),
+			Matcher: &elbv2model.HealthCheckMatcher{
+				HTTPCode: awssdk.String(frontendNLBHealthCheckMatcherHTTPCode),
+			},
+		},
+		ALBTarget: &elbv2model.TargetGroupALBTarget{
+			LoadBalancerARN: alb.LoadBalancerARN(),
+		},
+		Tags: alb.Spec.Tags,
+	}
+}
+
+// buildFrontendNLBResourceName builds the name for the frontend NLB or its targetGroups, which is distinguished by discriminant.
+func (t *defaultModelBuildTask) buildFrontendNLBResourceName(discriminant string) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.ingGroup.ID.String()))
+	_, _ = uuidHash.Write([]byte(resourceIDFrontendNLB))
+	_, _ = uuidHash.Write([]byte(discriminant))
+	if t.shardIndex != 0 {
+		_, _ = uuidHash.Write([]byte(strconv.Itoa(t.shardIndex)))
+	}
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	if t.ingGroup.ID.IsExplicit() {
+		payload := invalidLoadBalancerNamePattern.ReplaceAllString(t.ingGroup.ID.Name, "")
+		return fmt.Sprintf("k8s-%.17s-%.10s", payload, uuid)
+	}
+	sanitizedNamespace := invalidLoadBalancerNamePattern.ReplaceAllString(t.ingGroup.ID.Namespace, "")
+	sanitizedName := invalidLoadBalancerNamePattern.ReplaceAllString(t.ingGroup.ID.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
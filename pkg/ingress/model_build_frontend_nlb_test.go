@@ -0,0 +1,278 @@
+package ingress
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_defaultModelBuildTask_buildFrontendNLBConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		ingGroup Group
+		want     *frontendNLBConfig
+		wantErr  error
+	}{
+		{
+			name: "frontend NLB isn't enabled",
+			ingGroup: Group{
+				ID: GroupID{Namespace: "awesome-ns", Name: "ing-1"},
+				Members: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "frontend NLB enabled via annotations",
+			ingGroup: Group{
+				ID: GroupID{Name: "awesome-group"},
+				Members: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/enable-frontend-nlb":          "true",
+									"alb.ingress.kubernetes.io/frontend-nlb-eip-allocations": "eipalloc-1,eipalloc-2",
+								},
+							},
+						},
+					},
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-2",
+							},
+						},
+					},
+				},
+			},
+			want: &frontendNLBConfig{
+				eipAllocations: []string{"eipalloc-1", "eipalloc-2"},
+			},
+		},
+		{
+			name: "frontend NLB from IngressClassParams takes precedence over annotations",
+			ingGroup: Group{
+				ID: GroupID{Name: "awesome-group"},
+				Members: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/enable-frontend-nlb":          "false",
+									"alb.ingress.kubernetes.io/frontend-nlb-eip-allocations": "eipalloc-1,eipalloc-2",
+								},
+							},
+						},
+						IngClassConfig: ClassConfiguration{
+							IngClassParams: &elbv2api.IngressClassParams{
+								Spec: elbv2api.IngressClassParamsSpec{
+									FrontendNLB: &elbv2api.FrontendNLB{
+										EIPAllocations: []string{"eipalloc-3", "eipalloc-4"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: &frontendNLBConfig{
+				eipAllocations: []string{"eipalloc-3", "eipalloc-4"},
+			},
+		},
+		{
+			name: "conflicting eipAllocations",
+			ingGroup: Group{
+				ID: GroupID{Name: "awesome-group"},
+				Members: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/enable-frontend-nlb":          "true",
+									"alb.ingress.kubernetes.io/frontend-nlb-eip-allocations": "eipalloc-1,eipalloc-2",
+								},
+							},
+						},
+					},
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-2",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/enable-frontend-nlb": "true",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("conflicting frontend NLB eipAllocations, awesome-ns/ing-1: [eipalloc-1 eipalloc-2] | awesome-ns/ing-2: []"),
+		},
+		{
+			name: "invalid enable-frontend-nlb annotation",
+			ingGroup: Group{
+				ID: GroupID{Namespace: "awesome-ns", Name: "ing-1"},
+				Members: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/enable-frontend-nlb": "yes",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("ingress: awesome-ns/ing-1: failed to parse bool annotation, alb.ingress.kubernetes.io/enable-frontend-nlb: yes: strconv.ParseBool: parsing \"yes\": invalid syntax"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				ingGroup:         tt.ingGroup,
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got, err := task.buildFrontendNLBConfig(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildFrontendNLBSpec(t *testing.T) {
+	schemeInternetFacing := elbv2.LoadBalancerSchemeInternetFacing
+	schemeInternal := elbv2.LoadBalancerSchemeInternal
+	ipAddressTypeIPv4 := elbv2.IPAddressTypeIPV4
+	tests := []struct {
+		name      string
+		albScheme *elbv2.LoadBalancerScheme
+		cfg       frontendNLBConfig
+		want      elbv2.LoadBalancerSpec
+		wantErr   error
+	}{
+		{
+			name:      "frontend NLB without eipAllocations",
+			albScheme: &schemeInternal,
+			cfg:       frontendNLBConfig{},
+			want: elbv2.LoadBalancerSpec{
+				Name:          "k8s-awesomegroup-dfa1e518b2",
+				Type:          elbv2.LoadBalancerTypeNetwork,
+				Scheme:        &schemeInternal,
+				IPAddressType: &ipAddressTypeIPv4,
+				SubnetMappings: []elbv2.SubnetMapping{
+					{SubnetID: "subnet-1"},
+					{SubnetID: "subnet-2"},
+				},
+				Tags: map[string]string{"k": "v"},
+			},
+		},
+		{
+			name:      "frontend NLB with eipAllocations",
+			albScheme: &schemeInternetFacing,
+			cfg: frontendNLBConfig{
+				eipAllocations: []string{"eipalloc-1", "eipalloc-2"},
+			},
+			want: elbv2.LoadBalancerSpec{
+				Name:          "k8s-awesomegroup-cc2f73ad32",
+				Type:          elbv2.LoadBalancerTypeNetwork,
+				Scheme:        &schemeInternetFacing,
+				IPAddressType: &ipAddressTypeIPv4,
+				SubnetMappings: []elbv2.SubnetMapping{
+					{SubnetID: "subnet-1", AllocationID: awssdk.String("eipalloc-1")},
+					{SubnetID: "subnet-2", AllocationID: awssdk.String("eipalloc-2")},
+				},
+				Tags: map[string]string{"k": "v"},
+			},
+		},
+		{
+			name:      "eipAllocations with internal scheme",
+			albScheme: &schemeInternal,
+			cfg: frontendNLBConfig{
+				eipAllocations: []string{"eipalloc-1", "eipalloc-2"},
+			},
+			wantErr: errors.New("frontend NLB eipAllocations can only be used with internet-facing scheme"),
+		},
+		{
+			name:      "eipAllocations count mismatch with subnets",
+			albScheme: &schemeInternetFacing,
+			cfg: frontendNLBConfig{
+				eipAllocations: []string{"eipalloc-1"},
+			},
+			wantErr: errors.New("count of frontend NLB eipAllocations (1) and subnets (2) must match"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := core.NewDefaultStack(core.StackID{Name: "awesome-group"})
+			alb := elbv2.NewLoadBalancer(stack, resourceIDLoadBalancer, elbv2.LoadBalancerSpec{
+				Type:          elbv2.LoadBalancerTypeApplication,
+				Scheme:        tt.albScheme,
+				IPAddressType: &ipAddressTypeIPv4,
+				SubnetMappings: []elbv2.SubnetMapping{
+					{SubnetID: "subnet-1"},
+					{SubnetID: "subnet-2"},
+				},
+				Tags: map[string]string{"k": "v"},
+			})
+			task := &defaultModelBuildTask{
+				clusterName: "cluster-name",
+				ingGroup:    Group{ID: GroupID{Name: "awesome-group"}},
+			}
+			got, err := task.buildFrontendNLBSpec(context.Background(), alb, tt.cfg)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_FindFrontendNLB(t *testing.T) {
+	stack := core.NewDefaultStack(core.StackID{Name: "awesome-group"})
+	alb := elbv2.NewLoadBalancer(stack, resourceIDLoadBalancer, elbv2.LoadBalancerSpec{})
+	shardALB := elbv2.NewLoadBalancer(stack, "shard-1/"+resourceIDLoadBalancer, elbv2.LoadBalancerSpec{})
+	shardNLB := elbv2.NewLoadBalancer(stack, "shard-1/"+resourceIDFrontendNLB, elbv2.LoadBalancerSpec{})
+
+	got, err := FindFrontendNLB(stack, alb)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	got, err = FindFrontendNLB(stack, shardALB)
+	assert.NoError(t, err)
+	assert.Equal(t, shardNLB, got)
+}
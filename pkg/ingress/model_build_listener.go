@@ -7,16 +7,18 @@ import (
 	"net"
 	"strings"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certs"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
 )
 
 func (t *defaultModelBuildTask) buildListener(ctx context.Context, lbARN core.StringToken, port int64, config listenPortConfig, ingList []ClassifiedIngress) (*elbv2model.Listener, error) {
@@ -24,7 +26,7 @@ func (t *defaultModelBuildTask) buildListener(ctx context.Context, lbARN core.St
 	if err != nil {
 		return nil, err
 	}
-	lsResID := fmt.Sprintf("%v", port)
+	lsResID := t.buildShardResourceID(fmt.Sprintf("%v", port))
 	ls := elbv2model.NewListener(t.stack, lsResID, lsSpec)
 	return ls, nil
 }
@@ -38,20 +40,37 @@ func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, lbARN cor
 	if err != nil {
 		return elbv2model.ListenerSpec{}, err
 	}
-	certs := make([]elbv2model.Certificate, 0, len(config.tlsCerts))
+	certs := make([]elbv2model.Certificate, 0, len(config.tlsCerts)+len(config.tlsSecrets))
 	for _, certARN := range config.tlsCerts {
 		certs = append(certs, elbv2model.Certificate{
-			CertificateARN: awssdk.String(certARN),
+			CertificateARN: core.LiteralStringToken(certARN),
 		})
 	}
+	for _, secretKey := range config.tlsSecrets {
+		cert, err := t.buildImportedCertificate(ctx, secretKey)
+		if err != nil {
+			return elbv2model.ListenerSpec{}, err
+		}
+		certs = append(certs, elbv2model.Certificate{
+			CertificateARN: cert.CertificateARN(),
+		})
+	}
+	var mutualAuthentication *elbv2model.MutualAuthenticationAttributes
+	if config.mutualAuthentication != nil {
+		mutualAuthentication, err = t.buildListenerMutualAuthentication(ctx, *config.mutualAuthentication)
+		if err != nil {
+			return elbv2model.ListenerSpec{}, err
+		}
+	}
 	return elbv2model.ListenerSpec{
-		LoadBalancerARN: lbARN,
-		Port:            port,
-		Protocol:        config.protocol,
-		DefaultActions:  defaultActions,
-		Certificates:    certs,
-		SSLPolicy:       config.sslPolicy,
-		Tags:            tags,
+		LoadBalancerARN:      lbARN,
+		Port:                 port,
+		Protocol:             config.protocol,
+		DefaultActions:       defaultActions,
+		Certificates:         certs,
+		SSLPolicy:            config.sslPolicy,
+		MutualAuthentication: mutualAuthentication,
+		Tags:                 tags,
 	}, nil
 }
 
@@ -67,7 +86,7 @@ func (t *defaultModelBuildTask) buildListenerDefaultActions(ctx context.Context,
 		}
 	}
 	if len(ingsWithDefaultBackend) == 0 {
-		action404 := t.build404Action(ctx)
+		action404 := elbv2model.NewFixedResponseAction("404")
 		return []elbv2model.Action{action404}, nil
 	}
 	if len(ingsWithDefaultBackend) > 1 {
@@ -80,11 +99,16 @@ func (t *defaultModelBuildTask) buildListenerDefaultActions(ctx context.Context,
 	ing := ingsWithDefaultBackend[0]
 	enhancedBackend, err := t.enhancedBackendBuilder.Build(ctx, ing.Ing, *ing.Ing.Spec.DefaultBackend,
 		WithLoadBackendServices(true, t.backendServices),
-		WithLoadAuthConfig(true))
+		WithLoadAuthConfig(true),
+		WithLoadTrafficShifts(t.enableTrafficShifts))
 	if err != nil {
-		return nil, err
+		return nil, newMemberBuildError(k8s.NamespacedName(ing.Ing), err)
 	}
-	return t.buildActions(ctx, protocol, ing, enhancedBackend)
+	actions, err := t.buildActions(ctx, protocol, ing, enhancedBackend)
+	if err != nil {
+		return nil, newMemberBuildError(k8s.NamespacedName(ing.Ing), err)
+	}
+	return actions, nil
 }
 
 func (t *defaultModelBuildTask) buildListenerTags(_ context.Context, ingList []ClassifiedIngress) (map[string]string, error) {
@@ -102,20 +126,38 @@ type listenPortConfig struct {
 	inboundCIDRv6s []string
 	sslPolicy      *string
 	tlsCerts       []string
+	// tlsSecrets are the Kubernetes TLS Secrets to import into ACM, used after tlsCerts.
+	tlsSecrets []types.NamespacedName
+	// mutualAuthentication is the mutual TLS authentication config, it's left unmanaged if nil.
+	mutualAuthentication *mutualAuthenticationConfig
 }
 
-func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context.Context, ing *networking.Ingress) (map[int64]listenPortConfig, error) {
+func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context.Context, member ClassifiedIngress) (map[int64]listenPortConfig, error) {
+	ing := member.Ing
 	explicitTLSCertARNs := t.computeIngressExplicitTLSCertARNs(ctx, ing)
 	explicitSSLPolicy := t.computeIngressExplicitSSLPolicy(ctx, ing)
 	inboundCIDRv4s, inboundCIDRV6s, err := t.computeIngressExplicitInboundCIDRs(ctx, ing)
 	if err != nil {
 		return nil, err
 	}
-	preferTLS := len(explicitTLSCertARNs) != 0
+	var tlsSecrets []types.NamespacedName
+	if t.importTLSSecrets && len(explicitTLSCertARNs) == 0 {
+		tlsSecrets = t.computeIngressTLSSecrets(ctx, ing)
+	}
+	preferTLS := len(explicitTLSCertARNs) != 0 || len(tlsSecrets) != 0
 	listenPorts, err := t.computeIngressListenPorts(ctx, ing, preferTLS)
 	if err != nil {
 		return nil, err
 	}
+	mutualAuthByPort, err := t.computeIngressMutualAuthenticationByPort(ctx, member)
+	if err != nil {
+		return nil, err
+	}
+	for port := range mutualAuthByPort {
+		if listenPorts[port] != elbv2model.ProtocolHTTPS {
+			return nil, errors.Errorf("mutual-authentication is only supported for %v listen ports: %v", elbv2model.ProtocolHTTPS, port)
+		}
+	}
 
 	containsHTTPSPort := false
 	for _, protocol := range listenPorts {
@@ -125,8 +167,8 @@ func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context
 		}
 	}
 	var inferredTLSCertARNs []string
-	if containsHTTPSPort && len(explicitTLSCertARNs) == 0 {
-		inferredTLSCertARNs, err = t.computeIngressInferredTLSCertARNs(ctx, ing)
+	if containsHTTPSPort && len(explicitTLSCertARNs) == 0 && len(tlsSecrets) == 0 {
+		inferredTLSCertARNs, err = t.computeIngressInferredTLSCertARNs(ctx, member)
 		if err != nil {
 			return nil, err
 		}
@@ -145,7 +187,11 @@ func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context
 			} else {
 				cfg.tlsCerts = explicitTLSCertARNs
 			}
+			cfg.tlsSecrets = tlsSecrets
 			cfg.sslPolicy = explicitSSLPolicy
+			if mutualAuth, ok := mutualAuthByPort[port]; ok {
+				cfg.mutualAuthentication = &mutualAuth
+			}
 		}
 		listenPortConfigByPort[port] = cfg
 	}
@@ -159,7 +205,58 @@ func (t *defaultModelBuildTask) computeIngressExplicitTLSCertARNs(_ context.Cont
 	return rawTLSCertARNs
 }
 
-func (t *defaultModelBuildTask) computeIngressInferredTLSCertARNs(ctx context.Context, ing *networking.Ingress) ([]string, error) {
+// computeIngressTLSSecrets computes the TLS Secrets referenced by Ingress spec.tls, which are imported into ACM.
+func (t *defaultModelBuildTask) computeIngressTLSSecrets(_ context.Context, ing *networking.Ingress) []types.NamespacedName {
+	var secretKeys []types.NamespacedName
+	for _, tls := range ing.Spec.TLS {
+		if len(tls.SecretName) == 0 {
+			continue
+		}
+		secretKeys = append(secretKeys, types.NamespacedName{Namespace: ing.Namespace, Name: tls.SecretName})
+	}
+	return secretKeys
+}
+
+func (t *defaultModelBuildTask) computeIngressInferredTLSCertARNs(ctx context.Context, member ClassifiedIngress) ([]string, error) {
+	hosts := computeIngressTLSHosts(member.Ing)
+	selections, err := t.certDiscovery.Discover(ctx, hosts.List(), buildCertSelectionPolicyOverride(member.IngClassConfig))
+	if err != nil {
+		var notFoundErr *certs.CertificateNotFoundError
+		if t.certRequester != nil && errors.As(err, &notFoundErr) {
+			return nil, t.requestCertificates(ctx, member, notFoundErr.Hosts)
+		}
+		return nil, err
+	}
+	for _, selection := range selections {
+		if len(selection.Decision) != 0 && t.certSelectionTracker.Changed(k8s.NamespacedName(member.Ing), selection) {
+			t.eventRecorder.Event(member.Ing, corev1.EventTypeNormal, k8s.IngressEventReasonCertificateSelected,
+				fmt.Sprintf("Selected certificate %v for host %v: %v", selection.CertARN, selection.Host, selection.Decision))
+		}
+	}
+	return certs.CertSelectionARNs(selections), nil
+}
+
+// requestCertificates requests certificates for tlsHosts without certificate,
+// and returns an error to requeue the member Ingress until the certificates are issued and discovered.
+func (t *defaultModelBuildTask) requestCertificates(ctx context.Context, member ClassifiedIngress, tlsHosts []string) error {
+	certRequests, err := t.certRequester.Request(ctx, t.stack.StackID(), tlsHosts)
+	if err != nil {
+		return err
+	}
+	for _, certRequest := range certRequests {
+		if certRequest.Requested {
+			t.eventRecorder.Event(member.Ing, corev1.EventTypeNormal, k8s.IngressEventReasonCertificateRequested,
+				fmt.Sprintf("Requested certificate %v for host %v", certRequest.CertARN, certRequest.Host))
+			continue
+		}
+		t.eventRecorder.Event(member.Ing, corev1.EventTypeNormal, k8s.IngressEventReasonCertificatePending,
+			fmt.Sprintf("Waiting for certificate %v for host %v, status: %v", certRequest.CertARN, certRequest.Host, certRequest.Status))
+	}
+	return runtime.NewRequeueNeededAfter(fmt.Sprintf("waiting for certificates for hosts: %v", tlsHosts), defaultCertRequestRequeueDuration)
+}
+
+// computeIngressTLSHosts computes the hosts of Ingress to infer certificates for.
+func computeIngressTLSHosts(ing *networking.Ingress) sets.String {
 	hosts := sets.NewString()
 	for _, r := range ing.Spec.Rules {
 		if len(r.Host) != 0 {
@@ -169,7 +266,39 @@ func (t *defaultModelBuildTask) computeIngressInferredTLSCertARNs(ctx context.Co
 	for _, t := range ing.Spec.TLS {
 		hosts.Insert(t.Hosts...)
 	}
-	return t.certDiscovery.Discover(ctx, hosts.List())
+	return hosts
+}
+
+// ComputeGroupTLSHosts computes the hosts of member Ingresses of ingGroup to infer certificates for.
+func ComputeGroupTLSHosts(ingGroup Group) []string {
+	hosts := sets.NewString()
+	for _, member := range ingGroup.Members {
+		hosts = hosts.Union(computeIngressTLSHosts(member.Ing))
+	}
+	return hosts.List()
+}
+
+// buildCertSelectionPolicyOverride returns the overrides of controller-wide certificate selection policy from IngressClassParams, or nil if there is none.
+func buildCertSelectionPolicyOverride(ingClassConfig ClassConfiguration) *certs.CertSelectionPolicyOverride {
+	if ingClassConfig.IngClassParams == nil || ingClassConfig.IngClassParams.Spec.CertificateSelection == nil {
+		return nil
+	}
+	certSelection := ingClassConfig.IngClassParams.Spec.CertificateSelection
+	policy := &certs.CertSelectionPolicyOverride{
+		PreferExactMatch:          certSelection.PreferExactMatch,
+		PreferLatestExpiry:        certSelection.PreferLatestExpiry,
+		ExcludeExpiringWithinDays: certSelection.ExcludeExpiringWithinDays,
+	}
+	if len(certSelection.Tags) != 0 {
+		policy.Tags = make(map[string]string, len(certSelection.Tags))
+		for _, tag := range certSelection.Tags {
+			policy.Tags[tag.Key] = tag.Value
+		}
+	}
+	for _, certType := range certSelection.Types {
+		policy.Types = append(policy.Types, string(certType))
+	}
+	return policy
 }
 
 func (t *defaultModelBuildTask) computeIngressListenPorts(_ context.Context, ing *networking.Ingress, preferTLS bool) (map[int64]elbv2model.Protocol, error) {
@@ -7,52 +7,97 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
+const (
+	// maxListenerRulePriority is the maximum priority of listener rules that ALB supports.
+	maxListenerRulePriority int64 = 50000
+	// listenerRulePriorityBlockSize is the number of priorities reserved for rules of each group order.
+	// it's chosen so that blocks for all group orders fits within maxListenerRulePriority.
+	listenerRulePriorityBlockSize int64 = maxListenerRulePriority / (maxGroupOder + 1)
+)
+
 func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN core.StringToken, port int64, protocol elbv2model.Protocol, ingList []ClassifiedIngress) error {
 	if t.sslRedirectConfig != nil && protocol == elbv2model.ProtocolHTTP {
 		return nil
 	}
 
 	var rules []Rule
+	explicitPriorityOwners := make(map[int64]explicitRulePriorityOwner)
 	for _, ing := range ingList {
+		groupOrder, err := t.buildGroupOrder(ctx, ing)
+		if err != nil {
+			return newMemberBuildError(k8s.NamespacedName(ing.Ing), err)
+		}
+		rulePriorities, err := ParseRulePriorities(t.annotationParser, ing.Ing)
+		if err != nil {
+			return newMemberBuildError(k8s.NamespacedName(ing.Ing), err)
+		}
+		explicitRuleCountByKey := make(map[string]int64)
 		for _, rule := range ing.Ing.Spec.Rules {
 			if rule.HTTP == nil {
 				continue
 			}
 			paths, err := t.sortIngressPaths(rule.HTTP.Paths)
 			if err != nil {
-				return err
+				return newMemberBuildError(k8s.NamespacedName(ing.Ing), err)
 			}
 			for _, path := range paths {
 				enhancedBackend, err := t.enhancedBackendBuilder.Build(ctx, ing.Ing, path.Backend,
 					WithLoadBackendServices(true, t.backendServices),
-					WithLoadAuthConfig(true))
+					WithLoadAuthConfig(true),
+					WithLoadTrafficShifts(t.enableTrafficShifts))
 				if err != nil {
-					return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
+					return newMemberBuildError(k8s.NamespacedName(ing.Ing), err)
 				}
-				conditions, err := t.buildRuleConditions(ctx, rule, path, enhancedBackend)
-				if err != nil {
-					return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
+				explicitPriorityKey, explicitPriority := findExplicitRulePriority(rulePriorities, path)
+				// canary rules are built before the rules of backend, so that they take priority.
+				for _, backend := range expandCanaryBackends(enhancedBackend) {
+					conditions, err := t.buildRuleConditions(ctx, rule, path, backend)
+					if err != nil {
+						return newMemberBuildError(k8s.NamespacedName(ing.Ing), err)
+					}
+					actions, err := t.buildActions(ctx, protocol, ing, backend)
+					if err != nil {
+						return newMemberBuildError(k8s.NamespacedName(ing.Ing), err)
+					}
+					tags, err := t.buildListenerRuleTags(ctx, ing)
+					if err != nil {
+						return newMemberBuildError(k8s.NamespacedName(ing.Ing), err)
+					}
+					splitConditions := elbv2model.SplitRuleConditions(conditions)
+					if len(splitConditions) > 1 {
+						t.eventRecorder.Event(ing.Ing, corev1.EventTypeNormal, k8s.IngressEventReasonSplitListenerRule,
+							fmt.Sprintf("Split listener rule for host %q and path %q into %v rules to stay within the limits of %v values per rule and %v values per condition",
+								rule.Host, path.Path, len(splitConditions), elbv2model.MaxRuleConditionValues, elbv2model.MaxConditionValues))
+					}
+					for _, ruleConditions := range splitConditions {
+						var priority int64
+						if explicitPriority != 0 {
+							priority = explicitPriority + explicitRuleCountByKey[explicitPriorityKey]
+							explicitRuleCountByKey[explicitPriorityKey]++
+							if err := t.claimExplicitRulePriority(ing, port, priority, explicitPriorityKey, explicitPriorityOwners); err != nil {
+								return err
+							}
+						}
+						rules = append(rules, Rule{
+							Conditions: ruleConditions,
+							Actions:    actions,
+							Tags:       tags,
+							GroupOrder: groupOrder,
+							Priority:   priority,
+						})
+					}
 				}
-				actions, err := t.buildActions(ctx, protocol, ing, enhancedBackend)
-				if err != nil {
-					return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
-				}
-				tags, err := t.buildListenerRuleTags(ctx, ing)
-				if err != nil {
-					return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
-				}
-				rules = append(rules, Rule{
-					Conditions: conditions,
-					Actions:    actions,
-					Tags:       tags,
-				})
 			}
 		}
 	}
@@ -61,22 +106,141 @@ func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN co
 		return err
 	}
 
-	priority := int64(1)
-	for _, rule := range optimizedRules {
-		ruleResID := fmt.Sprintf("%v:%v", port, priority)
+	priorities, err := buildListenerRulePriorities(optimizedRules)
+	if err != nil {
+		return err
+	}
+	for i, rule := range optimizedRules {
+		ruleResID := t.buildShardResourceID(fmt.Sprintf("%v:%v", port, priorities[i]))
 		_ = elbv2model.NewListenerRule(t.stack, ruleResID, elbv2model.ListenerRuleSpec{
 			ListenerARN: lsARN,
-			Priority:    priority,
+			Priority:    priorities[i],
 			Conditions:  rule.Conditions,
 			Actions:     rule.Actions,
 			Tags:        rule.Tags,
 		})
-		priority += 1
 	}
 
 	return nil
 }
 
+// expandCanaryBackends expands the backend into the backends of canary rules followed by itself.
+// each canary backend routes requests matching one of the canary match conditions to the canary Service.
+func expandCanaryBackends(backend EnhancedBackend) []EnhancedBackend {
+	if backend.Canary == nil {
+		return []EnhancedBackend{backend}
+	}
+	backends := make([]EnhancedBackend, 0, len(backend.Canary.MatchConditions)+1)
+	for _, matchCondition := range backend.Canary.MatchConditions {
+		conditions := make([]RuleCondition, 0, len(backend.Conditions)+1)
+		conditions = append(conditions, backend.Conditions...)
+		conditions = append(conditions, matchCondition)
+		backends = append(backends, EnhancedBackend{
+			Conditions: conditions,
+			Action:     backend.Canary.Action,
+			AuthConfig: backend.AuthConfig,
+		})
+	}
+	backendWithoutCanary := backend
+	backendWithoutCanary.Canary = nil
+	return append(backends, backendWithoutCanary)
+}
+
+// claimExplicitRulePriority claims the explicit priority on listener of port for the rules of Ingress specified by key.
+// the Ingress fails to build if the priority is already claimed, either by another Ingress, or by another key of the same Ingress
+// when rules of a key are split or expanded into a range of priorities overlapping the priority of another key.
+func (t *defaultModelBuildTask) claimExplicitRulePriority(ing ClassifiedIngress, port int64, priority int64, key string,
+	owners map[int64]explicitRulePriorityOwner) error {
+	ingKey := k8s.NamespacedName(ing.Ing)
+	owner, claimed := owners[priority]
+	if !claimed {
+		owners[priority] = explicitRulePriorityOwner{ingKey: ingKey, key: key}
+		return nil
+	}
+	collision := rulePriorityCollision{
+		port:     port,
+		priority: priority,
+		ingKeys:  []types.NamespacedName{owner.ingKey, ingKey},
+	}
+	t.eventRecorder.Event(ing.Ing, corev1.EventTypeWarning, k8s.IngressEventReasonConflictingRulePriority, collision.Error())
+	return newMemberBuildError(ingKey, collision)
+}
+
+// buildGroupOrder returns the group order of Ingress, which is defaultGroupOrder if not explicitly specified.
+func (t *defaultModelBuildTask) buildGroupOrder(_ context.Context, ing ClassifiedIngress) (int64, error) {
+	groupOrder := defaultGroupOrder
+	if _, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixGroupOrder, &groupOrder, ing.Ing.Annotations); err != nil {
+		return 0, err
+	}
+	return groupOrder, nil
+}
+
+// findExplicitRulePriority finds the explicit priority for rule of path, along with the key it's specified by.
+// Priority specified by path takes precedence over priority specified by the name of use-annotation action.
+func findExplicitRulePriority(rulePriorities map[string]int64, path networking.HTTPIngressPath) (string, int64) {
+	if priority, ok := rulePriorities[path.Path]; ok {
+		return path.Path, priority
+	}
+	if path.Backend.Service != nil && path.Backend.Service.Port.Name == magicServicePortUseAnnotation {
+		if priority, ok := rulePriorities[path.Backend.Service.Name]; ok {
+			return path.Backend.Service.Name, priority
+		}
+	}
+	return "", 0
+}
+
+// buildListenerRulePriorities assigns priorities to rules, which are expected to be sorted by their group order.
+// Rules with explicit priority keep their priority, and other rules never take these priorities.
+// A block of listenerRulePriorityBlockSize priorities is reserved for each group order, so that adding or removing rules of
+// an Ingress won't change the priorities of rules for Ingresses of other group orders, unless the block overflows.
+// If the reserved blocks cannot fit within maxListenerRulePriority, consecutive priorities are assigned instead.
+func buildListenerRulePriorities(rules []Rule) ([]int64, error) {
+	explicitPriorities := sets.NewInt64()
+	for _, rule := range rules {
+		if rule.Priority == 0 {
+			continue
+		}
+		if rule.Priority > maxListenerRulePriority {
+			return nil, errors.Errorf("explicit listener rule priority exceeds %v: %v", maxListenerRulePriority, rule.Priority)
+		}
+		if explicitPriorities.Has(rule.Priority) {
+			return nil, errors.Errorf("conflicting listener rule priority: %v", rule.Priority)
+		}
+		explicitPriorities.Insert(rule.Priority)
+	}
+
+	assignPriorities := func(blockSize int64) ([]int64, bool) {
+		priorities := make([]int64, 0, len(rules))
+		nextPriority := int64(1)
+		for _, rule := range rules {
+			if rule.Priority != 0 {
+				priorities = append(priorities, rule.Priority)
+				continue
+			}
+			priority := rule.GroupOrder*blockSize + 1
+			if priority < nextPriority {
+				priority = nextPriority
+			}
+			for explicitPriorities.Has(priority) {
+				priority++
+			}
+			if priority > maxListenerRulePriority {
+				return nil, false
+			}
+			priorities = append(priorities, priority)
+			nextPriority = priority + 1
+		}
+		return priorities, true
+	}
+	if priorities, ok := assignPriorities(listenerRulePriorityBlockSize); ok {
+		return priorities, nil
+	}
+	if priorities, ok := assignPriorities(0); ok {
+		return priorities, nil
+	}
+	return nil, errors.Errorf("listener rules exceeds maximum priority %v", maxListenerRulePriority)
+}
+
 // sortIngressPaths will sort the paths following the strategy:
 // all exact match paths come first, no need to sort since exact match has to be unique
 // followed by prefix paths, sort by lengths - longer paths get precedence
@@ -173,13 +337,13 @@ func (t *defaultModelBuildTask) buildRuleConditions(ctx context.Context, rule ne
 		}
 	}
 	if len(hosts) != 0 {
-		conditions = append(conditions, t.buildHostHeaderCondition(ctx, hosts))
+		conditions = append(conditions, elbv2model.NewHostHeaderCondition(hosts))
 	}
 	if len(paths) != 0 {
-		conditions = append(conditions, t.buildPathPatternCondition(ctx, paths))
+		conditions = append(conditions, elbv2model.NewPathPatternCondition(paths))
 	}
 	if len(conditions) == 0 {
-		conditions = append(conditions, t.buildPathPatternCondition(ctx, []string{"/*"}))
+		conditions = append(conditions, elbv2model.NewPathPatternCondition([]string{"/*"}))
 	}
 	return conditions, nil
 }
@@ -289,29 +453,13 @@ func (t *defaultModelBuildTask) buildSourceIPCondition(_ context.Context, condit
 	}, nil
 }
 
-func (t *defaultModelBuildTask) buildHostHeaderCondition(_ context.Context, hosts []string) elbv2model.RuleCondition {
-	return elbv2model.RuleCondition{
-		Field: elbv2model.RuleConditionFieldHostHeader,
-		HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
-			Values: hosts,
-		},
-	}
-}
-
-func (t *defaultModelBuildTask) buildPathPatternCondition(_ context.Context, paths []string) elbv2model.RuleCondition {
-	return elbv2model.RuleCondition{
-		Field: elbv2model.RuleConditionFieldPathPattern,
-		PathPatternConfig: &elbv2model.PathPatternConditionConfig{
-			Values: paths,
-		},
-	}
-}
-
+// buildListenerRuleTags builds the AWS Tags for ListenerRules of ing, they are tagged with the Ingress as well,
+// so that they can be retained if ing is quarantined.
 func (t *defaultModelBuildTask) buildListenerRuleTags(_ context.Context, ing ClassifiedIngress) (map[string]string, error) {
 	ingTags, err := t.buildIngressResourceTags(ing)
 	if err != nil {
 		return nil, err
 	}
-
-	return algorithm.MergeStringMap(t.defaultTags, ingTags), nil
+	ingKeyTags := map[string]string{ListenerRuleTagKeyIngress: k8s.NamespacedName(ing.Ing).String()}
+	return algorithm.MergeStringMap(ingKeyTags, t.defaultTags, ingTags), nil
 }
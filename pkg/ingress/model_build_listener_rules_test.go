@@ -5,6 +5,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"testing"
 )
 
@@ -675,3 +677,283 @@ func Test_defaultModelBuildTask_buildPathPatternsForPrefixPathType(t *testing.T)
 		})
 	}
 }
+
+func Test_buildListenerRulePriorities(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   []Rule
+		want    []int64
+		wantErr string
+	}{
+		{
+			name:  "rules of implicit group order",
+			rules: []Rule{{}, {}, {}},
+			want:  []int64{1, 2, 3},
+		},
+		{
+			name:  "rules of explicit group orders",
+			rules: []Rule{{}, {}, {GroupOrder: 1}, {GroupOrder: 1}, {GroupOrder: 10}},
+			want:  []int64{1, 2, 50, 51, 491},
+		},
+		{
+			name:  "rules overflows reserved block",
+			rules: []Rule{{GroupOrder: 1}, {GroupOrder: 1}, {GroupOrder: 1}, {GroupOrder: 2}},
+			want:  []int64{50, 51, 52, 99},
+		},
+		{
+			name:  "rules with explicit priorities",
+			rules: []Rule{{Priority: 2}, {}, {}, {}, {GroupOrder: 1, Priority: 10}, {GroupOrder: 1}},
+			want:  []int64{2, 1, 3, 4, 10, 50},
+		},
+		{
+			name:    "rules with conflicting explicit priorities",
+			rules:   []Rule{{Priority: 2}, {}, {Priority: 2}},
+			wantErr: "conflicting listener rule priority: 2",
+		},
+		{
+			name: "rules exceeds maximum priority",
+			rules: func() []Rule {
+				var rules []Rule
+				for i := 0; i < 1001; i++ {
+					rules = append(rules, Rule{GroupOrder: 1000})
+				}
+				return rules
+			}(),
+			want: func() []int64 {
+				var priorities []int64
+				for i := 1; i <= 1001; i++ {
+					priorities = append(priorities, int64(i))
+				}
+				return priorities
+			}(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildListenerRulePriorities(tt.rules)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_claimExplicitRulePriority(t *testing.T) {
+	newIngress := func(name string) ClassifiedIngress {
+		return ClassifiedIngress{
+			Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: name}},
+		}
+	}
+	type claim struct {
+		ing      ClassifiedIngress
+		priority int64
+		key      string
+	}
+	tests := []struct {
+		name    string
+		claims  []claim
+		wantErr string
+	}{
+		{
+			name: "priorities of split rules don't collide",
+			claims: []claim{
+				{ing: newIngress("ing-1"), priority: 1, key: "/healthz"},
+				{ing: newIngress("ing-1"), priority: 2, key: "/healthz"},
+				{ing: newIngress("ing-2"), priority: 3, key: "/status"},
+			},
+		},
+		{
+			name: "split rules collide with priority of another Ingress",
+			claims: []claim{
+				{ing: newIngress("ing-1"), priority: 2, key: "/status"},
+				{ing: newIngress("ing-2"), priority: 1, key: "/healthz"},
+				{ing: newIngress("ing-2"), priority: 2, key: "/healthz"},
+			},
+			wantErr: "ingress: awesome-ns/ing-2: conflicting listener rule priority 2 on port 80 between Ingresses: [awesome-ns/ing-1 awesome-ns/ing-2]",
+		},
+		{
+			name: "split rules collide with priority of another key within Ingress",
+			claims: []claim{
+				{ing: newIngress("ing-1"), priority: 1, key: "/healthz"},
+				{ing: newIngress("ing-1"), priority: 2, key: "/healthz"},
+				{ing: newIngress("ing-1"), priority: 2, key: "/status"},
+			},
+			wantErr: "ingress: awesome-ns/ing-1: conflicting listener rule priority 2 on port 80 between Ingresses: [awesome-ns/ing-1 awesome-ns/ing-1]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{eventRecorder: record.NewFakeRecorder(10)}
+			owners := make(map[int64]explicitRulePriorityOwner)
+			var err error
+			for _, c := range tt.claims {
+				if err = task.claimExplicitRulePriority(c.ing, 80, c.priority, c.key, owners); err != nil {
+					break
+				}
+			}
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_findExplicitRulePriority(t *testing.T) {
+	rulePriorities := map[string]int64{
+		"/healthz":    1,
+		"maintenance": 2,
+	}
+	tests := []struct {
+		name         string
+		path         networking.HTTPIngressPath
+		wantKey      string
+		wantPriority int64
+	}{
+		{
+			name: "priority specified by path",
+			path: networking.HTTPIngressPath{
+				Path: "/healthz",
+				Backend: networking.IngressBackend{
+					Service: &networking.IngressServiceBackend{Name: "svc", Port: networking.ServiceBackendPort{Number: 80}},
+				},
+			},
+			wantKey:      "/healthz",
+			wantPriority: 1,
+		},
+		{
+			name: "priority specified by use-annotation action",
+			path: networking.HTTPIngressPath{
+				Path: "/*",
+				Backend: networking.IngressBackend{
+					Service: &networking.IngressServiceBackend{Name: "maintenance", Port: networking.ServiceBackendPort{Name: "use-annotation"}},
+				},
+			},
+			wantKey:      "maintenance",
+			wantPriority: 2,
+		},
+		{
+			name: "service with same name as action",
+			path: networking.HTTPIngressPath{
+				Path: "/*",
+				Backend: networking.IngressBackend{
+					Service: &networking.IngressServiceBackend{Name: "maintenance", Port: networking.ServiceBackendPort{Number: 80}},
+				},
+			},
+			wantKey:      "",
+			wantPriority: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotKey, gotPriority := findExplicitRulePriority(rulePriorities, tt.path)
+			assert.Equal(t, tt.wantKey, gotKey)
+			assert.Equal(t, tt.wantPriority, gotPriority)
+		})
+	}
+}
+
+func Test_expandCanaryBackends(t *testing.T) {
+	stableAction := Action{
+		Type: ActionTypeForward,
+		ForwardConfig: &ForwardActionConfig{
+			TargetGroups: []TargetGroupTuple{
+				{ServiceName: awssdk.String("svc"), Weight: awssdk.Int64(90)},
+				{ServiceName: awssdk.String("svc-canary"), Weight: awssdk.Int64(10)},
+			},
+		},
+	}
+	canaryAction := Action{
+		Type: ActionTypeForward,
+		ForwardConfig: &ForwardActionConfig{
+			TargetGroups: []TargetGroupTuple{
+				{ServiceName: awssdk.String("svc-canary")},
+			},
+		},
+	}
+	methodCondition := RuleCondition{
+		Field:                   RuleConditionFieldHTTPRequestMethod,
+		HTTPRequestMethodConfig: &HTTPRequestMethodConditionConfig{Values: []string{"GET"}},
+	}
+	headerCondition := RuleCondition{
+		Field:            RuleConditionFieldHTTPHeader,
+		HTTPHeaderConfig: &HTTPHeaderConditionConfig{HTTPHeaderName: "X-Canary", Values: []string{"always"}},
+	}
+	cookieCondition := RuleCondition{
+		Field:            RuleConditionFieldHTTPHeader,
+		HTTPHeaderConfig: &HTTPHeaderConditionConfig{HTTPHeaderName: "Cookie", Values: []string{"canary=always", "canary=always;*", "*; canary=always", "*; canary=always;*"}},
+	}
+	authCfg := AuthConfig{Type: AuthTypeCognito}
+	tests := []struct {
+		name    string
+		backend EnhancedBackend
+		want    []EnhancedBackend
+	}{
+		{
+			name: "backend without canary",
+			backend: EnhancedBackend{
+				Conditions: []RuleCondition{methodCondition},
+				Action:     canaryAction,
+			},
+			want: []EnhancedBackend{
+				{
+					Conditions: []RuleCondition{methodCondition},
+					Action:     canaryAction,
+				},
+			},
+		},
+		{
+			name: "backend with canary by header and cookie",
+			backend: EnhancedBackend{
+				Conditions: []RuleCondition{methodCondition},
+				Action:     stableAction,
+				AuthConfig: authCfg,
+				Canary: &CanaryBackend{
+					MatchConditions: []RuleCondition{headerCondition, cookieCondition},
+					Action:          canaryAction,
+				},
+			},
+			want: []EnhancedBackend{
+				{
+					Conditions: []RuleCondition{methodCondition, headerCondition},
+					Action:     canaryAction,
+					AuthConfig: authCfg,
+				},
+				{
+					Conditions: []RuleCondition{methodCondition, cookieCondition},
+					Action:     canaryAction,
+					AuthConfig: authCfg,
+				},
+				{
+					Conditions: []RuleCondition{methodCondition},
+					Action:     stableAction,
+					AuthConfig: authCfg,
+				},
+			},
+		},
+		{
+			name: "backend with canary by weight only",
+			backend: EnhancedBackend{
+				Action: stableAction,
+				Canary: &CanaryBackend{
+					Action: canaryAction,
+				},
+			},
+			want: []EnhancedBackend{
+				{
+					Action: stableAction,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandCanaryBackends(tt.backend)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
@@ -17,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
@@ -34,7 +36,7 @@ func (t *defaultModelBuildTask) buildLoadBalancer(ctx context.Context, listenPor
 	if err != nil {
 		return nil, err
 	}
-	lb := elbv2model.NewLoadBalancer(t.stack, resourceIDLoadBalancer, lbSpec)
+	lb := elbv2model.NewLoadBalancer(t.stack, t.buildShardResourceID(resourceIDLoadBalancer), lbSpec)
 	t.loadBalancer = lb
 	return lb, nil
 }
@@ -97,6 +99,9 @@ func (t *defaultModelBuildTask) buildLoadBalancerName(_ context.Context, scheme
 		explicitNames.Insert(rawName)
 	}
 	if len(explicitNames) == 1 {
+		if t.shardIndex != 0 {
+			return "", errors.New("load balancer name cannot be specified when IngressGroup is sharded across multiple load balancers")
+		}
 		name, _ := explicitNames.PopAny()
 		// The name of the loadbalancer can only have up to 32 characters
 		if len(name) > 32 {
@@ -111,6 +116,9 @@ func (t *defaultModelBuildTask) buildLoadBalancerName(_ context.Context, scheme
 	_, _ = uuidHash.Write([]byte(t.clusterName))
 	_, _ = uuidHash.Write([]byte(t.ingGroup.ID.String()))
 	_, _ = uuidHash.Write([]byte(scheme))
+	if t.shardIndex != 0 {
+		_, _ = uuidHash.Write([]byte(strconv.Itoa(t.shardIndex)))
+	}
 	uuid := hex.EncodeToString(uuidHash.Sum(nil))
 
 	if t.ingGroup.ID.IsExplicit() {
@@ -219,6 +227,8 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
+	// the stack also contains the frontend NLB if it's enabled, its subnets are derived from the ALB rather than the other way around.
+	sdkLBs = excludeSDKNetworkLoadBalancers(sdkLBs)
 
 	if len(sdkLBs) == 0 || (string(scheme) != awssdk.StringValue(sdkLBs[0].LoadBalancer.Scheme)) {
 		chosenSubnets, err := t.subnetsResolver.ResolveViaDiscovery(ctx,
@@ -241,6 +251,17 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Cont
 	return buildLoadBalancerSubnetMappingsWithSubnetIDs(subnetIDs), nil
 }
 
+func excludeSDKNetworkLoadBalancers(sdkLBs []elbv2deploy.LoadBalancerWithTags) []elbv2deploy.LoadBalancerWithTags {
+	var sdkALBs []elbv2deploy.LoadBalancerWithTags
+	for _, sdkLB := range sdkLBs {
+		if awssdk.StringValue(sdkLB.LoadBalancer.Type) == string(elbv2model.LoadBalancerTypeNetwork) {
+			continue
+		}
+		sdkALBs = append(sdkALBs, sdkLB)
+	}
+	return sdkALBs
+}
+
 func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig, ipAddressType elbv2model.IPAddressType) ([]core.StringToken, error) {
 	sgNameOrIDsViaAnnotation, err := t.buildFrontendSGNameOrIDsFromAnnotation(ctx)
 	if err != nil {
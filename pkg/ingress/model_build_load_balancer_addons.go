@@ -40,7 +40,7 @@ func (t *defaultModelBuildTask) buildWAFv2WebACLAssociation(_ context.Context, l
 	}
 	webACLARN, _ := explicitWebACLARNs.PopAny()
 	if webACLARN != "" {
-		association := wafv2model.NewWebACLAssociation(t.stack, resourceIDLoadBalancer, wafv2model.WebACLAssociationSpec{
+		association := wafv2model.NewWebACLAssociation(t.stack, t.buildShardResourceID(resourceIDLoadBalancer), wafv2model.WebACLAssociationSpec{
 			WebACLARN:   webACLARN,
 			ResourceARN: lbARN,
 		})
@@ -67,7 +67,7 @@ func (t *defaultModelBuildTask) buildWAFRegionalWebACLAssociation(_ context.Cont
 	}
 	webACLID, _ := explicitWebACLIDs.PopAny()
 	if webACLID != "" {
-		association := wafregionalmodel.NewWebACLAssociation(t.stack, resourceIDLoadBalancer, wafregionalmodel.WebACLAssociationSpec{
+		association := wafregionalmodel.NewWebACLAssociation(t.stack, t.buildShardResourceID(resourceIDLoadBalancer), wafregionalmodel.WebACLAssociationSpec{
 			WebACLID:    webACLID,
 			ResourceARN: lbARN,
 		})
@@ -95,7 +95,7 @@ func (t *defaultModelBuildTask) buildShieldProtection(_ context.Context, lbARN c
 		return nil, errors.New("conflicting enable shield advanced protection")
 	}
 	if _, enableProtection := explicitEnableProtections[true]; enableProtection {
-		protection := shieldmodel.NewProtection(t.stack, resourceIDLoadBalancer, shieldmodel.ProtectionSpec{
+		protection := shieldmodel.NewProtection(t.stack, t.buildShardResourceID(resourceIDLoadBalancer), shieldmodel.ProtectionSpec{
 			ResourceARN: lbARN,
 		})
 		return protection, nil
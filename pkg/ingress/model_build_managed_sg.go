@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"regexp"
+	"strconv"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
@@ -23,7 +24,7 @@ func (t *defaultModelBuildTask) buildManagedSecurityGroup(ctx context.Context, l
 		return nil, err
 	}
 
-	sg := ec2model.NewSecurityGroup(t.stack, resourceIDManagedSecurityGroup, sgSpec)
+	sg := ec2model.NewSecurityGroup(t.stack, t.buildShardResourceID(resourceIDManagedSecurityGroup), sgSpec)
 	return sg, nil
 }
 
@@ -48,6 +49,9 @@ func (t *defaultModelBuildTask) buildManagedSecurityGroupName(_ context.Context)
 	uuidHash := sha256.New()
 	_, _ = uuidHash.Write([]byte(t.clusterName))
 	_, _ = uuidHash.Write([]byte(t.ingGroup.ID.String()))
+	if t.shardIndex != 0 {
+		_, _ = uuidHash.Write([]byte(strconv.Itoa(t.shardIndex)))
+	}
 	uuid := hex.EncodeToString(uuidHash.Sum(nil))
 
 	if t.ingGroup.ID.IsExplicit() {
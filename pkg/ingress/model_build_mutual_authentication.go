@@ -0,0 +1,269 @@
+package ingress
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	// the default key of the CA certificates bundle within ConfigMaps or Secrets.
+	defaultCABundleKey = "ca.crt"
+)
+
+// MutualAuthenticationConfig is the mutual TLS authentication config of a listener port, specified via the mutual-authentication annotation.
+type MutualAuthenticationConfig struct {
+	Port                          int64           `json:"port"`
+	Mode                          string          `json:"mode"`
+	TrustStore                    *string         `json:"trustStore,omitempty"`
+	CABundle                      *CABundleConfig `json:"caBundle,omitempty"`
+	IgnoreClientCertificateExpiry *bool           `json:"ignoreClientCertificateExpiry,omitempty"`
+}
+
+// CABundleConfig is the ConfigMap or Secret in Ingress's namespace that contains the CA certificates bundle.
+type CABundleConfig struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	Key  string `json:"key,omitempty"`
+
+	// namespace is only set for CA certificates bundles referenced from IngressClassParams, defaults to Ingress's namespace.
+	namespace string
+}
+
+// the mutual authentication config for specific listener port.
+type mutualAuthenticationConfig struct {
+	mode          elbv2model.MutualAuthenticationMode
+	trustStoreARN string
+	// caBundle is the CA certificates bundle a trustStore is managed from, used instead of trustStoreARN if set.
+	caBundle                      *caBundleRef
+	ignoreClientCertificateExpiry bool
+}
+
+// the reference to a CA certificates bundle in ConfigMap or Secret.
+type caBundleRef struct {
+	kind string
+	key  types.NamespacedName
+	// the key within the ConfigMap or Secret.
+	dataKey string
+}
+
+func (r caBundleRef) String() string {
+	return fmt.Sprintf("%v/%v/%v", r.kind, r.key, r.dataKey)
+}
+
+func (c mutualAuthenticationConfig) Equal(other mutualAuthenticationConfig) bool {
+	if c.mode != other.mode || c.trustStoreARN != other.trustStoreARN || c.ignoreClientCertificateExpiry != other.ignoreClientCertificateExpiry {
+		return false
+	}
+	if c.caBundle == nil || other.caBundle == nil {
+		return c.caBundle == nil && other.caBundle == nil
+	}
+	return *c.caBundle == *other.caBundle
+}
+
+// computeIngressMutualAuthenticationByPort computes the mutual authentication config by port for Ingress.
+// the mutualAuthentication settings in IngressClassParams takes higher priority than the mutual-authentication annotation.
+func (t *defaultModelBuildTask) computeIngressMutualAuthenticationByPort(_ context.Context, member ClassifiedIngress) (map[int64]mutualAuthenticationConfig, error) {
+	var rawConfigs []MutualAuthenticationConfig
+	if member.IngClassConfig.IngClassParams != nil && len(member.IngClassConfig.IngClassParams.Spec.MutualAuthentication) != 0 {
+		rawConfigs = convertIngClassParamsMutualAuthentication(member.IngClassConfig.IngClassParams.Spec.MutualAuthentication)
+	} else if _, err := t.annotationParser.ParseJSONAnnotation(annotations.IngressSuffixMutualAuthentication, &rawConfigs, member.Ing.Annotations); err != nil {
+		return nil, err
+	}
+	if len(rawConfigs) == 0 {
+		return nil, nil
+	}
+
+	mutualAuthByPort := make(map[int64]mutualAuthenticationConfig, len(rawConfigs))
+	for _, rawConfig := range rawConfigs {
+		if _, exists := mutualAuthByPort[rawConfig.Port]; exists {
+			return nil, errors.Errorf("duplicate mutual-authentication configuration for port: %v", rawConfig.Port)
+		}
+		cfg, err := t.buildMutualAuthenticationConfig(rawConfig, member.Ing.Namespace)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid mutual-authentication configuration for port: %v", rawConfig.Port)
+		}
+		mutualAuthByPort[rawConfig.Port] = cfg
+	}
+	return mutualAuthByPort, nil
+}
+
+func (t *defaultModelBuildTask) buildMutualAuthenticationConfig(rawConfig MutualAuthenticationConfig, namespace string) (mutualAuthenticationConfig, error) {
+	mode := elbv2model.MutualAuthenticationMode(rawConfig.Mode)
+	switch mode {
+	case elbv2model.MutualAuthenticationModeOff, elbv2model.MutualAuthenticationModePassthrough:
+		if rawConfig.TrustStore != nil || rawConfig.CABundle != nil || rawConfig.IgnoreClientCertificateExpiry != nil {
+			return mutualAuthenticationConfig{}, errors.Errorf("trustStore, caBundle and ignoreClientCertificateExpiry are only supported in %v mode", elbv2model.MutualAuthenticationModeVerify)
+		}
+		return mutualAuthenticationConfig{mode: mode}, nil
+	case elbv2model.MutualAuthenticationModeVerify:
+	default:
+		return mutualAuthenticationConfig{}, errors.Errorf("mode must be within [%v, %v, %v]: %v",
+			elbv2model.MutualAuthenticationModeOff, elbv2model.MutualAuthenticationModePassthrough, elbv2model.MutualAuthenticationModeVerify, rawConfig.Mode)
+	}
+
+	cfg := mutualAuthenticationConfig{
+		mode:                          mode,
+		ignoreClientCertificateExpiry: rawConfig.IgnoreClientCertificateExpiry != nil && *rawConfig.IgnoreClientCertificateExpiry,
+	}
+	switch {
+	case rawConfig.TrustStore != nil && rawConfig.CABundle != nil:
+		return mutualAuthenticationConfig{}, errors.New("trustStore and caBundle are mutually exclusive")
+	case rawConfig.TrustStore != nil:
+		cfg.trustStoreARN = *rawConfig.TrustStore
+	case rawConfig.CABundle != nil:
+		if !t.enableManagedTrustStores {
+			return mutualAuthenticationConfig{}, errors.New("caBundle requires the controller to be configured with a trust store S3 bucket")
+		}
+		kind := rawConfig.CABundle.Kind
+		if kind != string(elbv2api.CABundleSourceKindConfigMap) && kind != string(elbv2api.CABundleSourceKindSecret) {
+			return mutualAuthenticationConfig{}, errors.Errorf("caBundle kind must be within [%v, %v]: %v",
+				elbv2api.CABundleSourceKindConfigMap, elbv2api.CABundleSourceKindSecret, kind)
+		}
+		dataKey := rawConfig.CABundle.Key
+		if len(dataKey) == 0 {
+			dataKey = defaultCABundleKey
+		}
+		caBundleNamespace := namespace
+		if len(rawConfig.CABundle.namespace) != 0 {
+			caBundleNamespace = rawConfig.CABundle.namespace
+		}
+		cfg.caBundle = &caBundleRef{
+			kind:    kind,
+			key:     types.NamespacedName{Namespace: caBundleNamespace, Name: rawConfig.CABundle.Name},
+			dataKey: dataKey,
+		}
+	default:
+		return mutualAuthenticationConfig{}, errors.Errorf("either trustStore or caBundle must be specified in %v mode", elbv2model.MutualAuthenticationModeVerify)
+	}
+	return cfg, nil
+}
+
+// convertIngClassParamsMutualAuthentication converts the mutualAuthentication settings in IngressClassParams into annotation configs.
+// the CA certificates bundle is referenced with explicit namespace in IngressClassParams.
+func convertIngClassParamsMutualAuthentication(mutualAuths []elbv2api.MutualAuthentication) []MutualAuthenticationConfig {
+	rawConfigs := make([]MutualAuthenticationConfig, 0, len(mutualAuths))
+	for _, mutualAuth := range mutualAuths {
+		rawConfig := MutualAuthenticationConfig{
+			Port:                          mutualAuth.Port,
+			Mode:                          string(mutualAuth.Mode),
+			TrustStore:                    mutualAuth.TrustStore,
+			IgnoreClientCertificateExpiry: mutualAuth.IgnoreClientCertificateExpiry,
+		}
+		if mutualAuth.CABundle != nil {
+			rawConfig.CABundle = &CABundleConfig{
+				Kind:      string(mutualAuth.CABundle.Kind),
+				Name:      mutualAuth.CABundle.Name,
+				Key:       mutualAuth.CABundle.Key,
+				namespace: mutualAuth.CABundle.Namespace,
+			}
+		}
+		rawConfigs = append(rawConfigs, rawConfig)
+	}
+	return rawConfigs
+}
+
+// buildListenerMutualAuthentication builds the mutual authentication settings of listener.
+func (t *defaultModelBuildTask) buildListenerMutualAuthentication(ctx context.Context, cfg mutualAuthenticationConfig) (*elbv2model.MutualAuthenticationAttributes, error) {
+	attrs := &elbv2model.MutualAuthenticationAttributes{
+		Mode: cfg.mode,
+	}
+	if cfg.mode != elbv2model.MutualAuthenticationModeVerify {
+		return attrs, nil
+	}
+	attrs.IgnoreClientCertificateExpiry = &cfg.ignoreClientCertificateExpiry
+	if cfg.caBundle == nil {
+		attrs.TrustStoreARN = core.LiteralStringToken(cfg.trustStoreARN)
+		return attrs, nil
+	}
+	ts, err := t.buildManagedTrustStore(ctx, *cfg.caBundle)
+	if err != nil {
+		return nil, err
+	}
+	attrs.TrustStoreARN = ts.TrustStoreARN()
+	return attrs, nil
+}
+
+// buildManagedTrustStore builds the TrustStore managed from a CA certificates bundle.
+// the TrustStore is shared by all listeners referencing the same CA certificates bundle.
+func (t *defaultModelBuildTask) buildManagedTrustStore(ctx context.Context, ref caBundleRef) (*elbv2model.TrustStore, error) {
+	if ts, exists := t.trustStoreByCABundle[ref]; exists {
+		return ts, nil
+	}
+	caBundle, err := t.loadCABundle(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	tsResID := ref.String()
+	ts := elbv2model.NewTrustStore(t.stack, tsResID, elbv2model.TrustStoreSpec{
+		Name:                 t.buildTrustStoreName(tsResID),
+		BundleRef:            ref.String(),
+		CACertificatesBundle: caBundle,
+		Tags:                 t.defaultTags,
+	})
+	t.trustStoreByCABundle[ref] = ts
+	return ts, nil
+}
+
+// loadCABundle loads the PEM encoded CA certificates bundle from ConfigMap or Secret.
+// the ConfigMaps and Secrets are monitored to rebuild the model upon changes, see caBundleKeys.
+func (t *defaultModelBuildTask) loadCABundle(ctx context.Context, ref caBundleRef) (string, error) {
+	var rawCABundle []byte
+	switch ref.kind {
+	case string(elbv2api.CABundleSourceKindSecret):
+		secret := &corev1.Secret{}
+		if err := t.k8sClient.Get(ctx, ref.key, secret); err != nil {
+			return "", err
+		}
+		rawCABundle = secret.Data[ref.dataKey]
+	default:
+		configMap := &corev1.ConfigMap{}
+		if err := t.k8sClient.Get(ctx, ref.key, configMap); err != nil {
+			return "", err
+		}
+		rawCABundle = []byte(configMap.Data[ref.dataKey])
+	}
+	if len(rawCABundle) == 0 {
+		return "", errors.Errorf("missing %v, %v: %v", ref.dataKey, ref.kind, ref.key)
+	}
+	if block, _ := pem.Decode(rawCABundle); block == nil || block.Type != pemBlockTypeCertificate {
+		return "", errors.Errorf("invalid %v, %v: %v, no PEM encoded certificate found", ref.dataKey, ref.kind, ref.key)
+	}
+	return string(rawCABundle), nil
+}
+
+// caBundleKeys returns the ConfigMaps and Secrets holding the CA certificates bundles of managed trustStores.
+func (t *defaultModelBuildTask) caBundleKeys() ([]types.NamespacedName, []types.NamespacedName) {
+	var configMapKeys, secretKeys []types.NamespacedName
+	for ref := range t.trustStoreByCABundle {
+		if ref.kind == string(elbv2api.CABundleSourceKindSecret) {
+			secretKeys = append(secretKeys, ref.key)
+		} else {
+			configMapKeys = append(configMapKeys, ref.key)
+		}
+	}
+	return configMapKeys, secretKeys
+}
+
+// buildTrustStoreName builds a name unique per cluster, IngressGroup and CA certificates bundle.
+// trustStore names can only have up to 32 alphanumeric characters or hyphens.
+func (t *defaultModelBuildTask) buildTrustStoreName(tsResID string) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.ingGroup.ID.String()))
+	_, _ = uuidHash.Write([]byte(tsResID))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	sanitizedName := invalidLoadBalancerNamePattern.ReplaceAllString(t.ingGroup.ID.Name, "")
+	return fmt.Sprintf("k8s-%.14s-%.12s", sanitizedName, uuid)
+}
@@ -0,0 +1,334 @@
+package ingress
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"testing"
+)
+
+func Test_defaultModelBuildTask_computeIngressMutualAuthenticationByPort(t *testing.T) {
+	tests := []struct {
+		name                     string
+		enableManagedTrustStores bool
+		member                   ClassifiedIngress
+		want                     map[int64]mutualAuthenticationConfig
+		wantErr                  error
+	}{
+		{
+			name: "no mutual authentication",
+			member: ClassifiedIngress{
+				Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "ing"}},
+			},
+			want: nil,
+		},
+		{
+			name: "mutual authentication via annotation",
+			member: ClassifiedIngress{
+				Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-ns",
+					Name:      "ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/mutual-authentication": `[{"port":443,"mode":"verify","trustStore":"ts-arn","ignoreClientCertificateExpiry":true},{"port":8443,"mode":"passthrough"}]`,
+					},
+				}},
+			},
+			want: map[int64]mutualAuthenticationConfig{
+				443: {
+					mode:                          elbv2model.MutualAuthenticationModeVerify,
+					trustStoreARN:                 "ts-arn",
+					ignoreClientCertificateExpiry: true,
+				},
+				8443: {
+					mode: elbv2model.MutualAuthenticationModePassthrough,
+				},
+			},
+		},
+		{
+			name:                     "mutual authentication with CA bundle in Ingress namespace",
+			enableManagedTrustStores: true,
+			member: ClassifiedIngress{
+				Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-ns",
+					Name:      "ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/mutual-authentication": `[{"port":443,"mode":"verify","caBundle":{"kind":"ConfigMap","name":"my-ca"}}]`,
+					},
+				}},
+			},
+			want: map[int64]mutualAuthenticationConfig{
+				443: {
+					mode: elbv2model.MutualAuthenticationModeVerify,
+					caBundle: &caBundleRef{
+						kind:    "ConfigMap",
+						key:     types.NamespacedName{Namespace: "my-ns", Name: "my-ca"},
+						dataKey: "ca.crt",
+					},
+				},
+			},
+		},
+		{
+			name:                     "IngressClassParams takes priority over annotation",
+			enableManagedTrustStores: true,
+			member: ClassifiedIngress{
+				Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-ns",
+					Name:      "ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/mutual-authentication": `[{"port":443,"mode":"off"}]`,
+					},
+				}},
+				IngClassConfig: ClassConfiguration{
+					IngClassParams: &elbv2api.IngressClassParams{
+						Spec: elbv2api.IngressClassParamsSpec{
+							MutualAuthentication: []elbv2api.MutualAuthentication{
+								{
+									Port: 443,
+									Mode: elbv2api.MutualAuthenticationModeVerify,
+									CABundle: &elbv2api.CABundleSource{
+										Kind:      elbv2api.CABundleSourceKindSecret,
+										Namespace: "ca-ns",
+										Name:      "my-ca",
+										Key:       "bundle.pem",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: map[int64]mutualAuthenticationConfig{
+				443: {
+					mode: elbv2model.MutualAuthenticationModeVerify,
+					caBundle: &caBundleRef{
+						kind:    "Secret",
+						key:     types.NamespacedName{Namespace: "ca-ns", Name: "my-ca"},
+						dataKey: "bundle.pem",
+					},
+				},
+			},
+		},
+		{
+			name: "CA bundle without managed trust stores",
+			member: ClassifiedIngress{
+				Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-ns",
+					Name:      "ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/mutual-authentication": `[{"port":443,"mode":"verify","caBundle":{"kind":"ConfigMap","name":"my-ca"}}]`,
+					},
+				}},
+			},
+			wantErr: errors.New("invalid mutual-authentication configuration for port: 443: caBundle requires the controller to be configured with a trust store S3 bucket"),
+		},
+		{
+			name: "verify mode without trustStore",
+			member: ClassifiedIngress{
+				Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-ns",
+					Name:      "ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/mutual-authentication": `[{"port":443,"mode":"verify"}]`,
+					},
+				}},
+			},
+			wantErr: errors.New("invalid mutual-authentication configuration for port: 443: either trustStore or caBundle must be specified in verify mode"),
+		},
+		{
+			name: "passthrough mode with trustStore",
+			member: ClassifiedIngress{
+				Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-ns",
+					Name:      "ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/mutual-authentication": `[{"port":443,"mode":"passthrough","trustStore":"ts-arn"}]`,
+					},
+				}},
+			},
+			wantErr: errors.New("invalid mutual-authentication configuration for port: 443: trustStore, caBundle and ignoreClientCertificateExpiry are only supported in verify mode"),
+		},
+		{
+			name: "unknown mode",
+			member: ClassifiedIngress{
+				Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-ns",
+					Name:      "ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/mutual-authentication": `[{"port":443,"mode":"strict"}]`,
+					},
+				}},
+			},
+			wantErr: errors.New("invalid mutual-authentication configuration for port: 443: mode must be within [off, passthrough, verify]: strict"),
+		},
+		{
+			name: "duplicate port",
+			member: ClassifiedIngress{
+				Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+					Namespace: "my-ns",
+					Name:      "ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/mutual-authentication": `[{"port":443,"mode":"off"},{"port":443,"mode":"passthrough"}]`,
+					},
+				}},
+			},
+			wantErr: errors.New("duplicate mutual-authentication configuration for port: 443"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser:         annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				enableManagedTrustStores: tt.enableManagedTrustStores,
+			}
+			got, err := task.computeIngressMutualAuthenticationByPort(context.Background(), tt.member)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_loadCABundle(t *testing.T) {
+	caCert := encodeTestPEMBlock("CERTIFICATE", "ca")
+	tests := []struct {
+		name       string
+		configMaps []*corev1.ConfigMap
+		secrets    []*corev1.Secret
+		ref        caBundleRef
+		want       string
+		wantErr    error
+	}{
+		{
+			name: "CA bundle from ConfigMap",
+			configMaps: []*corev1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "my-ca"},
+					Data:       map[string]string{"ca.crt": caCert},
+				},
+			},
+			ref: caBundleRef{
+				kind:    "ConfigMap",
+				key:     types.NamespacedName{Namespace: "my-ns", Name: "my-ca"},
+				dataKey: "ca.crt",
+			},
+			want: caCert,
+		},
+		{
+			name: "CA bundle from Secret",
+			secrets: []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "my-ca"},
+					Data:       map[string][]byte{"bundle.pem": []byte(caCert)},
+				},
+			},
+			ref: caBundleRef{
+				kind:    "Secret",
+				key:     types.NamespacedName{Namespace: "my-ns", Name: "my-ca"},
+				dataKey: "bundle.pem",
+			},
+			want: caCert,
+		},
+		{
+			name: "CA bundle key missing",
+			configMaps: []*corev1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "my-ca"},
+					Data:       map[string]string{"other": caCert},
+				},
+			},
+			ref: caBundleRef{
+				kind:    "ConfigMap",
+				key:     types.NamespacedName{Namespace: "my-ns", Name: "my-ca"},
+				dataKey: "ca.crt",
+			},
+			wantErr: errors.New("missing ca.crt, ConfigMap: my-ns/my-ca"),
+		},
+		{
+			name: "CA bundle not PEM encoded",
+			configMaps: []*corev1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "my-ns", Name: "my-ca"},
+					Data:       map[string]string{"ca.crt": "garbage"},
+				},
+			},
+			ref: caBundleRef{
+				kind:    "ConfigMap",
+				key:     types.NamespacedName{Namespace: "my-ns", Name: "my-ca"},
+				dataKey: "ca.crt",
+			},
+			wantErr: errors.New("invalid ca.crt, ConfigMap: my-ns/my-ca, no PEM encoded certificate found"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			for _, configMap := range tt.configMaps {
+				assert.NoError(t, k8sClient.Create(context.Background(), configMap.DeepCopy()))
+			}
+			for _, secret := range tt.secrets {
+				assert.NoError(t, k8sClient.Create(context.Background(), secret.DeepCopy()))
+			}
+
+			task := &defaultModelBuildTask{
+				k8sClient: k8sClient,
+			}
+			got, err := task.loadCABundle(context.Background(), tt.ref)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_mutualAuthenticationConfig_Equal(t *testing.T) {
+	caBundle := &caBundleRef{kind: "ConfigMap", key: types.NamespacedName{Namespace: "my-ns", Name: "my-ca"}, dataKey: "ca.crt"}
+	sameCABundle := &caBundleRef{kind: "ConfigMap", key: types.NamespacedName{Namespace: "my-ns", Name: "my-ca"}, dataKey: "ca.crt"}
+	tests := []struct {
+		name  string
+		cfg   mutualAuthenticationConfig
+		other mutualAuthenticationConfig
+		want  bool
+	}{
+		{
+			name:  "same CA bundle",
+			cfg:   mutualAuthenticationConfig{mode: elbv2model.MutualAuthenticationModeVerify, caBundle: caBundle},
+			other: mutualAuthenticationConfig{mode: elbv2model.MutualAuthenticationModeVerify, caBundle: sameCABundle},
+			want:  true,
+		},
+		{
+			name:  "CA bundle and trustStore",
+			cfg:   mutualAuthenticationConfig{mode: elbv2model.MutualAuthenticationModeVerify, caBundle: caBundle},
+			other: mutualAuthenticationConfig{mode: elbv2model.MutualAuthenticationModeVerify, trustStoreARN: "ts-arn"},
+			want:  false,
+		},
+		{
+			name:  "different mode",
+			cfg:   mutualAuthenticationConfig{mode: elbv2model.MutualAuthenticationModeOff},
+			other: mutualAuthenticationConfig{mode: elbv2model.MutualAuthenticationModePassthrough},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.cfg.Equal(tt.other))
+		})
+	}
+}
@@ -0,0 +1,367 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+const (
+	// the ALB quotas that IngressGroups are sharded by.
+	shardMaxRulesPerListener        = 100
+	shardMaxCertificatesPerListener = 25
+	shardMaxTargetGroups            = 100
+)
+
+// shardUnit is a set of member Ingresses that must be hosted by the same LoadBalancer,
+// which are the members sharing any host, since DNS of a host can only point to a single LoadBalancer.
+type shardUnit struct {
+	// key identifies the unit when assigning it to shards, it's the smallest host of members.
+	key string
+	// members are the member Ingresses in group order.
+	members []ClassifiedIngress
+	// rulesByPort is the estimated number of listener rules needed by members for each listen port.
+	rulesByPort map[int64]int
+	// certsByPort is the certificates needed by members for each listen port.
+	certsByPort map[int64]sets.String
+	// targetGroups is the estimated number of targetGroups needed by members.
+	targetGroups int
+}
+
+// shardUsage is the quota usage of a shard.
+type shardUsage struct {
+	rulesByPort  map[int64]int
+	certsByPort  map[int64]sets.String
+	targetGroups int
+	unitCount    int
+}
+
+func newShardUsage() *shardUsage {
+	return &shardUsage{
+		rulesByPort: make(map[int64]int),
+		certsByPort: make(map[int64]sets.String),
+	}
+}
+
+// fits checks whether unit can be added into the shard without exceeding quotas.
+// an empty shard always fits, so that a unit exceeding quotas on its own is still assigned.
+func (u *shardUsage) fits(unit shardUnit) bool {
+	if u.unitCount == 0 {
+		return true
+	}
+	if u.targetGroups+unit.targetGroups > shardMaxTargetGroups {
+		return false
+	}
+	for port, rules := range unit.rulesByPort {
+		if u.rulesByPort[port]+rules > shardMaxRulesPerListener {
+			return false
+		}
+	}
+	for port, certs := range unit.certsByPort {
+		if u.certsByPort[port].Union(certs).Len() > shardMaxCertificatesPerListener {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *shardUsage) add(unit shardUnit) {
+	u.unitCount++
+	u.targetGroups += unit.targetGroups
+	for port, rules := range unit.rulesByPort {
+		u.rulesByPort[port] += rules
+	}
+	for port, certs := range unit.certsByPort {
+		if u.certsByPort[port] == nil {
+			u.certsByPort[port] = sets.NewString()
+		}
+		u.certsByPort[port].Insert(certs.UnsortedList()...)
+	}
+}
+
+// buildShards splits members of IngressGroup into shards, each shard is hosted by a separate LoadBalancer.
+// the index of shard is significant, since it's part of the resource IDs and names of its resources. Shards without members are nil.
+// If sharding isn't enabled, all members are within shard 0.
+func (t *defaultModelBuildTask) buildShards(ctx context.Context, listenPortConfigByPortByIngKey map[types.NamespacedName]map[int64]listenPortConfig) ([][]ClassifiedIngress, error) {
+	shardingEnabled, err := t.buildShardingEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !shardingEnabled {
+		return [][]ClassifiedIngress{t.ingGroup.Members}, nil
+	}
+	units := buildShardUnits(t.ingGroup.Members, listenPortConfigByPortByIngKey)
+	shardIndexes := assignShards(units)
+	var shards [][]ClassifiedIngress
+	for i, unit := range units {
+		for len(shards) <= shardIndexes[i] {
+			shards = append(shards, nil)
+		}
+		shards[shardIndexes[i]] = append(shards[shardIndexes[i]], unit.members...)
+	}
+	// keep members of each shard in group order, which affects the order of listener rules.
+	memberIndexByIngKey := make(map[types.NamespacedName]int, len(t.ingGroup.Members))
+	for i, member := range t.ingGroup.Members {
+		memberIndexByIngKey[k8s.NamespacedName(member.Ing)] = i
+	}
+	for _, shard := range shards {
+		sort.Slice(shard, func(i, j int) bool {
+			return memberIndexByIngKey[k8s.NamespacedName(shard[i].Ing)] < memberIndexByIngKey[k8s.NamespacedName(shard[j].Ing)]
+		})
+	}
+	return shards, nil
+}
+
+// buildShardingEnabled checks whether sharding is enabled for IngressGroup, members must not have conflicting settings.
+func (t *defaultModelBuildTask) buildShardingEnabled(_ context.Context) (bool, error) {
+	explicitShardingEnabled := make(map[bool]struct{})
+	for _, member := range t.ingGroup.Members {
+		rawShardingEnabled := false
+		exists, err := t.annotationParser.ParseBoolAnnotation(annotations.IngressSuffixGroupSharding, &rawShardingEnabled, member.Ing.Annotations)
+		if err != nil {
+			return false, newMemberBuildError(k8s.NamespacedName(member.Ing), err)
+		}
+		if exists {
+			explicitShardingEnabled[rawShardingEnabled] = struct{}{}
+		}
+	}
+	if len(explicitShardingEnabled) > 1 {
+		return false, errors.New("conflicting group sharding")
+	}
+	_, shardingEnabled := explicitShardingEnabled[true]
+	return shardingEnabled, nil
+}
+
+// buildShardUnits groups members sharing any host into shardUnits, units are ordered by their first member in group order.
+func buildShardUnits(members []ClassifiedIngress, listenPortConfigByPortByIngKey map[types.NamespacedName]map[int64]listenPortConfig) []shardUnit {
+	parents := make([]int, len(members))
+	for i := range parents {
+		parents[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parents[i] != i {
+			parents[i] = find(parents[i])
+		}
+		return parents[i]
+	}
+	memberIndexByHost := make(map[string]int)
+	for i, member := range members {
+		for _, host := range computeIngressHosts(member) {
+			if j, ok := memberIndexByHost[host]; ok {
+				rootI, rootJ := find(i), find(j)
+				if rootI < rootJ {
+					parents[rootJ] = rootI
+				} else {
+					parents[rootI] = rootJ
+				}
+				continue
+			}
+			memberIndexByHost[host] = i
+		}
+	}
+
+	var units []shardUnit
+	var unitHosts []sets.String
+	unitIndexByRoot := make(map[int]int)
+	for i, member := range members {
+		root := find(i)
+		unitIndex, ok := unitIndexByRoot[root]
+		if !ok {
+			unitIndex = len(units)
+			unitIndexByRoot[root] = unitIndex
+			units = append(units, shardUnit{
+				rulesByPort: make(map[int64]int),
+				certsByPort: make(map[int64]sets.String),
+			})
+			unitHosts = append(unitHosts, sets.NewString())
+		}
+		unit := &units[unitIndex]
+		unit.members = append(unit.members, member)
+		unitHosts[unitIndex].Insert(computeIngressHosts(member)...)
+		ruleCount := computeIngressRuleCount(member)
+		for port, cfg := range listenPortConfigByPortByIngKey[k8s.NamespacedName(member.Ing)] {
+			unit.rulesByPort[port] += ruleCount
+			if len(cfg.tlsCerts) != 0 {
+				if unit.certsByPort[port] == nil {
+					unit.certsByPort[port] = sets.NewString()
+				}
+				unit.certsByPort[port].Insert(cfg.tlsCerts...)
+			}
+			if len(cfg.tlsSecrets) != 0 {
+				if unit.certsByPort[port] == nil {
+					unit.certsByPort[port] = sets.NewString()
+				}
+				for _, secretKey := range cfg.tlsSecrets {
+					unit.certsByPort[port].Insert(secretKey.String())
+				}
+			}
+		}
+		unit.targetGroups += computeIngressTargetGroupCount(member)
+	}
+	for i := range units {
+		units[i].key = unitHosts[i].List()[0]
+	}
+	return units
+}
+
+// assignShards assigns units into shards, and returns the index of shard for each unit.
+// the minimal number of shards that fits all units within quotas is used. Units are assigned in order,
+// each into the shard with the highest rendezvous hash score that still fits it, so that most units stay
+// in their shard when units are added, removed, or the number of shards changes.
+func assignShards(units []shardUnit) []int {
+	for shardCount := computeMinimalShardCount(units); ; shardCount++ {
+		if shardIndexes, ok := tryAssignShards(units, shardCount); ok {
+			return shardIndexes
+		}
+	}
+}
+
+func tryAssignShards(units []shardUnit, shardCount int) ([]int, bool) {
+	usages := make([]*shardUsage, shardCount)
+	for i := range usages {
+		usages[i] = newShardUsage()
+	}
+	shardIndexes := make([]int, 0, len(units))
+	for _, unit := range units {
+		assigned := false
+		for _, shardIndex := range rankShards(unit.key, shardCount) {
+			if usages[shardIndex].fits(unit) {
+				usages[shardIndex].add(unit)
+				shardIndexes = append(shardIndexes, shardIndex)
+				assigned = true
+				break
+			}
+		}
+		if !assigned {
+			return nil, false
+		}
+	}
+	return shardIndexes, true
+}
+
+// rankShards ranks shards for unitKey by their rendezvous hash score in descending order.
+func rankShards(unitKey string, shardCount int) []int {
+	shardIndexes := make([]int, shardCount)
+	scores := make([]uint64, shardCount)
+	for i := 0; i < shardCount; i++ {
+		hash := fnv.New64a()
+		_, _ = hash.Write([]byte(fmt.Sprintf("%v/%v", unitKey, i)))
+		shardIndexes[i] = i
+		scores[i] = hash.Sum64()
+	}
+	sort.Slice(shardIndexes, func(i, j int) bool {
+		return scores[shardIndexes[i]] > scores[shardIndexes[j]]
+	})
+	return shardIndexes
+}
+
+// computeMinimalShardCount computes the lower bound for the number of shards needed to fit units within quotas.
+func computeMinimalShardCount(units []shardUnit) int {
+	shardCount := 1
+	targetGroups := 0
+	rulesByPort := make(map[int64]int)
+	certsByPort := make(map[int64]sets.String)
+	for _, unit := range units {
+		targetGroups += unit.targetGroups
+		for port, rules := range unit.rulesByPort {
+			rulesByPort[port] += rules
+		}
+		for port, certs := range unit.certsByPort {
+			if certsByPort[port] == nil {
+				certsByPort[port] = sets.NewString()
+			}
+			certsByPort[port].Insert(certs.UnsortedList()...)
+		}
+	}
+	shardCount = maxInt(shardCount, divideRoundUp(targetGroups, shardMaxTargetGroups))
+	for _, rules := range rulesByPort {
+		shardCount = maxInt(shardCount, divideRoundUp(rules, shardMaxRulesPerListener))
+	}
+	for _, certs := range certsByPort {
+		shardCount = maxInt(shardCount, divideRoundUp(certs.Len(), shardMaxCertificatesPerListener))
+	}
+	return shardCount
+}
+
+// computeIngressHosts computes the hosts of Ingress, an empty host represents rules without host.
+func computeIngressHosts(ing ClassifiedIngress) []string {
+	hosts := sets.NewString()
+	for _, rule := range ing.Ing.Spec.Rules {
+		hosts.Insert(rule.Host)
+	}
+	if len(hosts) == 0 {
+		hosts.Insert("")
+	}
+	return hosts.List()
+}
+
+// computeIngressRuleCount estimates the number of listener rules needed by Ingress on each listen port.
+func computeIngressRuleCount(ing ClassifiedIngress) int {
+	ruleCount := 0
+	for _, rule := range ing.Ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		ruleCount += len(rule.HTTP.Paths)
+	}
+	return ruleCount
+}
+
+// computeIngressTargetGroupCount estimates the number of targetGroups needed by Ingress,
+// which is the number of distinct service backends. Backends using actions annotation are counted individually.
+func computeIngressTargetGroupCount(ing ClassifiedIngress) int {
+	backends := sets.NewString()
+	targetGroupCount := 0
+	countBackend := func(svcName string, portName string, portNumber int32) {
+		if portName == magicServicePortUseAnnotation {
+			targetGroupCount++
+			return
+		}
+		backends.Insert(fmt.Sprintf("%v:%v:%v", svcName, portName, portNumber))
+	}
+	if ing.Ing.Spec.DefaultBackend != nil && ing.Ing.Spec.DefaultBackend.Service != nil {
+		svc := ing.Ing.Spec.DefaultBackend.Service
+		countBackend(svc.Name, svc.Port.Name, svc.Port.Number)
+	}
+	for _, rule := range ing.Ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			if path.Backend.Service == nil {
+				continue
+			}
+			svc := path.Backend.Service
+			countBackend(svc.Name, svc.Port.Name, svc.Port.Number)
+		}
+	}
+	return targetGroupCount + backends.Len()
+}
+
+// buildShardResourceID builds the resource ID for resources of current shard.
+// resources of shard 0 use the same resource IDs as non-sharded IngressGroups.
+func (t *defaultModelBuildTask) buildShardResourceID(resID string) string {
+	if t.shardIndex == 0 {
+		return resID
+	}
+	return fmt.Sprintf("shard-%v/%v", t.shardIndex, resID)
+}
+
+func divideRoundUp(a int, b int) int {
+	return (a + b - 1) / b
+}
+
+func maxInt(a int, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,240 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+func newShardTestIngress(name string, annotations map[string]string, hosts []string, pathsPerHost int) ClassifiedIngress {
+	var rules []networking.IngressRule
+	for _, host := range hosts {
+		var paths []networking.HTTPIngressPath
+		for i := 0; i < pathsPerHost; i++ {
+			paths = append(paths, networking.HTTPIngressPath{
+				Path: fmt.Sprintf("/path-%v", i),
+				Backend: networking.IngressBackend{
+					Service: &networking.IngressServiceBackend{
+						Name: fmt.Sprintf("svc-%v", i),
+						Port: networking.ServiceBackendPort{Number: 80},
+					},
+				},
+			})
+		}
+		rules = append(rules, networking.IngressRule{
+			Host: host,
+			IngressRuleValue: networking.IngressRuleValue{
+				HTTP: &networking.HTTPIngressRuleValue{Paths: paths},
+			},
+		})
+	}
+	return ClassifiedIngress{
+		Ing: &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "awesome-ns",
+				Name:        name,
+				Annotations: annotations,
+			},
+			Spec: networking.IngressSpec{Rules: rules},
+		},
+	}
+}
+
+func shardTestListenPortConfigs(members []ClassifiedIngress) map[types.NamespacedName]map[int64]listenPortConfig {
+	cfgs := make(map[types.NamespacedName]map[int64]listenPortConfig, len(members))
+	for _, member := range members {
+		cfgs[types.NamespacedName{Namespace: member.Ing.Namespace, Name: member.Ing.Name}] = map[int64]listenPortConfig{
+			80: {},
+		}
+	}
+	return cfgs
+}
+
+func Test_defaultModelBuildTask_buildShards(t *testing.T) {
+	shardingAnnotations := map[string]string{
+		"alb.ingress.kubernetes.io/group.sharding": "true",
+	}
+	tests := []struct {
+		name           string
+		members        []ClassifiedIngress
+		wantShardNames [][]string
+		wantErr        string
+	}{
+		{
+			name: "sharding not enabled",
+			members: []ClassifiedIngress{
+				newShardTestIngress("ing-1", nil, []string{"a.example.com"}, 60),
+				newShardTestIngress("ing-2", nil, []string{"b.example.com"}, 60),
+			},
+			wantShardNames: [][]string{{"ing-1", "ing-2"}},
+		},
+		{
+			name: "sharding enabled but fits into single load balancer",
+			members: []ClassifiedIngress{
+				newShardTestIngress("ing-1", shardingAnnotations, []string{"a.example.com"}, 10),
+				newShardTestIngress("ing-2", nil, []string{"b.example.com"}, 10),
+			},
+			wantShardNames: [][]string{{"ing-1", "ing-2"}},
+		},
+		{
+			name: "sharding enabled and exceeds rules quota",
+			members: []ClassifiedIngress{
+				newShardTestIngress("ing-1", shardingAnnotations, []string{"a.example.com"}, 60),
+				newShardTestIngress("ing-2", shardingAnnotations, []string{"b.example.com"}, 60),
+			},
+			wantShardNames: [][]string{{"ing-2"}, {"ing-1"}},
+		},
+		{
+			name: "members sharing host stay together",
+			members: []ClassifiedIngress{
+				newShardTestIngress("ing-1", shardingAnnotations, []string{"a.example.com"}, 30),
+				newShardTestIngress("ing-2", shardingAnnotations, []string{"b.example.com"}, 60),
+				newShardTestIngress("ing-3", shardingAnnotations, []string{"a.example.com"}, 30),
+			},
+			wantShardNames: [][]string{{"ing-2"}, {"ing-1", "ing-3"}},
+		},
+		{
+			name: "conflicting sharding",
+			members: []ClassifiedIngress{
+				newShardTestIngress("ing-1", shardingAnnotations, []string{"a.example.com"}, 1),
+				newShardTestIngress("ing-2", map[string]string{
+					"alb.ingress.kubernetes.io/group.sharding": "false",
+				}, []string{"b.example.com"}, 1),
+			},
+			wantErr: "conflicting group sharding",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				ingGroup:         Group{Members: tt.members},
+			}
+			shards, err := task.buildShards(context.Background(), shardTestListenPortConfigs(tt.members))
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			var gotShardNames [][]string
+			for _, shard := range shards {
+				var names []string
+				for _, member := range shard {
+					names = append(names, member.Ing.Name)
+				}
+				gotShardNames = append(gotShardNames, names)
+			}
+			assert.Equal(t, tt.wantShardNames, gotShardNames)
+		})
+	}
+}
+
+func Test_buildShardUnits(t *testing.T) {
+	members := []ClassifiedIngress{
+		newShardTestIngress("ing-1", nil, []string{"b.example.com"}, 2),
+		newShardTestIngress("ing-2", nil, []string{"c.example.com"}, 1),
+		newShardTestIngress("ing-3", nil, []string{"a.example.com", "b.example.com"}, 3),
+		newShardTestIngress("ing-4", nil, nil, 0),
+	}
+	cfgs := shardTestListenPortConfigs(members)
+	cfgs[types.NamespacedName{Namespace: "awesome-ns", Name: "ing-3"}] = map[int64]listenPortConfig{
+		443: {tlsCerts: []string{"cert-a", "cert-b"}},
+	}
+	units := buildShardUnits(members, cfgs)
+	assert.Equal(t, 3, len(units))
+
+	assert.Equal(t, "a.example.com", units[0].key)
+	assert.Equal(t, []ClassifiedIngress{members[0], members[2]}, units[0].members)
+	assert.Equal(t, map[int64]int{80: 2, 443: 6}, units[0].rulesByPort)
+	assert.Equal(t, map[int64]sets.String{443: sets.NewString("cert-a", "cert-b")}, units[0].certsByPort)
+	assert.Equal(t, 5, units[0].targetGroups)
+
+	assert.Equal(t, "c.example.com", units[1].key)
+	assert.Equal(t, []ClassifiedIngress{members[1]}, units[1].members)
+
+	assert.Equal(t, "", units[2].key)
+	assert.Equal(t, []ClassifiedIngress{members[3]}, units[2].members)
+	assert.Equal(t, 0, units[2].targetGroups)
+}
+
+func Test_assignShards(t *testing.T) {
+	newUnit := func(key string, rules int, certs int) shardUnit {
+		unit := shardUnit{
+			key:         key,
+			rulesByPort: map[int64]int{443: rules},
+			certsByPort: map[int64]sets.String{443: sets.NewString()},
+		}
+		for i := 0; i < certs; i++ {
+			unit.certsByPort[443].Insert(fmt.Sprintf("%v-cert-%v", key, i))
+		}
+		return unit
+	}
+	var units []shardUnit
+	for i := 0; i < 45; i++ {
+		units = append(units, newUnit(fmt.Sprintf("host-%v.example.com", i), 8, 1))
+	}
+
+	shardIndexes := assignShards(units)
+	assertShardsWithinQuotas(t, units, shardIndexes)
+	assert.Equal(t, 4, countShards(shardIndexes))
+
+	// assignments are deterministic.
+	assert.Equal(t, shardIndexes, assignShards(units))
+
+	// removing units moves few of the remaining units.
+	shardIndexesAfterRemoval := assignShards(units[5:])
+	assertShardsWithinQuotas(t, units[5:], shardIndexesAfterRemoval)
+	assert.Equal(t, 4, countShards(shardIndexesAfterRemoval))
+	movedUnits := 0
+	for i := range shardIndexesAfterRemoval {
+		if shardIndexesAfterRemoval[i] != shardIndexes[i+5] {
+			movedUnits++
+		}
+	}
+	assert.LessOrEqual(t, movedUnits, 5)
+
+	// adding units moves few of the existing units.
+	moreUnits := append([]shardUnit{}, units...)
+	for i := 45; i < 47; i++ {
+		moreUnits = append(moreUnits, newUnit(fmt.Sprintf("host-%v.example.com", i), 8, 1))
+	}
+	shardIndexesAfterAddition := assignShards(moreUnits)
+	assertShardsWithinQuotas(t, moreUnits, shardIndexesAfterAddition)
+	assert.Equal(t, 4, countShards(shardIndexesAfterAddition))
+	movedUnits = 0
+	for i := range shardIndexes {
+		if shardIndexesAfterAddition[i] != shardIndexes[i] {
+			movedUnits++
+		}
+	}
+	assert.LessOrEqual(t, movedUnits, 5)
+
+	// a unit exceeding quotas on its own is assigned into its own shard.
+	oversizedUnits := []shardUnit{newUnit("a.example.com", 150, 1), newUnit("b.example.com", 10, 1)}
+	oversizedShardIndexes := assignShards(oversizedUnits)
+	assert.NotEqual(t, oversizedShardIndexes[0], oversizedShardIndexes[1])
+}
+
+func assertShardsWithinQuotas(t *testing.T, units []shardUnit, shardIndexes []int) {
+	usageByShard := make(map[int]*shardUsage)
+	for i, unit := range units {
+		usage, ok := usageByShard[shardIndexes[i]]
+		if !ok {
+			usage = newShardUsage()
+			usageByShard[shardIndexes[i]] = usage
+		}
+		assert.True(t, usage.fits(unit))
+		usage.add(unit)
+	}
+}
+
+func countShards(shardIndexes []int) int {
+	return sets.NewInt(shardIndexes...).Len()
+}
@@ -451,6 +451,10 @@ func (t *defaultModelBuildTask) buildTargetGroupTags(_ context.Context, ing Clas
 }
 
 func (t *defaultModelBuildTask) buildTargetGroupResourceID(ingKey types.NamespacedName, svcKey types.NamespacedName, port intstr.IntOrString) string {
+	// service from another namespace is qualified by its namespace, which is omitted otherwise to keep existing resource IDs unchanged.
+	if svcKey.Namespace != ingKey.Namespace {
+		return fmt.Sprintf("%s/%s-%s/%s:%s", ingKey.Namespace, ingKey.Name, svcKey.Namespace, svcKey.Name, port.String())
+	}
 	return fmt.Sprintf("%s/%s-%s:%s", ingKey.Namespace, ingKey.Name, svcKey.Name, port.String())
 }
 
@@ -13,9 +13,12 @@ import (
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certs"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	acmmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/acm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
@@ -30,19 +33,41 @@ const (
 // ModelBuilder is responsible for build mode stack for a IngressGroup.
 type ModelBuilder interface {
 	// build mode stack for a IngressGroup.
-	Build(ctx context.Context, ingGroup Group) (core.Stack, *elbv2model.LoadBalancer, []types.NamespacedName, error)
+	// the LoadBalancer of each member Ingress is returned along with the primary LoadBalancer, they differ only when IngressGroup is sharded.
+	Build(ctx context.Context, ingGroup Group, opts ...ModelBuildOption) (core.Stack, *elbv2model.LoadBalancer, map[types.NamespacedName]*elbv2model.LoadBalancer, []types.NamespacedName, error)
+}
+
+// ModelBuildOptions is the options to build model stack for a IngressGroup.
+type ModelBuildOptions struct {
+	// whether the model is built to plan changes only.
+	// side effects outside the model, e.g. requesting certificates, are skipped in dry-run.
+	DryRun bool
+}
+
+type ModelBuildOption func(opts *ModelBuildOptions)
+
+func (opts *ModelBuildOptions) ApplyOptions(options ...ModelBuildOption) {
+	for _, option := range options {
+		option(opts)
+	}
+}
+
+// WithDryRun is a option that sets the DryRun.
+func WithDryRun(dryRun bool) ModelBuildOption {
+	return func(opts *ModelBuildOptions) {
+		opts.DryRun = dryRun
+	}
 }
 
 // NewDefaultModelBuilder constructs new defaultModelBuilder.
 func NewDefaultModelBuilder(k8sClient client.Client, eventRecorder record.EventRecorder,
-	ec2Client services.EC2, acmClient services.ACM,
-	annotationParser annotations.Parser, subnetsResolver networkingpkg.SubnetsResolver,
-	authConfigBuilder AuthConfigBuilder, enhancedBackendBuilder EnhancedBackendBuilder,
+	ec2Client services.EC2, certDiscovery certs.CertDiscovery, certRequester CertRequester, awsSecretsManager AWSSecretsManager,
+	caBundleMonitor CABundleMonitor, annotationParser annotations.Parser, subnetsResolver networkingpkg.SubnetsResolver,
+	authConfigBuilder AuthConfigBuilder, enhancedBackendBuilder EnhancedBackendBuilder, ruleOptimizer RuleOptimizer,
 	trackingProvider tracking.Provider, elbv2TaggingManager elbv2deploy.TaggingManager,
 	vpcID string, clusterName string, defaultTags map[string]string, externalManagedTags []string, defaultSSLPolicy string,
-	backendSGProvider networkingpkg.BackendSGProvider, enableBackendSG bool, disableRestrictedSGRules bool, logger logr.Logger) *defaultModelBuilder {
-	certDiscovery := NewACMCertDiscovery(acmClient, logger)
-	ruleOptimizer := NewDefaultRuleOptimizer(logger)
+	backendSGProvider networkingpkg.BackendSGProvider, enableBackendSG bool, disableRestrictedSGRules bool,
+	enableManagedTrustStores bool, featureGates config.FeatureGates, logger logr.Logger) *defaultModelBuilder {
 	return &defaultModelBuilder{
 		k8sClient:                k8sClient,
 		eventRecorder:            eventRecorder,
@@ -53,6 +78,10 @@ func NewDefaultModelBuilder(k8sClient client.Client, eventRecorder record.EventR
 		subnetsResolver:          subnetsResolver,
 		backendSGProvider:        backendSGProvider,
 		certDiscovery:            certDiscovery,
+		certRequester:            certRequester,
+		certSelectionTracker:     newCertSelectionTracker(),
+		awsSecretsManager:        awsSecretsManager,
+		caBundleMonitor:          caBundleMonitor,
 		authConfigBuilder:        authConfigBuilder,
 		enhancedBackendBuilder:   enhancedBackendBuilder,
 		ruleOptimizer:            ruleOptimizer,
@@ -63,6 +92,8 @@ func NewDefaultModelBuilder(k8sClient client.Client, eventRecorder record.EventR
 		defaultSSLPolicy:         defaultSSLPolicy,
 		enableBackendSG:          enableBackendSG,
 		disableRestrictedSGRules: disableRestrictedSGRules,
+		enableManagedTrustStores: enableManagedTrustStores,
+		featureGates:             featureGates,
 		logger:                   logger,
 	}
 }
@@ -81,7 +112,11 @@ type defaultModelBuilder struct {
 	annotationParser         annotations.Parser
 	subnetsResolver          networkingpkg.SubnetsResolver
 	backendSGProvider        networkingpkg.BackendSGProvider
-	certDiscovery            CertDiscovery
+	certDiscovery            certs.CertDiscovery
+	certRequester            CertRequester
+	certSelectionTracker     *certSelectionTracker
+	awsSecretsManager        AWSSecretsManager
+	caBundleMonitor          CABundleMonitor
 	authConfigBuilder        AuthConfigBuilder
 	enhancedBackendBuilder   EnhancedBackendBuilder
 	ruleOptimizer            RuleOptimizer
@@ -92,13 +127,21 @@ type defaultModelBuilder struct {
 	defaultSSLPolicy         string
 	enableBackendSG          bool
 	disableRestrictedSGRules bool
+	enableManagedTrustStores bool
+	featureGates             config.FeatureGates
 
 	logger logr.Logger
 }
 
 // build mode stack for a IngressGroup.
-func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.Stack, *elbv2model.LoadBalancer, []types.NamespacedName, error) {
+func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group, opts ...ModelBuildOption) (core.Stack, *elbv2model.LoadBalancer, map[types.NamespacedName]*elbv2model.LoadBalancer, []types.NamespacedName, error) {
+	buildOpts := ModelBuildOptions{}
+	buildOpts.ApplyOptions(opts...)
 	stack := core.NewDefaultStack(core.StackID(ingGroup.ID))
+	certRequester := b.certRequester
+	if buildOpts.DryRun {
+		certRequester = nil
+	}
 	task := &defaultModelBuildTask{
 		k8sClient:                b.k8sClient,
 		eventRecorder:            b.eventRecorder,
@@ -108,6 +151,9 @@ func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.S
 		annotationParser:         b.annotationParser,
 		subnetsResolver:          b.subnetsResolver,
 		certDiscovery:            b.certDiscovery,
+		certRequester:            certRequester,
+		certSelectionTracker:     b.certSelectionTracker,
+		awsSecretsManager:        b.awsSecretsManager,
 		authConfigBuilder:        b.authConfigBuilder,
 		enhancedBackendBuilder:   b.enhancedBackendBuilder,
 		ruleOptimizer:            b.ruleOptimizer,
@@ -117,6 +163,9 @@ func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.S
 		logger:                   b.logger,
 		enableBackendSG:          b.enableBackendSG,
 		disableRestrictedSGRules: b.disableRestrictedSGRules,
+		importTLSSecrets:         b.featureGates.Enabled(config.ImportTLSSecrets),
+		enableManagedTrustStores: b.enableManagedTrustStores,
+		enableTrafficShifts:      b.featureGates.Enabled(config.WeightedTargetGroups),
 
 		ingGroup: ingGroup,
 		stack:    stack,
@@ -138,14 +187,24 @@ func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.S
 		defaultHealthCheckMatcherHTTPCode:         "200",
 		defaultHealthCheckMatcherGRPCCode:         "12",
 
-		loadBalancer:    nil,
-		tgByResID:       make(map[string]*elbv2model.TargetGroup),
-		backendServices: make(map[types.NamespacedName]*corev1.Service),
+		loadBalancer:         nil,
+		loadBalancerByIngKey: make(map[types.NamespacedName]*elbv2model.LoadBalancer),
+		tgByResID:            make(map[string]*elbv2model.TargetGroup),
+		backendServices:      make(map[types.NamespacedName]*corev1.Service),
+		certByTLSSecretKey:   make(map[types.NamespacedName]*acmmodel.Certificate),
+		trustStoreByCABundle: make(map[caBundleRef]*elbv2model.TrustStore),
 	}
 	if err := task.run(ctx); err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
+	}
+	if b.awsSecretsManager != nil {
+		b.awsSecretsManager.MonitorSecrets(ingGroup.ID, task.awsSecretARNs)
 	}
-	return task.stack, task.loadBalancer, task.secretKeys, nil
+	if b.caBundleMonitor != nil {
+		configMapKeys, secretKeys := task.caBundleKeys()
+		b.caBundleMonitor.MonitorCABundles(ingGroup.ID, configMapKeys, secretKeys)
+	}
+	return task.stack, task.loadBalancer, task.loadBalancerByIngKey, task.secretKeys, nil
 }
 
 // the default model build task
@@ -158,7 +217,10 @@ type defaultModelBuildTask struct {
 	annotationParser       annotations.Parser
 	subnetsResolver        networkingpkg.SubnetsResolver
 	backendSGProvider      networkingpkg.BackendSGProvider
-	certDiscovery          CertDiscovery
+	certDiscovery          certs.CertDiscovery
+	certRequester          CertRequester
+	certSelectionTracker   *certSelectionTracker
+	awsSecretsManager      AWSSecretsManager
 	authConfigBuilder      AuthConfigBuilder
 	enhancedBackendBuilder EnhancedBackendBuilder
 	ruleOptimizer          RuleOptimizer
@@ -167,11 +229,15 @@ type defaultModelBuildTask struct {
 	logger                 logr.Logger
 
 	ingGroup                 Group
+	shardIndex               int
 	sslRedirectConfig        *SSLRedirectConfig
 	stack                    core.Stack
 	backendSGIDToken         core.StringToken
 	enableBackendSG          bool
 	disableRestrictedSGRules bool
+	importTLSSecrets         bool
+	enableManagedTrustStores bool
+	enableTrafficShifts      bool
 
 	defaultTags                               map[string]string
 	externalManagedTags                       sets.String
@@ -190,10 +256,14 @@ type defaultModelBuildTask struct {
 	defaultHealthCheckMatcherHTTPCode         string
 	defaultHealthCheckMatcherGRPCCode         string
 
-	loadBalancer    *elbv2model.LoadBalancer
-	tgByResID       map[string]*elbv2model.TargetGroup
-	backendServices map[types.NamespacedName]*corev1.Service
-	secretKeys      []types.NamespacedName
+	loadBalancer         *elbv2model.LoadBalancer
+	loadBalancerByIngKey map[types.NamespacedName]*elbv2model.LoadBalancer
+	tgByResID            map[string]*elbv2model.TargetGroup
+	backendServices      map[types.NamespacedName]*corev1.Service
+	secretKeys           []types.NamespacedName
+	awsSecretARNs        []string
+	certByTLSSecretKey   map[types.NamespacedName]*acmmodel.Certificate
+	trustStoreByCABundle map[caBundleRef]*elbv2model.TrustStore
 }
 
 func (t *defaultModelBuildTask) run(ctx context.Context) error {
@@ -211,16 +281,63 @@ func (t *defaultModelBuildTask) run(ctx context.Context) error {
 	if len(t.ingGroup.Members) == 0 {
 		return nil
 	}
+	// the existing rules of retained members are identified by their tag, thus kept on whichever LoadBalancer hosts them.
+	for _, ingKey := range t.ingGroup.RetainedMembers {
+		elbv2model.NewRetainedListenerRules(t.stack, ingKey.String(), elbv2model.RetainedListenerRulesSpec{
+			Tags: map[string]string{ListenerRuleTagKeyIngress: ingKey.String()},
+		})
+	}
 
-	ingListByPort := make(map[int64][]ClassifiedIngress)
-	listenPortConfigsByPort := make(map[int64][]listenPortConfigWithIngress)
+	listenPortConfigByPortByIngKey := make(map[types.NamespacedName]map[int64]listenPortConfig, len(t.ingGroup.Members))
 	for _, member := range t.ingGroup.Members {
 		ingKey := k8s.NamespacedName(member.Ing)
-		listenPortConfigByPortForIngress, err := t.computeIngressListenPortConfigByPort(ctx, member.Ing)
+		listenPortConfigByPortForIngress, err := t.computeIngressListenPortConfigByPort(ctx, member)
 		if err != nil {
-			return errors.Wrapf(err, "ingress: %v", ingKey.String())
+			return newMemberBuildError(ingKey, err)
+		}
+		listenPortConfigByPortByIngKey[ingKey] = listenPortConfigByPortForIngress
+	}
+
+	shards, err := t.buildShards(ctx, listenPortConfigByPortByIngKey)
+	if err != nil {
+		return err
+	}
+	ingGroup := t.ingGroup
+	var primaryLB *elbv2model.LoadBalancer
+	for shardIndex, shardMembers := range shards {
+		if len(shardMembers) == 0 {
+			continue
+		}
+		t.ingGroup = Group{
+			ID:              ingGroup.ID,
+			Members:         shardMembers,
+			InactiveMembers: ingGroup.InactiveMembers,
+		}
+		t.shardIndex = shardIndex
+		lb, err := t.buildShard(ctx, listenPortConfigByPortByIngKey)
+		if err != nil {
+			return err
+		}
+		if primaryLB == nil {
+			primaryLB = lb
+		}
+		for _, member := range shardMembers {
+			t.loadBalancerByIngKey[k8s.NamespacedName(member.Ing)] = lb
 		}
-		for port, cfg := range listenPortConfigByPortForIngress {
+	}
+	t.ingGroup = ingGroup
+	t.shardIndex = 0
+	t.loadBalancer = primaryLB
+	return nil
+}
+
+// buildShard builds the LoadBalancer and its resources for members of current shard.
+func (t *defaultModelBuildTask) buildShard(ctx context.Context, listenPortConfigByPortByIngKey map[types.NamespacedName]map[int64]listenPortConfig) (*elbv2model.LoadBalancer, error) {
+	ingListByPort := make(map[int64][]ClassifiedIngress)
+	listenPortConfigsByPort := make(map[int64][]listenPortConfigWithIngress)
+	for _, member := range t.ingGroup.Members {
+		ingKey := k8s.NamespacedName(member.Ing)
+		for port, cfg := range listenPortConfigByPortByIngKey[ingKey] {
 			ingListByPort[port] = append(ingListByPort[port], member)
 			listenPortConfigsByPort[port] = append(listenPortConfigsByPort[port], listenPortConfigWithIngress{
 				ingKey:           ingKey,
@@ -233,38 +350,38 @@ func (t *defaultModelBuildTask) run(ctx context.Context) error {
 	for port, cfgs := range listenPortConfigsByPort {
 		mergedCfg, err := t.mergeListenPortConfigs(ctx, cfgs)
 		if err != nil {
-			return errors.Wrapf(err, "failed to merge listenPort config for port: %v", port)
+			return nil, errors.Wrapf(err, "failed to merge listenPort config for port: %v", port)
 		}
 		listenPortConfigByPort[port] = mergedCfg
 	}
 
 	lb, err := t.buildLoadBalancer(ctx, listenPortConfigByPort)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	t.sslRedirectConfig, err = t.buildSSLRedirectConfig(ctx, listenPortConfigByPort)
 	if err != nil {
-		return err
-	}
-	if err != nil {
-		return err
+		return nil, err
 	}
 	for port, cfg := range listenPortConfigByPort {
 		ingList := ingListByPort[port]
 		ls, err := t.buildListener(ctx, lb.LoadBalancerARN(), port, cfg, ingList)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if err := t.buildListenerRules(ctx, ls.ListenerARN(), port, cfg.protocol, ingList); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	if err := t.buildLoadBalancerAddOns(ctx, lb.LoadBalancerARN()); err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	if _, err := t.buildFrontendNLB(ctx, lb, listenPortConfigByPort); err != nil {
+		return nil, err
+	}
+	return lb, nil
 }
 
 func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listenPortConfigs []listenPortConfigWithIngress) (listenPortConfig, error) {
@@ -278,16 +395,24 @@ func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listen
 	var mergedSSLPolicyProvider *types.NamespacedName
 	var mergedSSLPolicy *string
 
+	var mergedMutualAuthenticationProvider *types.NamespacedName
+	var mergedMutualAuthentication *mutualAuthenticationConfig
+
 	var mergedTLSCerts []string
 	mergedTLSCertsSet := sets.NewString()
+	var mergedTLSSecrets []types.NamespacedName
+	mergedTLSSecretsSet := make(map[types.NamespacedName]struct{})
 
 	for _, cfg := range listenPortConfigs {
 		if mergedProtocolProvider == nil {
 			mergedProtocolProvider = &cfg.ingKey
 			mergedProtocol = cfg.listenPortConfig.protocol
 		} else if mergedProtocol != cfg.listenPortConfig.protocol {
-			return listenPortConfig{}, errors.Errorf("conflicting protocol, %v: %v | %v: %v",
-				*mergedProtocolProvider, mergedProtocol, cfg.ingKey, cfg.listenPortConfig.protocol)
+			return listenPortConfig{}, &MemberBuildError{
+				IngKey: cfg.ingKey,
+				Err: errors.Errorf("conflicting protocol, %v: %v | %v: %v",
+					*mergedProtocolProvider, mergedProtocol, cfg.ingKey, cfg.listenPortConfig.protocol),
+			}
 		}
 
 		if len(cfg.listenPortConfig.inboundCIDRv4s) != 0 || len(cfg.listenPortConfig.inboundCIDRv6s) != 0 {
@@ -298,8 +423,11 @@ func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listen
 				mergedInboundCIDRv4s = cfgInboundCIDRv4s
 				mergedInboundCIDRv6s = cfgInboundCIDRv6s
 			} else if !mergedInboundCIDRv4s.Equal(cfgInboundCIDRv4s) || !mergedInboundCIDRv6s.Equal(cfgInboundCIDRv6s) {
-				return listenPortConfig{}, errors.Errorf("conflicting inbound-cidrs, %v: %v, %v | %v: %v, %v",
-					*mergedInboundCIDRsProvider, mergedInboundCIDRv4s.List(), mergedInboundCIDRv6s.List(), cfg.ingKey, cfgInboundCIDRv4s.List(), cfgInboundCIDRv6s.List())
+				return listenPortConfig{}, &MemberBuildError{
+					IngKey: cfg.ingKey,
+					Err: errors.Errorf("conflicting inbound-cidrs, %v: %v, %v | %v: %v, %v",
+						*mergedInboundCIDRsProvider, mergedInboundCIDRv4s.List(), mergedInboundCIDRv6s.List(), cfg.ingKey, cfgInboundCIDRv4s.List(), cfgInboundCIDRv6s.List()),
+				}
 			}
 		}
 
@@ -308,8 +436,24 @@ func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listen
 				mergedSSLPolicyProvider = &cfg.ingKey
 				mergedSSLPolicy = cfg.listenPortConfig.sslPolicy
 			} else if awssdk.StringValue(mergedSSLPolicy) != awssdk.StringValue(cfg.listenPortConfig.sslPolicy) {
-				return listenPortConfig{}, errors.Errorf("conflicting sslPolicy, %v: %v | %v: %v",
-					*mergedSSLPolicyProvider, awssdk.StringValue(mergedSSLPolicy), cfg.ingKey, awssdk.StringValue(cfg.listenPortConfig.sslPolicy))
+				return listenPortConfig{}, &MemberBuildError{
+					IngKey: cfg.ingKey,
+					Err: errors.Errorf("conflicting sslPolicy, %v: %v | %v: %v",
+						*mergedSSLPolicyProvider, awssdk.StringValue(mergedSSLPolicy), cfg.ingKey, awssdk.StringValue(cfg.listenPortConfig.sslPolicy)),
+				}
+			}
+		}
+
+		if cfg.listenPortConfig.mutualAuthentication != nil {
+			if mergedMutualAuthenticationProvider == nil {
+				mergedMutualAuthenticationProvider = &cfg.ingKey
+				mergedMutualAuthentication = cfg.listenPortConfig.mutualAuthentication
+			} else if !mergedMutualAuthentication.Equal(*cfg.listenPortConfig.mutualAuthentication) {
+				return listenPortConfig{}, &MemberBuildError{
+					IngKey: cfg.ingKey,
+					Err: errors.Errorf("conflicting mutual-authentication, %v: %v | %v: %v",
+						*mergedMutualAuthenticationProvider, mergedMutualAuthentication.mode, cfg.ingKey, cfg.listenPortConfig.mutualAuthentication.mode),
+				}
 			}
 		}
 
@@ -320,6 +464,13 @@ func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listen
 			mergedTLSCertsSet.Insert(cert)
 			mergedTLSCerts = append(mergedTLSCerts, cert)
 		}
+		for _, secretKey := range cfg.listenPortConfig.tlsSecrets {
+			if _, ok := mergedTLSSecretsSet[secretKey]; ok {
+				continue
+			}
+			mergedTLSSecretsSet[secretKey] = struct{}{}
+			mergedTLSSecrets = append(mergedTLSSecrets, secretKey)
+		}
 	}
 
 	if len(mergedInboundCIDRv4s) == 0 && len(mergedInboundCIDRv6s) == 0 {
@@ -336,6 +487,9 @@ func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listen
 		inboundCIDRv6s: mergedInboundCIDRv6s.List(),
 		sslPolicy:      mergedSSLPolicy,
 		tlsCerts:       mergedTLSCerts,
+		tlsSecrets:     mergedTLSSecrets,
+
+		mutualAuthentication: mergedMutualAuthentication,
 	}, nil
 }
 
@@ -346,7 +500,7 @@ func (t *defaultModelBuildTask) buildSSLRedirectConfig(ctx context.Context, list
 		var rawSSLRedirectPort int64
 		exists, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixSSLRedirect, &rawSSLRedirectPort, member.Ing.Annotations)
 		if err != nil {
-			return nil, errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(member.Ing))
+			return nil, newMemberBuildError(k8s.NamespacedName(member.Ing), err)
 		}
 		if exists {
 			explicitSSLRedirectPorts.Insert(rawSSLRedirectPort)
@@ -20,6 +20,8 @@ import (
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certs"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
@@ -362,7 +364,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             },
             "80:2":{
@@ -402,7 +407,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             },
             "80:3":{
@@ -442,7 +450,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             }
         },
@@ -842,7 +853,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             },
             "80:2":{
@@ -882,7 +896,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             },
             "80:3":{
@@ -922,7 +939,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             }
         },
@@ -1332,7 +1352,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             },
             "80:2":{
@@ -1372,7 +1395,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             },
             "80:3":{
@@ -1412,7 +1438,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             }
         },
@@ -1828,7 +1857,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             },
             "443:2":{
@@ -1868,7 +1900,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             },
             "443:3":{
@@ -1908,7 +1943,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             }
         },
@@ -2288,7 +2326,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             },
             "80:2":{
@@ -2328,7 +2369,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             }
         },
@@ -2708,7 +2752,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             },
             "80:2":{
@@ -2748,7 +2795,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             },
             "80:3":{
@@ -2788,7 +2838,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             }
         },
@@ -3183,7 +3236,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             }
         },
@@ -3461,7 +3517,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             }
         },
@@ -3735,7 +3794,10 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
                                 ]
                             }
                         }
-                    ]
+                    ],
+                    "tags":{
+                        "ingress.k8s.aws/ingress":"ns-1/ing-1"
+                    }
                 }
             }
         },
@@ -3862,11 +3924,11 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 				subnetsResolver.EXPECT().ResolveViaDiscovery(gomock.Any(), gomock.Any()).Return(call.subnets, call.err)
 			}
 
-			certDiscovery := NewMockCertDiscovery(ctrl)
+			certDiscovery := certs.NewMockCertDiscovery(ctrl)
 			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
 			authConfigBuilder := NewDefaultAuthConfigBuilder(annotationParser)
 			enhancedBackendBuilder := NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder)
-			ruleOptimizer := NewDefaultRuleOptimizer(&log.NullLogger{})
+			ruleOptimizer := NewDefaultRuleOptimizer(config.NewFeatureGates(), &log.NullLogger{})
 			trackingProvider := tracking.NewDefaultProvider("ingress.k8s.aws", clusterName)
 			stackMarshaller := deploy.NewDefaultStackMarshaller()
 			backendSGProvider := networkingpkg.NewMockBackendSGProvider(ctrl)
@@ -3895,12 +3957,13 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 				trackingProvider:       trackingProvider,
 				elbv2TaggingManager:    elbv2TaggingManager,
 				enableBackendSG:        tt.fields.enableBackendSG,
+				featureGates:           config.NewFeatureGates(),
 				logger:                 &log.NullLogger{},
 
 				defaultSSLPolicy: "ELBSecurityPolicy-2016-08",
 			}
 
-			gotStack, _, _, err := b.Build(context.Background(), tt.args.ingGroup)
+			gotStack, _, _, _, err := b.Build(context.Background(), tt.args.ingGroup)
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
@@ -0,0 +1,355 @@
+package ingress
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// PortStatusErrorQuarantined is reported in the Ingress status of quarantined member Ingresses.
+	PortStatusErrorQuarantined = "ingress.k8s.aws/Quarantined"
+	// ListenerRuleTagKeyIngress is the AWS tag identifying the member Ingress a ListenerRule is built for.
+	ListenerRuleTagKeyIngress = "ingress.k8s.aws/ingress"
+
+	// lastKnownGoodConfigMapKeyIngress is the ConfigMap key for the last successfully built version of an Ingress.
+	lastKnownGoodConfigMapKeyIngress = "ingress.json"
+	// lastKnownGoodConfigMapKeyGroup is the ConfigMap key for the IngressGroup an Ingress was successfully built in.
+	lastKnownGoodConfigMapKeyGroup   = "ingressGroup"
+	lastKnownGoodConfigMapNameSuffix = "-last-known-good"
+	// lastKnownGoodConfigMapLabelKey labels the ConfigMaps managed by the controller to persist last known good versions.
+	lastKnownGoodConfigMapLabelKey   = "ingress.k8s.aws/last-known-good"
+	lastKnownGoodConfigMapLabelValue = "true"
+)
+
+// MemberBuildError is a model build error caused by the configuration of a specific member Ingress.
+type MemberBuildError struct {
+	// IngKey is the member Ingress that caused the error.
+	IngKey types.NamespacedName
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *MemberBuildError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *MemberBuildError) Unwrap() error {
+	return e.Err
+}
+
+// newMemberBuildError attributes err to the member Ingress with ingKey.
+func newMemberBuildError(ingKey types.NamespacedName, err error) error {
+	return &MemberBuildError{
+		IngKey: ingKey,
+		Err:    errors.Wrapf(err, "ingress: %v", ingKey),
+	}
+}
+
+// QuarantinedMember is a member Ingress excluded from the model of its IngressGroup due to a build error.
+type QuarantinedMember struct {
+	// Ing is the quarantined Ingress.
+	Ing *networking.Ingress
+	// Err is the build error caused by the Ingress.
+	Err error
+	// LastKnownGood is whether the Ingress is still modeled with its last successfully built configuration,
+	// otherwise the Ingress is dropped from the model and its existing rules are retained as is.
+	LastKnownGood bool
+}
+
+// MemberQuarantiner quarantines member Ingresses whose configuration fails to build,
+// so that a single broken Ingress doesn't block the whole IngressGroup.
+type MemberQuarantiner interface {
+	// Quarantine excludes the member Ingress that caused buildErr from ingGroup.
+	// The member is replaced with its last successfully built version when known,
+	// otherwise it's dropped from ingGroup and recorded as retained so that its existing rules are kept as is.
+	// false is returned if buildErr cannot be attributed to a member, or no members would be left.
+	Quarantine(ctx context.Context, ingGroup Group, buildErr error) (Group, QuarantinedMember, bool, error)
+
+	// Remember records the members of ingGroup as successfully built, members in quarantined are not recorded.
+	Remember(ctx context.Context, ingGroup Group, quarantined []QuarantinedMember) error
+}
+
+// LastKnownGoodConfigMapKey returns the key of ConfigMap that holds the last successfully built version of Ingress with ingKey.
+func LastKnownGoodConfigMapKey(ingKey types.NamespacedName) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: ingKey.Namespace,
+		Name:      ingKey.Name + lastKnownGoodConfigMapNameSuffix,
+	}
+}
+
+// NewDefaultMemberQuarantiner constructs new defaultMemberQuarantiner.
+func NewDefaultMemberQuarantiner(k8sClient client.Client) *defaultMemberQuarantiner {
+	return &defaultMemberQuarantiner{
+		k8sClient:            k8sClient,
+		lastKnownGoodByGroup: make(map[GroupID]map[types.NamespacedName]*networking.Ingress),
+	}
+}
+
+var _ MemberQuarantiner = &defaultMemberQuarantiner{}
+
+// default implementation for MemberQuarantiner.
+// the last successfully built version of Ingresses are persisted in a ConfigMap owned by each Ingress,
+// so that they survive controller restarts and leader changes. they are cached in memory as well.
+type defaultMemberQuarantiner struct {
+	k8sClient client.Client
+
+	lastKnownGoodByGroup      map[GroupID]map[types.NamespacedName]*networking.Ingress
+	lastKnownGoodByGroupMutex sync.RWMutex
+}
+
+func (q *defaultMemberQuarantiner) Quarantine(ctx context.Context, ingGroup Group, buildErr error) (Group, QuarantinedMember, bool, error) {
+	var memberErr *MemberBuildError
+	if !errors.As(buildErr, &memberErr) {
+		return Group{}, QuarantinedMember{}, false, nil
+	}
+	memberIdx := -1
+	for idx, member := range ingGroup.Members {
+		if k8s.NamespacedName(member.Ing) == memberErr.IngKey {
+			memberIdx = idx
+			break
+		}
+	}
+	if memberIdx == -1 {
+		return Group{}, QuarantinedMember{}, false, nil
+	}
+
+	member := ingGroup.Members[memberIdx]
+	quarantined := QuarantinedMember{
+		Ing: member.Ing,
+		Err: buildErr,
+	}
+	lastKnownGoodIng, err := q.lastKnownGood(ctx, ingGroup.ID, member.Ing)
+	if err != nil {
+		return Group{}, QuarantinedMember{}, false, err
+	}
+	members := make([]ClassifiedIngress, 0, len(ingGroup.Members))
+	members = append(members, ingGroup.Members[:memberIdx]...)
+	retainedMembers := ingGroup.RetainedMembers
+	// the last known good version is used only once, if it fails to build as well, the member is dropped.
+	if lastKnownGoodIng != nil && lastKnownGoodIng != member.Ing {
+		members = append(members, ClassifiedIngress{
+			Ing:            lastKnownGoodIng,
+			IngClassConfig: member.IngClassConfig,
+		})
+		quarantined.LastKnownGood = true
+	} else {
+		retainedMembers = append(append([]types.NamespacedName(nil), retainedMembers...), memberErr.IngKey)
+	}
+	members = append(members, ingGroup.Members[memberIdx+1:]...)
+	if len(members) == 0 {
+		return Group{}, QuarantinedMember{}, false, nil
+	}
+	return Group{
+		ID:              ingGroup.ID,
+		Members:         members,
+		InactiveMembers: ingGroup.InactiveMembers,
+		RetainedMembers: retainedMembers,
+	}, quarantined, true, nil
+}
+
+func (q *defaultMemberQuarantiner) Remember(ctx context.Context, ingGroup Group, quarantined []QuarantinedMember) error {
+	// the last known good versions of Ingresses that left the group are obsolete.
+	for _, ing := range ingGroup.InactiveMembers {
+		if err := q.deleteLastKnownGood(ctx, ing); err != nil {
+			return err
+		}
+	}
+	if len(ingGroup.Members) == 0 {
+		q.lastKnownGoodByGroupMutex.Lock()
+		delete(q.lastKnownGoodByGroup, ingGroup.ID)
+		q.lastKnownGoodByGroupMutex.Unlock()
+		return nil
+	}
+
+	quarantinedKeys := make(map[types.NamespacedName]struct{}, len(quarantined))
+	for _, member := range quarantined {
+		quarantinedKeys[k8s.NamespacedName(member.Ing)] = struct{}{}
+	}
+	q.lastKnownGoodByGroupMutex.RLock()
+	existingLastKnownGood := q.lastKnownGoodByGroup[ingGroup.ID]
+	q.lastKnownGoodByGroupMutex.RUnlock()
+	lastKnownGood := make(map[types.NamespacedName]*networking.Ingress, len(ingGroup.Members))
+	for _, member := range ingGroup.Members {
+		ingKey := k8s.NamespacedName(member.Ing)
+		existingIng, exists := existingLastKnownGood[ingKey]
+		if _, isQuarantined := quarantinedKeys[ingKey]; isQuarantined {
+			if exists {
+				lastKnownGood[ingKey] = existingIng
+			}
+			continue
+		}
+		ing := buildLastKnownGoodIngress(member.Ing)
+		if !exists || !equality.Semantic.DeepEqual(existingIng, ing) {
+			if err := q.persistLastKnownGood(ctx, ingGroup.ID, member.Ing, ing); err != nil {
+				return err
+			}
+		} else {
+			ing = existingIng
+		}
+		lastKnownGood[ingKey] = ing
+	}
+
+	q.lastKnownGoodByGroupMutex.Lock()
+	defer q.lastKnownGoodByGroupMutex.Unlock()
+	q.lastKnownGoodByGroup[ingGroup.ID] = lastKnownGood
+	return nil
+}
+
+// lastKnownGood returns the last successfully built version of ing in IngressGroup with groupID if any.
+// the persisted version is used when it's not cached, e.g. after controller restarts.
+func (q *defaultMemberQuarantiner) lastKnownGood(ctx context.Context, groupID GroupID, ing *networking.Ingress) (*networking.Ingress, error) {
+	ingKey := k8s.NamespacedName(ing)
+	q.lastKnownGoodByGroupMutex.RLock()
+	cachedIng, cached := q.lastKnownGoodByGroup[groupID][ingKey]
+	q.lastKnownGoodByGroupMutex.RUnlock()
+	if cached {
+		return cachedIng, nil
+	}
+
+	cm := &corev1.ConfigMap{}
+	cmKey := LastKnownGoodConfigMapKey(ingKey)
+	if err := q.k8sClient.Get(ctx, cmKey, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to load last known good version from configMap: %v", cmKey)
+	}
+	if !isLastKnownGoodConfigMap(cm, ing) {
+		return nil, nil
+	}
+	persistedIng := &networking.Ingress{}
+	if err := json.Unmarshal([]byte(cm.Data[lastKnownGoodConfigMapKeyIngress]), persistedIng); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode last known good version from configMap: %v", cmKey)
+	}
+	// the persisted version is ignored if it's from a previous Ingress with the same name, or from another IngressGroup.
+	if persistedIng.UID != ing.UID || cm.Data[lastKnownGoodConfigMapKeyGroup] != groupID.String() {
+		return nil, nil
+	}
+
+	// the decoded version is cached so that it's recognized if it fails to build as well.
+	q.lastKnownGoodByGroupMutex.Lock()
+	defer q.lastKnownGoodByGroupMutex.Unlock()
+	if _, exists := q.lastKnownGoodByGroup[groupID]; !exists {
+		q.lastKnownGoodByGroup[groupID] = make(map[types.NamespacedName]*networking.Ingress)
+	}
+	q.lastKnownGoodByGroup[groupID][ingKey] = persistedIng
+	return persistedIng, nil
+}
+
+// persistLastKnownGood persists lastKnownGoodIng into the ConfigMap owned by ing.
+// an existing ConfigMap is only updated if it's managed by the controller, and only when its content changes.
+func (q *defaultMemberQuarantiner) persistLastKnownGood(ctx context.Context, groupID GroupID, ing *networking.Ingress, lastKnownGoodIng *networking.Ingress) error {
+	payload, err := json.Marshal(lastKnownGoodIng)
+	if err != nil {
+		return err
+	}
+	cmKey := LastKnownGoodConfigMapKey(k8s.NamespacedName(ing))
+	cmData := map[string]string{
+		lastKnownGoodConfigMapKeyIngress: string(payload),
+		lastKnownGoodConfigMapKeyGroup:   groupID.String(),
+	}
+	cm := &corev1.ConfigMap{}
+	if err := q.k8sClient.Get(ctx, cmKey, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to load last known good version from configMap: %v", cmKey)
+		}
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: cmKey.Namespace,
+				Name:      cmKey.Name,
+				Labels:    map[string]string{lastKnownGoodConfigMapLabelKey: lastKnownGoodConfigMapLabelValue},
+			},
+			Data: cmData,
+		}
+		if err := controllerutil.SetOwnerReference(ing, cm, q.k8sClient.Scheme()); err != nil {
+			return err
+		}
+		if err := q.k8sClient.Create(ctx, cm); err != nil {
+			return errors.Wrapf(err, "failed to persist last known good version into configMap: %v", cmKey)
+		}
+		return nil
+	}
+	if !isLastKnownGoodConfigMap(cm, ing) {
+		return errors.Errorf("configMap %v isn't managed by the controller, cannot persist last known good version into it", cmKey)
+	}
+	oldCM := cm.DeepCopy()
+	cm.Data = cmData
+	if cm.Labels == nil {
+		cm.Labels = make(map[string]string)
+	}
+	cm.Labels[lastKnownGoodConfigMapLabelKey] = lastKnownGoodConfigMapLabelValue
+	if err := controllerutil.SetOwnerReference(ing, cm, q.k8sClient.Scheme()); err != nil {
+		return err
+	}
+	if equality.Semantic.DeepEqual(oldCM, cm) {
+		return nil
+	}
+	if err := q.k8sClient.Patch(ctx, cm, client.MergeFrom(oldCM)); err != nil {
+		return errors.Wrapf(err, "failed to persist last known good version into configMap: %v", cmKey)
+	}
+	return nil
+}
+
+// deleteLastKnownGood deletes the persisted last known good version of ing if any.
+// ConfigMaps that aren't managed by the controller are left as is.
+func (q *defaultMemberQuarantiner) deleteLastKnownGood(ctx context.Context, ing *networking.Ingress) error {
+	cmKey := LastKnownGoodConfigMapKey(k8s.NamespacedName(ing))
+	cm := &corev1.ConfigMap{}
+	if err := q.k8sClient.Get(ctx, cmKey, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to load last known good version from configMap: %v", cmKey)
+	}
+	if !isLastKnownGoodConfigMap(cm, ing) {
+		return nil
+	}
+	if err := q.k8sClient.Delete(ctx, cm); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete last known good version in configMap: %v", cmKey)
+	}
+	return nil
+}
+
+// isLastKnownGoodConfigMap checks whether cm is managed by the controller to persist the last known good version of ing.
+// ConfigMaps persisted before they were labeled are recognized by their ownerReference to ing.
+func isLastKnownGoodConfigMap(cm *corev1.ConfigMap, ing *networking.Ingress) bool {
+	if cm.Labels[lastKnownGoodConfigMapLabelKey] == lastKnownGoodConfigMapLabelValue {
+		return true
+	}
+	return k8s.HasOwnerReference(cm, ing)
+}
+
+// buildLastKnownGoodIngress builds the version of ing to remember, which only keeps the fields needed to build the model.
+func buildLastKnownGoodIngress(ing *networking.Ingress) *networking.Ingress {
+	var ingAnnotations map[string]string
+	for key, value := range ing.Annotations {
+		if key == corev1.LastAppliedConfigAnnotation {
+			continue
+		}
+		if ingAnnotations == nil {
+			ingAnnotations = make(map[string]string, len(ing.Annotations))
+		}
+		ingAnnotations[key] = value
+	}
+	return &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   ing.Namespace,
+			Name:        ing.Name,
+			UID:         ing.UID,
+			Annotations: ingAnnotations,
+		},
+		Spec: *ing.Spec.DeepCopy(),
+	}
+}
@@ -0,0 +1,415 @@
+package ingress
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultMemberQuarantiner_Quarantine(t *testing.T) {
+	groupID := GroupID{Name: "awesome-group"}
+	ing1 := &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-1", UID: "uid-1"}}
+	ing2 := &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-2", UID: "uid-2"}}
+	ing2LastKnownGood := &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-2", UID: "uid-2", ResourceVersion: "1"}}
+	ing2Persisted := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "ns-1",
+			Name:        "ing-2",
+			UID:         "uid-2",
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/scheme": "internet-facing"},
+		},
+	}
+	ing2Key := types.NamespacedName{Namespace: "ns-1", Name: "ing-2"}
+	buildLastKnownGoodConfigMap := func(ing *networking.Ingress, groupID GroupID) *corev1.ConfigMap {
+		payload, _ := json.Marshal(ing)
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns-1",
+				Name:      "ing-2-last-known-good",
+				Labels:    map[string]string{"ingress.k8s.aws/last-known-good": "true"},
+			},
+			Data: map[string]string{
+				"ingress.json": string(payload),
+				"ingressGroup": groupID.String(),
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		lastKnownGood   map[types.NamespacedName]*networking.Ingress
+		configMaps      []*corev1.ConfigMap
+		ingGroup        Group
+		buildErr        error
+		wantGroup       Group
+		wantQuarantined QuarantinedMember
+		wantOK          bool
+	}{
+		{
+			name:     "error not attributable to a member",
+			ingGroup: Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing1}, {Ing: ing2}}},
+			buildErr: errors.New("conflicting scheme"),
+			wantOK:   false,
+		},
+		{
+			name:     "error attributed to an unknown member",
+			ingGroup: Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing1}}},
+			buildErr: newMemberBuildError(ing2Key, errors.New("invalid annotation")),
+			wantOK:   false,
+		},
+		{
+			name:     "member without last known good version is dropped and retained",
+			ingGroup: Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing1}, {Ing: ing2}}},
+			buildErr: errors.Wrap(newMemberBuildError(ing2Key, errors.New("invalid annotation")), "failed to merge listenPort config"),
+			wantGroup: Group{
+				ID:              groupID,
+				Members:         []ClassifiedIngress{{Ing: ing1}},
+				RetainedMembers: []types.NamespacedName{ing2Key},
+			},
+			wantQuarantined: QuarantinedMember{
+				Ing: ing2,
+				Err: errors.Wrap(newMemberBuildError(ing2Key, errors.New("invalid annotation")), "failed to merge listenPort config"),
+			},
+			wantOK: true,
+		},
+		{
+			name:          "member with last known good version is substituted",
+			lastKnownGood: map[types.NamespacedName]*networking.Ingress{ing2Key: ing2LastKnownGood},
+			ingGroup:      Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing1}, {Ing: ing2}}},
+			buildErr:      newMemberBuildError(ing2Key, errors.New("invalid annotation")),
+			wantGroup:     Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing1}, {Ing: ing2LastKnownGood}}},
+			wantQuarantined: QuarantinedMember{
+				Ing:           ing2,
+				Err:           newMemberBuildError(ing2Key, errors.New("invalid annotation")),
+				LastKnownGood: true,
+			},
+			wantOK: true,
+		},
+		{
+			name:          "last known good version that fails to build is dropped and retained",
+			lastKnownGood: map[types.NamespacedName]*networking.Ingress{ing2Key: ing2LastKnownGood},
+			ingGroup:      Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing1}, {Ing: ing2LastKnownGood}}},
+			buildErr:      newMemberBuildError(ing2Key, errors.New("certificate not found")),
+			wantGroup: Group{
+				ID:              groupID,
+				Members:         []ClassifiedIngress{{Ing: ing1}},
+				RetainedMembers: []types.NamespacedName{ing2Key},
+			},
+			wantQuarantined: QuarantinedMember{
+				Ing: ing2LastKnownGood,
+				Err: newMemberBuildError(ing2Key, errors.New("certificate not found")),
+			},
+			wantOK: true,
+		},
+		{
+			name:       "member with persisted last known good version is substituted",
+			configMaps: []*corev1.ConfigMap{buildLastKnownGoodConfigMap(ing2Persisted, groupID)},
+			ingGroup:   Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing1}, {Ing: ing2}}},
+			buildErr:   newMemberBuildError(ing2Key, errors.New("invalid annotation")),
+			wantGroup:  Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing1}, {Ing: ing2Persisted}}},
+			wantQuarantined: QuarantinedMember{
+				Ing:           ing2,
+				Err:           newMemberBuildError(ing2Key, errors.New("invalid annotation")),
+				LastKnownGood: true,
+			},
+			wantOK: true,
+		},
+		{
+			name:       "persisted last known good version from another IngressGroup is ignored",
+			configMaps: []*corev1.ConfigMap{buildLastKnownGoodConfigMap(ing2Persisted, GroupID{Name: "other-group"})},
+			ingGroup:   Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing1}, {Ing: ing2}}},
+			buildErr:   newMemberBuildError(ing2Key, errors.New("invalid annotation")),
+			wantGroup: Group{
+				ID:              groupID,
+				Members:         []ClassifiedIngress{{Ing: ing1}},
+				RetainedMembers: []types.NamespacedName{ing2Key},
+			},
+			wantQuarantined: QuarantinedMember{
+				Ing: ing2,
+				Err: newMemberBuildError(ing2Key, errors.New("invalid annotation")),
+			},
+			wantOK: true,
+		},
+		{
+			name: "persisted last known good version of a previous Ingress with same name is ignored",
+			configMaps: []*corev1.ConfigMap{buildLastKnownGoodConfigMap(&networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-2", UID: "uid-previous"},
+			}, groupID)},
+			ingGroup: Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing1}, {Ing: ing2}}},
+			buildErr: newMemberBuildError(ing2Key, errors.New("invalid annotation")),
+			wantGroup: Group{
+				ID:              groupID,
+				Members:         []ClassifiedIngress{{Ing: ing1}},
+				RetainedMembers: []types.NamespacedName{ing2Key},
+			},
+			wantQuarantined: QuarantinedMember{
+				Ing: ing2,
+				Err: newMemberBuildError(ing2Key, errors.New("invalid annotation")),
+			},
+			wantOK: true,
+		},
+		{
+			name: "configMap not managed by the controller is ignored",
+			configMaps: []*corev1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-2-last-known-good"},
+					Data:       map[string]string{"ingress.json": "not json"},
+				},
+			},
+			ingGroup: Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing1}, {Ing: ing2}}},
+			buildErr: newMemberBuildError(ing2Key, errors.New("invalid annotation")),
+			wantGroup: Group{
+				ID:              groupID,
+				Members:         []ClassifiedIngress{{Ing: ing1}},
+				RetainedMembers: []types.NamespacedName{ing2Key},
+			},
+			wantQuarantined: QuarantinedMember{
+				Ing: ing2,
+				Err: newMemberBuildError(ing2Key, errors.New("invalid annotation")),
+			},
+			wantOK: true,
+		},
+		{
+			name:     "last member is not quarantined",
+			ingGroup: Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing2}}},
+			buildErr: newMemberBuildError(ing2Key, errors.New("invalid annotation")),
+			wantOK:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			for _, cm := range tt.configMaps {
+				assert.NoError(t, k8sClient.Create(ctx, cm.DeepCopy()))
+			}
+			q := NewDefaultMemberQuarantiner(k8sClient)
+			if tt.lastKnownGood != nil {
+				q.lastKnownGoodByGroup[groupID] = tt.lastKnownGood
+			}
+			gotGroup, gotQuarantined, gotOK, err := q.Quarantine(ctx, tt.ingGroup, tt.buildErr)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOK, gotOK)
+			if !tt.wantOK {
+				return
+			}
+			assert.Equal(t, tt.wantGroup, gotGroup)
+			assert.Equal(t, tt.wantQuarantined.Ing, gotQuarantined.Ing)
+			assert.EqualError(t, gotQuarantined.Err, tt.wantQuarantined.Err.Error())
+			assert.Equal(t, tt.wantQuarantined.LastKnownGood, gotQuarantined.LastKnownGood)
+		})
+	}
+}
+
+func Test_defaultMemberQuarantiner_Remember(t *testing.T) {
+	groupID := GroupID{Name: "awesome-group"}
+	ing1Key := types.NamespacedName{Namespace: "ns-1", Name: "ing-1"}
+	ing2Key := types.NamespacedName{Namespace: "ns-1", Name: "ing-2"}
+	ing1 := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "ns-1",
+			Name:            "ing-1",
+			UID:             "uid-1",
+			ResourceVersion: "2",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/scheme":                 "internet-facing",
+				"kubectl.kubernetes.io/last-applied-configuration": "{}",
+			},
+		},
+	}
+	ing2 := &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-2", UID: "uid-2", ResourceVersion: "2"}}
+	ing2LastKnownGood := &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-2", UID: "uid-2"}}
+	ing1Remembered := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "ns-1",
+			Name:        "ing-1",
+			UID:         "uid-1",
+			Annotations: map[string]string{"alb.ingress.kubernetes.io/scheme": "internet-facing"},
+		},
+	}
+	ing2Remembered := &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-2", UID: "uid-2"}}
+
+	buildLastKnownGoodConfigMap := func(ing *networking.Ingress, labels map[string]string, ownerRefs []metav1.OwnerReference) *corev1.ConfigMap {
+		payload, _ := json.Marshal(ing)
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       ing.Namespace,
+				Name:            ing.Name + "-last-known-good",
+				Labels:          labels,
+				OwnerReferences: ownerRefs,
+			},
+			Data: map[string]string{
+				"ingress.json": string(payload),
+				"ingressGroup": groupID.String(),
+			},
+		}
+	}
+	ing1OwnerRefs := []metav1.OwnerReference{{APIVersion: "networking.k8s.io/v1", Kind: "Ingress", Name: "ing-1", UID: "uid-1"}}
+
+	tests := []struct {
+		name              string
+		lastKnownGood     map[types.NamespacedName]*networking.Ingress
+		configMaps        []*corev1.ConfigMap
+		ingGroup          Group
+		quarantined       []QuarantinedMember
+		wantLastKnownGood map[types.NamespacedName]*networking.Ingress
+		wantPersisted     map[types.NamespacedName]*networking.Ingress
+		wantUnchanged     []*corev1.ConfigMap
+		wantDeleted       []types.NamespacedName
+		wantErr           error
+	}{
+		{
+			name:              "all members built successfully",
+			ingGroup:          Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing1}, {Ing: ing2}}},
+			wantLastKnownGood: map[types.NamespacedName]*networking.Ingress{ing1Key: ing1Remembered, ing2Key: ing2Remembered},
+			wantPersisted:     map[types.NamespacedName]*networking.Ingress{ing1Key: ing1Remembered, ing2Key: ing2Remembered},
+		},
+		{
+			name:              "quarantined member keeps its last known good version",
+			lastKnownGood:     map[types.NamespacedName]*networking.Ingress{ing2Key: ing2LastKnownGood},
+			ingGroup:          Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing1}, {Ing: ing2LastKnownGood}}},
+			quarantined:       []QuarantinedMember{{Ing: ing2, LastKnownGood: true}},
+			wantLastKnownGood: map[types.NamespacedName]*networking.Ingress{ing1Key: ing1Remembered, ing2Key: ing2LastKnownGood},
+			wantPersisted:     map[types.NamespacedName]*networking.Ingress{ing1Key: ing1Remembered},
+		},
+		{
+			name:              "quarantined member without last known good version",
+			ingGroup:          Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing1}}, RetainedMembers: []types.NamespacedName{ing2Key}},
+			quarantined:       []QuarantinedMember{{Ing: ing2}},
+			wantLastKnownGood: map[types.NamespacedName]*networking.Ingress{ing1Key: ing1Remembered},
+			wantPersisted:     map[types.NamespacedName]*networking.Ingress{ing1Key: ing1Remembered},
+		},
+		{
+			name: "persisted version is updated when changed",
+			configMaps: []*corev1.ConfigMap{
+				buildLastKnownGoodConfigMap(ing2Remembered, map[string]string{"ingress.k8s.aws/last-known-good": "true"}, nil),
+				buildLastKnownGoodConfigMap(&networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-1", UID: "uid-1"}}, nil, ing1OwnerRefs),
+			},
+			ingGroup:          Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing1}, {Ing: ing2}}},
+			wantLastKnownGood: map[types.NamespacedName]*networking.Ingress{ing1Key: ing1Remembered, ing2Key: ing2Remembered},
+			wantPersisted:     map[types.NamespacedName]*networking.Ingress{ing1Key: ing1Remembered, ing2Key: ing2Remembered},
+		},
+		{
+			name: "persisted version is not rewritten when unchanged",
+			configMaps: []*corev1.ConfigMap{
+				buildLastKnownGoodConfigMap(ing1Remembered, map[string]string{"ingress.k8s.aws/last-known-good": "true"}, ing1OwnerRefs),
+			},
+			ingGroup:          Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing1}}},
+			wantLastKnownGood: map[types.NamespacedName]*networking.Ingress{ing1Key: ing1Remembered},
+			wantUnchanged: []*corev1.ConfigMap{
+				buildLastKnownGoodConfigMap(ing1Remembered, map[string]string{"ingress.k8s.aws/last-known-good": "true"}, ing1OwnerRefs),
+			},
+		},
+		{
+			name: "configMap not managed by the controller is not overwritten",
+			configMaps: []*corev1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-1-last-known-good"},
+					Data:       map[string]string{"some-key": "some-value"},
+				},
+			},
+			ingGroup: Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing1}}},
+			wantUnchanged: []*corev1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-1-last-known-good"},
+					Data:       map[string]string{"some-key": "some-value"},
+				},
+			},
+			wantErr: errors.New("configMap ns-1/ing-1-last-known-good isn't managed by the controller, cannot persist last known good version into it"),
+		},
+		{
+			name:          "members removed from group are forgotten",
+			lastKnownGood: map[types.NamespacedName]*networking.Ingress{ing1Key: ing1Remembered, ing2Key: ing2Remembered},
+			configMaps: []*corev1.ConfigMap{
+				{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-2-last-known-good", Labels: map[string]string{"ingress.k8s.aws/last-known-good": "true"}}},
+			},
+			ingGroup:          Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing1}}, InactiveMembers: []*networking.Ingress{ing2}},
+			wantLastKnownGood: map[types.NamespacedName]*networking.Ingress{ing1Key: ing1Remembered},
+			wantDeleted:       []types.NamespacedName{{Namespace: "ns-1", Name: "ing-2-last-known-good"}},
+		},
+		{
+			name:          "configMap not managed by the controller is not deleted",
+			lastKnownGood: map[types.NamespacedName]*networking.Ingress{ing1Key: ing1Remembered, ing2Key: ing2Remembered},
+			configMaps: []*corev1.ConfigMap{
+				{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-2-last-known-good"}},
+			},
+			ingGroup:          Group{ID: groupID, Members: []ClassifiedIngress{{Ing: ing1}}, InactiveMembers: []*networking.Ingress{ing2}},
+			wantLastKnownGood: map[types.NamespacedName]*networking.Ingress{ing1Key: ing1Remembered},
+			wantUnchanged: []*corev1.ConfigMap{
+				{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-2-last-known-good"}},
+			},
+		},
+		{
+			name:          "group without members is forgotten",
+			lastKnownGood: map[types.NamespacedName]*networking.Ingress{ing1Key: ing1Remembered},
+			configMaps: []*corev1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:       "ns-1",
+						Name:            "ing-1-last-known-good",
+						OwnerReferences: []metav1.OwnerReference{{APIVersion: "networking.k8s.io/v1", Kind: "Ingress", Name: "ing-1", UID: "uid-1"}},
+					},
+				},
+			},
+			ingGroup:          Group{ID: groupID, InactiveMembers: []*networking.Ingress{ing1}},
+			wantLastKnownGood: nil,
+			wantDeleted:       []types.NamespacedName{{Namespace: "ns-1", Name: "ing-1-last-known-good"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			for _, cm := range tt.configMaps {
+				assert.NoError(t, k8sClient.Create(ctx, cm.DeepCopy()))
+			}
+			q := NewDefaultMemberQuarantiner(k8sClient)
+			if tt.lastKnownGood != nil {
+				q.lastKnownGoodByGroup[groupID] = tt.lastKnownGood
+			}
+			err := q.Remember(ctx, tt.ingGroup, tt.quarantined)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantLastKnownGood, q.lastKnownGoodByGroup[groupID])
+			}
+			for ingKey, wantIng := range tt.wantPersisted {
+				cm := &corev1.ConfigMap{}
+				assert.NoError(t, k8sClient.Get(ctx, LastKnownGoodConfigMapKey(ingKey), cm))
+				gotIng := &networking.Ingress{}
+				assert.NoError(t, json.Unmarshal([]byte(cm.Data["ingress.json"]), gotIng))
+				assert.Equal(t, wantIng, gotIng)
+				assert.Equal(t, groupID.String(), cm.Data["ingressGroup"])
+				assert.Equal(t, wantIng.UID, cm.OwnerReferences[0].UID)
+			}
+			for _, wantCM := range tt.wantUnchanged {
+				cm := &corev1.ConfigMap{}
+				assert.NoError(t, k8sClient.Get(ctx, types.NamespacedName{Namespace: wantCM.Namespace, Name: wantCM.Name}, cm))
+				assert.Equal(t, "1", cm.ResourceVersion)
+				assert.Equal(t, wantCM.Labels, cm.Labels)
+				assert.Equal(t, wantCM.OwnerReferences, cm.OwnerReferences)
+				assert.Equal(t, wantCM.Data, cm.Data)
+			}
+			for _, cmKey := range tt.wantDeleted {
+				err := k8sClient.Get(ctx, cmKey, &corev1.ConfigMap{})
+				assert.True(t, apierrors.IsNotFound(err))
+			}
+		})
+	}
+}
@@ -6,14 +6,19 @@ import (
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
 	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
 	// IndexKeyServiceRefName is index key for services referenced by Ingress.
+	// services in other namespaces are indexed in format of `namespace/name`.
 	IndexKeyServiceRefName = "ingress.serviceRef.name"
+	// IndexKeyServiceRefNamespace is index key for namespaces of services referenced by Ingress from another namespace.
+	IndexKeyServiceRefNamespace = "ingress.serviceRef.namespace"
 	// IndexKeySecretRefName is index key for secrets referenced by Ingress or Service.
 	IndexKeySecretRefName = "ingress.secretRef.name"
 	// IndexKeyIngressClassRefName is index key for ingressClass referenced by Ingress.
@@ -26,6 +31,8 @@ const (
 type ReferenceIndexer interface {
 	// BuildServiceRefIndexes returns the name of related Service objects.
 	BuildServiceRefIndexes(ctx context.Context, ing *networking.Ingress) []string
+	// BuildServiceRefNamespaceIndexes returns the namespace of related Service objects from other namespaces.
+	BuildServiceRefNamespaceIndexes(ctx context.Context, ing *networking.Ingress) []string
 	// BuildSecretRefIndexes returns the name of related Secret objects.
 	BuildSecretRefIndexes(ctx context.Context, ingOrSvc client.Object) []string
 	// BuildIngressClassRefIndexes returns the name of related IngressClass objects.
@@ -35,10 +42,12 @@ type ReferenceIndexer interface {
 }
 
 // NewDefaultReferenceIndexer constructs new defaultReferenceIndexer.
-func NewDefaultReferenceIndexer(enhancedBackendBuilder EnhancedBackendBuilder, authConfigBuilder AuthConfigBuilder, logger logr.Logger) *defaultReferenceIndexer {
+func NewDefaultReferenceIndexer(enhancedBackendBuilder EnhancedBackendBuilder, authConfigBuilder AuthConfigBuilder,
+	featureGates config.FeatureGates, logger logr.Logger) *defaultReferenceIndexer {
 	return &defaultReferenceIndexer{
 		enhancedBackendBuilder: enhancedBackendBuilder,
 		authConfigBuilder:      authConfigBuilder,
+		featureGates:           featureGates,
 		logger:                 logger,
 	}
 }
@@ -49,38 +58,42 @@ var _ ReferenceIndexer = &defaultReferenceIndexer{}
 type defaultReferenceIndexer struct {
 	enhancedBackendBuilder EnhancedBackendBuilder
 	authConfigBuilder      AuthConfigBuilder
+	featureGates           config.FeatureGates
 	logger                 logr.Logger
 }
 
 func (i *defaultReferenceIndexer) BuildServiceRefIndexes(ctx context.Context, ing *networking.Ingress) []string {
-	var backends []networking.IngressBackend
-	if ing.Spec.DefaultBackend != nil {
-		backends = append(backends, *ing.Spec.DefaultBackend)
+	svcKeys, err := i.buildServiceRefKeys(ctx, ing)
+	if err != nil {
+		i.logger.Error(err, "failed to build Ingress indexes",
+			"indexKey", IndexKeyServiceRefName)
+		return nil
 	}
-	for _, rule := range ing.Spec.Rules {
-		if rule.HTTP == nil {
-			continue
-		}
-		for _, path := range rule.HTTP.Paths {
-			backends = append(backends, path.Backend)
+	serviceNames := sets.NewString()
+	for _, svcKey := range svcKeys {
+		if svcKey.Namespace == ing.Namespace {
+			serviceNames.Insert(svcKey.Name)
+		} else {
+			serviceNames.Insert(svcKey.String())
 		}
 	}
+	return serviceNames.List()
+}
 
-	serviceNames := sets.NewString()
-	for _, backend := range backends {
-		enhancedBackend, err := i.enhancedBackendBuilder.Build(ctx, ing, backend,
-			WithLoadBackendServices(false, nil),
-			WithLoadAuthConfig(false),
-		)
-		if err != nil {
-			i.logger.Error(err, "failed to build Ingress indexes",
-				"indexKey", IndexKeyServiceRefName)
-			return nil
+func (i *defaultReferenceIndexer) BuildServiceRefNamespaceIndexes(ctx context.Context, ing *networking.Ingress) []string {
+	svcKeys, err := i.buildServiceRefKeys(ctx, ing)
+	if err != nil {
+		i.logger.Error(err, "failed to build Ingress indexes",
+			"indexKey", IndexKeyServiceRefNamespace)
+		return nil
+	}
+	serviceNamespaces := sets.NewString()
+	for _, svcKey := range svcKeys {
+		if svcKey.Namespace != ing.Namespace {
+			serviceNamespaces.Insert(svcKey.Namespace)
 		}
-		serviceNamesFromBackend := extractServiceNamesFromAction(enhancedBackend.Action)
-		serviceNames.Insert(serviceNamesFromBackend...)
 	}
-	return serviceNames.List()
+	return serviceNamespaces.List()
 }
 
 func (i *defaultReferenceIndexer) BuildSecretRefIndexes(ctx context.Context, ingOrSvc client.Object) []string {
@@ -90,7 +103,11 @@ func (i *defaultReferenceIndexer) BuildSecretRefIndexes(ctx context.Context, ing
 			"indexKey", IndexKeySecretRefName)
 		return nil
 	}
-	return extractSecretNamesFromAuthConfig(authCfg)
+	secretNames := extractSecretNamesFromAuthConfig(authCfg)
+	if ing, ok := ingOrSvc.(*networking.Ingress); ok && i.featureGates.Enabled(config.ImportTLSSecrets) {
+		secretNames = sets.NewString(secretNames...).Insert(extractTLSSecretNames(ing)...).List()
+	}
+	return secretNames
 }
 
 func (i *defaultReferenceIndexer) BuildIngressClassRefIndexes(_ context.Context, ing *networking.Ingress) []string {
@@ -115,6 +132,37 @@ func (i *defaultReferenceIndexer) BuildIngressClassParamsRefIndexes(_ context.Co
 	return []string{ingClassParamsName}
 }
 
+// buildServiceRefKeys returns the key of Service objects referenced by Ingress backends.
+func (i *defaultReferenceIndexer) buildServiceRefKeys(ctx context.Context, ing *networking.Ingress) ([]types.NamespacedName, error) {
+	var backends []networking.IngressBackend
+	if ing.Spec.DefaultBackend != nil {
+		backends = append(backends, *ing.Spec.DefaultBackend)
+	}
+	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			backends = append(backends, path.Backend)
+		}
+	}
+
+	var svcKeys []types.NamespacedName
+	for _, backend := range backends {
+		enhancedBackend, err := i.enhancedBackendBuilder.Build(ctx, ing, backend,
+			WithLoadBackendServices(false, nil),
+			WithLoadAuthConfig(false),
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, svcName := range extractServiceNamesFromAction(enhancedBackend.Action) {
+			svcKeys = append(svcKeys, backendServiceKey(ing.Namespace, svcName))
+		}
+	}
+	return svcKeys, nil
+}
+
 func extractServiceNamesFromAction(action Action) []string {
 	if action.Type != ActionTypeForward || action.ForwardConfig == nil {
 		return nil
@@ -135,8 +183,20 @@ func extractServiceNamesFromTargetGroupTuple(tgt TargetGroupTuple) []string {
 }
 
 func extractSecretNamesFromAuthConfig(authCfg AuthConfig) []string {
-	if authCfg.IDPConfigOIDC == nil {
+	// OIDC IdP with AWS Secrets Manager secret doesn't reference k8s secret.
+	if authCfg.IDPConfigOIDC == nil || len(authCfg.IDPConfigOIDC.SecretName) == 0 {
 		return nil
 	}
 	return []string{authCfg.IDPConfigOIDC.SecretName}
 }
+
+// extractTLSSecretNames returns the name of Secrets referenced by Ingress spec.tls.
+func extractTLSSecretNames(ing *networking.Ingress) []string {
+	var secretNames []string
+	for _, tls := range ing.Spec.TLS {
+		if len(tls.SecretName) != 0 {
+			secretNames = append(secretNames, tls.SecretName)
+		}
+	}
+	return secretNames
+}
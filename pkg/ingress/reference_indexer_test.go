@@ -10,6 +10,7 @@ import (
 	networking "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -326,14 +327,88 @@ func Test_defaultReferenceIndexer_BuildServiceRefIndexes(t *testing.T) {
 	}
 }
 
-func Test_defaultReferenceIndexer_BuildSecretRefIndexes(t *testing.T) {
+func Test_defaultReferenceIndexer_BuildServiceRefNamespaceIndexes(t *testing.T) {
 	type args struct {
-		ingOrSvc client.Object
+		ing *networking.Ingress
 	}
 	tests := []struct {
 		name string
 		args args
 		want []string
+	}{
+		{
+			name: "Ingress without services in other namespaces",
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "my-ing",
+					},
+					Spec: networking.IngressSpec{
+						DefaultBackend: &networking.IngressBackend{
+							Service: &networking.IngressServiceBackend{
+								Name: "svc-a",
+								Port: networking.ServiceBackendPort{
+									Number: 80,
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []string{},
+		},
+		{
+			name: "Ingress with services in other namespaces",
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "my-ing",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/actions.forward-multiple-tg": `{"type":"forward","forwardConfig":{"targetGroups":[{"serviceName":"ns-b/svc-b","servicePort":"80","weight":1},{"serviceName":"awesome-ns/svc-c","servicePort":"80","weight":1},{"serviceName":"ns-d/svc-d","servicePort":"80","weight":1}]}}`,
+						},
+					},
+					Spec: networking.IngressSpec{
+						DefaultBackend: &networking.IngressBackend{
+							Service: &networking.IngressServiceBackend{
+								Name: "forward-multiple-tg",
+								Port: networking.ServiceBackendPort{
+									Name: "use-annotation",
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []string{"ns-b", "ns-d"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
+			authConfigBuilder := NewDefaultAuthConfigBuilder(annotationParser)
+			enhancedBackendBuilder := NewDefaultEnhancedBackendBuilder(nil, annotationParser, nil)
+			i := &defaultReferenceIndexer{
+				enhancedBackendBuilder: enhancedBackendBuilder,
+				authConfigBuilder:      authConfigBuilder,
+				logger:                 &log.NullLogger{},
+			}
+			got := i.BuildServiceRefNamespaceIndexes(context.Background(), tt.args.ing)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultReferenceIndexer_BuildSecretRefIndexes(t *testing.T) {
+	type args struct {
+		ingOrSvc client.Object
+	}
+	tests := []struct {
+		name             string
+		importTLSSecrets bool
+		args             args
+		want             []string
 	}{
 		{
 			name: "ingress with AuthOIDC annotation",
@@ -349,6 +424,58 @@ func Test_defaultReferenceIndexer_BuildSecretRefIndexes(t *testing.T) {
 			},
 			want: []string{"my-k8s-secret"},
 		},
+		{
+			name: "ingress with AuthOIDC annotation referencing AWS secret",
+			args: args{
+				ingOrSvc: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-ing",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/auth-idp-oidc": `{"issuer":"https://example.com","authorizationEndpoint":"https://authorization.example.com","tokenEndpoint":"https://token.example.com","userInfoEndpoint":"https://userinfo.example.com","secretARN":"arn:aws:secretsmanager:us-west-2:123456789012:secret:my-idp-secret-a1b2c3"}`,
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "ingress with TLS secrets - import disabled",
+			args: args{
+				ingOrSvc: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-ing",
+					},
+					Spec: networking.IngressSpec{
+						TLS: []networking.IngressTLS{
+							{Hosts: []string{"app.example.com"}, SecretName: "app-tls"},
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name:             "ingress with TLS secrets and AuthOIDC annotation - import enabled",
+			importTLSSecrets: true,
+			args: args{
+				ingOrSvc: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-ing",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/auth-idp-oidc": `{"issuer":"https://example.com","authorizationEndpoint":"https://authorization.example.com","tokenEndpoint":"https://token.example.com","userInfoEndpoint":"https://userinfo.example.com","secretName":"my-k8s-secret"}`,
+						},
+					},
+					Spec: networking.IngressSpec{
+						TLS: []networking.IngressTLS{
+							{Hosts: []string{"app.example.com"}, SecretName: "app-tls"},
+							{Hosts: []string{"api.example.com"}, SecretName: "api-tls"},
+							{Hosts: []string{"www.example.com"}},
+						},
+					},
+				},
+			},
+			want: []string{"api-tls", "app-tls", "my-k8s-secret"},
+		},
 		{
 			name: "ingress with no annotation",
 			args: args{
@@ -366,9 +493,14 @@ func Test_defaultReferenceIndexer_BuildSecretRefIndexes(t *testing.T) {
 			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
 			authConfigBuilder := NewDefaultAuthConfigBuilder(annotationParser)
 			enhancedBackendBuilder := NewDefaultEnhancedBackendBuilder(nil, annotationParser, nil)
+			featureGates := config.NewFeatureGates()
+			if tt.importTLSSecrets {
+				featureGates.Enable(config.ImportTLSSecrets)
+			}
 			i := &defaultReferenceIndexer{
 				enhancedBackendBuilder: enhancedBackendBuilder,
 				authConfigBuilder:      authConfigBuilder,
+				featureGates:           featureGates,
 				logger:                 &log.NullLogger{},
 			}
 			got := i.BuildSecretRefIndexes(context.Background(), tt.args.ingOrSvc)
@@ -2,10 +2,14 @@ package ingress
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
+
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
@@ -13,6 +17,10 @@ type Rule struct {
 	Conditions []elbv2model.RuleCondition
 	Actions    []elbv2model.Action
 	Tags       map[string]string
+	// GroupOrder is the group order of the Ingress this rule is built from.
+	GroupOrder int64
+	// Priority is the explicit priority of this rule, zero if not specified.
+	Priority int64
 }
 
 // RuleOptimizer will optimize the listener Rules for a single Listener.
@@ -21,9 +29,10 @@ type RuleOptimizer interface {
 }
 
 // NewDefaultRuleOptimizer constructs new defaultRuleOptimizer.
-func NewDefaultRuleOptimizer(logger logr.Logger) *defaultRuleOptimizer {
+func NewDefaultRuleOptimizer(featureGates config.FeatureGates, logger logr.Logger) *defaultRuleOptimizer {
 	return &defaultRuleOptimizer{
-		logger: logger,
+		featureGates: featureGates,
+		logger:       logger,
 	}
 }
 
@@ -39,13 +48,30 @@ var _ RuleOptimizer = &defaultRuleOptimizer{}
 //   * It will omit any redirect rules that would result in a infinite redirect loop.
 //   * it will omit any rules that take priority by a redirect rule with a super set of conditions
 //  	(ideally this could applies to other action type as well, but we only consider redirect action for now)
+//   * if ListenerRulesCompaction feature is enabled, it will merge adjacent rules with same actions and tags, see compactRules.
+//     the number of saved rules is reported to the RuleOptimizerMetricsCollector of ctx if any.
 type defaultRuleOptimizer struct {
-	logger logr.Logger
+	featureGates config.FeatureGates
+	logger       logr.Logger
 }
 
-func (o *defaultRuleOptimizer) Optimize(_ context.Context, port int64, protocol elbv2model.Protocol, rules []Rule) ([]Rule, error) {
+func (o *defaultRuleOptimizer) Optimize(ctx context.Context, port int64, protocol elbv2model.Protocol, rules []Rule) ([]Rule, error) {
 	optimizedRules := o.omitInfiniteRedirectRules(port, protocol, rules)
 	optimizedRules = o.omitOvershadowedRulesAfterRedirectRules(optimizedRules)
+	if o.featureGates.Enabled(config.ListenerRulesCompaction) {
+		compactedRules, err := o.compactRules(optimizedRules)
+		if err != nil {
+			return nil, err
+		}
+		savedRules := len(optimizedRules) - len(compactedRules)
+		if savedRules > 0 {
+			o.logger.V(1).Info("compacted listener rules", "port", port, "rules", len(optimizedRules), "compactedRules", len(compactedRules))
+		}
+		if metricsCollector := ContextGetRuleOptimizerMetricsCollector(ctx); metricsCollector != nil {
+			metricsCollector.ObserveCompactedRules(savedRules)
+		}
+		optimizedRules = compactedRules
+	}
 	return optimizedRules, nil
 }
 
@@ -179,3 +205,123 @@ func findRedirectActionConfig(actions []elbv2model.Action) *elbv2model.RedirectA
 	}
 	return redirectActionCFG
 }
+
+// compactRules merges each rule into its preceding rule when they have same actions and tags,
+// and their conditions only differ in values of a host-header or path-pattern condition.
+// Since merged rules are adjacent and have same actions, requests are still routed the same, thus the evaluation order is kept.
+// Rules are not merged if the merged rule would exceed the condition values limits of ALB.
+func (o *defaultRuleOptimizer) compactRules(rules []Rule) ([]Rule, error) {
+	var compactedRules []Rule
+	for _, rule := range rules {
+		if len(compactedRules) != 0 {
+			mergedRule, merged, err := mergeRules(compactedRules[len(compactedRules)-1], rule)
+			if err != nil {
+				return nil, err
+			}
+			if merged {
+				compactedRules[len(compactedRules)-1] = mergedRule
+				continue
+			}
+		}
+		compactedRules = append(compactedRules, rule)
+	}
+	return compactedRules, nil
+}
+
+// mergeRules merges rhsRule into lhsRule if possible, returns whether they are merged.
+func mergeRules(lhsRule Rule, rhsRule Rule) (Rule, bool, error) {
+	if lhsRule.Priority != 0 || rhsRule.Priority != 0 {
+		return Rule{}, false, nil
+	}
+	if lhsRule.GroupOrder != rhsRule.GroupOrder || !reflect.DeepEqual(lhsRule.Tags, rhsRule.Tags) {
+		return Rule{}, false, nil
+	}
+	actionsEqual, err := jsonEqual(lhsRule.Actions, rhsRule.Actions)
+	if err != nil || !actionsEqual {
+		return Rule{}, false, err
+	}
+	if len(lhsRule.Conditions) != len(rhsRule.Conditions) {
+		return Rule{}, false, nil
+	}
+
+	mergedConditions := make([]elbv2model.RuleCondition, 0, len(lhsRule.Conditions))
+	differentConditionCount := 0
+	for i := range lhsRule.Conditions {
+		lhsCondition, rhsCondition := lhsRule.Conditions[i], rhsRule.Conditions[i]
+		conditionEqual, err := jsonEqual(lhsCondition, rhsCondition)
+		if err != nil {
+			return Rule{}, false, err
+		}
+		if conditionEqual {
+			mergedConditions = append(mergedConditions, lhsCondition)
+			continue
+		}
+		differentConditionCount++
+		mergedCondition, ok := mergeRuleConditionValues(lhsCondition, rhsCondition)
+		if !ok || differentConditionCount > 1 || elbv2model.CountRuleConditionValues([]elbv2model.RuleCondition{mergedCondition}) > elbv2model.MaxConditionValues {
+			return Rule{}, false, nil
+		}
+		mergedConditions = append(mergedConditions, mergedCondition)
+	}
+	if elbv2model.CountRuleConditionValues(mergedConditions) > elbv2model.MaxRuleConditionValues {
+		return Rule{}, false, nil
+	}
+	return Rule{
+		Conditions: mergedConditions,
+		Actions:    lhsRule.Actions,
+		Tags:       lhsRule.Tags,
+		GroupOrder: lhsRule.GroupOrder,
+	}, true, nil
+}
+
+// mergeRuleConditionValues merges the values of host-header or path-pattern conditions.
+func mergeRuleConditionValues(lhsCondition elbv2model.RuleCondition, rhsCondition elbv2model.RuleCondition) (elbv2model.RuleCondition, bool) {
+	if lhsCondition.Field != rhsCondition.Field {
+		return elbv2model.RuleCondition{}, false
+	}
+	switch {
+	case lhsCondition.Field == elbv2model.RuleConditionFieldHostHeader && lhsCondition.HostHeaderConfig != nil && rhsCondition.HostHeaderConfig != nil:
+		return elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldHostHeader,
+			HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+				Values: unionStrings(lhsCondition.HostHeaderConfig.Values, rhsCondition.HostHeaderConfig.Values),
+			},
+		}, true
+	case lhsCondition.Field == elbv2model.RuleConditionFieldPathPattern && lhsCondition.PathPatternConfig != nil && rhsCondition.PathPatternConfig != nil:
+		return elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldPathPattern,
+			PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+				Values: unionStrings(lhsCondition.PathPatternConfig.Values, rhsCondition.PathPatternConfig.Values),
+			},
+		}, true
+	}
+	return elbv2model.RuleCondition{}, false
+}
+
+// unionStrings returns the union of lhs and rhs, preserving the order of values.
+func unionStrings(lhs []string, rhs []string) []string {
+	values := make([]string, 0, len(lhs)+len(rhs))
+	valueSet := sets.NewString()
+	for _, value := range append(append([]string{}, lhs...), rhs...) {
+		if valueSet.Has(value) {
+			continue
+		}
+		valueSet.Insert(value)
+		values = append(values, value)
+	}
+	return values
+}
+
+// jsonEqual checks whether lhs and rhs have same JSON representation.
+// resource references within actions are compared by the resource they refer to.
+func jsonEqual(lhs interface{}, rhs interface{}) (bool, error) {
+	lhsJSON, err := json.Marshal(lhs)
+	if err != nil {
+		return false, err
+	}
+	rhsJSON, err := json.Marshal(rhs)
+	if err != nil {
+		return false, err
+	}
+	return string(lhsJSON) == string(rhsJSON), nil
+}
@@ -0,0 +1,90 @@
+package ingress
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricSubsystemIngress = "ingress"
+
+	metricListenerRulesCompacted = "listener_rules_compacted"
+)
+
+// RuleOptimizerMetricsCollector collects metrics for RuleOptimizer.
+type RuleOptimizerMetricsCollector interface {
+	// ObserveCompactedRules observes the number of listener rules saved by compacting the rules of a listener.
+	ObserveCompactedRules(savedRules int)
+}
+
+// NewRuleOptimizerMetricsCollector constructs new ruleOptimizerMetricsCollector, and registers its metrics to registerer.
+func NewRuleOptimizerMetricsCollector(registerer prometheus.Registerer) (*ruleOptimizerMetricsCollector, error) {
+	listenerRulesCompacted := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Subsystem: metricSubsystemIngress,
+		Name:      metricListenerRulesCompacted,
+		Help:      "Number of listener rules saved by rule compaction, observed each time the rules of a listener are deployed",
+		Buckets:   []float64{0, 1, 2, 5, 10, 20, 50, 100},
+	})
+	if err := registerer.Register(listenerRulesCompacted); err != nil {
+		return nil, err
+	}
+	return &ruleOptimizerMetricsCollector{
+		listenerRulesCompacted: listenerRulesCompacted,
+	}, nil
+}
+
+var _ RuleOptimizerMetricsCollector = &ruleOptimizerMetricsCollector{}
+
+// default implementation for RuleOptimizerMetricsCollector.
+type ruleOptimizerMetricsCollector struct {
+	listenerRulesCompacted prometheus.Histogram
+}
+
+func (c *ruleOptimizerMetricsCollector) ObserveCompactedRules(savedRules int) {
+	c.listenerRulesCompacted.Observe(float64(savedRules))
+}
+
+type contextKey string
+
+const (
+	contextKeyRuleOptimizerMetricsCollector contextKey = "ruleOptimizerMetricsCollector"
+)
+
+// ContextWithRuleOptimizerMetricsCollector returns a context that makes RuleOptimizer report its metrics to collector.
+func ContextWithRuleOptimizerMetricsCollector(ctx context.Context, collector RuleOptimizerMetricsCollector) context.Context {
+	return context.WithValue(ctx, contextKeyRuleOptimizerMetricsCollector, collector)
+}
+
+// ContextGetRuleOptimizerMetricsCollector returns the RuleOptimizerMetricsCollector of ctx, nil if there is none.
+func ContextGetRuleOptimizerMetricsCollector(ctx context.Context) RuleOptimizerMetricsCollector {
+	if v := ctx.Value(contextKeyRuleOptimizerMetricsCollector); v != nil {
+		return v.(RuleOptimizerMetricsCollector)
+	}
+	return nil
+}
+
+// NewPendingRuleOptimizerMetrics constructs new PendingRuleOptimizerMetrics.
+func NewPendingRuleOptimizerMetrics() *PendingRuleOptimizerMetrics {
+	return &PendingRuleOptimizerMetrics{}
+}
+
+var _ RuleOptimizerMetricsCollector = &PendingRuleOptimizerMetrics{}
+
+// PendingRuleOptimizerMetrics holds the metrics observed while building a model,
+// so that they can be reported only once the model is actually deployed.
+type PendingRuleOptimizerMetrics struct {
+	savedRules []int
+}
+
+func (m *PendingRuleOptimizerMetrics) ObserveCompactedRules(savedRules int) {
+	m.savedRules = append(m.savedRules, savedRules)
+}
+
+// Flush reports the pending metrics to collector.
+func (m *PendingRuleOptimizerMetrics) Flush(collector RuleOptimizerMetricsCollector) {
+	for _, savedRules := range m.savedRules {
+		collector.ObserveCompactedRules(savedRules)
+	}
+	m.savedRules = nil
+}
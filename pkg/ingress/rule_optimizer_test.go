@@ -4,6 +4,8 @@ import (
 	"context"
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"testing"
@@ -233,7 +235,8 @@ func Test_defaultRuleOptimizer_Optimize(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			o := &defaultRuleOptimizer{
-				logger: &log.NullLogger{},
+				featureGates: config.NewFeatureGates(),
+				logger:       &log.NullLogger{},
 			}
 			got, err := o.Optimize(context.Background(), tt.args.port, tt.args.protocol, tt.args.rules)
 			if tt.wantErr != nil {
@@ -981,3 +984,156 @@ func Test_findRedirectActionConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultRuleOptimizer_compactRules(t *testing.T) {
+	forwardActions := func(tgARN string) []elbv2model.Action {
+		return []elbv2model.Action{
+			{
+				Type: elbv2model.ActionTypeForward,
+				ForwardConfig: &elbv2model.ForwardActionConfig{
+					TargetGroups: []elbv2model.TargetGroupTuple{
+						{
+							TargetGroupARN: core.LiteralStringToken(tgARN),
+						},
+					},
+				},
+			},
+		}
+	}
+	hostPathConditions := func(hosts []string, paths []string) []elbv2model.RuleCondition {
+		return []elbv2model.RuleCondition{
+			{
+				Field: elbv2model.RuleConditionFieldHostHeader,
+				HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+					Values: hosts,
+				},
+			},
+			{
+				Field: elbv2model.RuleConditionFieldPathPattern,
+				PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+					Values: paths,
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name  string
+		rules []Rule
+		want  []Rule
+	}{
+		{
+			name: "paths of same host and backend are merged",
+			rules: []Rule{
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/a"}), Actions: forwardActions("tg-1")},
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/b", "/b/*"}), Actions: forwardActions("tg-1")},
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/c"}), Actions: forwardActions("tg-1")},
+			},
+			want: []Rule{
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/a", "/b", "/b/*"}), Actions: forwardActions("tg-1")},
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/c"}), Actions: forwardActions("tg-1")},
+			},
+		},
+		{
+			name: "hosts of same path and backend are merged",
+			rules: []Rule{
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/*"}), Actions: forwardActions("tg-1")},
+				{Conditions: hostPathConditions([]string{"b.example.com"}, []string{"/*"}), Actions: forwardActions("tg-1")},
+			},
+			want: []Rule{
+				{Conditions: hostPathConditions([]string{"a.example.com", "b.example.com"}, []string{"/*"}), Actions: forwardActions("tg-1")},
+			},
+		},
+		{
+			name: "rules with different backends are not merged",
+			rules: []Rule{
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/a"}), Actions: forwardActions("tg-1")},
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/b"}), Actions: forwardActions("tg-2")},
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/c"}), Actions: forwardActions("tg-1")},
+			},
+			want: []Rule{
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/a"}), Actions: forwardActions("tg-1")},
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/b"}), Actions: forwardActions("tg-2")},
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/c"}), Actions: forwardActions("tg-1")},
+			},
+		},
+		{
+			name: "rules with different tags are not merged",
+			rules: []Rule{
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/a"}), Actions: forwardActions("tg-1"), Tags: map[string]string{"team": "a"}},
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/b"}), Actions: forwardActions("tg-1"), Tags: map[string]string{"team": "b"}},
+			},
+			want: []Rule{
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/a"}), Actions: forwardActions("tg-1"), Tags: map[string]string{"team": "a"}},
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/b"}), Actions: forwardActions("tg-1"), Tags: map[string]string{"team": "b"}},
+			},
+		},
+		{
+			name: "rules differ in both host and path are not merged",
+			rules: []Rule{
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/a"}), Actions: forwardActions("tg-1")},
+				{Conditions: hostPathConditions([]string{"b.example.com"}, []string{"/b"}), Actions: forwardActions("tg-1")},
+			},
+			want: []Rule{
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/a"}), Actions: forwardActions("tg-1")},
+				{Conditions: hostPathConditions([]string{"b.example.com"}, []string{"/b"}), Actions: forwardActions("tg-1")},
+			},
+		},
+		{
+			name: "rules are not merged beyond condition values limit",
+			rules: []Rule{
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/a", "/a/*"}), Actions: forwardActions("tg-1")},
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/b", "/b/*"}), Actions: forwardActions("tg-1")},
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/c", "/c/*"}), Actions: forwardActions("tg-1")},
+			},
+			want: []Rule{
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/a", "/a/*"}), Actions: forwardActions("tg-1")},
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/b", "/b/*"}), Actions: forwardActions("tg-1")},
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/c", "/c/*"}), Actions: forwardActions("tg-1")},
+			},
+		},
+		{
+			name: "rules with different condition fields are not merged",
+			rules: []Rule{
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/a"}), Actions: forwardActions("tg-1")},
+				{
+					Conditions: []elbv2model.RuleCondition{
+						{
+							Field: elbv2model.RuleConditionFieldPathPattern,
+							PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+								Values: []string{"/b"},
+							},
+						},
+					},
+					Actions: forwardActions("tg-1"),
+				},
+			},
+			want: []Rule{
+				{Conditions: hostPathConditions([]string{"a.example.com"}, []string{"/a"}), Actions: forwardActions("tg-1")},
+				{
+					Conditions: []elbv2model.RuleCondition{
+						{
+							Field: elbv2model.RuleConditionFieldPathPattern,
+							PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+								Values: []string{"/b"},
+							},
+						},
+					},
+					Actions: forwardActions("tg-1"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureGates := config.NewFeatureGates()
+			featureGates.Enable(config.ListenerRulesCompaction)
+			o := NewDefaultRuleOptimizer(featureGates, &log.NullLogger{})
+			metrics := NewPendingRuleOptimizerMetrics()
+			ctx := ContextWithRuleOptimizerMetricsCollector(context.Background(), metrics)
+			got, err := o.Optimize(ctx, 80, elbv2model.ProtocolHTTP, tt.rules)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, []int{len(tt.rules) - len(tt.want)}, metrics.savedRules)
+		})
+	}
+}
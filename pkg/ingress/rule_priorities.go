@@ -0,0 +1,130 @@
+package ingress
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+const (
+	// minExplicitRulePriority is the minimum explicit priority of listener rules.
+	minExplicitRulePriority int64 = 1
+)
+
+// rulePriorityCollision represents an explicit listener rule priority that's assigned more than once on a listener within IngressGroup.
+type rulePriorityCollision struct {
+	port     int64
+	priority int64
+	ingKeys  []types.NamespacedName
+}
+
+func (c rulePriorityCollision) Error() string {
+	return fmt.Sprintf("conflicting listener rule priority %v on port %v between Ingresses: %v", c.priority, c.port, c.ingKeys)
+}
+
+// explicitRulePriorityOwner identifies the Ingress and the key that assigned an explicit listener rule priority.
+type explicitRulePriorityOwner struct {
+	ingKey types.NamespacedName
+	key    string
+}
+
+// ParseRulePriorities parses the explicit listener rule priorities of Ingress.
+// The priorities are keyed by either path of Ingress, or the name of use-annotation action.
+func ParseRulePriorities(annotationParser annotations.Parser, ing *networking.Ingress) (map[string]int64, error) {
+	rulePriorities := make(map[string]int64)
+	if _, err := annotationParser.ParseJSONAnnotation(annotations.IngressSuffixRulePriorities, &rulePriorities, ing.Annotations); err != nil {
+		return nil, err
+	}
+	for key, priority := range rulePriorities {
+		if priority < minExplicitRulePriority || priority > maxListenerRulePriority {
+			return nil, errors.Errorf("explicit listener rule priority must be within [%v:%v], key: %v, priority: %v",
+				minExplicitRulePriority, maxListenerRulePriority, key, priority)
+		}
+	}
+	return rulePriorities, nil
+}
+
+// ValidateRulePriorities validates that explicit listener rule priorities of Ingresses within IngressGroup don't collide on any listen port.
+// Only the explicit priorities themselves are validated, rules that are split or expanded into a range of priorities are validated when the model is built.
+func ValidateRulePriorities(annotationParser annotations.Parser, ings []*networking.Ingress) error {
+	collisions := findRulePriorityCollisions(annotationParser, ings)
+	if len(collisions) != 0 {
+		return collisions[0]
+	}
+	return nil
+}
+
+// findRulePriorityCollisions finds explicit listener rule priorities that's assigned more than once on a listen port among Ingresses.
+// Ingresses with invalid explicit listener rule priorities or listen ports are ignored, since they cannot be built anyway.
+func findRulePriorityCollisions(annotationParser annotations.Parser, ings []*networking.Ingress) []rulePriorityCollision {
+	type portAndPriority struct {
+		port     int64
+		priority int64
+	}
+	ingKeysByPortAndPriority := make(map[portAndPriority][]types.NamespacedName)
+	for _, ing := range ings {
+		rulePriorities, err := ParseRulePriorities(annotationParser, ing)
+		if err != nil || len(rulePriorities) == 0 {
+			continue
+		}
+		ports, err := parseRulePriorityListenPorts(annotationParser, ing)
+		if err != nil {
+			continue
+		}
+		for _, port := range ports {
+			for _, priority := range rulePriorities {
+				key := portAndPriority{port: port, priority: priority}
+				ingKeysByPortAndPriority[key] = append(ingKeysByPortAndPriority[key], k8s.NamespacedName(ing))
+			}
+		}
+	}
+
+	var collisions []rulePriorityCollision
+	for key, ingKeys := range ingKeysByPortAndPriority {
+		if len(ingKeys) > 1 {
+			collisions = append(collisions, rulePriorityCollision{
+				port:     key.port,
+				priority: key.priority,
+				ingKeys:  ingKeys,
+			})
+		}
+	}
+	sort.Slice(collisions, func(i, j int) bool {
+		if collisions[i].priority != collisions[j].priority {
+			return collisions[i].priority < collisions[j].priority
+		}
+		return collisions[i].port < collisions[j].port
+	})
+	return collisions
+}
+
+// parseRulePriorityListenPorts parses the listen ports of Ingress that its explicit listener rule priorities apply to.
+// Without listen-ports annotation, the Ingress listens on port 443 if certificate-arn annotation is specified, or port 80 otherwise.
+func parseRulePriorityListenPorts(annotationParser annotations.Parser, ing *networking.Ingress) ([]int64, error) {
+	rawListenPorts := ""
+	if exists := annotationParser.ParseStringAnnotation(annotations.IngressSuffixListenPorts, &rawListenPorts, ing.Annotations); !exists {
+		var rawCertARNs []string
+		if exists := annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixCertificateARN, &rawCertARNs, ing.Annotations); exists && len(rawCertARNs) != 0 {
+			return []int64{443}, nil
+		}
+		return []int64{80}, nil
+	}
+	var entries []map[string]int64
+	if err := json.Unmarshal([]byte(rawListenPorts), &entries); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse listen-ports configuration: `%s`", rawListenPorts)
+	}
+	ports := sets.NewInt64()
+	for _, entry := range entries {
+		for _, port := range entry {
+			ports.Insert(port)
+		}
+	}
+	return ports.List(), nil
+}
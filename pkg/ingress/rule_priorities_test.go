@@ -0,0 +1,134 @@
+package ingress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+func newRulePrioritiesTestIngress(name string, rulePriorities string) *networking.Ingress {
+	ing := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "awesome-ns",
+			Name:        name,
+			Annotations: map[string]string{},
+		},
+	}
+	if rulePriorities != "" {
+		ing.Annotations["alb.ingress.kubernetes.io/rule-priorities"] = rulePriorities
+	}
+	return ing
+}
+
+func withRulePrioritiesTestAnnotation(ing *networking.Ingress, key string, value string) *networking.Ingress {
+	ing.Annotations[key] = value
+	return ing
+}
+
+func TestParseRulePriorities(t *testing.T) {
+	tests := []struct {
+		name    string
+		ing     *networking.Ingress
+		want    map[string]int64
+		wantErr string
+	}{
+		{
+			name: "no explicit priorities",
+			ing:  newRulePrioritiesTestIngress("ing-1", ""),
+			want: map[string]int64{},
+		},
+		{
+			name: "explicit priorities",
+			ing:  newRulePrioritiesTestIngress("ing-1", `{"/healthz": 1, "maintenance": 50000}`),
+			want: map[string]int64{"/healthz": 1, "maintenance": 50000},
+		},
+		{
+			name:    "explicit priority out of range",
+			ing:     newRulePrioritiesTestIngress("ing-1", `{"/healthz": 50001}`),
+			wantErr: "explicit listener rule priority must be within [1:50000], key: /healthz, priority: 50001",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
+			got, err := ParseRulePriorities(annotationParser, tt.ing)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidateRulePriorities(t *testing.T) {
+	tests := []struct {
+		name    string
+		ings    []*networking.Ingress
+		wantErr string
+	}{
+		{
+			name: "no collisions",
+			ings: []*networking.Ingress{
+				newRulePrioritiesTestIngress("ing-1", `{"/healthz": 1}`),
+				newRulePrioritiesTestIngress("ing-2", `{"/healthz": 2}`),
+				newRulePrioritiesTestIngress("ing-3", ""),
+			},
+		},
+		{
+			name: "collision across Ingresses",
+			ings: []*networking.Ingress{
+				newRulePrioritiesTestIngress("ing-1", `{"/healthz": 1, "/status": 3}`),
+				newRulePrioritiesTestIngress("ing-2", `{"/healthz": 3}`),
+			},
+			wantErr: "conflicting listener rule priority 3 on port 80 between Ingresses: [awesome-ns/ing-1 awesome-ns/ing-2]",
+		},
+		{
+			name: "collision within Ingress",
+			ings: []*networking.Ingress{
+				newRulePrioritiesTestIngress("ing-1", `{"/healthz": 1, "/status": 1}`),
+			},
+			wantErr: "conflicting listener rule priority 1 on port 80 between Ingresses: [awesome-ns/ing-1 awesome-ns/ing-1]",
+		},
+		{
+			name: "same priority on different listen ports",
+			ings: []*networking.Ingress{
+				newRulePrioritiesTestIngress("ing-1", `{"/healthz": 1}`),
+				withRulePrioritiesTestAnnotation(newRulePrioritiesTestIngress("ing-2", `{"/healthz": 1}`),
+					"alb.ingress.kubernetes.io/listen-ports", `[{"HTTPS": 443}]`),
+			},
+		},
+		{
+			name: "collision on default HTTPS listen port",
+			ings: []*networking.Ingress{
+				withRulePrioritiesTestAnnotation(newRulePrioritiesTestIngress("ing-1", `{"/healthz": 1}`),
+					"alb.ingress.kubernetes.io/certificate-arn", "arn:aws:acm:us-west-2:123456789012:certificate/cert-1"),
+				withRulePrioritiesTestAnnotation(newRulePrioritiesTestIngress("ing-2", `{"/healthz": 1}`),
+					"alb.ingress.kubernetes.io/listen-ports", `[{"HTTP": 80}, {"HTTPS": 443}]`),
+			},
+			wantErr: "conflicting listener rule priority 1 on port 443 between Ingresses: [awesome-ns/ing-1 awesome-ns/ing-2]",
+		},
+		{
+			name: "invalid priorities are ignored",
+			ings: []*networking.Ingress{
+				newRulePrioritiesTestIngress("ing-1", `{"/healthz": 1}`),
+				newRulePrioritiesTestIngress("ing-2", `{"/healthz": 1, "/status": 0}`),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
+			err := ValidateRulePriorities(annotationParser, tt.ings)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -2,24 +2,43 @@ package k8s
 
 const (
 	// Ingress events
-	IngressEventReasonConflictingIngressClass = "ConflictingIngressClass"
-	IngressEventReasonFailedLoadGroupID       = "FailedLoadGroupID"
-	IngressEventReasonFailedAddFinalizer      = "FailedAddFinalizer"
-	IngressEventReasonFailedRemoveFinalizer   = "FailedRemoveFinalizer"
-	IngressEventReasonFailedUpdateStatus      = "FailedUpdateStatus"
-	IngressEventReasonFailedBuildModel        = "FailedBuildModel"
-	IngressEventReasonFailedDeployModel       = "FailedDeployModel"
-	IngressEventReasonSuccessfullyReconciled  = "SuccessfullyReconciled"
+	IngressEventReasonCertificateSelected       = "CertificateSelected"
+	IngressEventReasonCertificateRequested      = "CertificateRequested"
+	IngressEventReasonCertificatePending        = "CertificatePending"
+	IngressEventReasonConflictingIngressClass   = "ConflictingIngressClass"
+	IngressEventReasonConflictingRulePriority   = "ConflictingRulePriority"
+	IngressEventReasonFailedLoadGroupID         = "FailedLoadGroupID"
+	IngressEventReasonFailedAddFinalizer        = "FailedAddFinalizer"
+	IngressEventReasonFailedRemoveFinalizer     = "FailedRemoveFinalizer"
+	IngressEventReasonFailedUpdateStatus        = "FailedUpdateStatus"
+	IngressEventReasonFailedBuildModel          = "FailedBuildModel"
+	IngressEventReasonFailedDeployModel         = "FailedDeployModel"
+	IngressEventReasonFailedCleanupCertificates = "FailedCleanupCertificates"
+	IngressEventReasonQuarantined               = "Quarantined"
+	IngressEventReasonPlannedChanges            = "PlannedChanges"
+	IngressEventReasonSplitListenerRule         = "SplitListenerRule"
+	IngressEventReasonSuccessfullyReconciled    = "SuccessfullyReconciled"
 
 	// Service events
+	ServiceEventReasonFailedLoadGroupName    = "FailedLoadGroupName"
 	ServiceEventReasonFailedAddFinalizer     = "FailedAddFinalizer"
 	ServiceEventReasonFailedRemoveFinalizer  = "FailedRemoveFinalizer"
 	ServiceEventReasonFailedUpdateStatus     = "FailedUpdateStatus"
 	ServiceEventReasonFailedCleanupStatus    = "FailedCleanupStatus"
 	ServiceEventReasonFailedBuildModel       = "FailedBuildModel"
 	ServiceEventReasonFailedDeployModel      = "FailedDeployModel"
+	ServiceEventReasonPlannedChanges         = "PlannedChanges"
 	ServiceEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
 
+	// Gateway events
+	GatewayEventReasonFailedAddFinalizer     = "FailedAddFinalizer"
+	GatewayEventReasonFailedRemoveFinalizer  = "FailedRemoveFinalizer"
+	GatewayEventReasonFailedUpdateStatus     = "FailedUpdateStatus"
+	GatewayEventReasonFailedLoadRoutes       = "FailedLoadRoutes"
+	GatewayEventReasonFailedBuildModel       = "FailedBuildModel"
+	GatewayEventReasonFailedDeployModel      = "FailedDeployModel"
+	GatewayEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
+
 	// TargetGroupBinding events
 	TargetGroupBindingEventReasonFailedAddFinalizer     = "FailedAddFinalizer"
 	TargetGroupBindingEventReasonFailedRemoveFinalizer  = "FailedRemoveFinalizer"
@@ -27,4 +46,9 @@ const (
 	TargetGroupBindingEventReasonFailedCleanup          = "FailedCleanup"
 	TargetGroupBindingEventReasonBackendNotFound        = "BackendNotFound"
 	TargetGroupBindingEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
+
+	// TrafficShift events
+	TrafficShiftEventReasonFailedCheckHealth  = "FailedCheckHealth"
+	TrafficShiftEventReasonFailedUpdateStatus = "FailedUpdateStatus"
+	TrafficShiftEventReasonStepUpdated        = "StepUpdated"
 )
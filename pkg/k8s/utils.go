@@ -1,7 +1,9 @@
 package k8s
 
 import (
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -12,3 +14,24 @@ func NamespacedName(obj metav1.Object) types.NamespacedName {
 		Name:      obj.GetName(),
 	}
 }
+
+// IsKindInstalled checks whether the kind of gvk is served by the API server, e.g. its CRD is installed.
+func IsKindInstalled(restMapper meta.RESTMapper, gvk schema.GroupVersionKind) (bool, error) {
+	if _, err := restMapper.RESTMapping(gvk.GroupKind(), gvk.Version); err != nil {
+		if meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// HasOwnerReference checks whether obj has an ownerReference to owner.
+func HasOwnerReference(obj metav1.Object, owner metav1.Object) bool {
+	for _, ownerRef := range obj.GetOwnerReferences() {
+		if ownerRef.UID == owner.GetUID() {
+			return true
+		}
+	}
+	return false
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	networking "k8s.io/api/networking/v1"
 	rbac "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -49,3 +51,77 @@ func TestNamespacedName(t *testing.T) {
 		})
 	}
 }
+
+func TestIsKindInstalled(t *testing.T) {
+	installedGVK := schema.GroupVersionKind{Group: "elbv2.k8s.aws", Version: "v1beta1", Kind: "TargetGroupBinding"}
+	restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{installedGVK.GroupVersion()})
+	restMapper.Add(installedGVK, meta.RESTScopeNamespace)
+	tests := []struct {
+		name string
+		gvk  schema.GroupVersionKind
+		want bool
+	}{
+		{
+			name: "installed kind",
+			gvk:  installedGVK,
+			want: true,
+		},
+		{
+			name: "kind not installed",
+			gvk:  schema.GroupVersionKind{Group: "elbv2.k8s.aws", Version: "v1beta1", Kind: "TrafficShift"},
+			want: false,
+		},
+		{
+			name: "group not installed",
+			gvk:  schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1beta1", Kind: "ReferenceGrant"},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsKindInstalled(restMapper, tt.gvk)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHasOwnerReference(t *testing.T) {
+	owner := &metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-ing", UID: "uid-1"}
+	tests := []struct {
+		name string
+		obj  metav1.Object
+		want bool
+	}{
+		{
+			name: "owned by owner",
+			obj: &metav1.ObjectMeta{
+				OwnerReferences: []metav1.OwnerReference{
+					{Kind: "Service", Name: "awesome-svc", UID: "uid-2"},
+					{Kind: "Ingress", Name: "awesome-ing", UID: "uid-1"},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "owned by previous owner with same name",
+			obj: &metav1.ObjectMeta{
+				OwnerReferences: []metav1.OwnerReference{
+					{Kind: "Ingress", Name: "awesome-ing", UID: "uid-0"},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "without ownerReferences",
+			obj:  &metav1.ObjectMeta{},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := HasOwnerReference(tt.obj, owner)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
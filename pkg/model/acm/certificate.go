@@ -0,0 +1,83 @@
+package acm
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+var _ core.Resource = &Certificate{}
+
+// Certificate represents a ACM Certificate imported from a Kubernetes TLS Secret.
+type Certificate struct {
+	core.ResourceMeta `json:"-"`
+
+	// desired state of Certificate
+	Spec CertificateSpec `json:"spec"`
+
+	// observed state of Certificate
+	Status *CertificateStatus `json:"status,omitempty"`
+}
+
+// NewCertificate constructs new Certificate resource.
+func NewCertificate(stack core.Stack, id string, spec CertificateSpec) *Certificate {
+	cert := &Certificate{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS::CertificateManager::Certificate", id),
+		Spec:         spec,
+		Status:       nil,
+	}
+	stack.AddResource(cert)
+	return cert
+}
+
+// SetStatus sets the Certificate's status
+func (cert *Certificate) SetStatus(status CertificateStatus) {
+	cert.Status = &status
+}
+
+// CertificateARN returns a token for this Certificate's certificateARN.
+func (cert *Certificate) CertificateARN() core.StringToken {
+	return core.NewResourceFieldStringToken(cert, "status/certificateARN",
+		func(ctx context.Context, res core.Resource, fieldPath string) (s string, err error) {
+			cert := res.(*Certificate)
+			if cert.Status == nil {
+				return "", errors.Errorf("Certificate is not fulfilled yet: %v", cert.ID())
+			}
+			return cert.Status.CertificateARN, nil
+		},
+	)
+}
+
+// CertificateSpec defines the desired state of Certificate
+type CertificateSpec struct {
+	// The namespaced name of the Kubernetes Secret this certificate is imported from.
+	SecretRef string `json:"secretRef"`
+
+	// The PEM encoded leaf certificate.
+	Certificate string `json:"certificate"`
+
+	// The PEM encoded intermediate certificates.
+	// +optional
+	CertificateChain string `json:"certificateChain,omitempty"`
+
+	// The PEM encoded private key that matches the leaf certificate.
+	PrivateKey string `json:"privateKey"`
+
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// MarshalJSON marshals the CertificateSpec with the private key redacted.
+func (spec CertificateSpec) MarshalJSON() ([]byte, error) {
+	type redactedSpec CertificateSpec
+	redacted := redactedSpec(spec)
+	redacted.PrivateKey = "[REDACTED]"
+	return json.Marshal(redacted)
+}
+
+// CertificateStatus defines the observed state of Certificate
+type CertificateStatus struct {
+	// The Amazon Resource Name (ARN) of the certificate.
+	CertificateARN string `json:"certificateARN"`
+}
@@ -21,6 +21,9 @@ type Stack interface {
 	// pResourceSlice must be a pointer to a slice of resources, which will be filled.
 	ListResources(pResourceSlice interface{}) error
 
+	// ListDependents list resources that depend on specific resource.
+	ListDependents(res Resource) []Resource
+
 	// TopologicalTraversal visits resources in stack in topological order.
 	TopologicalTraversal(visitor ResourceVisitor) error
 }
@@ -99,6 +102,21 @@ func (s *defaultStack) ListResources(pResourceSlice interface{}) error {
 	return nil
 }
 
+// ListDependents list resources that depend on specific resource.
+func (s *defaultStack) ListDependents(res Resource) []Resource {
+	resUID := s.computeResourceUID(res)
+	visited := make(map[graph.ResourceUID]bool)
+	var dependents []Resource
+	for _, dependentUID := range s.resourceGraph.OutEdgeNodes(resUID) {
+		if visited[dependentUID] {
+			continue
+		}
+		visited[dependentUID] = true
+		dependents = append(dependents, s.resources[dependentUID])
+	}
+	return dependents
+}
+
 func (s *defaultStack) TopologicalTraversal(visitor ResourceVisitor) error {
 	return graph.TopologicalTraversal(s.resourceGraph, func(uid graph.ResourceUID) error {
 		return visitor.Visit(s.resources[uid])
@@ -106,3 +106,36 @@ func Test_defaultStack_ListResources(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultStack_ListDependents(t *testing.T) {
+	stack := NewDefaultStack(StackID{Namespace: "namespace", Name: "name"})
+	resA := NewFakeResource(stack, "fake", "id-A", FakeResourceSpec{}, nil)
+	resB := NewFakeResource(stack, "fake", "id-B", FakeResourceSpec{}, nil)
+	resC := NewFakeResource(stack, "fake", "id-C", FakeResourceSpec{}, nil)
+	assert.NoError(t, stack.AddDependency(resA, resB))
+	assert.NoError(t, stack.AddDependency(resA, resC))
+	assert.NoError(t, stack.AddDependency(resA, resC))
+
+	tests := []struct {
+		name string
+		res  Resource
+		want []Resource
+	}{
+		{
+			name: "resource with dependents",
+			res:  resA,
+			want: []Resource{resB, resC},
+		},
+		{
+			name: "resource without dependents",
+			res:  resB,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := stack.ListDependents(tt.res)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
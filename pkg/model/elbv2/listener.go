@@ -56,6 +56,19 @@ func (ls *Listener) registerDependencies(stack core.Stack) {
 	for _, dep := range ls.Spec.LoadBalancerARN.Dependencies() {
 		stack.AddDependency(dep, ls)
 	}
+	for _, cert := range ls.Spec.Certificates {
+		if cert.CertificateARN == nil {
+			continue
+		}
+		for _, dep := range cert.CertificateARN.Dependencies() {
+			stack.AddDependency(dep, ls)
+		}
+	}
+	if ls.Spec.MutualAuthentication != nil && ls.Spec.MutualAuthentication.TrustStoreARN != nil {
+		for _, dep := range ls.Spec.MutualAuthentication.TrustStoreARN.Dependencies() {
+			stack.AddDependency(dep, ls)
+		}
+	}
 }
 
 type Protocol string
@@ -289,7 +302,29 @@ type Action struct {
 type Certificate struct {
 	// The Amazon Resource Name (ARN) of the certificate.
 	// +optional
-	CertificateARN *string `json:"certificateARN,omitempty"`
+	CertificateARN core.StringToken `json:"certificateARN,omitempty"`
+}
+
+type MutualAuthenticationMode string
+
+const (
+	MutualAuthenticationModeOff         MutualAuthenticationMode = "off"
+	MutualAuthenticationModePassthrough MutualAuthenticationMode = "passthrough"
+	MutualAuthenticationModeVerify      MutualAuthenticationMode = "verify"
+)
+
+// Information about the mutual authentication attributes of a listener.
+type MutualAuthenticationAttributes struct {
+	// The client certificate handling method.
+	Mode MutualAuthenticationMode `json:"mode"`
+
+	// [verify mode] The Amazon Resource Name (ARN) of the trust store.
+	// +optional
+	TrustStoreARN core.StringToken `json:"trustStoreARN,omitempty"`
+
+	// [verify mode] Indicates whether expired client certificates are ignored.
+	// +optional
+	IgnoreClientCertificateExpiry *bool `json:"ignoreClientCertificateExpiry,omitempty"`
 }
 
 // ALPNPolicy ALPN policy configuration for TLS listeners forwarding to TLS target groups
@@ -332,6 +367,10 @@ type ListenerSpec struct {
 	// +optional
 	ALPNPolicy []string `json:"alpnPolicy,omitempty"`
 
+	// [HTTPS listener] The mutual authentication configuration.
+	// +optional
+	MutualAuthentication *MutualAuthenticationAttributes `json:"mutualAuthentication,omitempty"`
+
 	// The tags.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
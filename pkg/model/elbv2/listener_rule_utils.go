@@ -0,0 +1,175 @@
+package elbv2
+
+import (
+	awssdk "github.com/aws/aws-sdk-go/aws"
+)
+
+const (
+	// MaxRuleConditionValues is the maximum number of condition values per rule that ALB supports.
+	MaxRuleConditionValues = 5
+	// MaxConditionValues is the maximum number of values per condition that ALB supports.
+	MaxConditionValues = 3
+)
+
+// NewHostHeaderCondition constructs a host-header condition that matches any of hosts.
+func NewHostHeaderCondition(hosts []string) RuleCondition {
+	return RuleCondition{
+		Field: RuleConditionFieldHostHeader,
+		HostHeaderConfig: &HostHeaderConditionConfig{
+			Values: hosts,
+		},
+	}
+}
+
+// NewPathPatternCondition constructs a path-pattern condition that matches any of paths.
+func NewPathPatternCondition(paths []string) RuleCondition {
+	return RuleCondition{
+		Field: RuleConditionFieldPathPattern,
+		PathPatternConfig: &PathPatternConditionConfig{
+			Values: paths,
+		},
+	}
+}
+
+// NewFixedResponseAction constructs a fixed-response action with an empty text/plain body.
+func NewFixedResponseAction(statusCode string) Action {
+	return Action{
+		Type: ActionTypeFixedResponse,
+		FixedResponseConfig: &FixedResponseActionConfig{
+			ContentType: awssdk.String("text/plain"),
+			StatusCode:  statusCode,
+		},
+	}
+}
+
+// CountRuleConditionValues counts the condition values of a rule the same way as ALB quota does.
+func CountRuleConditionValues(conditions []RuleCondition) int {
+	count := 0
+	for _, condition := range conditions {
+		switch {
+		case condition.HostHeaderConfig != nil:
+			count += len(condition.HostHeaderConfig.Values)
+		case condition.HTTPHeaderConfig != nil:
+			count += len(condition.HTTPHeaderConfig.Values)
+		case condition.HTTPRequestMethodConfig != nil:
+			count += len(condition.HTTPRequestMethodConfig.Values)
+		case condition.PathPatternConfig != nil:
+			count += len(condition.PathPatternConfig.Values)
+		case condition.QueryStringConfig != nil:
+			count += len(condition.QueryStringConfig.Values)
+		case condition.SourceIPConfig != nil:
+			count += len(condition.SourceIPConfig.Values)
+		}
+	}
+	return count
+}
+
+// SplitRuleConditions splits the conditions of a rule into the conditions of several rules, so that each rule stays within
+// the condition values limits of ALB. Since values within a condition are ORed, rules with same actions and conditions whose
+// values partition the values of original condition are equivalent to the original rule.
+// If the conditions cannot be split further, i.e. each condition has a single value, they're returned as is.
+func SplitRuleConditions(conditions []RuleCondition) [][]RuleCondition {
+	totalValueCount := CountRuleConditionValues(conditions)
+	splitConditionIndex := -1
+	splitConditionValueCount := 1
+	for i, condition := range conditions {
+		if valueCount := CountRuleConditionValues([]RuleCondition{condition}); valueCount > splitConditionValueCount {
+			splitConditionIndex = i
+			splitConditionValueCount = valueCount
+		}
+	}
+	if totalValueCount <= MaxRuleConditionValues && splitConditionValueCount <= MaxConditionValues {
+		return [][]RuleCondition{conditions}
+	}
+	if splitConditionIndex == -1 {
+		return [][]RuleCondition{conditions}
+	}
+
+	chunkSize := MaxRuleConditionValues - (totalValueCount - splitConditionValueCount)
+	if chunkSize > MaxConditionValues {
+		chunkSize = MaxConditionValues
+	}
+	if chunkSize < 1 {
+		chunkSize = 1
+	}
+	var splitConditions [][]RuleCondition
+	for _, chunkCondition := range splitRuleConditionValues(conditions[splitConditionIndex], chunkSize) {
+		chunkConditions := append([]RuleCondition{}, conditions...)
+		chunkConditions[splitConditionIndex] = chunkCondition
+		splitConditions = append(splitConditions, SplitRuleConditions(chunkConditions)...)
+	}
+	return splitConditions
+}
+
+// splitRuleConditionValues splits the values of condition into conditions with at most chunkSize values.
+func splitRuleConditionValues(condition RuleCondition, chunkSize int) []RuleCondition {
+	var chunkConditions []RuleCondition
+	switch {
+	case condition.HostHeaderConfig != nil:
+		for _, values := range chunkStrings(condition.HostHeaderConfig.Values, chunkSize) {
+			chunkConditions = append(chunkConditions, RuleCondition{
+				Field:            condition.Field,
+				HostHeaderConfig: &HostHeaderConditionConfig{Values: values},
+			})
+		}
+	case condition.HTTPHeaderConfig != nil:
+		for _, values := range chunkStrings(condition.HTTPHeaderConfig.Values, chunkSize) {
+			chunkConditions = append(chunkConditions, RuleCondition{
+				Field: condition.Field,
+				HTTPHeaderConfig: &HTTPHeaderConditionConfig{
+					HTTPHeaderName: condition.HTTPHeaderConfig.HTTPHeaderName,
+					Values:         values,
+				},
+			})
+		}
+	case condition.HTTPRequestMethodConfig != nil:
+		for _, values := range chunkStrings(condition.HTTPRequestMethodConfig.Values, chunkSize) {
+			chunkConditions = append(chunkConditions, RuleCondition{
+				Field:                   condition.Field,
+				HTTPRequestMethodConfig: &HTTPRequestMethodConditionConfig{Values: values},
+			})
+		}
+	case condition.PathPatternConfig != nil:
+		for _, values := range chunkStrings(condition.PathPatternConfig.Values, chunkSize) {
+			chunkConditions = append(chunkConditions, RuleCondition{
+				Field:             condition.Field,
+				PathPatternConfig: &PathPatternConditionConfig{Values: values},
+			})
+		}
+	case condition.QueryStringConfig != nil:
+		values := condition.QueryStringConfig.Values
+		for start := 0; start < len(values); start += chunkSize {
+			end := start + chunkSize
+			if end > len(values) {
+				end = len(values)
+			}
+			chunkConditions = append(chunkConditions, RuleCondition{
+				Field:             condition.Field,
+				QueryStringConfig: &QueryStringConditionConfig{Values: values[start:end]},
+			})
+		}
+	case condition.SourceIPConfig != nil:
+		for _, values := range chunkStrings(condition.SourceIPConfig.Values, chunkSize) {
+			chunkConditions = append(chunkConditions, RuleCondition{
+				Field:          condition.Field,
+				SourceIPConfig: &SourceIPConditionConfig{Values: values},
+			})
+		}
+	default:
+		chunkConditions = append(chunkConditions, condition)
+	}
+	return chunkConditions
+}
+
+// chunkStrings splits values into chunks with at most chunkSize values.
+func chunkStrings(values []string, chunkSize int) [][]string {
+	var chunks [][]string
+	for start := 0; start < len(values); start += chunkSize {
+		end := start + chunkSize
+		if end > len(values) {
+			end = len(values)
+		}
+		chunks = append(chunks, values[start:end])
+	}
+	return chunks
+}
@@ -0,0 +1,96 @@
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitRuleConditions(t *testing.T) {
+	hostCondition := func(hosts ...string) RuleCondition {
+		return RuleCondition{
+			Field:            RuleConditionFieldHostHeader,
+			HostHeaderConfig: &HostHeaderConditionConfig{Values: hosts},
+		}
+	}
+	pathCondition := func(paths ...string) RuleCondition {
+		return RuleCondition{
+			Field:             RuleConditionFieldPathPattern,
+			PathPatternConfig: &PathPatternConditionConfig{Values: paths},
+		}
+	}
+	sourceIPCondition := func(cidrs ...string) RuleCondition {
+		return RuleCondition{
+			Field:          RuleConditionFieldSourceIP,
+			SourceIPConfig: &SourceIPConditionConfig{Values: cidrs},
+		}
+	}
+	tests := []struct {
+		name       string
+		conditions []RuleCondition
+		want       [][]RuleCondition
+	}{
+		{
+			name:       "conditions within limit",
+			conditions: []RuleCondition{hostCondition("a.com", "b.com"), pathCondition("/foo", "/foo/*")},
+			want: [][]RuleCondition{
+				{hostCondition("a.com", "b.com"), pathCondition("/foo", "/foo/*")},
+			},
+		},
+		{
+			name:       "hosts exceeding condition limit",
+			conditions: []RuleCondition{hostCondition("a.com", "b.com", "c.com", "d.com")},
+			want: [][]RuleCondition{
+				{hostCondition("a.com", "b.com", "c.com")},
+				{hostCondition("d.com")},
+			},
+		},
+		{
+			name:       "hosts exceeding rule limit",
+			conditions: []RuleCondition{hostCondition("a.com", "b.com", "c.com", "d.com", "e.com", "f.com", "g.com"), pathCondition("/foo", "/foo/*")},
+			want: [][]RuleCondition{
+				{hostCondition("a.com", "b.com", "c.com"), pathCondition("/foo", "/foo/*")},
+				{hostCondition("d.com", "e.com", "f.com"), pathCondition("/foo", "/foo/*")},
+				{hostCondition("g.com"), pathCondition("/foo", "/foo/*")},
+			},
+		},
+		{
+			name: "multiple conditions exceeding limit",
+			conditions: []RuleCondition{
+				sourceIPCondition("10.0.0.0/16", "10.1.0.0/16", "10.2.0.0/16"),
+				hostCondition("a.com", "b.com", "c.com", "d.com"),
+			},
+			want: [][]RuleCondition{
+				{sourceIPCondition("10.0.0.0/16", "10.1.0.0/16", "10.2.0.0/16"), hostCondition("a.com", "b.com")},
+				{sourceIPCondition("10.0.0.0/16", "10.1.0.0/16", "10.2.0.0/16"), hostCondition("c.com", "d.com")},
+			},
+		},
+		{
+			name: "conditions with single value cannot be split",
+			conditions: []RuleCondition{
+				hostCondition("a.com"), pathCondition("/foo"), sourceIPCondition("10.0.0.0/16"),
+				hostCondition("b.com"), pathCondition("/bar"), sourceIPCondition("10.1.0.0/16"),
+			},
+			want: [][]RuleCondition{
+				{
+					hostCondition("a.com"), pathCondition("/foo"), sourceIPCondition("10.0.0.0/16"),
+					hostCondition("b.com"), pathCondition("/bar"), sourceIPCondition("10.1.0.0/16"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitRuleConditions(tt.conditions)
+			assert.Equal(t, tt.want, got)
+			if len(got) > 1 {
+				for _, conditions := range got {
+					assert.LessOrEqual(t, CountRuleConditionValues(conditions), MaxRuleConditionValues)
+					for _, condition := range conditions {
+						assert.LessOrEqual(t, CountRuleConditionValues([]RuleCondition{condition}), MaxConditionValues)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package elbv2
+
+import (
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+var _ core.Resource = &RetainedListenerRules{}
+
+// RetainedListenerRules represents existing ELBV2 ListenerRules that should be kept as is,
+// along with the TargetGroups they forward to, even if they are no longer part of the stack.
+type RetainedListenerRules struct {
+	core.ResourceMeta `json:"-"`
+
+	// desired state of RetainedListenerRules
+	Spec RetainedListenerRulesSpec `json:"spec"`
+
+	// observed state of RetainedListenerRules
+	// +optional
+	Status *RetainedListenerRulesStatus `json:"status,omitempty"`
+}
+
+// NewRetainedListenerRules constructs new RetainedListenerRules resource.
+func NewRetainedListenerRules(stack core.Stack, id string, spec RetainedListenerRulesSpec) *RetainedListenerRules {
+	retained := &RetainedListenerRules{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::RetainedListenerRules", id),
+		Spec:         spec,
+		Status:       nil,
+	}
+	stack.AddResource(retained)
+	return retained
+}
+
+// SetStatus sets the RetainedListenerRules's status
+func (r *RetainedListenerRules) SetStatus(status RetainedListenerRulesStatus) {
+	r.Status = &status
+}
+
+// MatchesTags checks whether a ListenerRule with tags should be retained.
+func (r *RetainedListenerRules) MatchesTags(tags map[string]string) bool {
+	if len(r.Spec.Tags) == 0 {
+		return false
+	}
+	for key, value := range r.Spec.Tags {
+		if tagValue, exists := tags[key]; !exists || tagValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// RetainedListenerRulesSpec defines the desired state of RetainedListenerRules
+type RetainedListenerRulesSpec struct {
+	// The tags identifying the ListenerRules to retain.
+	Tags map[string]string `json:"tags"`
+}
+
+// RetainedListenerRulesStatus defines the observed state of RetainedListenerRules
+type RetainedListenerRulesStatus struct {
+	// The Amazon Resource Names (ARN) of the retained ListenerRules.
+	RuleARNs []string `json:"ruleARNs"`
+
+	// The Amazon Resource Names (ARN) of the TargetGroups the retained ListenerRules forward to.
+	TargetGroupARNs []string `json:"targetGroupARNs"`
+}
@@ -29,6 +29,7 @@ func NewTargetGroup(stack core.Stack, id string, spec TargetGroupSpec) *TargetGr
 		Status:       nil,
 	}
 	stack.AddResource(tg)
+	tg.registerDependencies(stack)
 	return tg
 }
 
@@ -50,11 +51,21 @@ func (tg *TargetGroup) TargetGroupARN() core.StringToken {
 	)
 }
 
+// register dependencies for TargetGroup.
+func (tg *TargetGroup) registerDependencies(stack core.Stack) {
+	if tg.Spec.ALBTarget != nil {
+		for _, dep := range tg.Spec.ALBTarget.LoadBalancerARN.Dependencies() {
+			stack.AddDependency(dep, tg)
+		}
+	}
+}
+
 type TargetType string
 
 const (
 	TargetTypeInstance TargetType = "instance"
 	TargetTypeIP       TargetType = "ip"
+	TargetTypeALB      TargetType = "alb"
 )
 
 type TargetGroupIPAddressType string
@@ -117,6 +128,12 @@ type TargetGroupAttribute struct {
 	Value string `json:"value"`
 }
 
+// TargetGroupALBTarget is the Application Load Balancer registered as target of a TargetGroup with alb TargetType.
+type TargetGroupALBTarget struct {
+	// The Amazon Resource Name (ARN) of the Application Load Balancer.
+	LoadBalancerARN core.StringToken `json:"loadBalancerARN"`
+}
+
 // TargetGroupSpec defines the observed state of TargetGroup
 type TargetGroupSpec struct {
 	// The name of the target group.
@@ -147,6 +164,10 @@ type TargetGroupSpec struct {
 	// +optional
 	TargetGroupAttributes []TargetGroupAttribute `json:"targetGroupAttributes,omitempty"`
 
+	// [alb TargetType] The Application Load Balancer registered as the target.
+	// +optional
+	ALBTarget *TargetGroupALBTarget `json:"albTarget,omitempty"`
+
 	// The tags.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
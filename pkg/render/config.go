@@ -0,0 +1,160 @@
+package render
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const (
+	defaultClusterName          = "render"
+	defaultVpcID                = "vpc-render"
+	defaultIngressClass         = "alb"
+	defaultLoadBalancerClass    = "service.k8s.aws/nlb"
+	defaultSSLPolicy            = "ELBSecurityPolicy-2016-08"
+	defaultBackendSecurityGroup = "sg-render-backend"
+
+	// SubnetRolePublic is the role of subnets for internet-facing load balancers.
+	SubnetRolePublic = "public"
+	// SubnetRoleInternal is the role of subnets for internal load balancers.
+	SubnetRoleInternal = "internal"
+)
+
+// Config is the local configuration that replaces the cluster and AWS environment when rendering.
+type Config struct {
+	// ClusterName is the name of the kubernetes cluster.
+	ClusterName string `json:"clusterName"`
+	// VpcID is the ID of the VPC.
+	VpcID string `json:"vpcID"`
+	// VpcCIDRs are the IPv4 CIDRs associated with the VPC.
+	VpcCIDRs []string `json:"vpcCIDRs"`
+	// VpcIPv6CIDRs are the IPv6 CIDRs associated with the VPC.
+	VpcIPv6CIDRs []string `json:"vpcIPv6CIDRs"`
+
+	// IngressClass is the name of the ingress class this controller satisfies.
+	IngressClass string `json:"ingressClass"`
+	// LoadBalancerClass is the name of the load balancer class for services reconciled by this controller.
+	LoadBalancerClass string `json:"loadBalancerClass"`
+	// DefaultSSLPolicy is the default SSL policy for load balancers.
+	DefaultSSLPolicy string `json:"defaultSSLPolicy"`
+	// DefaultTags are the tags applied to all AWS resources.
+	DefaultTags map[string]string `json:"defaultTags"`
+	// ExternalManagedTags are the tag keys managed externally.
+	ExternalManagedTags []string `json:"externalManagedTags"`
+	// EnableBackendSecurityGroup enables the shared backend security group, defaults to true.
+	EnableBackendSecurityGroup *bool `json:"enableBackendSecurityGroup"`
+	// BackendSecurityGroup is the ID of the shared backend security group.
+	BackendSecurityGroup string `json:"backendSecurityGroup"`
+	// DisableRestrictedSGRules disables the usage of restricted security group rules.
+	DisableRestrictedSGRules bool `json:"disableRestrictedSGRules"`
+	// CompactListenerRules enables listener rules compaction, same as the ListenerRulesCompaction feature gate.
+	CompactListenerRules bool `json:"compactListenerRules"`
+
+	// Subnets are the subnets within the VPC.
+	Subnets []SubnetConfig `json:"subnets"`
+	// Certificates are the ACM certificates available for discovery.
+	Certificates []CertificateConfig `json:"certificates"`
+	// SecurityGroups are the security groups that can be referenced by name or ID.
+	SecurityGroups []SecurityGroupConfig `json:"securityGroups"`
+}
+
+// SubnetConfig is the configuration of a subnet.
+type SubnetConfig struct {
+	// ID is the ID of subnet.
+	ID string `json:"id"`
+	// Name is the value of subnet's Name tag.
+	Name string `json:"name"`
+	// AvailabilityZone is the availability zone of subnet.
+	AvailabilityZone string `json:"availabilityZone"`
+	// CIDR is the IPv4 CIDR of subnet.
+	CIDR string `json:"cidr"`
+	// IPv6CIDR is the IPv6 CIDR of subnet.
+	IPv6CIDR string `json:"ipv6CIDR"`
+	// Role is the role of subnet for subnet discovery, either public or internal.
+	Role string `json:"role"`
+}
+
+// CertificateConfig is the configuration of an ACM certificate.
+type CertificateConfig struct {
+	// ARN is the ARN of certificate.
+	ARN string `json:"arn"`
+	// Domains are the domain names of certificate, including subject alternative names.
+	Domains []string `json:"domains"`
+}
+
+// SecurityGroupConfig is the configuration of a security group.
+type SecurityGroupConfig struct {
+	// ID is the ID of security group.
+	ID string `json:"id"`
+	// Name is the value of security group's Name tag.
+	Name string `json:"name"`
+}
+
+// LoadConfig loads the Config from a YAML or JSON file, an empty path returns the default Config.
+func LoadConfig(path string) (Config, error) {
+	cfg := Config{}
+	if path != "" {
+		file, err := os.Open(path)
+		if err != nil {
+			return Config{}, err
+		}
+		defer file.Close()
+		if err := yaml.NewYAMLOrJSONDecoder(file, 4096).Decode(&cfg); err != nil {
+			return Config{}, errors.Wrapf(err, "failed to decode config: %v", path)
+		}
+	}
+	cfg.applyDefaults()
+	if err := cfg.validate(); err != nil {
+		return Config{}, errors.Wrapf(err, "invalid config: %v", path)
+	}
+	return cfg, nil
+}
+
+func (cfg *Config) applyDefaults() {
+	if cfg.ClusterName == "" {
+		cfg.ClusterName = defaultClusterName
+	}
+	if cfg.VpcID == "" {
+		cfg.VpcID = defaultVpcID
+	}
+	if cfg.IngressClass == "" {
+		cfg.IngressClass = defaultIngressClass
+	}
+	if cfg.LoadBalancerClass == "" {
+		cfg.LoadBalancerClass = defaultLoadBalancerClass
+	}
+	if cfg.DefaultSSLPolicy == "" {
+		cfg.DefaultSSLPolicy = defaultSSLPolicy
+	}
+	if cfg.EnableBackendSecurityGroup == nil {
+		enableBackendSG := true
+		cfg.EnableBackendSecurityGroup = &enableBackendSG
+	}
+	if cfg.BackendSecurityGroup == "" {
+		cfg.BackendSecurityGroup = defaultBackendSecurityGroup
+	}
+}
+
+func (cfg *Config) validate() error {
+	for _, subnet := range cfg.Subnets {
+		if subnet.ID == "" {
+			return errors.New("subnet id must be specified")
+		}
+		if subnet.Role != SubnetRolePublic && subnet.Role != SubnetRoleInternal {
+			return errors.Errorf("subnet %v has unknown role: %v, must be %v or %v",
+				subnet.ID, subnet.Role, SubnetRolePublic, SubnetRoleInternal)
+		}
+	}
+	for _, cert := range cfg.Certificates {
+		if cert.ARN == "" {
+			return errors.New("certificate arn must be specified")
+		}
+	}
+	for _, sg := range cfg.SecurityGroups {
+		if sg.ID == "" {
+			return errors.New("securityGroup id must be specified")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// defaultNamespace is the namespace for namespaced objects without namespace in manifests.
+	defaultNamespace = "default"
+)
+
+// clusterScopedKinds are the kinds of cluster scoped objects that can appear in manifests.
+var clusterScopedKinds = sets.NewString(
+	"Namespace",
+	"Node",
+	"IngressClass",
+	"IngressClassParams",
+	"ClusterRole",
+	"ClusterRoleBinding",
+	"CustomResourceDefinition",
+	"PersistentVolume",
+	"StorageClass",
+	"PriorityClass",
+)
+
+// manifestExtensions are the extensions of manifest files loaded from directories.
+var manifestExtensions = sets.NewString(".yaml", ".yml", ".json")
+
+// ManifestLoader loads kubernetes objects from manifest files.
+type ManifestLoader interface {
+	// Load objects from manifest files or directories. Directories are walked recursively.
+	Load(paths []string) ([]client.Object, error)
+}
+
+// NewDefaultManifestLoader constructs new defaultManifestLoader.
+func NewDefaultManifestLoader(scheme *runtime.Scheme) *defaultManifestLoader {
+	return &defaultManifestLoader{
+		decoder: serializer.NewCodecFactory(scheme).UniversalDeserializer(),
+	}
+}
+
+var _ ManifestLoader = &defaultManifestLoader{}
+
+// defaultManifestLoader loads multi-document YAML or JSON manifests.
+// objects of kinds unknown to the scheme are skipped, and v1 Lists are flattened.
+type defaultManifestLoader struct {
+	decoder runtime.Decoder
+}
+
+func (l *defaultManifestLoader) Load(paths []string) ([]client.Object, error) {
+	var files []string
+	for _, path := range paths {
+		pathFiles, err := l.listManifestFiles(path)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, pathFiles...)
+	}
+
+	var objs []client.Object
+	objKeys := sets.NewString()
+	for _, file := range files {
+		fileObjs, err := l.loadFile(file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load manifest: %v", file)
+		}
+		for _, obj := range fileObjs {
+			objKey := strings.Join([]string{obj.GetObjectKind().GroupVersionKind().Kind, obj.GetNamespace(), obj.GetName()}, "/")
+			if objKeys.Has(objKey) {
+				return nil, errors.Errorf("duplicate object in manifest %v: %v", file, objKey)
+			}
+			objKeys.Insert(objKey)
+			objs = append(objs, obj)
+		}
+	}
+	return objs, nil
+}
+
+func (l *defaultManifestLoader) listManifestFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	var files []string
+	if err := filepath.Walk(path, func(filePath string, fileInfo os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !fileInfo.IsDir() && manifestExtensions.Has(filepath.Ext(filePath)) {
+			files = append(files, filePath)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func (l *defaultManifestLoader) loadFile(file string) ([]client.Object, error) {
+	payload, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	reader := yaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(payload)))
+	var objs []client.Object
+	for {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		docObjs, err := l.decode(doc)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, docObjs...)
+	}
+	return objs, nil
+}
+
+func (l *defaultManifestLoader) decode(doc []byte) ([]client.Object, error) {
+	rawObj, gvk, err := l.decoder.Decode(doc, nil, nil)
+	if err != nil {
+		if runtime.IsNotRegisteredError(err) || runtime.IsMissingKind(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if list, ok := rawObj.(*corev1.List); ok {
+		var objs []client.Object
+		for _, item := range list.Items {
+			itemObjs, err := l.decode(item.Raw)
+			if err != nil {
+				return nil, err
+			}
+			objs = append(objs, itemObjs...)
+		}
+		return objs, nil
+	}
+	obj, ok := rawObj.(client.Object)
+	if !ok {
+		return nil, nil
+	}
+	obj.GetObjectKind().SetGroupVersionKind(*gvk)
+	if obj.GetNamespace() == "" && !clusterScopedKinds.Has(gvk.Kind) {
+		obj.SetNamespace(defaultNamespace)
+	}
+	return []client.Object{obj}, nil
+}
@@ -0,0 +1,128 @@
+package render
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+)
+
+func Test_defaultManifestLoader_Load(t *testing.T) {
+	tests := []struct {
+		name      string
+		manifests map[string]string
+		wantObjs  []string
+		wantErr   string
+	}{
+		{
+			name: "multi-document manifest",
+			manifests: map[string]string{
+				"app.yaml": `
+apiVersion: networking.k8s.io/v1
+kind: IngressClass
+metadata:
+  name: alb
+---
+apiVersion: elbv2.k8s.aws/v1beta1
+kind: IngressClassParams
+metadata:
+  name: alb-params
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: svc
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: ing
+  namespace: app
+---
+apiVersion: example.com/v1
+kind: Unknown
+metadata:
+  name: unknown
+`,
+			},
+			wantObjs: []string{
+				"IngressClass//alb",
+				"IngressClassParams//alb-params",
+				"Service/default/svc",
+				"Ingress/app/ing",
+			},
+		},
+		{
+			name: "list manifest and json manifest",
+			manifests: map[string]string{
+				"list.yaml": `
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: svc-a
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: svc-b
+`,
+				"svc.json":  `{"apiVersion": "v1", "kind": "Service", "metadata": {"name": "svc-c", "namespace": "app"}}`,
+				"README.md": `not a manifest`,
+			},
+			wantObjs: []string{
+				"Service/default/svc-a",
+				"Service/default/svc-b",
+				"Service/app/svc-c",
+			},
+		},
+		{
+			name: "duplicate objects",
+			manifests: map[string]string{
+				"app.yaml": `
+apiVersion: v1
+kind: Service
+metadata:
+  name: svc
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: svc
+  namespace: default
+`,
+			},
+			wantErr: "duplicate object in manifest",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.manifests {
+				assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+			}
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = elbv2api.AddToScheme(scheme)
+
+			l := NewDefaultManifestLoader(scheme)
+			objs, err := l.Load([]string{dir})
+			if tt.wantErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			var gotObjs []string
+			for _, obj := range objs {
+				gotObjs = append(gotObjs, obj.GetObjectKind().GroupVersionKind().Kind+"/"+obj.GetNamespace()+"/"+obj.GetName())
+			}
+			assert.ElementsMatch(t, tt.wantObjs, gotObjs)
+		})
+	}
+}
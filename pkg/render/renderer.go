@@ -0,0 +1,257 @@
+package render
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certs"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// the tag prefixes and finalizer are the same as the ones used by the ingress and service controllers.
+	ingressTagPrefix        = "ingress.k8s.aws"
+	serviceTagPrefix        = "service.k8s.aws"
+	serviceFinalizer        = "service.k8s.aws/resources"
+	serviceAnnotationPrefix = "service.beta.kubernetes.io"
+
+	// SourceIngress is the source of stacks built from IngressGroups.
+	SourceIngress = "ingress"
+	// SourceService is the source of stacks built from Services.
+	SourceService = "service"
+)
+
+// RenderedStack is the rendered model of a resource stack.
+type RenderedStack struct {
+	// Source is the source that the stack is built from, either ingress or service.
+	Source string `json:"source"`
+	// StackID is the ID of the stack.
+	StackID string `json:"stackID"`
+	// Model is the stack in the JSON format produced by deploy.StackMarshaller.
+	Model json.RawMessage `json:"model"`
+	// Graph is the resource graph of the stack.
+	Graph string `json:"graph"`
+}
+
+// Renderer renders the model stacks for IngressGroups and Services.
+type Renderer interface {
+	// Render builds the model stacks of all IngressGroups and Services, with the resource graph in graphFormat.
+	Render(ctx context.Context, graphFormat deploy.GraphFormat) ([]RenderedStack, error)
+}
+
+// NewDefaultRenderer constructs new defaultRenderer, k8sClient is expected to be populated from manifests.
+func NewDefaultRenderer(k8sClient client.Client, cfg Config, logger logr.Logger) *defaultRenderer {
+	subnetsResolver := NewStaticSubnetsResolver(cfg)
+	backendSGProvider := NewStaticBackendSGProvider(cfg)
+	vpcInfoProvider := NewStaticVPCInfoProvider(cfg)
+	elbv2TaggingManager := NewEmptyTaggingManager()
+	ec2Client := NewStaticEC2(cfg)
+	domainsByCertARN := make(map[string][]string, len(cfg.Certificates))
+	for _, cert := range cfg.Certificates {
+		domainsByCertARN[cert.ARN] = cert.Domains
+	}
+	certDiscovery := certs.NewStaticCertDiscovery(domainsByCertARN)
+	eventRecorder := &record.FakeRecorder{}
+
+	ingAnnotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
+	authConfigBuilder := ingress.NewDefaultAuthConfigBuilder(ingAnnotationParser)
+	enhancedBackendBuilder := ingress.NewDefaultEnhancedBackendBuilder(k8sClient, ingAnnotationParser, authConfigBuilder)
+	featureGates := config.NewFeatureGates()
+	if cfg.CompactListenerRules {
+		featureGates.Enable(config.ListenerRulesCompaction)
+	}
+	ruleOptimizer := ingress.NewDefaultRuleOptimizer(featureGates, logger)
+	ingTrackingProvider := tracking.NewDefaultProvider(ingressTagPrefix, cfg.ClusterName)
+	ingModelBuilder := ingress.NewDefaultModelBuilder(k8sClient, eventRecorder,
+		ec2Client, certDiscovery, nil, nil, nil,
+		ingAnnotationParser, subnetsResolver,
+		authConfigBuilder, enhancedBackendBuilder, ruleOptimizer, ingTrackingProvider, elbv2TaggingManager,
+		cfg.VpcID, cfg.ClusterName, cfg.DefaultTags, cfg.ExternalManagedTags,
+		cfg.DefaultSSLPolicy, backendSGProvider, *cfg.EnableBackendSecurityGroup, cfg.DisableRestrictedSGRules,
+		false, featureGates, logger)
+	classLoader := ingress.NewDefaultClassLoader(k8sClient)
+	classAnnotationMatcher := ingress.NewDefaultClassAnnotationMatcher(cfg.IngressClass)
+	groupLoader := ingress.NewDefaultGroupLoader(k8sClient, eventRecorder, ingAnnotationParser, classLoader, classAnnotationMatcher, false)
+
+	svcAnnotationParser := annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix)
+	svcTrackingProvider := tracking.NewDefaultProvider(serviceTagPrefix, cfg.ClusterName)
+	sgResolver := networkingpkg.NewDefaultSecurityGroupResolver(ec2Client, cfg.VpcID)
+	serviceUtils := service.NewServiceUtils(svcAnnotationParser, serviceFinalizer, cfg.LoadBalancerClass, featureGates)
+	svcModelBuilder := service.NewDefaultModelBuilder(svcAnnotationParser, subnetsResolver, vpcInfoProvider, sgResolver, cfg.VpcID, svcTrackingProvider,
+		elbv2TaggingManager, certDiscovery, cfg.ClusterName, cfg.DefaultTags, cfg.ExternalManagedTags, cfg.DefaultSSLPolicy, serviceUtils, featureGates)
+	svcGroupLoader := service.NewDefaultGroupLoader(k8sClient, svcAnnotationParser, serviceUtils)
+
+	return &defaultRenderer{
+		k8sClient:       k8sClient,
+		groupLoader:     groupLoader,
+		ingModelBuilder: ingModelBuilder,
+		serviceUtils:    serviceUtils,
+		svcModelBuilder: svcModelBuilder,
+		svcGroupLoader:  svcGroupLoader,
+		svcGroupBuilder: svcModelBuilder,
+		stackMarshaller: deploy.NewDefaultStackMarshaller(),
+		graphRenderer:   deploy.NewDefaultStackGraphRenderer(),
+	}
+}
+
+var _ Renderer = &defaultRenderer{}
+
+// default implementation for Renderer.
+type defaultRenderer struct {
+	k8sClient       client.Client
+	groupLoader     ingress.GroupLoader
+	ingModelBuilder ingress.ModelBuilder
+	serviceUtils    service.ServiceUtils
+	svcModelBuilder service.ModelBuilder
+	svcGroupLoader  service.GroupLoader
+	svcGroupBuilder service.GroupModelBuilder
+	stackMarshaller deploy.StackMarshaller
+	graphRenderer   deploy.StackGraphRenderer
+}
+
+func (r *defaultRenderer) Render(ctx context.Context, graphFormat deploy.GraphFormat) ([]RenderedStack, error) {
+	ingStacks, err := r.renderIngressGroups(ctx, graphFormat)
+	if err != nil {
+		return nil, err
+	}
+	svcStacks, err := r.renderServices(ctx, graphFormat)
+	if err != nil {
+		return nil, err
+	}
+	return append(ingStacks, svcStacks...), nil
+}
+
+func (r *defaultRenderer) renderIngressGroups(ctx context.Context, graphFormat deploy.GraphFormat) ([]RenderedStack, error) {
+	ingList := &networking.IngressList{}
+	if err := r.k8sClient.List(ctx, ingList); err != nil {
+		return nil, err
+	}
+	groupIDByKey := make(map[string]ingress.GroupID)
+	for i := range ingList.Items {
+		groupID, err := r.groupLoader.LoadGroupIDIfAny(ctx, &ingList.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		if groupID != nil {
+			groupIDByKey[groupID.String()] = *groupID
+		}
+	}
+	groupKeys := make([]string, 0, len(groupIDByKey))
+	for groupKey := range groupIDByKey {
+		groupKeys = append(groupKeys, groupKey)
+	}
+	sort.Strings(groupKeys)
+
+	var renderedStacks []RenderedStack
+	for _, groupKey := range groupKeys {
+		ingGroup, err := r.groupLoader.Load(ctx, groupIDByKey[groupKey])
+		if err != nil {
+			return nil, err
+		}
+		if len(ingGroup.Members) == 0 {
+			continue
+		}
+		stack, _, _, _, err := r.ingModelBuilder.Build(ctx, ingGroup)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to build model for ingressGroup: %v", groupKey)
+		}
+		renderedStack, err := r.renderStack(SourceIngress, stack, graphFormat)
+		if err != nil {
+			return nil, err
+		}
+		renderedStacks = append(renderedStacks, renderedStack)
+	}
+	return renderedStacks, nil
+}
+
+func (r *defaultRenderer) renderServices(ctx context.Context, graphFormat deploy.GraphFormat) ([]RenderedStack, error) {
+	svcList := &corev1.ServiceList{}
+	if err := r.k8sClient.List(ctx, svcList); err != nil {
+		return nil, err
+	}
+	svcs := svcList.Items
+	sort.Slice(svcs, func(i, j int) bool {
+		if svcs[i].Namespace != svcs[j].Namespace {
+			return svcs[i].Namespace < svcs[j].Namespace
+		}
+		return svcs[i].Name < svcs[j].Name
+	})
+
+	var renderedStacks []RenderedStack
+	groupIDByKey := make(map[string]service.GroupID)
+	for i := range svcs {
+		svc := &svcs[i]
+		if !r.serviceUtils.IsServiceSupported(svc) {
+			continue
+		}
+		if groupID, inGroup, _ := r.svcGroupLoader.LoadGroupIDIfAny(svc); inGroup {
+			groupIDByKey[groupID.String()] = groupID
+			continue
+		}
+		stack, lb, err := r.svcModelBuilder.Build(ctx, svc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to build model for service: %v/%v", svc.Namespace, svc.Name)
+		}
+		if lb == nil {
+			continue
+		}
+		renderedStack, err := r.renderStack(SourceService, stack, graphFormat)
+		if err != nil {
+			return nil, err
+		}
+		renderedStacks = append(renderedStacks, renderedStack)
+	}
+
+	groupKeys := make([]string, 0, len(groupIDByKey))
+	for groupKey := range groupIDByKey {
+		groupKeys = append(groupKeys, groupKey)
+	}
+	sort.Strings(groupKeys)
+	for _, groupKey := range groupKeys {
+		svcGroup, err := r.svcGroupLoader.Load(ctx, groupIDByKey[groupKey])
+		if err != nil {
+			return nil, err
+		}
+		stack, _, _, err := r.svcGroupBuilder.BuildGroup(ctx, svcGroup)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to build model for serviceGroup: %v", groupKey)
+		}
+		renderedStack, err := r.renderStack(SourceService, stack, graphFormat)
+		if err != nil {
+			return nil, err
+		}
+		renderedStacks = append(renderedStacks, renderedStack)
+	}
+	return renderedStacks, nil
+}
+
+func (r *defaultRenderer) renderStack(source string, stack core.Stack, graphFormat deploy.GraphFormat) (RenderedStack, error) {
+	stackJSON, err := r.stackMarshaller.Marshal(stack)
+	if err != nil {
+		return RenderedStack{}, err
+	}
+	graph, err := r.graphRenderer.Render(stack, graphFormat)
+	if err != nil {
+		return RenderedStack{}, err
+	}
+	return RenderedStack{
+		Source:  source,
+		StackID: stack.StackID().String(),
+		Model:   json.RawMessage(stackJSON),
+		Graph:   graph,
+	}, nil
+}
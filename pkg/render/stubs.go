@@ -0,0 +1,249 @@
+package render
+
+import (
+	"context"
+	"sort"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+// NewStaticSubnetsResolver constructs new staticSubnetsResolver.
+func NewStaticSubnetsResolver(cfg Config) *staticSubnetsResolver {
+	return &staticSubnetsResolver{
+		vpcID:   cfg.VpcID,
+		subnets: cfg.Subnets,
+	}
+}
+
+var _ networking.SubnetsResolver = &staticSubnetsResolver{}
+
+// staticSubnetsResolver resolves subnets from the subnets within Config.
+// subnets are discovered by their role instead of tags.
+type staticSubnetsResolver struct {
+	vpcID   string
+	subnets []SubnetConfig
+}
+
+func (r *staticSubnetsResolver) ResolveViaDiscovery(_ context.Context, opts ...networking.SubnetsResolveOption) ([]*ec2sdk.Subnet, error) {
+	resolveOpts := networking.SubnetsResolveOptions{
+		LBType:   elbv2model.LoadBalancerTypeApplication,
+		LBScheme: elbv2model.LoadBalancerSchemeInternetFacing,
+	}
+	resolveOpts.ApplyOptions(opts)
+	role := SubnetRolePublic
+	if resolveOpts.LBScheme == elbv2model.LoadBalancerSchemeInternal {
+		role = SubnetRoleInternal
+	}
+
+	subnetByAZ := make(map[string]SubnetConfig)
+	for _, subnet := range r.subnets {
+		if subnet.Role != role {
+			continue
+		}
+		if existing, exists := subnetByAZ[subnet.AvailabilityZone]; exists && existing.ID < subnet.ID {
+			continue
+		}
+		subnetByAZ[subnet.AvailabilityZone] = subnet
+	}
+	minimalCount := 1
+	if resolveOpts.LBType == elbv2model.LoadBalancerTypeApplication {
+		minimalCount = 2
+	}
+	if len(subnetByAZ) < minimalCount {
+		return nil, errors.Errorf("unable to resolve at least %d subnet with role %v, resolved %d", minimalCount, role, len(subnetByAZ))
+	}
+	subnets := make([]*ec2sdk.Subnet, 0, len(subnetByAZ))
+	for _, subnet := range subnetByAZ {
+		subnets = append(subnets, r.buildSDKSubnet(subnet))
+	}
+	sortSDKSubnets(subnets)
+	return subnets, nil
+}
+
+func (r *staticSubnetsResolver) ResolveViaNameOrIDSlice(_ context.Context, subnetNameOrIDs []string, _ ...networking.SubnetsResolveOption) ([]*ec2sdk.Subnet, error) {
+	var subnets []*ec2sdk.Subnet
+	for _, nameOrID := range subnetNameOrIDs {
+		for _, subnet := range r.subnets {
+			if subnet.ID == nameOrID || subnet.Name == nameOrID {
+				subnets = append(subnets, r.buildSDKSubnet(subnet))
+				break
+			}
+		}
+	}
+	if len(subnets) != len(subnetNameOrIDs) {
+		return nil, errors.Errorf("couldn't find all subnets, nameOrIDs: %v", subnetNameOrIDs)
+	}
+	sortSDKSubnets(subnets)
+	return subnets, nil
+}
+
+func (r *staticSubnetsResolver) buildSDKSubnet(subnet SubnetConfig) *ec2sdk.Subnet {
+	sdkSubnet := &ec2sdk.Subnet{
+		SubnetId:         awssdk.String(subnet.ID),
+		VpcId:            awssdk.String(r.vpcID),
+		AvailabilityZone: awssdk.String(subnet.AvailabilityZone),
+		CidrBlock:        awssdk.String(subnet.CIDR),
+	}
+	if subnet.Name != "" {
+		sdkSubnet.Tags = []*ec2sdk.Tag{{Key: awssdk.String("Name"), Value: awssdk.String(subnet.Name)}}
+	}
+	if subnet.IPv6CIDR != "" {
+		sdkSubnet.Ipv6CidrBlockAssociationSet = []*ec2sdk.SubnetIpv6CidrBlockAssociation{
+			{
+				Ipv6CidrBlock: awssdk.String(subnet.IPv6CIDR),
+				Ipv6CidrBlockState: &ec2sdk.SubnetCidrBlockState{
+					State: awssdk.String(ec2sdk.SubnetCidrBlockStateCodeAssociated),
+				},
+			},
+		}
+	}
+	return sdkSubnet
+}
+
+// sortSDKSubnets sorts subnets by availability zone, so that the rendered model is stable.
+func sortSDKSubnets(subnets []*ec2sdk.Subnet) {
+	sort.Slice(subnets, func(i, j int) bool {
+		return awssdk.StringValue(subnets[i].AvailabilityZone) < awssdk.StringValue(subnets[j].AvailabilityZone)
+	})
+}
+
+// NewStaticBackendSGProvider constructs new staticBackendSGProvider.
+func NewStaticBackendSGProvider(cfg Config) *staticBackendSGProvider {
+	return &staticBackendSGProvider{
+		backendSG: cfg.BackendSecurityGroup,
+	}
+}
+
+var _ networking.BackendSGProvider = &staticBackendSGProvider{}
+
+// staticBackendSGProvider provides the backend security group from Config.
+type staticBackendSGProvider struct {
+	backendSG string
+}
+
+func (p *staticBackendSGProvider) Get(_ context.Context) (string, error) {
+	return p.backendSG, nil
+}
+
+func (p *staticBackendSGProvider) Release(_ context.Context) error {
+	return nil
+}
+
+// NewStaticVPCInfoProvider constructs new staticVPCInfoProvider.
+func NewStaticVPCInfoProvider(cfg Config) *staticVPCInfoProvider {
+	return &staticVPCInfoProvider{
+		vpcID:        cfg.VpcID,
+		vpcCIDRs:     cfg.VpcCIDRs,
+		vpcIPv6CIDRs: cfg.VpcIPv6CIDRs,
+	}
+}
+
+var _ networking.VPCInfoProvider = &staticVPCInfoProvider{}
+
+// staticVPCInfoProvider provides the VPC info from Config.
+type staticVPCInfoProvider struct {
+	vpcID        string
+	vpcCIDRs     []string
+	vpcIPv6CIDRs []string
+}
+
+func (p *staticVPCInfoProvider) FetchVPCInfo(_ context.Context, vpcID string, _ ...networking.FetchVPCInfoOption) (networking.VPCInfo, error) {
+	if vpcID != p.vpcID {
+		return networking.VPCInfo{}, errors.Errorf("unknown vpc: %v", vpcID)
+	}
+	vpcInfo := networking.VPCInfo{
+		VpcId: awssdk.String(p.vpcID),
+	}
+	for _, cidr := range p.vpcCIDRs {
+		vpcInfo.CidrBlockAssociationSet = append(vpcInfo.CidrBlockAssociationSet, &ec2sdk.VpcCidrBlockAssociation{
+			CidrBlock:      awssdk.String(cidr),
+			CidrBlockState: &ec2sdk.VpcCidrBlockState{State: awssdk.String(ec2sdk.VpcCidrBlockStateCodeAssociated)},
+		})
+	}
+	for _, cidr := range p.vpcIPv6CIDRs {
+		vpcInfo.Ipv6CidrBlockAssociationSet = append(vpcInfo.Ipv6CidrBlockAssociationSet, &ec2sdk.VpcIpv6CidrBlockAssociation{
+			Ipv6CidrBlock:      awssdk.String(cidr),
+			Ipv6CidrBlockState: &ec2sdk.VpcCidrBlockState{State: awssdk.String(ec2sdk.VpcCidrBlockStateCodeAssociated)},
+		})
+	}
+	return vpcInfo, nil
+}
+
+// NewEmptyTaggingManager constructs new emptyTaggingManager.
+func NewEmptyTaggingManager() *emptyTaggingManager {
+	return &emptyTaggingManager{}
+}
+
+var _ elbv2deploy.TaggingManager = &emptyTaggingManager{}
+
+// emptyTaggingManager is a TaggingManager for an AWS account without any ELBV2 resources.
+type emptyTaggingManager struct{}
+
+func (m *emptyTaggingManager) ReconcileTags(_ context.Context, arn string, _ map[string]string, _ ...elbv2deploy.ReconcileTagsOption) error {
+	return errors.Errorf("tags cannot be reconciled when rendering: %v", arn)
+}
+
+func (m *emptyTaggingManager) ListLoadBalancers(_ context.Context, _ ...tracking.TagFilter) ([]elbv2deploy.LoadBalancerWithTags, error) {
+	return nil, nil
+}
+
+func (m *emptyTaggingManager) ListTargetGroups(_ context.Context, _ ...tracking.TagFilter) ([]elbv2deploy.TargetGroupWithTags, error) {
+	return nil, nil
+}
+
+func (m *emptyTaggingManager) ListListeners(_ context.Context, _ string) ([]elbv2deploy.ListenerWithTags, error) {
+	return nil, nil
+}
+
+func (m *emptyTaggingManager) ListListenerRules(_ context.Context, _ string) ([]elbv2deploy.ListenerRuleWithTags, error) {
+	return nil, nil
+}
+
+func (m *emptyTaggingManager) ListTrustStores(_ context.Context, _ ...tracking.TagFilter) ([]elbv2deploy.TrustStoreWithTags, error) {
+	return nil, nil
+}
+
+// NewStaticEC2 constructs new staticEC2.
+func NewStaticEC2(cfg Config) *staticEC2 {
+	return &staticEC2{
+		securityGroups: cfg.SecurityGroups,
+	}
+}
+
+var _ services.EC2 = &staticEC2{}
+
+// staticEC2 serves the security groups from Config.
+// only the APIs used by model builders are supported, other APIs will panic.
+type staticEC2 struct {
+	services.EC2
+
+	securityGroups []SecurityGroupConfig
+}
+
+func (c *staticEC2) DescribeSecurityGroupsAsList(_ context.Context, input *ec2sdk.DescribeSecurityGroupsInput) ([]*ec2sdk.SecurityGroup, error) {
+	groupIDs := sets.NewString(awssdk.StringValueSlice(input.GroupIds)...)
+	groupNames := sets.NewString()
+	for _, filter := range input.Filters {
+		if awssdk.StringValue(filter.Name) == "tag:Name" {
+			groupNames.Insert(awssdk.StringValueSlice(filter.Values)...)
+		}
+	}
+	var sdkSGs []*ec2sdk.SecurityGroup
+	for _, sg := range c.securityGroups {
+		if groupIDs.Has(sg.ID) || (sg.Name != "" && groupNames.Has(sg.Name)) {
+			sdkSGs = append(sdkSGs, &ec2sdk.SecurityGroup{
+				GroupId: awssdk.String(sg.ID),
+				Tags:    []*ec2sdk.Tag{{Key: awssdk.String("Name"), Value: awssdk.String(sg.Name)}},
+			})
+		}
+	}
+	return sdkSGs, nil
+}
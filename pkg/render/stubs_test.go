@@ -0,0 +1,110 @@
+package render
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/stretchr/testify/assert"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+func Test_staticSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
+	cfg := Config{
+		VpcID: "vpc-xxx",
+		Subnets: []SubnetConfig{
+			{ID: "subnet-b", AvailabilityZone: "us-west-2a", CIDR: "10.0.1.0/24", Role: SubnetRolePublic},
+			{ID: "subnet-a", AvailabilityZone: "us-west-2a", CIDR: "10.0.0.0/24", Role: SubnetRolePublic},
+			{ID: "subnet-c", AvailabilityZone: "us-west-2b", CIDR: "10.0.2.0/24", Role: SubnetRolePublic},
+			{ID: "subnet-d", AvailabilityZone: "us-west-2a", CIDR: "10.0.3.0/24", Role: SubnetRoleInternal},
+		},
+	}
+	tests := []struct {
+		name        string
+		opts        []networking.SubnetsResolveOption
+		wantSubnets []string
+		wantErr     string
+	}{
+		{
+			name:        "internet-facing ALB",
+			opts:        nil,
+			wantSubnets: []string{"subnet-a", "subnet-c"},
+		},
+		{
+			name: "internal NLB",
+			opts: []networking.SubnetsResolveOption{
+				networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
+				networking.WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternal),
+			},
+			wantSubnets: []string{"subnet-d"},
+		},
+		{
+			name: "internal ALB",
+			opts: []networking.SubnetsResolveOption{
+				networking.WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternal),
+			},
+			wantErr: "unable to resolve at least 2 subnet with role internal, resolved 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewStaticSubnetsResolver(cfg)
+			got, err := r.ResolveViaDiscovery(context.Background(), tt.opts...)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSubnets, sdkSubnetIDs(got))
+		})
+	}
+}
+
+func Test_staticSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
+	cfg := Config{
+		VpcID: "vpc-xxx",
+		Subnets: []SubnetConfig{
+			{ID: "subnet-a", Name: "public-a", AvailabilityZone: "us-west-2a", Role: SubnetRolePublic},
+			{ID: "subnet-b", Name: "public-b", AvailabilityZone: "us-west-2b", Role: SubnetRolePublic},
+		},
+	}
+	tests := []struct {
+		name            string
+		subnetNameOrIDs []string
+		wantSubnets     []string
+		wantErr         string
+	}{
+		{
+			name:            "resolve by name and ID",
+			subnetNameOrIDs: []string{"public-b", "subnet-a"},
+			wantSubnets:     []string{"subnet-a", "subnet-b"},
+		},
+		{
+			name:            "unknown subnet",
+			subnetNameOrIDs: []string{"subnet-a", "subnet-z"},
+			wantErr:         "couldn't find all subnets, nameOrIDs: [subnet-a subnet-z]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewStaticSubnetsResolver(cfg)
+			got, err := r.ResolveViaNameOrIDSlice(context.Background(), tt.subnetNameOrIDs)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSubnets, sdkSubnetIDs(got))
+		})
+	}
+}
+
+func sdkSubnetIDs(subnets []*ec2sdk.Subnet) []string {
+	var subnetIDs []string
+	for _, subnet := range subnets {
+		subnetIDs = append(subnetIDs, awssdk.StringValue(subnet.SubnetId))
+	}
+	return subnetIDs
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	groupFinalizerPrefix = "group.service.k8s.aws/"
+	// groupRequestNamePrefix prefixes the name of reconcile requests for Service groups.
+	// Service names can't contain '/', so such requests never collide with the ones for Services.
+	groupRequestNamePrefix = "group/"
+	maxGroupNameLength     = 63
+)
+
+// groupName must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character.
+// groupName must be no more than 63 character.
+var groupNameRegex = regexp.MustCompile("^([a-z0-9][-a-z0-9.]*)?[a-z0-9]$")
+
+// GroupID is the unique identifier for a Service group within cluster.
+// Service groups are scoped by namespace, so that Services can't join the groups of other namespaces.
+type GroupID types.NamespacedName
+
+// String returns the string representation of a GroupID.
+func (groupID GroupID) String() string {
+	return fmt.Sprintf("%s/%s", groupID.Namespace, groupID.Name)
+}
+
+// stackName returns the name of the model stack for the group.
+// namespaces can't contain '.', so it's unique among groups, and never collides with the "namespace/name" stacks of Services.
+func (groupID GroupID) stackName() string {
+	return fmt.Sprintf("%s.%s", groupID.Namespace, groupID.Name)
+}
+
+// Group is a group of Services that should be hosted by a single NLB.
+// Services join a group via the `aws-load-balancer-group-name` annotation, the listeners of all member Services are merged into the NLB.
+type Group struct {
+	ID GroupID
+
+	// Members are Services that belong to this group, sorted by namespace and name.
+	Members []*corev1.Service
+
+	// InactiveMembers are Services that no longer belong to this group, but still hold the finalizer.
+	InactiveMembers []*corev1.Service
+}
+
+// GroupLoader loads Service groups.
+type GroupLoader interface {
+	// Load returns the Service group with groupID.
+	Load(ctx context.Context, groupID GroupID) (Group, error)
+
+	// LoadGroupIDIfAny loads the groupID for Service if Service belongs to any Service group.
+	// Services that is not supported by this controller or in deletion state won't have a groupID.
+	LoadGroupIDIfAny(svc *corev1.Service) (GroupID, bool, error)
+
+	// LoadGroupIDsPendingFinalization returns groupIDs that have associated finalizer on Service.
+	LoadGroupIDsPendingFinalization(svc *corev1.Service) []GroupID
+}
+
+// NewDefaultGroupLoader constructs new defaultGroupLoader.
+func NewDefaultGroupLoader(k8sClient client.Client, annotationParser annotations.Parser, serviceUtils ServiceUtils) *defaultGroupLoader {
+	return &defaultGroupLoader{
+		k8sClient:        k8sClient,
+		annotationParser: annotationParser,
+		serviceUtils:     serviceUtils,
+	}
+}
+
+var _ GroupLoader = (*defaultGroupLoader)(nil)
+
+// default implementation for GroupLoader
+type defaultGroupLoader struct {
+	k8sClient        client.Client
+	annotationParser annotations.Parser
+	serviceUtils     ServiceUtils
+}
+
+func (l *defaultGroupLoader) Load(ctx context.Context, groupID GroupID) (Group, error) {
+	svcList := &corev1.ServiceList{}
+	if err := l.k8sClient.List(ctx, svcList, client.InNamespace(groupID.Namespace)); err != nil {
+		return Group{}, err
+	}
+
+	var members []*corev1.Service
+	var inactiveMembers []*corev1.Service
+	finalizer := BuildGroupFinalizer(groupID.Name)
+	for index := range svcList.Items {
+		svc := &svcList.Items[index]
+		svcGroupID, inGroup, err := l.LoadGroupIDIfAny(svc)
+		if err == nil && inGroup && svcGroupID == groupID {
+			members = append(members, svc)
+		} else if k8s.HasFinalizer(svc, finalizer) {
+			inactiveMembers = append(inactiveMembers, svc)
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Name < members[j].Name
+	})
+
+	return Group{
+		ID:              groupID,
+		Members:         members,
+		InactiveMembers: inactiveMembers,
+	}, nil
+}
+
+func (l *defaultGroupLoader) LoadGroupIDIfAny(svc *corev1.Service) (GroupID, bool, error) {
+	// Service no longer belong to any group when it's been deleted or no longer supported.
+	if !l.serviceUtils.IsServiceSupported(svc) {
+		return GroupID{}, false, nil
+	}
+	groupName, exists, err := loadGroupNameIfAny(l.annotationParser, svc)
+	if err != nil || !exists {
+		return GroupID{}, false, err
+	}
+	return GroupID{Namespace: svc.Namespace, Name: groupName}, true, nil
+}
+
+func (l *defaultGroupLoader) LoadGroupIDsPendingFinalization(svc *corev1.Service) []GroupID {
+	var groupIDs []GroupID
+	for _, finalizer := range svc.GetFinalizers() {
+		if strings.HasPrefix(finalizer, groupFinalizerPrefix) {
+			groupIDs = append(groupIDs, GroupID{Namespace: svc.Namespace, Name: finalizer[len(groupFinalizerPrefix):]})
+		}
+	}
+	return groupIDs
+}
+
+// loadGroupNameIfAny loads the groupName annotated on Service, an error is returned if the groupName is invalid.
+func loadGroupNameIfAny(annotationParser annotations.Parser, svc *corev1.Service) (string, bool, error) {
+	groupName := ""
+	if exists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixGroupName, &groupName, svc.Annotations); !exists {
+		return "", false, nil
+	}
+	if err := validateGroupName(groupName); err != nil {
+		return "", false, err
+	}
+	return groupName, true, nil
+}
+
+// BuildGroupFinalizer returns the finalizer for Service group with groupName.
+// the format is "group.service.k8s.aws/awesome-group", the namespace of group is the one of Service.
+func BuildGroupFinalizer(groupName string) string {
+	return fmt.Sprintf("%s%s", groupFinalizerPrefix, groupName)
+}
+
+// EncodeGroupIDToReconcileRequest encodes a GroupID into a controller-runtime reconcile request.
+// the name of request is prefixed to distinguish requests for Service groups from the ones for Services in the same namespace.
+func EncodeGroupIDToReconcileRequest(groupID GroupID) ctrl.Request {
+	return ctrl.Request{NamespacedName: types.NamespacedName{Namespace: groupID.Namespace, Name: groupRequestNamePrefix + groupID.Name}}
+}
+
+// DecodeGroupIDFromReconcileRequest decodes a GroupID from a controller-runtime reconcile request,
+// returns false if the request is not for a Service group.
+func DecodeGroupIDFromReconcileRequest(request ctrl.Request) (GroupID, bool) {
+	if !strings.HasPrefix(request.Name, groupRequestNamePrefix) {
+		return GroupID{}, false
+	}
+	return GroupID{Namespace: request.Namespace, Name: request.Name[len(groupRequestNamePrefix):]}, true
+}
+
+func validateGroupName(groupName string) error {
+	if !groupNameRegex.MatchString(groupName) {
+		return errors.New("groupName must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character")
+	}
+	if len(groupName) > maxGroupNameLength {
+		return errors.Errorf("groupName must be no more than %v characters", maxGroupNameLength)
+	}
+	return nil
+}
@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
+	ctrl "sigs.k8s.io/controller-runtime"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultGroupLoader_Load(t *testing.T) {
+	svcAWithGroup := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-a",
+			Name:      "svc-a",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type":       "nlb-ip",
+				"service.beta.kubernetes.io/aws-load-balancer-group-name": "awesome-group",
+			},
+		},
+	}
+	svcBWithGroup := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-a",
+			Name:      "svc-b",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type":       "nlb-ip",
+				"service.beta.kubernetes.io/aws-load-balancer-group-name": "awesome-group",
+			},
+			Finalizers: []string{"group.service.k8s.aws/awesome-group"},
+		},
+	}
+	svcCWithOtherGroup := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-a",
+			Name:      "svc-c",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type":       "nlb-ip",
+				"service.beta.kubernetes.io/aws-load-balancer-group-name": "other-group",
+			},
+		},
+	}
+	svcDLeftGroup := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-a",
+			Name:      "svc-d",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type": "nlb-ip",
+			},
+			Finalizers: []string{"group.service.k8s.aws/awesome-group"},
+		},
+	}
+	svcEUnsupported := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-a",
+			Name:      "svc-e",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-group-name": "awesome-group",
+			},
+		},
+	}
+	svcFInvalidGroup := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-a",
+			Name:      "svc-f",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type":       "nlb-ip",
+				"service.beta.kubernetes.io/aws-load-balancer-group-name": "Awesome-Group",
+			},
+		},
+	}
+	svcGWithGroupInOtherNamespace := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-b",
+			Name:      "svc-g",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type":       "nlb-ip",
+				"service.beta.kubernetes.io/aws-load-balancer-group-name": "awesome-group",
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		svcList []*corev1.Service
+		groupID GroupID
+		want    Group
+	}{
+		{
+			name:    "group with members and inactive members",
+			svcList: []*corev1.Service{svcBWithGroup, svcAWithGroup, svcCWithOtherGroup, svcDLeftGroup, svcEUnsupported, svcFInvalidGroup, svcGWithGroupInOtherNamespace},
+			groupID: GroupID{Namespace: "ns-a", Name: "awesome-group"},
+			want: Group{
+				ID:              GroupID{Namespace: "ns-a", Name: "awesome-group"},
+				Members:         []*corev1.Service{svcAWithGroup, svcBWithGroup},
+				InactiveMembers: []*corev1.Service{svcDLeftGroup},
+			},
+		},
+		{
+			name:    "group with same name in other namespace",
+			svcList: []*corev1.Service{svcAWithGroup, svcGWithGroupInOtherNamespace},
+			groupID: GroupID{Namespace: "ns-b", Name: "awesome-group"},
+			want: Group{
+				ID:      GroupID{Namespace: "ns-b", Name: "awesome-group"},
+				Members: []*corev1.Service{svcGWithGroupInOtherNamespace},
+			},
+		},
+		{
+			name:    "group without members",
+			svcList: []*corev1.Service{svcCWithOtherGroup},
+			groupID: GroupID{Namespace: "ns-a", Name: "awesome-group"},
+			want: Group{
+				ID: GroupID{Namespace: "ns-a", Name: "awesome-group"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			for _, svc := range tt.svcList {
+				assert.NoError(t, k8sClient.Create(context.Background(), svc.DeepCopy()))
+			}
+
+			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+			serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb", config.NewFeatureGates())
+			l := NewDefaultGroupLoader(k8sClient, annotationParser, serviceUtils)
+			got, err := l.Load(context.Background(), tt.groupID)
+			assert.NoError(t, err)
+			opt := cmp.Options{
+				equality.IgnoreFakeClientPopulatedFields(),
+			}
+			assert.True(t, cmp.Equal(tt.want, got, opt),
+				"diff: %v", cmp.Diff(tt.want, got, opt))
+		})
+	}
+}
+
+func Test_defaultGroupLoader_LoadGroupIDIfAny(t *testing.T) {
+	deletionTimestamp := metav1.Now()
+	tests := []struct {
+		name        string
+		svc         *corev1.Service
+		wantGroupID GroupID
+		wantInGroup bool
+		wantErr     error
+	}{
+		{
+			name: "service with group",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":       "nlb-ip",
+						"service.beta.kubernetes.io/aws-load-balancer-group-name": "awesome-group",
+					},
+				},
+			},
+			wantGroupID: GroupID{Namespace: "awesome-ns", Name: "awesome-group"},
+			wantInGroup: true,
+		},
+		{
+			name: "service without group",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type": "nlb-ip",
+					},
+				},
+			},
+			wantInGroup: false,
+		},
+		{
+			name: "deleted service with group",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":       "nlb-ip",
+						"service.beta.kubernetes.io/aws-load-balancer-group-name": "awesome-group",
+					},
+					DeletionTimestamp: &deletionTimestamp,
+				},
+			},
+			wantInGroup: false,
+		},
+		{
+			name: "service with invalid group",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-type":       "nlb-ip",
+						"service.beta.kubernetes.io/aws-load-balancer-group-name": "awesome_group",
+					},
+				},
+			},
+			wantErr: errors.New("groupName must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+			serviceUtils := NewServiceUtils(annotationParser, "service.k8s.aws/resources", "service.k8s.aws/nlb", config.NewFeatureGates())
+			l := NewDefaultGroupLoader(nil, annotationParser, serviceUtils)
+			groupID, inGroup, err := l.LoadGroupIDIfAny(tt.svc)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantGroupID, groupID)
+				assert.Equal(t, tt.wantInGroup, inGroup)
+			}
+		})
+	}
+}
+
+func Test_defaultGroupLoader_LoadGroupIDsPendingFinalization(t *testing.T) {
+	tests := []struct {
+		name string
+		svc  *corev1.Service
+		want []GroupID
+	}{
+		{
+			name: "service with group finalizers",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "awesome-ns",
+					Finalizers: []string{"service.k8s.aws/resources", "group.service.k8s.aws/group-a", "group.service.k8s.aws/group-b"},
+				},
+			},
+			want: []GroupID{
+				{Namespace: "awesome-ns", Name: "group-a"},
+				{Namespace: "awesome-ns", Name: "group-b"},
+			},
+		},
+		{
+			name: "service without group finalizers",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Finalizers: []string{"service.k8s.aws/resources"},
+				},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := &defaultGroupLoader{}
+			got := l.LoadGroupIDsPendingFinalization(tt.svc)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_DecodeGroupIDFromReconcileRequest(t *testing.T) {
+	groupID := GroupID{Namespace: "awesome-ns", Name: "awesome-group"}
+	gotGroupID, isGroup := DecodeGroupIDFromReconcileRequest(EncodeGroupIDToReconcileRequest(groupID))
+	assert.True(t, isGroup)
+	assert.Equal(t, groupID, gotGroupID)
+
+	svcRequest := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "awesome-ns", Name: "awesome-group"}}
+	_, isGroup = DecodeGroupIDFromReconcileRequest(svcRequest)
+	assert.False(t, isGroup)
+}
+
+func TestGroupID_stackName(t *testing.T) {
+	groupID := GroupID{Namespace: "awesome-ns", Name: "awesome-group"}
+	assert.Equal(t, "awesome-ns/awesome-group", groupID.String())
+	assert.Equal(t, "awesome-ns.awesome-group", groupID.stackName())
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// groupLoadBalancerAnnotationSuffixes are the annotations that configure the load balancer shared by a Service group.
+// they are merged across group members, and must not conflict with each other.
+var groupLoadBalancerAnnotationSuffixes = sets.NewString(
+	annotations.SvcLBSuffixLoadBalancerName,
+	annotations.SvcLBSuffixScheme,
+	annotations.SvcLBSuffixInternal,
+	annotations.SvcLBSuffixIPAddressType,
+	annotations.SvcLBSuffixSubnets,
+	annotations.SvcLBSuffixEIPAllocations,
+	annotations.SvcLBSuffixPrivateIpv4Addresses,
+	annotations.SvcLBSuffixLoadBalancerAttributes,
+	annotations.SvcLBSuffixAccessLogEnabled,
+	annotations.SvcLBSuffixAccessLogS3BucketName,
+	annotations.SvcLBSuffixAccessLogS3BucketPrefix,
+	annotations.SvcLBSuffixCrossZoneLoadBalancingEnabled,
+	annotations.SvcLBSuffixAdditionalTags,
+	annotations.SvcLBSuffixALPNPolicy,
+	annotations.SvcLBSuffixSecurityGroups,
+	annotations.SvcLBSuffixSourceRanges,
+)
+
+// GroupModelBuilder builds the model stack for Service groups.
+type GroupModelBuilder interface {
+	// BuildGroup builds the model stack for a Service group, the load balancer is nil if the group has no members.
+	// members that can't join the load balancer, e.g. due to conflicts with other members, are excluded from it and returned with their errors.
+	BuildGroup(ctx context.Context, group Group) (core.Stack, *elbv2model.LoadBalancer, []GroupMemberError, error)
+}
+
+// GroupMemberError is the error of a member Service that is excluded from the load balancer of its Service group.
+type GroupMemberError struct {
+	Member *corev1.Service
+	Err    error
+}
+
+var _ GroupModelBuilder = &defaultModelBuilder{}
+
+func (b *defaultModelBuilder) BuildGroup(ctx context.Context, group Group) (core.Stack, *elbv2model.LoadBalancer, []GroupMemberError, error) {
+	stack := core.NewDefaultStack(core.StackID{Namespace: "", Name: group.ID.stackName()})
+	if len(group.Members) == 0 {
+		return stack, nil, nil, nil
+	}
+	owner := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "",
+			Name:        group.ID.stackName(),
+			Annotations: make(map[string]string),
+		},
+	}
+	merger := newGroupMemberMerger(owner)
+	var memberErrs []GroupMemberError
+	for _, member := range group.Members {
+		memberListeners, err := b.buildGroupMemberListeners(ctx, stack, member)
+		if err == nil {
+			err = merger.merge(member, memberListeners)
+		}
+		if err != nil {
+			memberErrs = append(memberErrs, GroupMemberError{Member: member, Err: err})
+		}
+	}
+	// the load balancer is kept as is rather than deleted when none of members can join it.
+	if len(memberErrs) == len(group.Members) {
+		return nil, nil, nil, errors.Wrapf(memberErrs[0].Err, "service: %v", k8s.NamespacedName(memberErrs[0].Member))
+	}
+	lb, err := b.buildSharedLoadBalancer(ctx, stack, owner, group.ID.stackName(), merger.listeners)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return stack, lb, memberErrs, nil
+}
+
+// buildGroupMemberListeners builds the listeners for ports of a group member.
+func (b *defaultModelBuilder) buildGroupMemberListeners(ctx context.Context, stack core.Stack, member *corev1.Service) ([]SharedListener, error) {
+	memberTask := b.newModelBuildTask(member, stack)
+	cfg, err := memberTask.buildListenerConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var listeners []SharedListener
+	for _, port := range mergeTCPUDPServicePorts(member.Spec.Ports) {
+		listenerProtocol, _ := memberTask.buildListenerProtocols(port, cfg)
+		listener := SharedListener{
+			Port:        int64(port.Port),
+			Protocol:    listenerProtocol,
+			Service:     member,
+			ServicePort: port,
+		}
+		if listenerProtocol == elbv2model.ProtocolTLS {
+			listener.CertificateARNs = cfg.certificateARNs
+			listener.SSLPolicy = cfg.sslPolicy
+		}
+		listeners = append(listeners, listener)
+	}
+	return listeners, nil
+}
+
+// groupMemberMerger merges the load balancer annotations, source ranges and listeners of group members into the owner of the group's load balancer.
+// each of them is owned by the first member that configures it, a later member that conflicts with them isn't merged at all.
+type groupMemberMerger struct {
+	owner     *corev1.Service
+	listeners []SharedListener
+
+	lbAnnotationOwners map[string]*corev1.Service
+	sourceRangesOwner  *corev1.Service
+	listenerPortOwners map[int64]*corev1.Service
+}
+
+func newGroupMemberMerger(owner *corev1.Service) *groupMemberMerger {
+	return &groupMemberMerger{
+		owner:              owner,
+		lbAnnotationOwners: make(map[string]*corev1.Service),
+		listenerPortOwners: make(map[int64]*corev1.Service),
+	}
+}
+
+// merge merges member with its listeners into the owner, or returns an error without merging anything if member conflicts with merged members.
+func (m *groupMemberMerger) merge(member *corev1.Service, memberListeners []SharedListener) error {
+	lbAnnotations := make(map[string]string)
+	for key, value := range member.Annotations {
+		suffix := key[strings.LastIndex(key, "/")+1:]
+		if !groupLoadBalancerAnnotationSuffixes.Has(suffix) {
+			continue
+		}
+		if existingValue, exists := m.owner.Annotations[key]; exists && existingValue != value {
+			return errors.Errorf("conflicting annotation %v with service %v",
+				key, k8s.NamespacedName(m.lbAnnotationOwners[key]))
+		}
+		lbAnnotations[key] = value
+	}
+	hasSourceRanges := len(member.Spec.LoadBalancerSourceRanges) != 0
+	if hasSourceRanges && m.sourceRangesOwner != nil && !equality.Semantic.DeepEqual(m.owner.Spec.LoadBalancerSourceRanges, member.Spec.LoadBalancerSourceRanges) {
+		return errors.Errorf("conflicting loadBalancerSourceRanges with service %v",
+			k8s.NamespacedName(m.sourceRangesOwner))
+	}
+	for _, listener := range memberListeners {
+		if existingMember, exists := m.listenerPortOwners[listener.Port]; exists {
+			return errors.Errorf("conflicting listener port %v with service %v",
+				listener.Port, k8s.NamespacedName(existingMember))
+		}
+	}
+
+	for key, value := range lbAnnotations {
+		if _, exists := m.lbAnnotationOwners[key]; !exists {
+			m.lbAnnotationOwners[key] = member
+		}
+		m.owner.Annotations[key] = value
+	}
+	if hasSourceRanges && m.sourceRangesOwner == nil {
+		m.owner.Spec.LoadBalancerSourceRanges = member.Spec.LoadBalancerSourceRanges
+		m.sourceRangesOwner = member
+	}
+	for _, listener := range memberListeners {
+		m.listenerPortOwners[listener.Port] = member
+	}
+	m.listeners = append(m.listeners, memberListeners...)
+	return nil
+}
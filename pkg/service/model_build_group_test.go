@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_groupMemberMerger_merge(t *testing.T) {
+	svcA := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "svc-a",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":           "internet-facing",
+				"service.beta.kubernetes.io/aws-load-balancer-healthcheck-path": "/a",
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			LoadBalancerSourceRanges: []string{"10.0.0.0/16"},
+		},
+	}
+	svcB := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "svc-b",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":           "internet-facing",
+				"service.beta.kubernetes.io/aws-load-balancer-ip-address-type":  "dualstack",
+				"service.beta.kubernetes.io/aws-load-balancer-healthcheck-path": "/b",
+			},
+		},
+	}
+	svcCWithConflictingAnnotation := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "svc-c",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":          "internal",
+				"service.beta.kubernetes.io/aws-load-balancer-ip-address-type": "dualstack",
+			},
+		},
+	}
+	svcDWithConflictingSourceRanges := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "svc-d",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-subnets": "subnet-1",
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			LoadBalancerSourceRanges: []string{"10.1.0.0/16"},
+		},
+	}
+	svcEWithConflictingPort := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "svc-e",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-subnets": "subnet-1",
+			},
+		},
+	}
+	listenerA := SharedListener{Port: 80, Protocol: elbv2model.ProtocolTCP, Service: svcA}
+	listenerB := SharedListener{Port: 53, Protocol: elbv2model.ProtocolUDP, Service: svcB}
+	listenerE := SharedListener{Port: 80, Protocol: elbv2model.ProtocolTCP, Service: svcEWithConflictingPort}
+	type memberWithListeners struct {
+		member    *corev1.Service
+		listeners []SharedListener
+	}
+	tests := []struct {
+		name          string
+		members       []memberWithListeners
+		wantOwner     *corev1.Service
+		wantListeners []SharedListener
+		wantErrs      []error
+	}{
+		{
+			name: "load balancer annotations, source ranges and listeners are merged across members",
+			members: []memberWithListeners{
+				{member: svcA, listeners: []SharedListener{listenerA}},
+				{member: svcB, listeners: []SharedListener{listenerB}},
+			},
+			wantOwner: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "awesome-ns.awesome-group",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-scheme":          "internet-facing",
+						"service.beta.kubernetes.io/aws-load-balancer-ip-address-type": "dualstack",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					LoadBalancerSourceRanges: []string{"10.0.0.0/16"},
+				},
+			},
+			wantListeners: []SharedListener{listenerA, listenerB},
+			wantErrs:      []error{nil, nil},
+		},
+		{
+			name: "members conflicting with merged members are not merged",
+			members: []memberWithListeners{
+				{member: svcA, listeners: []SharedListener{listenerA}},
+				{member: svcCWithConflictingAnnotation},
+				{member: svcDWithConflictingSourceRanges},
+				{member: svcEWithConflictingPort, listeners: []SharedListener{listenerE}},
+			},
+			wantOwner: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "awesome-ns.awesome-group",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-scheme": "internet-facing",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					LoadBalancerSourceRanges: []string{"10.0.0.0/16"},
+				},
+			},
+			wantListeners: []SharedListener{listenerA},
+			wantErrs: []error{
+				nil,
+				errors.New("conflicting annotation service.beta.kubernetes.io/aws-load-balancer-scheme with service awesome-ns/svc-a"),
+				errors.New("conflicting loadBalancerSourceRanges with service awesome-ns/svc-a"),
+				errors.New("conflicting listener port 80 with service awesome-ns/svc-a"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "awesome-ns.awesome-group",
+					Annotations: make(map[string]string),
+				},
+			}
+			m := newGroupMemberMerger(owner)
+			for i, member := range tt.members {
+				err := m.merge(member.member, member.listeners)
+				if tt.wantErrs[i] != nil {
+					assert.EqualError(t, err, tt.wantErrs[i].Error())
+				} else {
+					assert.NoError(t, err)
+				}
+			}
+			assert.Equal(t, tt.wantOwner, owner)
+			assert.Equal(t, tt.wantListeners, m.listeners)
+		})
+	}
+}
+
+func Test_defaultModelBuilder_buildGroupMemberListeners(t *testing.T) {
+	svcA := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "svc-a",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-cert":  "cert-arn",
+				"service.beta.kubernetes.io/aws-load-balancer-ssl-ports": "443",
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:     "http",
+					Port:     80,
+					Protocol: corev1.ProtocolTCP,
+				},
+				{
+					Name:     "https",
+					Port:     443,
+					Protocol: corev1.ProtocolTCP,
+				},
+			},
+		},
+	}
+	svcB := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "svc-b",
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:     "dns",
+					Port:     53,
+					Protocol: corev1.ProtocolUDP,
+				},
+				{
+					Name:     "dns-tcp",
+					Port:     53,
+					Protocol: corev1.ProtocolTCP,
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name   string
+		member *corev1.Service
+		want   []SharedListener
+	}{
+		{
+			name:   "listeners for tcp and tls ports",
+			member: svcA,
+			want: []SharedListener{
+				{
+					Port:        80,
+					Protocol:    elbv2model.ProtocolTCP,
+					Service:     svcA,
+					ServicePort: svcA.Spec.Ports[0],
+				},
+				{
+					Port:            443,
+					Protocol:        elbv2model.ProtocolTLS,
+					CertificateARNs: []string{"cert-arn"},
+					SSLPolicy:       awssdk.String("ELBSecurityPolicy-2016-08"),
+					Service:         svcA,
+					ServicePort:     svcA.Spec.Ports[1],
+				},
+			},
+		},
+		{
+			name:   "listener for merged tcp_udp port",
+			member: svcB,
+			want: []SharedListener{
+				{
+					Port:     53,
+					Protocol: elbv2model.ProtocolTCP_UDP,
+					Service:  svcB,
+					ServicePort: corev1.ServicePort{
+						Name:     "dns-tcp",
+						Port:     53,
+						Protocol: corev1.Protocol(elbv2model.ProtocolTCP_UDP),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &defaultModelBuilder{
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				defaultSSLPolicy: "ELBSecurityPolicy-2016-08",
+			}
+			stack := core.NewDefaultStack(core.StackID{Name: "awesome-ns.awesome-group"})
+			got, err := b.buildGroupMemberListeners(context.Background(), stack, tt.member)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -5,17 +5,24 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certs"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
+// serviceProtocolTCPUDP is the protocol of Service ports merged from a TCP port and a UDP port, see mergeTCPUDPServicePorts.
+const serviceProtocolTCPUDP = corev1.Protocol(elbv2model.ProtocolTCP_UDP)
+
 func (t *defaultModelBuildTask) buildListeners(ctx context.Context, scheme elbv2model.LoadBalancerScheme) error {
-	cfg := t.buildListenerConfig(ctx)
-	for _, port := range t.service.Spec.Ports {
+	cfg, err := t.buildListenerConfig(ctx)
+	if err != nil {
+		return err
+	}
+	for _, port := range mergeTCPUDPServicePorts(t.service.Spec.Ports) {
 		_, err := t.buildListener(ctx, port, cfg, scheme)
 		if err != nil {
 			return err
@@ -37,16 +44,7 @@ func (t *defaultModelBuildTask) buildListener(ctx context.Context, port corev1.S
 
 func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, port corev1.ServicePort, cfg listenerConfig,
 	scheme elbv2model.LoadBalancerScheme) (elbv2model.ListenerSpec, error) {
-	tgProtocol := elbv2model.Protocol(port.Protocol)
-	listenerProtocol := elbv2model.Protocol(port.Protocol)
-	if tgProtocol != elbv2model.ProtocolUDP && len(cfg.certificates) != 0 && (cfg.tlsPortsSet.Len() == 0 ||
-		cfg.tlsPortsSet.Has(port.Name) || cfg.tlsPortsSet.Has(strconv.Itoa(int(port.Port)))) {
-		if cfg.backendProtocol == "ssl" {
-			tgProtocol = elbv2model.ProtocolTLS
-		}
-		listenerProtocol = elbv2model.ProtocolTLS
-	}
-
+	listenerProtocol, tgProtocol := t.buildListenerProtocols(port, cfg)
 	tags, err := t.buildListenerTags(ctx)
 	if err != nil {
 		return elbv2model.ListenerSpec{}, err
@@ -65,7 +63,9 @@ func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, port core
 	var certificates []elbv2model.Certificate
 	if listenerProtocol == elbv2model.ProtocolTLS {
 		sslPolicy = cfg.sslPolicy
-		certificates = cfg.certificates
+		for _, certARN := range cfg.certificateARNs {
+			certificates = append(certificates, elbv2model.Certificate{CertificateARN: core.LiteralStringToken(certARN)})
+		}
 	}
 
 	defaultActions := t.buildListenerDefaultActions(ctx, targetGroup)
@@ -81,6 +81,49 @@ func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, port core
 	}, nil
 }
 
+// buildListenerProtocols returns the listener protocol and target group protocol for Service port.
+func (t *defaultModelBuildTask) buildListenerProtocols(port corev1.ServicePort, cfg listenerConfig) (elbv2model.Protocol, elbv2model.Protocol) {
+	tgProtocol := elbv2model.Protocol(port.Protocol)
+	listenerProtocol := elbv2model.Protocol(port.Protocol)
+	if tgProtocol != elbv2model.ProtocolUDP && tgProtocol != elbv2model.ProtocolTCP_UDP && len(cfg.certificateARNs) != 0 && (cfg.tlsPortsSet.Len() == 0 ||
+		cfg.tlsPortsSet.Has(port.Name) || cfg.tlsPortsSet.Has(strconv.Itoa(int(port.Port)))) {
+		if cfg.backendProtocol == "ssl" {
+			tgProtocol = elbv2model.ProtocolTLS
+		}
+		listenerProtocol = elbv2model.ProtocolTLS
+	}
+	return listenerProtocol, tgProtocol
+}
+
+// mergeTCPUDPServicePorts merges each pair of TCP and UDP Service ports with the same port, targetPort and nodePort into a single port
+// with TCP_UDP protocol, since NLB doesn't allow separate TCP and UDP listeners on the same port.
+func mergeTCPUDPServicePorts(ports []corev1.ServicePort) []corev1.ServicePort {
+	var mergedPorts []corev1.ServicePort
+	mergedPortIndexes := sets.NewInt()
+	for i, port := range ports {
+		if mergedPortIndexes.Has(i) {
+			continue
+		}
+		for j := i + 1; j < len(ports); j++ {
+			otherPort := ports[j]
+			if mergedPortIndexes.Has(j) || port.Port != otherPort.Port || port.TargetPort != otherPort.TargetPort || port.NodePort != otherPort.NodePort {
+				continue
+			}
+			if (port.Protocol == corev1.ProtocolTCP && otherPort.Protocol == corev1.ProtocolUDP) ||
+				(port.Protocol == corev1.ProtocolUDP && otherPort.Protocol == corev1.ProtocolTCP) {
+				if port.Protocol == corev1.ProtocolUDP {
+					port = otherPort
+				}
+				port.Protocol = serviceProtocolTCPUDP
+				mergedPortIndexes.Insert(j)
+				break
+			}
+		}
+		mergedPorts = append(mergedPorts, port)
+	}
+	return mergedPorts
+}
+
 func (t *defaultModelBuildTask) buildListenerDefaultActions(_ context.Context, targetGroup *elbv2model.TargetGroup) []elbv2model.Action {
 	return []elbv2model.Action{
 		{
@@ -104,15 +147,45 @@ func (t *defaultModelBuildTask) buildSSLNegotiationPolicy(_ context.Context) *st
 	return &t.defaultSSLPolicy
 }
 
-func (t *defaultModelBuildTask) buildListenerCertificates(_ context.Context) []elbv2model.Certificate {
+// buildListenerCertificateARNs builds the certificateARNs for TLS listeners, the first one is the default certificate.
+// certificates are auto-discovered from ACM for the TLS hostnames when they are not specified explicitly.
+func (t *defaultModelBuildTask) buildListenerCertificateARNs(ctx context.Context) ([]string, error) {
 	var rawCertificateARNs []string
-	_ = t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSSLCertificate, &rawCertificateARNs, t.service.Annotations)
+	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSSLCertificate, &rawCertificateARNs, t.service.Annotations); exists {
+		return rawCertificateARNs, nil
+	}
+	tlsHosts, err := t.buildTLSHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(tlsHosts) == 0 {
+		return nil, nil
+	}
+	selections, err := t.certDiscovery.Discover(ctx, tlsHosts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to discover certificates for hosts: %v", tlsHosts)
+	}
+	return certs.CertSelectionARNs(selections), nil
+}
 
-	var certificates []elbv2model.Certificate
-	for _, cert := range rawCertificateARNs {
-		certificates = append(certificates, elbv2model.Certificate{CertificateARN: aws.String(cert)})
+// buildTLSHosts builds the TLS hostnames to discover certificates for, which are specified by the ssl-hostnames annotation,
+// or by the external-dns hostname annotation if ssl-hostnames-from-external-dns is enabled.
+func (t *defaultModelBuildTask) buildTLSHosts(_ context.Context) ([]string, error) {
+	var rawTLSHosts []string
+	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSSLHostnames, &rawTLSHosts, t.service.Annotations); exists {
+		return sets.NewString(rawTLSHosts...).List(), nil
+	}
+	fromExternalDNS := false
+	if _, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixSSLHostnamesFromExternalDNS, &fromExternalDNS, t.service.Annotations); err != nil {
+		return nil, err
+	}
+	if !fromExternalDNS {
+		return nil, nil
 	}
-	return certificates
+	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.ExternalDNSHostname, &rawTLSHosts, t.service.Annotations, annotations.WithExact()); !exists {
+		return nil, errors.Errorf("missing annotation %v for TLS hostnames", annotations.ExternalDNSHostname)
+	}
+	return sets.NewString(rawTLSHosts...).List(), nil
 }
 
 func (t *defaultModelBuildTask) buildTLSPortsSet(_ context.Context) sets.String {
@@ -148,24 +221,27 @@ func (t *defaultModelBuildTask) buildListenerALPNPolicy(ctx context.Context, lis
 }
 
 type listenerConfig struct {
-	certificates    []elbv2model.Certificate
+	certificateARNs []string
 	tlsPortsSet     sets.String
 	sslPolicy       *string
 	backendProtocol string
 }
 
-func (t *defaultModelBuildTask) buildListenerConfig(ctx context.Context) listenerConfig {
-	certificates := t.buildListenerCertificates(ctx)
+func (t *defaultModelBuildTask) buildListenerConfig(ctx context.Context) (listenerConfig, error) {
+	certificateARNs, err := t.buildListenerCertificateARNs(ctx)
+	if err != nil {
+		return listenerConfig{}, err
+	}
 	tlsPortsSet := t.buildTLSPortsSet(ctx)
 	backendProtocol := t.buildBackendProtocol(ctx)
 	sslPolicy := t.buildSSLNegotiationPolicy(ctx)
 
 	return listenerConfig{
-		certificates:    certificates,
+		certificateARNs: certificateARNs,
 		tlsPortsSet:     tlsPortsSet,
 		sslPolicy:       sslPolicy,
 		backendProtocol: backendProtocol,
-	}
+	}, nil
 }
 
 func (t *defaultModelBuildTask) buildListenerTags(ctx context.Context) (map[string]string, error) {
@@ -8,7 +8,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certs"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
@@ -88,3 +90,202 @@ func Test_defaultModelBuilderTask_buildListenerALPNPolicy(t *testing.T) {
 		})
 	}
 }
+
+func Test_mergeTCPUDPServicePorts(t *testing.T) {
+	tests := []struct {
+		name  string
+		ports []corev1.ServicePort
+		want  []corev1.ServicePort
+	}{
+		{
+			name: "tcp and udp on same port are merged",
+			ports: []corev1.ServicePort{
+				{
+					Name:       "dns-udp",
+					Port:       53,
+					TargetPort: intstr.FromInt(5353),
+					Protocol:   corev1.ProtocolUDP,
+				},
+				{
+					Name:       "http",
+					Port:       80,
+					TargetPort: intstr.FromInt(8080),
+					Protocol:   corev1.ProtocolTCP,
+				},
+				{
+					Name:       "dns-tcp",
+					Port:       53,
+					TargetPort: intstr.FromInt(5353),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+			want: []corev1.ServicePort{
+				{
+					Name:       "dns-tcp",
+					Port:       53,
+					TargetPort: intstr.FromInt(5353),
+					Protocol:   corev1.Protocol(elbv2model.ProtocolTCP_UDP),
+				},
+				{
+					Name:       "http",
+					Port:       80,
+					TargetPort: intstr.FromInt(8080),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+		},
+		{
+			name: "tcp and udp on same port with different targetPort are not merged",
+			ports: []corev1.ServicePort{
+				{
+					Port:       53,
+					TargetPort: intstr.FromInt(53),
+					Protocol:   corev1.ProtocolTCP,
+				},
+				{
+					Port:       53,
+					TargetPort: intstr.FromInt(5353),
+					Protocol:   corev1.ProtocolUDP,
+				},
+			},
+			want: []corev1.ServicePort{
+				{
+					Port:       53,
+					TargetPort: intstr.FromInt(53),
+					Protocol:   corev1.ProtocolTCP,
+				},
+				{
+					Port:       53,
+					TargetPort: intstr.FromInt(5353),
+					Protocol:   corev1.ProtocolUDP,
+				},
+			},
+		},
+		{
+			name: "ports with different port are not merged",
+			ports: []corev1.ServicePort{
+				{
+					Port:     80,
+					Protocol: corev1.ProtocolTCP,
+				},
+				{
+					Port:     53,
+					Protocol: corev1.ProtocolUDP,
+				},
+			},
+			want: []corev1.ServicePort{
+				{
+					Port:     80,
+					Protocol: corev1.ProtocolTCP,
+				},
+				{
+					Port:     53,
+					Protocol: corev1.ProtocolUDP,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeTCPUDPServicePorts(tt.ports)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildListenerCertificateARNs(t *testing.T) {
+	tests := []struct {
+		name    string
+		svc     *corev1.Service
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "certificates specified explicitly",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-ssl-cert":      "cert-arn-1, cert-arn-2",
+						"service.beta.kubernetes.io/aws-load-balancer-ssl-hostnames": "app.example.com",
+					},
+				},
+			},
+			want: []string{"cert-arn-1", "cert-arn-2"},
+		},
+		{
+			name: "certificates discovered for ssl-hostnames",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-ssl-hostnames": "app.example.com, api.example.org",
+					},
+				},
+			},
+			want: []string{"cert-arn-example-com", "cert-arn-example-org"},
+		},
+		{
+			name: "certificates discovered for external-dns hostnames",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-ssl-hostnames-from-external-dns": "true",
+						"external-dns.alpha.kubernetes.io/hostname":                                    "app.example.com,www.example.com",
+					},
+				},
+			},
+			want: []string{"cert-arn-example-com"},
+		},
+		{
+			name: "external-dns hostnames not enabled",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"external-dns.alpha.kubernetes.io/hostname": "app.example.com",
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "external-dns hostnames enabled without external-dns annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-ssl-hostnames-from-external-dns": "true",
+					},
+				},
+			},
+			wantErr: errors.New("missing annotation external-dns.alpha.kubernetes.io/hostname for TLS hostnames"),
+		},
+		{
+			name: "no certificate found for ssl-hostnames",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-ssl-hostnames": "app.example.net",
+					},
+				},
+			},
+			wantErr: errors.New("failed to discover certificates for hosts: [app.example.net]: no certificate found for host: app.example.net"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				certDiscovery: certs.NewStaticCertDiscovery(map[string][]string{
+					"cert-arn-example-com": {"*.example.com"},
+					"cert-arn-example-org": {"api.example.org"},
+				}),
+				service: tt.svc,
+			}
+			got, err := task.buildListenerCertificateARNs(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
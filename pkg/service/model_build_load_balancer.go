@@ -17,7 +17,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 )
@@ -61,18 +63,62 @@ func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, schem
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
+	securityGroups, err := t.buildLoadBalancerSecurityGroups(ctx, scheme, ipAddressType)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
 	spec := elbv2model.LoadBalancerSpec{
 		Name:                   name,
 		Type:                   elbv2model.LoadBalancerTypeNetwork,
 		Scheme:                 &scheme,
 		IPAddressType:          &ipAddressType,
 		SubnetMappings:         subnetMappings,
+		SecurityGroups:         securityGroups,
 		LoadBalancerAttributes: lbAttributes,
 		Tags:                   tags,
 	}
 	return spec, nil
 }
 
+// buildLoadBalancerSecurityGroups returns the SecurityGroups of NLB, either specified via annotation or managed by controller.
+// SecurityGroups can neither be added to an existing NLB created without SecurityGroups, nor be all removed from an NLB.
+func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Context, scheme elbv2model.LoadBalancerScheme,
+	ipAddressType elbv2model.IPAddressType) ([]core.StringToken, error) {
+	if !t.featureGates.Enabled(config.NLBSecurityGroup) {
+		return nil, nil
+	}
+	var sgNameOrIDsViaAnnotation []string
+	sgConfigured := t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSecurityGroups, &sgNameOrIDsViaAnnotation, t.service.Annotations)
+	existingLB, err := t.fetchExistingLoadBalancer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if existingLB != nil && len(existingLB.LoadBalancer.SecurityGroups) == 0 {
+		if sgConfigured {
+			return nil, errors.New("securityGroups cannot be added to existing load balancer without securityGroups")
+		}
+		return nil, nil
+	}
+
+	var lbSGTokens []core.StringToken
+	if len(sgNameOrIDsViaAnnotation) == 0 {
+		managedSG, err := t.buildManagedSecurityGroup(ctx, scheme, ipAddressType)
+		if err != nil {
+			return nil, err
+		}
+		lbSGTokens = append(lbSGTokens, managedSG.GroupID())
+	} else {
+		frontendSGIDs, err := t.sgResolver.ResolveViaNameOrID(ctx, sgNameOrIDsViaAnnotation)
+		if err != nil {
+			return nil, err
+		}
+		for _, sgID := range frontendSGIDs {
+			lbSGTokens = append(lbSGTokens, core.LiteralStringToken(sgID))
+		}
+	}
+	return lbSGTokens, nil
+}
+
 func (t *defaultModelBuildTask) buildLoadBalancerIPAddressType(_ context.Context) (elbv2model.IPAddressType, error) {
 	rawIPAddressType := ""
 	if exists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixIPAddressType, &rawIPAddressType, t.service.Annotations); !exists {
@@ -369,9 +415,16 @@ func (t *defaultModelBuildTask) buildLoadBalancerName(_ context.Context, scheme
 	uuidHash := sha256.New()
 	_, _ = uuidHash.Write([]byte(t.clusterName))
 	_, _ = uuidHash.Write([]byte(t.service.UID))
+	if t.isServiceGroup() {
+		_, _ = uuidHash.Write([]byte(t.service.Name))
+	}
 	_, _ = uuidHash.Write([]byte(scheme))
 	uuid := hex.EncodeToString(uuidHash.Sum(nil))
 
+	if t.isServiceGroup() {
+		payload := invalidLoadBalancerNamePattern.ReplaceAllString(t.service.Name, "")
+		return fmt.Sprintf("k8s-%.17s-%.10s", payload, uuid), nil
+	}
 	sanitizedNamespace := invalidLoadBalancerNamePattern.ReplaceAllString(t.service.Namespace, "")
 	sanitizedName := invalidLoadBalancerNamePattern.ReplaceAllString(t.service.Name, "")
 	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid), nil
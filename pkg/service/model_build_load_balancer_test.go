@@ -17,8 +17,10 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
@@ -1005,6 +1007,18 @@ func Test_defaultModelBuildTask_buildLoadBalancerName(t *testing.T) {
 			scheme: elbv2.LoadBalancerSchemeInternetFacing,
 			want:   "k8s-foo-bar-e053368fb2",
 		},
+		{
+			name: "no name annotation, service group",
+			service: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "",
+					Name:        "awesome-group",
+					Annotations: map[string]string{},
+				},
+			},
+			scheme: elbv2.LoadBalancerSchemeInternetFacing,
+			want:   "k8s-awesomegroup-b8ca6055a3",
+		},
 		{
 			name: "non-empty name annotation",
 			service: &corev1.Service{
@@ -1048,3 +1062,158 @@ func Test_defaultModelBuildTask_buildLoadBalancerName(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildLoadBalancerSecurityGroups(t *testing.T) {
+	type listLoadBalancerCall struct {
+		sdkLBs []elbv2deploy.LoadBalancerWithTags
+		err    error
+	}
+	type resolveViaNameOrIDCall struct {
+		sgNameOrIDs []string
+		sgIDs       []string
+		err         error
+	}
+	listLoadBalancerCallForEmptyLB := listLoadBalancerCall{
+		sdkLBs: []elbv2deploy.LoadBalancerWithTags{},
+	}
+	listLoadBalancerCallForLBWithoutSG := listLoadBalancerCall{
+		sdkLBs: []elbv2deploy.LoadBalancerWithTags{
+			{
+				LoadBalancer: &elbv2sdk.LoadBalancer{
+					LoadBalancerArn: aws.String("lb-arn"),
+				},
+			},
+		},
+	}
+	listLoadBalancerCallForLBWithSG := listLoadBalancerCall{
+		sdkLBs: []elbv2deploy.LoadBalancerWithTags{
+			{
+				LoadBalancer: &elbv2sdk.LoadBalancer{
+					LoadBalancerArn: aws.String("lb-arn"),
+					SecurityGroups:  aws.StringSlice([]string{"sg-managed"}),
+				},
+			},
+		},
+	}
+	svcWithSGAnnotation := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-svc",
+			Annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-security-groups": "sg-a, sg-name-b",
+			},
+		},
+	}
+	svcWithoutSGAnnotation := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-svc",
+		},
+	}
+	tests := []struct {
+		name                    string
+		svc                     *corev1.Service
+		enableNLBSecurityGroup  bool
+		listLoadBalancersCalls  []listLoadBalancerCall
+		resolveViaNameOrIDCalls []resolveViaNameOrIDCall
+		wantManagedSG           bool
+		want                    []core.StringToken
+		wantErr                 error
+	}{
+		{
+			name:                   "NLBSecurityGroup feature disabled",
+			svc:                    svcWithSGAnnotation,
+			enableNLBSecurityGroup: false,
+			want:                   nil,
+		},
+		{
+			name:                   "new load balancer with managed securityGroup",
+			svc:                    svcWithoutSGAnnotation,
+			enableNLBSecurityGroup: true,
+			listLoadBalancersCalls: []listLoadBalancerCall{listLoadBalancerCallForEmptyLB},
+			wantManagedSG:          true,
+		},
+		{
+			name:                   "new load balancer with securityGroups via annotation",
+			svc:                    svcWithSGAnnotation,
+			enableNLBSecurityGroup: true,
+			listLoadBalancersCalls: []listLoadBalancerCall{listLoadBalancerCallForEmptyLB},
+			resolveViaNameOrIDCalls: []resolveViaNameOrIDCall{
+				{
+					sgNameOrIDs: []string{"sg-a", "sg-name-b"},
+					sgIDs:       []string{"sg-a", "sg-b"},
+				},
+			},
+			want: []core.StringToken{core.LiteralStringToken("sg-a"), core.LiteralStringToken("sg-b")},
+		},
+		{
+			name:                   "existing load balancer with securityGroups",
+			svc:                    svcWithoutSGAnnotation,
+			enableNLBSecurityGroup: true,
+			listLoadBalancersCalls: []listLoadBalancerCall{listLoadBalancerCallForLBWithSG},
+			wantManagedSG:          true,
+		},
+		{
+			name:                   "existing load balancer without securityGroups",
+			svc:                    svcWithoutSGAnnotation,
+			enableNLBSecurityGroup: true,
+			listLoadBalancersCalls: []listLoadBalancerCall{listLoadBalancerCallForLBWithoutSG},
+			want:                   nil,
+		},
+		{
+			name:                   "existing load balancer without securityGroups, securityGroups via annotation",
+			svc:                    svcWithSGAnnotation,
+			enableNLBSecurityGroup: true,
+			listLoadBalancersCalls: []listLoadBalancerCall{listLoadBalancerCallForLBWithoutSG},
+			wantErr:                errors.New("securityGroups cannot be added to existing load balancer without securityGroups"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2TaggingManager := elbv2deploy.NewMockTaggingManager(ctrl)
+			for _, call := range tt.listLoadBalancersCalls {
+				elbv2TaggingManager.EXPECT().ListLoadBalancers(gomock.Any(), gomock.Any()).Return(call.sdkLBs, call.err)
+			}
+			sgResolver := networking.NewMockSecurityGroupResolver(ctrl)
+			for _, call := range tt.resolveViaNameOrIDCalls {
+				sgResolver.EXPECT().ResolveViaNameOrID(gomock.Any(), call.sgNameOrIDs).Return(call.sgIDs, call.err)
+			}
+			featureGates := config.NewFeatureGates()
+			if tt.enableNLBSecurityGroup {
+				featureGates.Enable(config.NLBSecurityGroup)
+			}
+			stack := core.NewDefaultStack(core.StackID{Namespace: "awesome-ns", Name: "awesome-svc"})
+			task := &defaultModelBuildTask{
+				clusterName:             "cluster-name",
+				service:                 tt.svc,
+				stack:                   stack,
+				annotationParser:        annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				sgResolver:              sgResolver,
+				trackingProvider:        tracking.NewDefaultProvider("service.k8s.aws", "cluster-name"),
+				elbv2TaggingManager:     elbv2TaggingManager,
+				featureGates:            featureGates,
+				defaultIPv4SourceRanges: []string{"0.0.0.0/0"},
+				defaultIPv6SourceRanges: []string{"::/0"},
+			}
+			got, err := task.buildLoadBalancerSecurityGroups(context.Background(), elbv2.LoadBalancerSchemeInternetFacing, elbv2.IPAddressTypeIPV4)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			var managedSGs []*ec2model.SecurityGroup
+			assert.NoError(t, stack.ListResources(&managedSGs))
+			if tt.wantManagedSG {
+				assert.Len(t, managedSGs, 1)
+				assert.Len(t, got, 1)
+				assert.Equal(t, []core.Resource{managedSGs[0]}, got[0].Dependencies())
+			} else {
+				assert.Len(t, managedSGs, 0)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+const (
+	resourceIDManagedSecurityGroup = "ManagedLBSecurityGroup"
+)
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroup(ctx context.Context, scheme elbv2model.LoadBalancerScheme, ipAddressType elbv2model.IPAddressType) (*ec2model.SecurityGroup, error) {
+	sgSpec, err := t.buildManagedSecurityGroupSpec(ctx, scheme, ipAddressType)
+	if err != nil {
+		return nil, err
+	}
+
+	sg := ec2model.NewSecurityGroup(t.stack, resourceIDManagedSecurityGroup, sgSpec)
+	return sg, nil
+}
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroupSpec(ctx context.Context, scheme elbv2model.LoadBalancerScheme, ipAddressType elbv2model.IPAddressType) (ec2model.SecurityGroupSpec, error) {
+	name := t.buildManagedSecurityGroupName(ctx)
+	tags, err := t.buildManagedSecurityGroupTags(ctx)
+	if err != nil {
+		return ec2model.SecurityGroupSpec{}, err
+	}
+	ingressPermissions, err := t.buildManagedSecurityGroupIngressPermissions(ctx, scheme, ipAddressType)
+	if err != nil {
+		return ec2model.SecurityGroupSpec{}, err
+	}
+	return ec2model.SecurityGroupSpec{
+		GroupName:   name,
+		Description: "[k8s] Managed SecurityGroup for LoadBalancer",
+		Tags:        tags,
+		Ingress:     ingressPermissions,
+	}, nil
+}
+
+var invalidSecurityGroupNamePtn, _ = regexp.Compile("[[:^alnum:]]")
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroupName(_ context.Context) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.service.Namespace))
+	_, _ = uuidHash.Write([]byte(t.service.Name))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	if t.isServiceGroup() {
+		payload := invalidSecurityGroupNamePtn.ReplaceAllString(t.service.Name, "")
+		return fmt.Sprintf("k8s-%.17s-%.10s", payload, uuid)
+	}
+	sanitizedNamespace := invalidSecurityGroupNamePtn.ReplaceAllString(t.service.Namespace, "")
+	sanitizedName := invalidSecurityGroupNamePtn.ReplaceAllString(t.service.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroupTags(ctx context.Context) (map[string]string, error) {
+	return t.buildAdditionalResourceTags(ctx)
+}
+
+// buildManagedSecurityGroupIngressPermissions allows traffic from the source ranges to each Service port.
+func (t *defaultModelBuildTask) buildManagedSecurityGroupIngressPermissions(ctx context.Context, scheme elbv2model.LoadBalancerScheme, ipAddressType elbv2model.IPAddressType) ([]ec2model.IPPermission, error) {
+	cidrs, err := t.buildManagedSecurityGroupIngressCIDRs(ctx, scheme, ipAddressType)
+	if err != nil {
+		return nil, err
+	}
+	var permissions []ec2model.IPPermission
+	for _, port := range mergeTCPUDPServicePorts(t.service.Spec.Ports) {
+		var ipProtocols []string
+		switch port.Protocol {
+		case corev1.ProtocolUDP:
+			ipProtocols = []string{"udp"}
+		case corev1.ProtocolTCP, "":
+			ipProtocols = []string{"tcp"}
+		case serviceProtocolTCPUDP:
+			ipProtocols = []string{"tcp", "udp"}
+		default:
+			continue
+		}
+		for _, ipProtocol := range ipProtocols {
+			for _, cidr := range cidrs {
+				permission := ec2model.IPPermission{
+					IPProtocol: ipProtocol,
+					FromPort:   awssdk.Int64(int64(port.Port)),
+					ToPort:     awssdk.Int64(int64(port.Port)),
+				}
+				if strings.Contains(cidr, ":") {
+					permission.IPv6Range = []ec2model.IPv6Range{
+						{
+							CIDRIPv6: cidr,
+						},
+					}
+				} else {
+					permission.IPRanges = []ec2model.IPRange{
+						{
+							CIDRIP: cidr,
+						},
+					}
+				}
+				permissions = append(permissions, permission)
+			}
+		}
+	}
+	return permissions, nil
+}
+
+// buildManagedSecurityGroupIngressCIDRs returns the source ranges configured on Service.
+// If unspecified, internet-facing load balancers accept traffic from anywhere, and internal load balancers from the VPC.
+func (t *defaultModelBuildTask) buildManagedSecurityGroupIngressCIDRs(ctx context.Context, scheme elbv2model.LoadBalancerScheme, ipAddressType elbv2model.IPAddressType) ([]string, error) {
+	var sourceRanges []string
+	sourceRanges = append(sourceRanges, t.service.Spec.LoadBalancerSourceRanges...)
+	if len(sourceRanges) == 0 {
+		t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSourceRanges, &sourceRanges, t.service.Annotations)
+	}
+	if len(sourceRanges) != 0 {
+		var cidrs []string
+		for _, cidr := range sourceRanges {
+			if strings.Contains(cidr, ":") && ipAddressType != elbv2model.IPAddressTypeDualStack {
+				continue
+			}
+			cidrs = append(cidrs, cidr)
+		}
+		return cidrs, nil
+	}
+
+	if scheme == elbv2model.LoadBalancerSchemeInternal {
+		vpcInfo, err := t.vpcInfoProvider.FetchVPCInfo(ctx, t.vpcID, networking.FetchVPCInfoWithoutCache())
+		if err != nil {
+			return nil, err
+		}
+		cidrs := vpcInfo.AssociatedIPv4CIDRs()
+		if ipAddressType == elbv2model.IPAddressTypeDualStack {
+			cidrs = append(cidrs, vpcInfo.AssociatedIPv6CIDRs()...)
+		}
+		return cidrs, nil
+	}
+	cidrs := append([]string(nil), t.defaultIPv4SourceRanges...)
+	if ipAddressType == elbv2model.IPAddressTypeDualStack {
+		cidrs = append(cidrs, t.defaultIPv6SourceRanges...)
+	}
+	return cidrs, nil
+}
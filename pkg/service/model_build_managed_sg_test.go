@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+func Test_defaultModelBuildTask_buildManagedSecurityGroupIngressPermissions(t *testing.T) {
+	cidrBlockStateAssociated := ec2.VpcCidrBlockStateCodeAssociated
+	svcPorts := []corev1.ServicePort{
+		{
+			Port:     80,
+			Protocol: corev1.ProtocolTCP,
+		},
+		{
+			Port:     53,
+			Protocol: corev1.ProtocolUDP,
+		},
+	}
+	type fetchVPCInfoCall struct {
+		wantVPCInfo networking.VPCInfo
+		err         error
+	}
+	tests := []struct {
+		name              string
+		svc               *corev1.Service
+		scheme            elbv2model.LoadBalancerScheme
+		ipAddressType     elbv2model.IPAddressType
+		fetchVPCInfoCalls []fetchVPCInfoCall
+		want              []ec2model.IPPermission
+	}{
+		{
+			name: "internet-facing load balancer with default source ranges",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					Ports: svcPorts,
+				},
+			},
+			scheme:        elbv2model.LoadBalancerSchemeInternetFacing,
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(80),
+					ToPort:     awssdk.Int64(80),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: "0.0.0.0/0"}},
+				},
+				{
+					IPProtocol: "udp",
+					FromPort:   awssdk.Int64(53),
+					ToPort:     awssdk.Int64(53),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: "0.0.0.0/0"}},
+				},
+			},
+		},
+		{
+			name: "dualstack load balancer with default source ranges",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					Ports: svcPorts[:1],
+				},
+			},
+			scheme:        elbv2model.LoadBalancerSchemeInternetFacing,
+			ipAddressType: elbv2model.IPAddressTypeDualStack,
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(80),
+					ToPort:     awssdk.Int64(80),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: "0.0.0.0/0"}},
+				},
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(80),
+					ToPort:     awssdk.Int64(80),
+					IPv6Range:  []ec2model.IPv6Range{{CIDRIPv6: "::/0"}},
+				},
+			},
+		},
+		{
+			name: "internal load balancer with default source ranges",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					Ports: svcPorts[:1],
+				},
+			},
+			scheme:        elbv2model.LoadBalancerSchemeInternal,
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			fetchVPCInfoCalls: []fetchVPCInfoCall{
+				{
+					wantVPCInfo: networking.VPCInfo{
+						CidrBlockAssociationSet: []*ec2.VpcCidrBlockAssociation{
+							{
+								CidrBlock: awssdk.String("192.168.0.0/16"),
+								CidrBlockState: &ec2.VpcCidrBlockState{
+									State: &cidrBlockStateAssociated,
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(80),
+					ToPort:     awssdk.Int64(80),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: "192.168.0.0/16"}},
+				},
+			},
+		},
+		{
+			name: "source ranges via annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/load-balancer-source-ranges": "10.0.0.0/16, 2001:db8::/32",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Ports: svcPorts[:1],
+				},
+			},
+			scheme:        elbv2model.LoadBalancerSchemeInternal,
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(80),
+					ToPort:     awssdk.Int64(80),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: "10.0.0.0/16"}},
+				},
+			},
+		},
+		{
+			name: "source ranges via spec",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					Ports:                    svcPorts[1:],
+					LoadBalancerSourceRanges: []string{"10.0.0.0/16", "2001:db8::/32"},
+				},
+			},
+			scheme:        elbv2model.LoadBalancerSchemeInternetFacing,
+			ipAddressType: elbv2model.IPAddressTypeDualStack,
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol: "udp",
+					FromPort:   awssdk.Int64(53),
+					ToPort:     awssdk.Int64(53),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: "10.0.0.0/16"}},
+				},
+				{
+					IPProtocol: "udp",
+					FromPort:   awssdk.Int64(53),
+					ToPort:     awssdk.Int64(53),
+					IPv6Range:  []ec2model.IPv6Range{{CIDRIPv6: "2001:db8::/32"}},
+				},
+			},
+		},
+		{
+			name: "tcp and udp ports merged into tcp_udp port",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{
+						{
+							Port:     53,
+							Protocol: corev1.ProtocolTCP,
+						},
+						{
+							Port:     53,
+							Protocol: corev1.ProtocolUDP,
+						},
+					},
+					LoadBalancerSourceRanges: []string{"10.0.0.0/16"},
+				},
+			},
+			scheme:        elbv2model.LoadBalancerSchemeInternetFacing,
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(53),
+					ToPort:     awssdk.Int64(53),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: "10.0.0.0/16"}},
+				},
+				{
+					IPProtocol: "udp",
+					FromPort:   awssdk.Int64(53),
+					ToPort:     awssdk.Int64(53),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: "10.0.0.0/16"}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			vpcInfoProvider := networking.NewMockVPCInfoProvider(ctrl)
+			for _, call := range tt.fetchVPCInfoCalls {
+				vpcInfoProvider.EXPECT().FetchVPCInfo(gomock.Any(), gomock.Any(), gomock.Any()).Return(call.wantVPCInfo, call.err)
+			}
+			task := &defaultModelBuildTask{
+				service:                 tt.svc,
+				vpcID:                   "vpc-xxx",
+				annotationParser:        annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				vpcInfoProvider:         vpcInfoProvider,
+				defaultIPv4SourceRanges: []string{"0.0.0.0/0"},
+				defaultIPv6SourceRanges: []string{"::/0"},
+			}
+			got, err := task.buildManagedSecurityGroupIngressPermissions(context.Background(), tt.scheme, tt.ipAddressType)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// SharedListener is a NLB listener that forwards to a port of a backend Service.
+type SharedListener struct {
+	// Port of the listener.
+	Port int64
+	// Protocol of the listener.
+	Protocol elbv2model.Protocol
+	// CertificateARNs for TLS listeners, the first one is the default certificate.
+	CertificateARNs []string
+	// SSLPolicy for TLS listeners, the owner's SSL negotiation policy is used if nil.
+	SSLPolicy *string
+
+	// Service is the backend Service.
+	Service *corev1.Service
+	// ServicePort is the backend Service port.
+	ServicePort corev1.ServicePort
+}
+
+// SharedLoadBalancerBuilder builds the model stack for a NLB whose listeners forward to different Services.
+// The load balancer is configured via the Service annotations on its owner, and each target group via the annotations on its backend Service.
+type SharedLoadBalancerBuilder interface {
+	// BuildSharedLoadBalancer builds the model stack for a NLB owned by the object with ownerMeta.
+	BuildSharedLoadBalancer(ctx context.Context, stackID core.StackID, ownerMeta metav1.ObjectMeta, listeners []SharedListener) (core.Stack, *elbv2model.LoadBalancer, error)
+}
+
+var _ SharedLoadBalancerBuilder = &defaultModelBuilder{}
+
+func (b *defaultModelBuilder) BuildSharedLoadBalancer(ctx context.Context, stackID core.StackID, ownerMeta metav1.ObjectMeta,
+	listeners []SharedListener) (core.Stack, *elbv2model.LoadBalancer, error) {
+	stack := core.NewDefaultStack(stackID)
+	owner := &corev1.Service{
+		ObjectMeta: ownerMeta,
+	}
+	lb, err := b.buildSharedLoadBalancer(ctx, stack, owner, string(ownerMeta.UID), listeners)
+	if err != nil {
+		return nil, nil, err
+	}
+	return stack, lb, nil
+}
+
+// buildSharedLoadBalancer builds the load balancer configured by owner into stack, with a listener for each of listeners.
+// The ports of owner are populated from listeners, and targetGroupNameScope is included in the target group names.
+func (b *defaultModelBuilder) buildSharedLoadBalancer(ctx context.Context, stack core.Stack, owner *corev1.Service,
+	targetGroupNameScope string, listeners []SharedListener) (*elbv2model.LoadBalancer, error) {
+	owner.Spec.Type = corev1.ServiceTypeLoadBalancer
+	owner.Spec.Ports = buildSharedLoadBalancerPorts(listeners)
+	task := b.newModelBuildTask(owner, stack)
+	scheme, err := task.buildLoadBalancerScheme(ctx)
+	if err != nil {
+		return nil, err
+	}
+	task.ec2Subnets, err = task.buildLoadBalancerSubnets(ctx, scheme)
+	if err != nil {
+		return nil, err
+	}
+	if err := task.buildLoadBalancer(ctx, scheme); err != nil {
+		return nil, err
+	}
+
+	sortedListeners := append([]SharedListener(nil), listeners...)
+	sort.Slice(sortedListeners, func(i, j int) bool {
+		return sortedListeners[i].Port < sortedListeners[j].Port
+	})
+	for _, listener := range sortedListeners {
+		backendTask := b.newModelBuildTask(listener.Service, stack)
+		backendTask.targetGroupNameScope = targetGroupNameScope
+		backendTask.loadBalancer = task.loadBalancer
+		backendTask.ec2Subnets = task.ec2Subnets
+		backendTask.tgByResID = task.tgByResID
+		if _, err := task.buildSharedListener(ctx, backendTask, listener, scheme); err != nil {
+			return nil, err
+		}
+	}
+	return task.loadBalancer, nil
+}
+
+// buildSharedListener builds a listener on the owner's load balancer, and the target group for the listener's backend with backendTask.
+func (t *defaultModelBuildTask) buildSharedListener(ctx context.Context, backendTask *defaultModelBuildTask, listener SharedListener,
+	scheme elbv2model.LoadBalancerScheme) (*elbv2model.Listener, error) {
+	tgProtocol := listener.Protocol
+	if listener.Protocol == elbv2model.ProtocolTLS {
+		tgProtocol = elbv2model.ProtocolTCP
+		if backendTask.buildBackendProtocol(ctx) == "ssl" {
+			tgProtocol = elbv2model.ProtocolTLS
+		}
+	}
+	targetGroup, err := backendTask.buildTargetGroup(ctx, listener.ServicePort, tgProtocol, scheme)
+	if err != nil {
+		return nil, err
+	}
+	alpnPolicy, err := t.buildListenerALPNPolicy(ctx, listener.Protocol, tgProtocol)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := t.buildListenerTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var sslPolicy *string
+	var certificates []elbv2model.Certificate
+	if listener.Protocol == elbv2model.ProtocolTLS {
+		sslPolicy = listener.SSLPolicy
+		if sslPolicy == nil {
+			sslPolicy = t.buildSSLNegotiationPolicy(ctx)
+		}
+		for _, certARN := range listener.CertificateARNs {
+			certificates = append(certificates, elbv2model.Certificate{CertificateARN: core.LiteralStringToken(certARN)})
+		}
+	}
+	lsSpec := elbv2model.ListenerSpec{
+		LoadBalancerARN: t.loadBalancer.LoadBalancerARN(),
+		Port:            listener.Port,
+		Protocol:        listener.Protocol,
+		Certificates:    certificates,
+		SSLPolicy:       sslPolicy,
+		ALPNPolicy:      alpnPolicy,
+		DefaultActions:  backendTask.buildListenerDefaultActions(ctx, targetGroup),
+		Tags:            tags,
+	}
+	listenerResID := fmt.Sprintf("%v", listener.Port)
+	return elbv2model.NewListener(t.stack, listenerResID, lsSpec), nil
+}
+
+// buildSharedLoadBalancerPorts returns the Service ports that the shared load balancer listens on.
+func buildSharedLoadBalancerPorts(listeners []SharedListener) []corev1.ServicePort {
+	var ports []corev1.ServicePort
+	for _, listener := range listeners {
+		switch listener.Protocol {
+		case elbv2model.ProtocolUDP:
+			ports = append(ports, corev1.ServicePort{Port: int32(listener.Port), Protocol: corev1.ProtocolUDP})
+		case elbv2model.ProtocolTCP_UDP:
+			ports = append(ports, corev1.ServicePort{Port: int32(listener.Port), Protocol: corev1.ProtocolTCP},
+				corev1.ServicePort{Port: int32(listener.Port), Protocol: corev1.ProtocolUDP})
+		default:
+			ports = append(ports, corev1.ServicePort{Port: int32(listener.Port), Protocol: corev1.ProtocolTCP})
+		}
+	}
+	return ports
+}
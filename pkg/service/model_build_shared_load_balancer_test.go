@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+func Test_defaultModelBuilder_BuildSharedLoadBalancer(t *testing.T) {
+	ipTargetAnnotations := map[string]string{
+		"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "ip",
+	}
+	dbSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "db",
+			Name:        "postgres",
+			UID:         "postgres-uid",
+			Annotations: ipTargetAnnotations,
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeClusterIP,
+			Ports: []corev1.ServicePort{
+				{Port: 5432, TargetPort: intstr.FromInt(5432), Protocol: corev1.ProtocolTCP},
+			},
+		},
+	}
+	dnsSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "dns",
+			Name:        "coredns",
+			UID:         "coredns-uid",
+			Annotations: ipTargetAnnotations,
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeClusterIP,
+			Ports: []corev1.ServicePort{
+				{Port: 53, TargetPort: intstr.FromInt(53), Protocol: corev1.ProtocolUDP},
+			},
+		},
+	}
+	ownerMeta := metav1.ObjectMeta{
+		Namespace: "default",
+		Name:      "l4",
+		UID:       "gateway-uid",
+	}
+
+	type wantListener struct {
+		port            int64
+		protocol        elbv2model.Protocol
+		certificateARNs []string
+		sslPolicy       *string
+		tgbNamespace    string
+	}
+	tests := []struct {
+		name          string
+		listeners     []SharedListener
+		wantListeners []wantListener
+		wantNumTGs    int
+	}{
+		{
+			name: "listeners with backends in different namespaces",
+			listeners: []SharedListener{
+				{Port: 5432, Protocol: elbv2model.ProtocolTCP, Service: dbSvc, ServicePort: dbSvc.Spec.Ports[0]},
+				{Port: 53, Protocol: elbv2model.ProtocolUDP, Service: dnsSvc, ServicePort: dnsSvc.Spec.Ports[0]},
+			},
+			wantListeners: []wantListener{
+				{port: 53, protocol: elbv2model.ProtocolUDP, tgbNamespace: "dns"},
+				{port: 5432, protocol: elbv2model.ProtocolTCP, tgbNamespace: "db"},
+			},
+			wantNumTGs: 2,
+		},
+		{
+			name: "listeners sharing a backend",
+			listeners: []SharedListener{
+				{Port: 5432, Protocol: elbv2model.ProtocolTCP, Service: dbSvc, ServicePort: dbSvc.Spec.Ports[0]},
+				{Port: 443, Protocol: elbv2model.ProtocolTLS, CertificateARNs: []string{"cert-1", "cert-2"},
+					Service: dbSvc, ServicePort: dbSvc.Spec.Ports[0]},
+			},
+			wantListeners: []wantListener{
+				{port: 443, protocol: elbv2model.ProtocolTLS, certificateARNs: []string{"cert-1", "cert-2"},
+					sslPolicy: aws.String("ELBSecurityPolicy-2016-08"), tgbNamespace: "db"},
+				{port: 5432, protocol: elbv2model.ProtocolTCP, tgbNamespace: "db"},
+			},
+			wantNumTGs: 1,
+		},
+		{
+			name: "TLS listener with explicit SSL policy",
+			listeners: []SharedListener{
+				{Port: 443, Protocol: elbv2model.ProtocolTLS, CertificateARNs: []string{"cert-1"}, SSLPolicy: aws.String("ELBSecurityPolicy-TLS13-1-2-2021-06"),
+					Service: dbSvc, ServicePort: dbSvc.Spec.Ports[0]},
+			},
+			wantListeners: []wantListener{
+				{port: 443, protocol: elbv2model.ProtocolTLS, certificateARNs: []string{"cert-1"},
+					sslPolicy: aws.String("ELBSecurityPolicy-TLS13-1-2-2021-06"), tgbNamespace: "db"},
+			},
+			wantNumTGs: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			subnetsResolver := networking.NewMockSubnetsResolver(ctrl)
+			subnetsResolver.EXPECT().ResolveViaDiscovery(gomock.Any(), gomock.Any()).Return([]*ec2.Subnet{
+				{
+					SubnetId:  aws.String("subnet-1"),
+					CidrBlock: aws.String("192.168.0.0/19"),
+				},
+			}, nil)
+			elbv2TaggingManager := elbv2.NewMockTaggingManager(ctrl)
+			elbv2TaggingManager.EXPECT().ListLoadBalancers(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			vpcInfoProvider := networking.NewMockVPCInfoProvider(ctrl)
+			vpcInfoProvider.EXPECT().FetchVPCInfo(gomock.Any(), gomock.Any(), gomock.Any()).Return(networking.VPCInfo{}, nil).AnyTimes()
+
+			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+			trackingProvider := tracking.NewDefaultProvider("gateway.k8s.aws", "my-cluster")
+			featureGates := config.NewFeatureGates()
+			serviceUtils := NewServiceUtils(annotationParser, "gateway.k8s.aws/resources", "", featureGates)
+			builder := NewDefaultModelBuilder(annotationParser, subnetsResolver, vpcInfoProvider, nil, "vpc-xxx", trackingProvider, elbv2TaggingManager,
+				nil, "my-cluster", nil, nil, "ELBSecurityPolicy-2016-08", serviceUtils, featureGates)
+
+			stackID := core.StackID(types.NamespacedName{Namespace: "default", Name: "l4"})
+			stack, lb, err := builder.BuildSharedLoadBalancer(context.Background(), stackID, ownerMeta, tt.listeners)
+			assert.NoError(t, err)
+			assert.NotNil(t, lb)
+			assert.Equal(t, elbv2model.LoadBalancerTypeNetwork, lb.Spec.Type)
+
+			var listeners []*elbv2model.Listener
+			assert.NoError(t, stack.ListResources(&listeners))
+			var tgs []*elbv2model.TargetGroup
+			assert.NoError(t, stack.ListResources(&tgs))
+			var tgbs []*elbv2model.TargetGroupBindingResource
+			assert.NoError(t, stack.ListResources(&tgbs))
+			assert.Equal(t, tt.wantNumTGs, len(tgs))
+			assert.Equal(t, tt.wantNumTGs, len(tgbs))
+
+			assert.Equal(t, len(tt.wantListeners), len(listeners))
+			listenerByPort := make(map[int64]*elbv2model.Listener)
+			for _, ls := range listeners {
+				listenerByPort[ls.Spec.Port] = ls
+			}
+			tgbNamespaceByTGID := make(map[string]string)
+			for _, tgb := range tgbs {
+				for _, dep := range tgb.Spec.Template.Spec.TargetGroupARN.Dependencies() {
+					tgbNamespaceByTGID[dep.ID()] = tgb.Spec.Template.Namespace
+				}
+			}
+			for _, want := range tt.wantListeners {
+				ls, ok := listenerByPort[want.port]
+				if !assert.True(t, ok, "listener on port %v", want.port) {
+					continue
+				}
+				assert.Equal(t, want.protocol, ls.Spec.Protocol)
+				var certARNs []string
+				for _, cert := range ls.Spec.Certificates {
+					certARN, _ := cert.CertificateARN.Resolve(context.Background())
+					certARNs = append(certARNs, certARN)
+				}
+				assert.Equal(t, want.certificateARNs, certARNs)
+				assert.Equal(t, want.sslPolicy, ls.Spec.SSLPolicy)
+				if assert.Len(t, ls.Spec.DefaultActions, 1) {
+					action := ls.Spec.DefaultActions[0]
+					assert.Equal(t, elbv2model.ActionTypeForward, action.Type)
+					tgARN := action.ForwardConfig.TargetGroups[0].TargetGroupARN
+					for _, dep := range tgARN.Dependencies() {
+						assert.Equal(t, want.tgbNamespace, tgbNamespaceByTGID[dep.ID()])
+					}
+				}
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildTargetGroupName_scoped(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "db",
+			Name:      "postgres",
+			UID:       "postgres-uid",
+		},
+	}
+	hc := &elbv2model.TargetGroupHealthCheckConfig{}
+	task := &defaultModelBuildTask{clusterName: "my-cluster", service: svc, defaultHealthCheckInterval: 10}
+	unscopedName := task.buildTargetGroupName(context.Background(), intstr.FromInt(5432), 5432, elbv2model.TargetTypeIP, elbv2model.ProtocolTCP, hc)
+
+	task.targetGroupNameScope = "gateway-uid-1"
+	scopedName1 := task.buildTargetGroupName(context.Background(), intstr.FromInt(5432), 5432, elbv2model.TargetTypeIP, elbv2model.ProtocolTCP, hc)
+	task.targetGroupNameScope = "gateway-uid-2"
+	scopedName2 := task.buildTargetGroupName(context.Background(), intstr.FromInt(5432), 5432, elbv2model.TargetTypeIP, elbv2model.ProtocolTCP, hc)
+
+	assert.Equal(t, "k8s-db-postgres-64cba3425e", unscopedName)
+	assert.NotEqual(t, unscopedName, scopedName1)
+	assert.NotEqual(t, scopedName1, scopedName2)
+}
@@ -47,7 +47,7 @@ func (t *defaultModelBuildTask) buildTargetGroup(ctx context.Context, port corev
 	if err != nil {
 		return nil, err
 	}
-	preserveClientIP, err := t.buildPreserveClientIPFlag(ctx, targetType, tgAttrs)
+	preserveClientIP, err := t.buildPreserveClientIPFlag(ctx, targetType, tgProtocol, tgAttrs)
 	if err != nil {
 		return nil, err
 	}
@@ -187,6 +187,9 @@ func (t *defaultModelBuildTask) buildTargetGroupName(_ context.Context, svcPort
 	_, _ = uuidHash.Write([]byte(tgProtocol))
 	_, _ = uuidHash.Write([]byte(healthCheckProtocol))
 	_, _ = uuidHash.Write([]byte(healthCheckInterval))
+	if len(t.targetGroupNameScope) != 0 {
+		_, _ = uuidHash.Write([]byte(t.targetGroupNameScope))
+	}
 	uuid := hex.EncodeToString(uuidHash.Sum(nil))
 
 	sanitizedNamespace := invalidTargetGroupNamePattern.ReplaceAllString(t.service.Namespace, "")
@@ -231,16 +234,27 @@ func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context) ([
 	return attributes, nil
 }
 
-func (t *defaultModelBuildTask) buildPreserveClientIPFlag(_ context.Context, targetType elbv2model.TargetType, tgAttrs []elbv2model.TargetGroupAttribute) (bool, error) {
+// buildPreserveClientIPFlag builds whether client IP is preserved for the TargetGroup.
+// client IP is always preserved for UDP and TCP_UDP TargetGroups, it can't be disabled for them.
+func (t *defaultModelBuildTask) buildPreserveClientIPFlag(_ context.Context, targetType elbv2model.TargetType, tgProtocol elbv2model.Protocol,
+	tgAttrs []elbv2model.TargetGroupAttribute) (bool, error) {
+	alwaysPreserveClientIP := tgProtocol == elbv2model.ProtocolUDP || tgProtocol == elbv2model.ProtocolTCP_UDP
 	for _, attr := range tgAttrs {
 		if attr.Key == tgAttrsPreserveClientIPEnabled {
 			preserveClientIP, err := strconv.ParseBool(attr.Value)
 			if err != nil {
 				return false, errors.Wrapf(err, "failed to parse attribute %v=%v", tgAttrsPreserveClientIPEnabled, attr.Value)
 			}
+			if !preserveClientIP && alwaysPreserveClientIP {
+				return false, errors.Errorf("attribute %v=%v is not supported for %v target groups, client IP is always preserved",
+					tgAttrsPreserveClientIPEnabled, attr.Value, tgProtocol)
+			}
 			return preserveClientIP, nil
 		}
 	}
+	if alwaysPreserveClientIP {
+		return true, nil
+	}
 	switch targetType {
 	case elbv2model.TargetTypeIP:
 		return false, nil
@@ -441,15 +455,22 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(ctx context.Co
 	if !manageBackendSGRules {
 		return nil, nil
 	}
+	if len(t.loadBalancer.Spec.SecurityGroups) != 0 {
+		return t.buildTargetGroupBindingNetworkingViaSecurityGroups(ctx, tgPort, hcPort, port), nil
+	}
 	tgProtocol := port.Protocol
 	loadBalancerSubnetsSourceRanges := t.getLoadBalancerSubnetsSourceRanges(targetGroupIPAddressType)
 	networkingProtocol := elbv2api.NetworkingProtocolTCP
 	if tgProtocol == corev1.ProtocolUDP {
 		networkingProtocol = elbv2api.NetworkingProtocolUDP
 	}
+	// client IP is always preserved for UDP and TCP_UDP traffic, thus it's allowed from the source ranges instead of load balancer subnets.
+	if tgProtocol == corev1.ProtocolUDP || tgProtocol == serviceProtocolTCPUDP {
+		preserveClientIP = true
+	}
 	trafficSource := loadBalancerSubnetsSourceRanges
 	customSourceRangesConfigured := false
-	if networkingProtocol == elbv2api.NetworkingProtocolUDP || preserveClientIP {
+	if preserveClientIP {
 		trafficSource, customSourceRangesConfigured = t.buildPeersFromSourceRangesConfiguration(ctx, defaultSourceRanges)
 	}
 	tgbNetworking := &elbv2model.TargetGroupBindingNetworking{
@@ -465,6 +486,13 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(ctx context.Co
 			},
 		},
 	}
+	if tgProtocol == serviceProtocolTCPUDP {
+		networkingProtocolUDP := elbv2api.NetworkingProtocolUDP
+		tgbNetworking.Ingress[0].Ports = append(tgbNetworking.Ingress[0].Ports, elbv2api.NetworkingPort{
+			Port:     &tgPort,
+			Protocol: &networkingProtocolUDP,
+		})
+	}
 	if hcIngressRules := t.buildHealthCheckNetworkingIngressRules(trafficSource, loadBalancerSubnetsSourceRanges, tgPort, hcPort, tgProtocol,
 		preserveClientIP, customSourceRangesConfigured); len(hcIngressRules) > 0 {
 		tgbNetworking.Ingress = append(tgbNetworking.Ingress, hcIngressRules...)
@@ -472,13 +500,63 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(ctx context.Co
 	return tgbNetworking, nil
 }
 
+// buildTargetGroupBindingNetworkingViaSecurityGroups allows traffic from the load balancer's SecurityGroups, including health checks.
+func (t *defaultModelBuildTask) buildTargetGroupBindingNetworkingViaSecurityGroups(_ context.Context, tgPort intstr.IntOrString,
+	hcPort intstr.IntOrString, port corev1.ServicePort) *elbv2model.TargetGroupBindingNetworking {
+	trafficSource := make([]elbv2model.NetworkingPeer, 0, len(t.loadBalancer.Spec.SecurityGroups))
+	for _, sgToken := range t.loadBalancer.Spec.SecurityGroups {
+		trafficSource = append(trafficSource, elbv2model.NetworkingPeer{
+			SecurityGroup: &elbv2model.SecurityGroup{
+				GroupID: sgToken,
+			},
+		})
+	}
+	networkingProtocol := elbv2api.NetworkingProtocolTCP
+	if port.Protocol == corev1.ProtocolUDP {
+		networkingProtocol = elbv2api.NetworkingProtocolUDP
+	}
+	ports := []elbv2api.NetworkingPort{
+		{
+			Port:     &tgPort,
+			Protocol: &networkingProtocol,
+		},
+	}
+	if port.Protocol == serviceProtocolTCPUDP {
+		networkingProtocolUDP := elbv2api.NetworkingProtocolUDP
+		ports = append(ports, elbv2api.NetworkingPort{
+			Port:     &tgPort,
+			Protocol: &networkingProtocolUDP,
+		})
+	}
+	if networkingProtocol == elbv2api.NetworkingProtocolUDP ||
+		(hcPort.String() != healthCheckPortTrafficPort && hcPort.IntValue() != tgPort.IntValue()) {
+		networkingProtocolTCP := elbv2api.NetworkingProtocolTCP
+		networkingHealthCheckPort := hcPort
+		if hcPort.String() == healthCheckPortTrafficPort {
+			networkingHealthCheckPort = tgPort
+		}
+		ports = append(ports, elbv2api.NetworkingPort{
+			Port:     &networkingHealthCheckPort,
+			Protocol: &networkingProtocolTCP,
+		})
+	}
+	return &elbv2model.TargetGroupBindingNetworking{
+		Ingress: []elbv2model.NetworkingIngressRule{
+			{
+				From:  trafficSource,
+				Ports: ports,
+			},
+		},
+	}
+}
+
 func (t *defaultModelBuildTask) getDefaultIPSourceRanges(ctx context.Context, targetGroupIPAddressType elbv2model.TargetGroupIPAddressType,
 	protocol corev1.Protocol, preserveClientIP bool, scheme elbv2model.LoadBalancerScheme) ([]string, error) {
 	defaultSourceRanges := t.defaultIPv4SourceRanges
 	if targetGroupIPAddressType == elbv2model.TargetGroupIPAddressTypeIPv6 {
 		defaultSourceRanges = t.defaultIPv6SourceRanges
 	}
-	if (protocol == corev1.ProtocolUDP || preserveClientIP) && scheme == elbv2model.LoadBalancerSchemeInternal {
+	if (protocol == corev1.ProtocolUDP || protocol == serviceProtocolTCPUDP || preserveClientIP) && scheme == elbv2model.LoadBalancerSchemeInternal {
 		vpcInfo, err := t.vpcInfoProvider.FetchVPCInfo(ctx, t.vpcID, networking.FetchVPCInfoWithoutCache())
 		if err != nil {
 			return nil, err
@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
@@ -392,6 +393,7 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworking(t *testing.T
 		ipAddressType       elbv2.TargetGroupIPAddressType
 		preserveClientIP    bool
 		defaultSourceRanges []string
+		lbSecurityGroups    []core.StringToken
 		want                *elbv2.TargetGroupBindingNetworking
 	}{
 		{
@@ -1031,6 +1033,213 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworking(t *testing.T
 				},
 			},
 		},
+		{
+			name:             "tcp-service with load balancer securityGroups",
+			svc:              &corev1.Service{},
+			tgPort:           port80,
+			hcPort:           trafficPort,
+			tgProtocol:       corev1.ProtocolTCP,
+			ipAddressType:    elbv2.TargetGroupIPAddressTypeIPv4,
+			preserveClientIP: true,
+			lbSecurityGroups: []core.StringToken{core.LiteralStringToken("sg-lb1"), core.LiteralStringToken("sg-lb2")},
+			want: &elbv2.TargetGroupBindingNetworking{
+				Ingress: []elbv2.NetworkingIngressRule{
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								SecurityGroup: &elbv2.SecurityGroup{
+									GroupID: core.LiteralStringToken("sg-lb1"),
+								},
+							},
+							{
+								SecurityGroup: &elbv2.SecurityGroup{
+									GroupID: core.LiteralStringToken("sg-lb2"),
+								},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port80,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:             "udp-service with load balancer securityGroups",
+			svc:              &corev1.Service{},
+			tgPort:           port80,
+			hcPort:           port808,
+			tgProtocol:       corev1.ProtocolUDP,
+			ipAddressType:    elbv2.TargetGroupIPAddressTypeIPv4,
+			lbSecurityGroups: []core.StringToken{core.LiteralStringToken("sg-lb1")},
+			want: &elbv2.TargetGroupBindingNetworking{
+				Ingress: []elbv2.NetworkingIngressRule{
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								SecurityGroup: &elbv2.SecurityGroup{
+									GroupID: core.LiteralStringToken("sg-lb1"),
+								},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolUDP,
+								Port:     &port80,
+							},
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port808,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "tcp_udp-service with source ranges, client IP is always preserved",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					LoadBalancerSourceRanges: []string{"10.0.0.0/16"},
+				},
+			},
+			tgPort: port80,
+			hcPort: trafficPort,
+			subnets: []*ec2.Subnet{{
+				CidrBlock: aws.String("172.16.0.0/19"),
+				SubnetId:  aws.String("az-1"),
+			}},
+			tgProtocol:    corev1.Protocol(elbv2.ProtocolTCP_UDP),
+			ipAddressType: elbv2.TargetGroupIPAddressTypeIPv4,
+			want: &elbv2.TargetGroupBindingNetworking{
+				Ingress: []elbv2.NetworkingIngressRule{
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								IPBlock: &elbv2api.IPBlock{
+									CIDR: "10.0.0.0/16",
+								},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port80,
+							},
+							{
+								Protocol: &networkingProtocolUDP,
+								Port:     &port80,
+							},
+						},
+					},
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								IPBlock: &elbv2api.IPBlock{
+									CIDR: "172.16.0.0/19",
+								},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port80,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "tcp_udp-service with source ranges, preserve client IP",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					LoadBalancerSourceRanges: []string{"10.0.0.0/16"},
+				},
+			},
+			tgPort: port80,
+			hcPort: port808,
+			subnets: []*ec2.Subnet{{
+				CidrBlock: aws.String("172.16.0.0/19"),
+				SubnetId:  aws.String("az-1"),
+			}},
+			tgProtocol:       corev1.Protocol(elbv2.ProtocolTCP_UDP),
+			ipAddressType:    elbv2.TargetGroupIPAddressTypeIPv4,
+			preserveClientIP: true,
+			want: &elbv2.TargetGroupBindingNetworking{
+				Ingress: []elbv2.NetworkingIngressRule{
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								IPBlock: &elbv2api.IPBlock{
+									CIDR: "10.0.0.0/16",
+								},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port80,
+							},
+							{
+								Protocol: &networkingProtocolUDP,
+								Port:     &port80,
+							},
+						},
+					},
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								IPBlock: &elbv2api.IPBlock{
+									CIDR: "172.16.0.0/19",
+								},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port808,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:             "tcp_udp-service with load balancer securityGroups",
+			svc:              &corev1.Service{},
+			tgPort:           port80,
+			hcPort:           trafficPort,
+			tgProtocol:       corev1.Protocol(elbv2.ProtocolTCP_UDP),
+			ipAddressType:    elbv2.TargetGroupIPAddressTypeIPv4,
+			lbSecurityGroups: []core.StringToken{core.LiteralStringToken("sg-lb1")},
+			want: &elbv2.TargetGroupBindingNetworking{
+				Ingress: []elbv2.NetworkingIngressRule{
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								SecurityGroup: &elbv2.SecurityGroup{
+									GroupID: core.LiteralStringToken("sg-lb1"),
+								},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port80,
+							},
+							{
+								Protocol: &networkingProtocolUDP,
+								Port:     &port80,
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "with manage backend SG disabled via annotation",
 			svc: &corev1.Service{
@@ -1054,7 +1263,8 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworking(t *testing.T
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			parser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
-			builder := &defaultModelBuildTask{service: tt.svc, annotationParser: parser, ec2Subnets: tt.subnets}
+			builder := &defaultModelBuildTask{service: tt.svc, annotationParser: parser, ec2Subnets: tt.subnets,
+				loadBalancer: &elbv2.LoadBalancer{Spec: elbv2.LoadBalancerSpec{SecurityGroups: tt.lbSecurityGroups}}}
 			port := corev1.ServicePort{
 				Protocol: tt.tgProtocol,
 			}
@@ -1068,6 +1278,7 @@ func Test_defaultModelBuilder_buildPreserveClientIPFlag(t *testing.T) {
 	tests := []struct {
 		testName   string
 		targetType elbv2.TargetType
+		tgProtocol elbv2.Protocol
 		tgAttrs    []elbv2.TargetGroupAttribute
 		want       bool
 		wantErr    error
@@ -1141,6 +1352,54 @@ func Test_defaultModelBuilder_buildPreserveClientIPFlag(t *testing.T) {
 			},
 			wantErr: errors.New("failed to parse attribute preserve_client_ip.enabled= FalSe: strconv.ParseBool: parsing \" FalSe\": invalid syntax"),
 		},
+		{
+			testName:   "IP mode udp default",
+			targetType: elbv2.TargetTypeIP,
+			tgProtocol: elbv2.ProtocolUDP,
+			want:       true,
+		},
+		{
+			testName:   "IP mode tcp_udp default",
+			targetType: elbv2.TargetTypeIP,
+			tgProtocol: elbv2.ProtocolTCP_UDP,
+			want:       true,
+		},
+		{
+			testName:   "IP mode tcp_udp annotation",
+			targetType: elbv2.TargetTypeIP,
+			tgProtocol: elbv2.ProtocolTCP_UDP,
+			tgAttrs: []elbv2.TargetGroupAttribute{
+				{
+					Key:   tgAttrsPreserveClientIPEnabled,
+					Value: "true",
+				},
+			},
+			want: true,
+		},
+		{
+			testName:   "IP mode tcp_udp annotation disabled",
+			targetType: elbv2.TargetTypeIP,
+			tgProtocol: elbv2.ProtocolTCP_UDP,
+			tgAttrs: []elbv2.TargetGroupAttribute{
+				{
+					Key:   tgAttrsPreserveClientIPEnabled,
+					Value: "false",
+				},
+			},
+			wantErr: errors.New("attribute preserve_client_ip.enabled=false is not supported for TCP_UDP target groups, client IP is always preserved"),
+		},
+		{
+			testName:   "Instance mode tcp_udp annotation disabled",
+			targetType: elbv2.TargetTypeInstance,
+			tgProtocol: elbv2.ProtocolTCP_UDP,
+			tgAttrs: []elbv2.TargetGroupAttribute{
+				{
+					Key:   tgAttrsPreserveClientIPEnabled,
+					Value: "false",
+				},
+			},
+			wantErr: errors.New("attribute preserve_client_ip.enabled=false is not supported for TCP_UDP target groups, client IP is always preserved"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
@@ -1148,7 +1407,11 @@ func Test_defaultModelBuilder_buildPreserveClientIPFlag(t *testing.T) {
 			builder := &defaultModelBuildTask{
 				annotationParser: parser,
 			}
-			got, err := builder.buildPreserveClientIPFlag(context.Background(), tt.targetType, tt.tgAttrs)
+			tgProtocol := tt.tgProtocol
+			if tgProtocol == "" {
+				tgProtocol = elbv2.ProtocolTCP
+			}
+			got, err := builder.buildPreserveClientIPFlag(context.Background(), tt.targetType, tgProtocol, tt.tgAttrs)
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
@@ -10,6 +10,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certs"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
@@ -34,21 +36,24 @@ type ModelBuilder interface {
 
 // NewDefaultModelBuilder construct a new defaultModelBuilder
 func NewDefaultModelBuilder(annotationParser annotations.Parser, subnetsResolver networking.SubnetsResolver,
-	vpcInfoProvider networking.VPCInfoProvider, vpcID string, trackingProvider tracking.Provider,
-	elbv2TaggingManager elbv2deploy.TaggingManager, clusterName string, defaultTags map[string]string,
-	externalManagedTags []string, defaultSSLPolicy string, serviceUtils ServiceUtils) *defaultModelBuilder {
+	vpcInfoProvider networking.VPCInfoProvider, sgResolver networking.SecurityGroupResolver, vpcID string, trackingProvider tracking.Provider,
+	elbv2TaggingManager elbv2deploy.TaggingManager, certDiscovery certs.CertDiscovery, clusterName string, defaultTags map[string]string,
+	externalManagedTags []string, defaultSSLPolicy string, serviceUtils ServiceUtils, featureGates config.FeatureGates) *defaultModelBuilder {
 	return &defaultModelBuilder{
 		annotationParser:    annotationParser,
 		subnetsResolver:     subnetsResolver,
 		vpcInfoProvider:     vpcInfoProvider,
+		sgResolver:          sgResolver,
 		trackingProvider:    trackingProvider,
 		elbv2TaggingManager: elbv2TaggingManager,
+		certDiscovery:       certDiscovery,
 		serviceUtils:        serviceUtils,
 		clusterName:         clusterName,
 		vpcID:               vpcID,
 		defaultTags:         defaultTags,
 		externalManagedTags: sets.NewString(externalManagedTags...),
 		defaultSSLPolicy:    defaultSSLPolicy,
+		featureGates:        featureGates,
 	}
 }
 
@@ -58,8 +63,10 @@ type defaultModelBuilder struct {
 	annotationParser    annotations.Parser
 	subnetsResolver     networking.SubnetsResolver
 	vpcInfoProvider     networking.VPCInfoProvider
+	sgResolver          networking.SecurityGroupResolver
 	trackingProvider    tracking.Provider
 	elbv2TaggingManager elbv2deploy.TaggingManager
+	certDiscovery       certs.CertDiscovery
 	serviceUtils        ServiceUtils
 
 	clusterName         string
@@ -67,19 +74,32 @@ type defaultModelBuilder struct {
 	defaultTags         map[string]string
 	externalManagedTags sets.String
 	defaultSSLPolicy    string
+	featureGates        config.FeatureGates
 }
 
 func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service) (core.Stack, *elbv2model.LoadBalancer, error) {
 	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(service)))
-	task := &defaultModelBuildTask{
+	task := b.newModelBuildTask(service, stack)
+	if err := task.run(ctx); err != nil {
+		return nil, nil, err
+	}
+	return task.stack, task.loadBalancer, nil
+}
+
+// newModelBuildTask constructs a defaultModelBuildTask that builds resources for service into stack.
+func (b *defaultModelBuilder) newModelBuildTask(service *corev1.Service, stack core.Stack) *defaultModelBuildTask {
+	return &defaultModelBuildTask{
 		clusterName:         b.clusterName,
 		vpcID:               b.vpcID,
 		annotationParser:    b.annotationParser,
 		subnetsResolver:     b.subnetsResolver,
 		vpcInfoProvider:     b.vpcInfoProvider,
+		sgResolver:          b.sgResolver,
 		trackingProvider:    b.trackingProvider,
 		elbv2TaggingManager: b.elbv2TaggingManager,
+		certDiscovery:       b.certDiscovery,
 		serviceUtils:        b.serviceUtils,
+		featureGates:        b.featureGates,
 
 		service:   service,
 		stack:     stack,
@@ -113,11 +133,6 @@ func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service
 		defaultHealthCheckHealthyThresholdForInstanceModeLocal:   2,
 		defaultHealthCheckUnhealthyThresholdForInstanceModeLocal: 2,
 	}
-
-	if err := task.run(ctx); err != nil {
-		return nil, nil, err
-	}
-	return task.stack, task.loadBalancer, nil
 }
 
 type defaultModelBuildTask struct {
@@ -126,11 +141,16 @@ type defaultModelBuildTask struct {
 	annotationParser    annotations.Parser
 	subnetsResolver     networking.SubnetsResolver
 	vpcInfoProvider     networking.VPCInfoProvider
+	sgResolver          networking.SecurityGroupResolver
 	trackingProvider    tracking.Provider
 	elbv2TaggingManager elbv2deploy.TaggingManager
+	certDiscovery       certs.CertDiscovery
 	serviceUtils        ServiceUtils
+	featureGates        config.FeatureGates
 
 	service *corev1.Service
+	// targetGroupNameScope is included in target group names when set, so that load balancers sharing a service don't share target groups.
+	targetGroupNameScope string
 
 	stack        core.Stack
 	loadBalancer *elbv2model.LoadBalancer
@@ -172,7 +192,11 @@ type defaultModelBuildTask struct {
 }
 
 func (t *defaultModelBuildTask) run(ctx context.Context) error {
-	if !t.serviceUtils.IsServiceSupported(t.service) {
+	isServiceGroupMember, err := t.isServiceGroupMember()
+	if err != nil {
+		return err
+	}
+	if !t.serviceUtils.IsServiceSupported(t.service) || isServiceGroupMember {
 		if t.serviceUtils.IsServicePendingFinalization(t.service) {
 			deletionProtectionEnabled, err := t.getDeletionProtectionViaAnnotation(*t.service)
 			if err != nil {
@@ -184,8 +208,7 @@ func (t *defaultModelBuildTask) run(ctx context.Context) error {
 		}
 		return nil
 	}
-	err := t.buildModel(ctx)
-	return err
+	return t.buildModel(ctx)
 }
 
 func (t *defaultModelBuildTask) buildModel(ctx context.Context) error {
@@ -208,6 +231,23 @@ func (t *defaultModelBuildTask) buildModel(ctx context.Context) error {
 	return nil
 }
 
+// isServiceGroup tests whether the service is the owner of a load balancer shared by a Service group.
+// such owners are built by BuildGroup and named after the group, without a namespace.
+func (t *defaultModelBuildTask) isServiceGroup() bool {
+	return t.service.Namespace == ""
+}
+
+// isServiceGroupMember tests whether the service belongs to a Service group, such services don't have load balancers of their own.
+// an error is returned if the service is annotated with an invalid groupName.
+func (t *defaultModelBuildTask) isServiceGroupMember() (bool, error) {
+	// Service no longer belong to any group when it's been deleted or no longer supported.
+	if !t.serviceUtils.IsServiceSupported(t.service) {
+		return false, nil
+	}
+	_, inGroup, err := loadGroupNameIfAny(t.annotationParser, t.service)
+	return inGroup, err
+}
+
 func (t *defaultModelBuildTask) getDeletionProtectionViaAnnotation(svc corev1.Service) (bool, error) {
 	var lbAttributes map[string]string
 	_, err := t.annotationParser.ParseStringMapAnnotation(annotations.SvcLBSuffixLoadBalancerAttributes, &lbAttributes, svc.Annotations)
@@ -0,0 +1,78 @@
+package trafficshift
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HealthChecker checks the health of canary Service's targets.
+type HealthChecker interface {
+	// CheckCanaryHealth returns the percentage of healthy targets in canary Service's TargetGroups.
+	// nil will be returned if the health is unknown yet, e.g. no target has completed initial health checks.
+	CheckCanaryHealth(ctx context.Context, ts *elbv2api.TrafficShift) (*int64, error)
+}
+
+// NewDefaultHealthChecker constructs new defaultHealthChecker.
+func NewDefaultHealthChecker(k8sClient client.Client, targetsManager targetgroupbinding.TargetsManager, logger logr.Logger) *defaultHealthChecker {
+	return &defaultHealthChecker{
+		k8sClient:      k8sClient,
+		targetsManager: targetsManager,
+		logger:         logger,
+	}
+}
+
+var _ HealthChecker = &defaultHealthChecker{}
+
+// default implementation for HealthChecker.
+type defaultHealthChecker struct {
+	k8sClient      client.Client
+	targetsManager targetgroupbinding.TargetsManager
+	logger         logr.Logger
+}
+
+func (c *defaultHealthChecker) CheckCanaryHealth(ctx context.Context, ts *elbv2api.TrafficShift) (*int64, error) {
+	tgbList := &elbv2api.TargetGroupBindingList{}
+	if err := c.k8sClient.List(ctx, tgbList,
+		client.InNamespace(ts.Namespace),
+		client.MatchingFields{targetgroupbinding.IndexKeyServiceRefName: ts.Spec.CanaryService.Name}); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch targetGroupBindings")
+	}
+
+	var targets []targetgroupbinding.TargetInfo
+	for _, tgb := range tgbList.Items {
+		if tgb.Spec.ServiceRef.Port.String() != ts.Spec.CanaryService.Port.String() {
+			continue
+		}
+		tgTargets, err := c.targetsManager.ListTargets(ctx, tgb.Spec.TargetGroupARN)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, tgTargets...)
+	}
+	return computeHealthyPercent(targets), nil
+}
+
+// computeHealthyPercent computes the percentage of healthy targets.
+// targets in initial or draining state are excluded since their health are transitional.
+func computeHealthyPercent(targets []targetgroupbinding.TargetInfo) *int64 {
+	var totalCount, healthyCount int64
+	for _, target := range targets {
+		if target.IsInitial() || target.IsDraining() || target.IsNotRegistered() {
+			continue
+		}
+		totalCount++
+		if target.IsHealthy() {
+			healthyCount++
+		}
+	}
+	if totalCount == 0 {
+		return nil
+	}
+	return awssdk.Int64(healthyCount * 100 / totalCount)
+}
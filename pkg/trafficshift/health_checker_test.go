@@ -0,0 +1,66 @@
+package trafficshift
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
+)
+
+func Test_computeHealthyPercent(t *testing.T) {
+	newTarget := func(id string, state string) targetgroupbinding.TargetInfo {
+		return targetgroupbinding.TargetInfo{
+			Target: elbv2sdk.TargetDescription{
+				Id: awssdk.String(id),
+			},
+			TargetHealth: &elbv2sdk.TargetHealth{
+				State: awssdk.String(state),
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		targets []targetgroupbinding.TargetInfo
+		want    *int64
+	}{
+		{
+			name: "all targets are healthy",
+			targets: []targetgroupbinding.TargetInfo{
+				newTarget("192.168.1.1", elbv2sdk.TargetHealthStateEnumHealthy),
+				newTarget("192.168.1.2", elbv2sdk.TargetHealthStateEnumHealthy),
+			},
+			want: awssdk.Int64(100),
+		},
+		{
+			name: "some targets are unhealthy",
+			targets: []targetgroupbinding.TargetInfo{
+				newTarget("192.168.1.1", elbv2sdk.TargetHealthStateEnumHealthy),
+				newTarget("192.168.1.2", elbv2sdk.TargetHealthStateEnumUnhealthy),
+				newTarget("192.168.1.3", elbv2sdk.TargetHealthStateEnumUnhealthy),
+				newTarget("192.168.1.4", elbv2sdk.TargetHealthStateEnumInitial),
+				newTarget("192.168.1.5", elbv2sdk.TargetHealthStateEnumDraining),
+			},
+			want: awssdk.Int64(33),
+		},
+		{
+			name: "all targets are in initial state",
+			targets: []targetgroupbinding.TargetInfo{
+				newTarget("192.168.1.1", elbv2sdk.TargetHealthStateEnumInitial),
+			},
+			want: nil,
+		},
+		{
+			name:    "no targets",
+			targets: nil,
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := computeHealthyPercent(tt.targets)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package trafficshift
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultStepInterval = 10 * time.Minute
+	// the interval to check canary Service's health when a HealthCheck is configured.
+	defaultHealthCheckInterval = 1 * time.Minute
+)
+
+// ResourceManager manages the TrafficShift resource.
+type ResourceManager interface {
+	// Reconcile progresses the TrafficShift to its desired step, and records the canary weight in its status.
+	Reconcile(ctx context.Context, ts *elbv2api.TrafficShift) error
+}
+
+// NewDefaultResourceManager constructs new defaultResourceManager.
+func NewDefaultResourceManager(k8sClient client.Client, healthChecker HealthChecker,
+	eventRecorder record.EventRecorder, logger logr.Logger) *defaultResourceManager {
+	return &defaultResourceManager{
+		k8sClient:     k8sClient,
+		healthChecker: healthChecker,
+		eventRecorder: eventRecorder,
+		logger:        logger,
+
+		healthCheckInterval: defaultHealthCheckInterval,
+	}
+}
+
+var _ ResourceManager = &defaultResourceManager{}
+
+// default implementation for ResourceManager.
+type defaultResourceManager struct {
+	k8sClient     client.Client
+	healthChecker HealthChecker
+	eventRecorder record.EventRecorder
+	logger        logr.Logger
+
+	healthCheckInterval time.Duration
+}
+
+func (m *defaultResourceManager) Reconcile(ctx context.Context, ts *elbv2api.TrafficShift) error {
+	var healthyPercent *int64
+	if ts.Spec.HealthCheck != nil && !isTerminalPhase(ts.Status.Phase) {
+		var err error
+		healthyPercent, err = m.healthChecker.CheckCanaryHealth(ctx, ts)
+		if err != nil {
+			m.eventRecorder.Event(ts, corev1.EventTypeWarning, k8s.TrafficShiftEventReasonFailedCheckHealth, fmt.Sprintf("Failed check canary health due to %v", err))
+			return err
+		}
+	}
+
+	status, requeueAfter := m.computeStatus(ts, metav1.Now(), healthyPercent)
+	if err := m.updateTrafficShiftStatus(ctx, ts, status); err != nil {
+		m.eventRecorder.Event(ts, corev1.EventTypeWarning, k8s.TrafficShiftEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return err
+	}
+	if requeueAfter > 0 {
+		return runtime.NewRequeueNeededAfter("progress traffic shift", requeueAfter)
+	}
+	return nil
+}
+
+// computeStatus computes the status of TrafficShift at specified time, and the duration after which it should be computed again.
+// a zero duration means the TrafficShift won't progress until its spec changes.
+func (m *defaultResourceManager) computeStatus(ts *elbv2api.TrafficShift, now metav1.Time, healthyPercent *int64) (elbv2api.TrafficShiftStatus, time.Duration) {
+	status := *ts.Status.DeepCopy()
+	steps := ts.Spec.Steps
+	if len(steps) == 0 {
+		status.Message = "at least one step is required"
+		return status, 0
+	}
+	if awssdk.Int64Value(status.ObservedGeneration) != ts.Generation || status.Phase == "" {
+		status.ObservedGeneration = awssdk.Int64(ts.Generation)
+		// a finished TrafficShift restarts from the first step once its spec changes.
+		if status.Phase == "" || isTerminalPhase(status.Phase) {
+			status.Phase = elbv2api.TrafficShiftPhaseProgressing
+			status.CurrentStep = 0
+			status.LastStepTime = &now
+			status.Message = ""
+		} else if int(status.CurrentStep) >= len(steps) {
+			status.CurrentStep = int32(len(steps) - 1)
+		}
+		status.CanaryWeight = awssdk.Int64(steps[status.CurrentStep].Weight)
+	}
+	if isTerminalPhase(status.Phase) {
+		return status, 0
+	}
+	if status.LastStepTime == nil {
+		status.LastStepTime = &now
+	}
+	if ts.Spec.Paused {
+		status.Phase = elbv2api.TrafficShiftPhasePaused
+		status.Message = "paused by spec"
+		return status, 0
+	}
+
+	healthCheck := ts.Spec.HealthCheck
+	if healthCheck != nil && healthyPercent != nil && *healthyPercent < healthCheck.MinHealthyPercent {
+		status.Message = fmt.Sprintf("canary targets are %v%% healthy, below the minimum of %v%%", *healthyPercent, healthCheck.MinHealthyPercent)
+		if healthCheck.FailurePolicy != nil && *healthCheck.FailurePolicy == elbv2api.TrafficShiftFailurePolicyRollback {
+			status.Phase = elbv2api.TrafficShiftPhaseRolledBack
+			status.CanaryWeight = awssdk.Int64(0)
+			return status, 0
+		}
+		status.Phase = elbv2api.TrafficShiftPhasePaused
+		return status, m.healthCheckInterval
+	}
+
+	// a resumed TrafficShift stays at current step for another full interval.
+	if status.Phase == elbv2api.TrafficShiftPhasePaused {
+		status.Phase = elbv2api.TrafficShiftPhaseProgressing
+		status.LastStepTime = &now
+		status.Message = ""
+	}
+
+	interval := defaultStepInterval
+	if ts.Spec.Interval != nil {
+		interval = ts.Spec.Interval.Duration
+	}
+	requeueAfter := interval
+	if elapsed := now.Sub(status.LastStepTime.Time); elapsed < interval {
+		requeueAfter = interval - elapsed
+	} else if healthCheck != nil && healthyPercent == nil {
+		// the canary must be proven healthy before progressing, so the step is held until its health is known.
+		status.Message = "canary targets health is unknown, holding at current step"
+		return status, m.healthCheckInterval
+	} else if int(status.CurrentStep) == len(steps)-1 {
+		status.Phase = elbv2api.TrafficShiftPhaseCompleted
+		status.Message = ""
+		return status, 0
+	} else {
+		status.CurrentStep++
+		status.CanaryWeight = awssdk.Int64(steps[status.CurrentStep].Weight)
+		status.LastStepTime = &now
+		status.Message = ""
+	}
+	if healthCheck != nil && m.healthCheckInterval < requeueAfter {
+		requeueAfter = m.healthCheckInterval
+	}
+	return status, requeueAfter
+}
+
+func (m *defaultResourceManager) updateTrafficShiftStatus(ctx context.Context, ts *elbv2api.TrafficShift, status elbv2api.TrafficShiftStatus) error {
+	if equality.Semantic.DeepEqual(ts.Status, status) {
+		return nil
+	}
+	tsOld := ts.DeepCopy()
+	ts.Status = status
+	if err := m.k8sClient.Status().Patch(ctx, ts, client.MergeFrom(tsOld)); err != nil {
+		return errors.Wrapf(err, "failed to update trafficShift status: %v", k8s.NamespacedName(ts))
+	}
+
+	if tsOld.Status.Phase != status.Phase || awssdk.Int64Value(tsOld.Status.CanaryWeight) != awssdk.Int64Value(status.CanaryWeight) {
+		eventType := corev1.EventTypeNormal
+		if status.Phase == elbv2api.TrafficShiftPhasePaused || status.Phase == elbv2api.TrafficShiftPhaseRolledBack {
+			eventType = corev1.EventTypeWarning
+		}
+		message := fmt.Sprintf("Traffic shift is %v at %v%% canary weight", status.Phase, awssdk.Int64Value(status.CanaryWeight))
+		if status.Message != "" {
+			message = fmt.Sprintf("%v: %v", message, status.Message)
+		}
+		m.eventRecorder.Event(ts, eventType, k8s.TrafficShiftEventReasonStepUpdated, message)
+	}
+	m.logger.Info("updated trafficShift status", "trafficShift", k8s.NamespacedName(ts),
+		"phase", status.Phase, "step", status.CurrentStep, "canaryWeight", awssdk.Int64Value(status.CanaryWeight))
+	return nil
+}
+
+// isTerminalPhase returns whether the TrafficShift has finished in specified phase.
+func isTerminalPhase(phase elbv2api.TrafficShiftPhase) bool {
+	return phase == elbv2api.TrafficShiftPhaseCompleted || phase == elbv2api.TrafficShiftPhaseRolledBack
+}
@@ -0,0 +1,338 @@
+package trafficshift
+
+import (
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+)
+
+func Test_defaultResourceManager_computeStatus(t *testing.T) {
+	now := metav1.NewTime(time.Date(2021, 1, 1, 0, 30, 0, 0, time.UTC))
+	fiveMinutesAgo := metav1.NewTime(now.Add(-5 * time.Minute))
+	tenMinutesAgo := metav1.NewTime(now.Add(-10 * time.Minute))
+	rollback := elbv2api.TrafficShiftFailurePolicyRollback
+	steps := []elbv2api.TrafficShiftStep{{Weight: 5}, {Weight: 25}, {Weight: 50}, {Weight: 100}}
+	type args struct {
+		spec           elbv2api.TrafficShiftSpec
+		generation     int64
+		status         elbv2api.TrafficShiftStatus
+		healthyPercent *int64
+	}
+	tests := []struct {
+		name             string
+		args             args
+		wantStatus       elbv2api.TrafficShiftStatus
+		wantRequeueAfter time.Duration
+	}{
+		{
+			name: "new trafficShift starts from first step",
+			args: args{
+				spec:       elbv2api.TrafficShiftSpec{Steps: steps},
+				generation: 1,
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				ObservedGeneration: awssdk.Int64(1),
+				Phase:              elbv2api.TrafficShiftPhaseProgressing,
+				CurrentStep:        0,
+				CanaryWeight:       awssdk.Int64(5),
+				LastStepTime:       &now,
+			},
+			wantRequeueAfter: 10 * time.Minute,
+		},
+		{
+			name: "stays at current step within interval",
+			args: args{
+				spec:       elbv2api.TrafficShiftSpec{Steps: steps},
+				generation: 1,
+				status: elbv2api.TrafficShiftStatus{
+					ObservedGeneration: awssdk.Int64(1),
+					Phase:              elbv2api.TrafficShiftPhaseProgressing,
+					CurrentStep:        1,
+					CanaryWeight:       awssdk.Int64(25),
+					LastStepTime:       &fiveMinutesAgo,
+				},
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				ObservedGeneration: awssdk.Int64(1),
+				Phase:              elbv2api.TrafficShiftPhaseProgressing,
+				CurrentStep:        1,
+				CanaryWeight:       awssdk.Int64(25),
+				LastStepTime:       &fiveMinutesAgo,
+			},
+			wantRequeueAfter: 5 * time.Minute,
+		},
+		{
+			name: "progresses to next step after interval",
+			args: args{
+				spec: elbv2api.TrafficShiftSpec{
+					Steps:    steps,
+					Interval: &metav1.Duration{Duration: 5 * time.Minute},
+				},
+				generation: 1,
+				status: elbv2api.TrafficShiftStatus{
+					ObservedGeneration: awssdk.Int64(1),
+					Phase:              elbv2api.TrafficShiftPhaseProgressing,
+					CurrentStep:        1,
+					CanaryWeight:       awssdk.Int64(25),
+					LastStepTime:       &fiveMinutesAgo,
+				},
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				ObservedGeneration: awssdk.Int64(1),
+				Phase:              elbv2api.TrafficShiftPhaseProgressing,
+				CurrentStep:        2,
+				CanaryWeight:       awssdk.Int64(50),
+				LastStepTime:       &now,
+			},
+			wantRequeueAfter: 5 * time.Minute,
+		},
+		{
+			name: "completes after last step",
+			args: args{
+				spec:       elbv2api.TrafficShiftSpec{Steps: steps},
+				generation: 1,
+				status: elbv2api.TrafficShiftStatus{
+					ObservedGeneration: awssdk.Int64(1),
+					Phase:              elbv2api.TrafficShiftPhaseProgressing,
+					CurrentStep:        3,
+					CanaryWeight:       awssdk.Int64(100),
+					LastStepTime:       &tenMinutesAgo,
+				},
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				ObservedGeneration: awssdk.Int64(1),
+				Phase:              elbv2api.TrafficShiftPhaseCompleted,
+				CurrentStep:        3,
+				CanaryWeight:       awssdk.Int64(100),
+				LastStepTime:       &tenMinutesAgo,
+			},
+			wantRequeueAfter: 0,
+		},
+		{
+			name: "paused by spec",
+			args: args{
+				spec:       elbv2api.TrafficShiftSpec{Steps: steps, Paused: true},
+				generation: 2,
+				status: elbv2api.TrafficShiftStatus{
+					ObservedGeneration: awssdk.Int64(1),
+					Phase:              elbv2api.TrafficShiftPhaseProgressing,
+					CurrentStep:        1,
+					CanaryWeight:       awssdk.Int64(25),
+					LastStepTime:       &tenMinutesAgo,
+				},
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				ObservedGeneration: awssdk.Int64(2),
+				Phase:              elbv2api.TrafficShiftPhasePaused,
+				CurrentStep:        1,
+				CanaryWeight:       awssdk.Int64(25),
+				LastStepTime:       &tenMinutesAgo,
+				Message:            "paused by spec",
+			},
+			wantRequeueAfter: 0,
+		},
+		{
+			name: "resumed stays at current step for another interval",
+			args: args{
+				spec:       elbv2api.TrafficShiftSpec{Steps: steps},
+				generation: 3,
+				status: elbv2api.TrafficShiftStatus{
+					ObservedGeneration: awssdk.Int64(2),
+					Phase:              elbv2api.TrafficShiftPhasePaused,
+					CurrentStep:        1,
+					CanaryWeight:       awssdk.Int64(25),
+					LastStepTime:       &tenMinutesAgo,
+					Message:            "paused by spec",
+				},
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				ObservedGeneration: awssdk.Int64(3),
+				Phase:              elbv2api.TrafficShiftPhaseProgressing,
+				CurrentStep:        1,
+				CanaryWeight:       awssdk.Int64(25),
+				LastStepTime:       &now,
+			},
+			wantRequeueAfter: 10 * time.Minute,
+		},
+		{
+			name: "paused when canary is unhealthy",
+			args: args{
+				spec: elbv2api.TrafficShiftSpec{
+					Steps:       steps,
+					HealthCheck: &elbv2api.TrafficShiftHealthCheck{MinHealthyPercent: 80},
+				},
+				generation: 1,
+				status: elbv2api.TrafficShiftStatus{
+					ObservedGeneration: awssdk.Int64(1),
+					Phase:              elbv2api.TrafficShiftPhaseProgressing,
+					CurrentStep:        1,
+					CanaryWeight:       awssdk.Int64(25),
+					LastStepTime:       &fiveMinutesAgo,
+				},
+				healthyPercent: awssdk.Int64(50),
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				ObservedGeneration: awssdk.Int64(1),
+				Phase:              elbv2api.TrafficShiftPhasePaused,
+				CurrentStep:        1,
+				CanaryWeight:       awssdk.Int64(25),
+				LastStepTime:       &fiveMinutesAgo,
+				Message:            "canary targets are 50% healthy, below the minimum of 80%",
+			},
+			wantRequeueAfter: 1 * time.Minute,
+		},
+		{
+			name: "rolled back when canary is unhealthy",
+			args: args{
+				spec: elbv2api.TrafficShiftSpec{
+					Steps:       steps,
+					HealthCheck: &elbv2api.TrafficShiftHealthCheck{MinHealthyPercent: 80, FailurePolicy: &rollback},
+				},
+				generation: 1,
+				status: elbv2api.TrafficShiftStatus{
+					ObservedGeneration: awssdk.Int64(1),
+					Phase:              elbv2api.TrafficShiftPhaseProgressing,
+					CurrentStep:        1,
+					CanaryWeight:       awssdk.Int64(25),
+					LastStepTime:       &fiveMinutesAgo,
+				},
+				healthyPercent: awssdk.Int64(50),
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				ObservedGeneration: awssdk.Int64(1),
+				Phase:              elbv2api.TrafficShiftPhaseRolledBack,
+				CurrentStep:        1,
+				CanaryWeight:       awssdk.Int64(0),
+				LastStepTime:       &fiveMinutesAgo,
+				Message:            "canary targets are 50% healthy, below the minimum of 80%",
+			},
+			wantRequeueAfter: 0,
+		},
+		{
+			name: "health checked more often than interval",
+			args: args{
+				spec: elbv2api.TrafficShiftSpec{
+					Steps:       steps,
+					HealthCheck: &elbv2api.TrafficShiftHealthCheck{MinHealthyPercent: 80},
+				},
+				generation: 1,
+				status: elbv2api.TrafficShiftStatus{
+					ObservedGeneration: awssdk.Int64(1),
+					Phase:              elbv2api.TrafficShiftPhaseProgressing,
+					CurrentStep:        1,
+					CanaryWeight:       awssdk.Int64(25),
+					LastStepTime:       &fiveMinutesAgo,
+				},
+				healthyPercent: awssdk.Int64(100),
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				ObservedGeneration: awssdk.Int64(1),
+				Phase:              elbv2api.TrafficShiftPhaseProgressing,
+				CurrentStep:        1,
+				CanaryWeight:       awssdk.Int64(25),
+				LastStepTime:       &fiveMinutesAgo,
+			},
+			wantRequeueAfter: 1 * time.Minute,
+		},
+		{
+			name: "holds current step while canary health is unknown",
+			args: args{
+				spec: elbv2api.TrafficShiftSpec{
+					Steps:       steps,
+					HealthCheck: &elbv2api.TrafficShiftHealthCheck{MinHealthyPercent: 80},
+				},
+				generation: 1,
+				status: elbv2api.TrafficShiftStatus{
+					ObservedGeneration: awssdk.Int64(1),
+					Phase:              elbv2api.TrafficShiftPhaseProgressing,
+					CurrentStep:        1,
+					CanaryWeight:       awssdk.Int64(25),
+					LastStepTime:       &tenMinutesAgo,
+				},
+				healthyPercent: nil,
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				ObservedGeneration: awssdk.Int64(1),
+				Phase:              elbv2api.TrafficShiftPhaseProgressing,
+				CurrentStep:        1,
+				CanaryWeight:       awssdk.Int64(25),
+				LastStepTime:       &tenMinutesAgo,
+				Message:            "canary targets health is unknown, holding at current step",
+			},
+			wantRequeueAfter: 1 * time.Minute,
+		},
+		{
+			name: "progresses to next step once canary health is known",
+			args: args{
+				spec: elbv2api.TrafficShiftSpec{
+					Steps:       steps,
+					HealthCheck: &elbv2api.TrafficShiftHealthCheck{MinHealthyPercent: 80},
+				},
+				generation: 1,
+				status: elbv2api.TrafficShiftStatus{
+					ObservedGeneration: awssdk.Int64(1),
+					Phase:              elbv2api.TrafficShiftPhaseProgressing,
+					CurrentStep:        1,
+					CanaryWeight:       awssdk.Int64(25),
+					LastStepTime:       &tenMinutesAgo,
+					Message:            "canary targets health is unknown, holding at current step",
+				},
+				healthyPercent: awssdk.Int64(100),
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				ObservedGeneration: awssdk.Int64(1),
+				Phase:              elbv2api.TrafficShiftPhaseProgressing,
+				CurrentStep:        2,
+				CanaryWeight:       awssdk.Int64(50),
+				LastStepTime:       &now,
+			},
+			wantRequeueAfter: 1 * time.Minute,
+		},
+		{
+			name: "rolled back trafficShift restarts once spec changes",
+			args: args{
+				spec:       elbv2api.TrafficShiftSpec{Steps: steps},
+				generation: 2,
+				status: elbv2api.TrafficShiftStatus{
+					ObservedGeneration: awssdk.Int64(1),
+					Phase:              elbv2api.TrafficShiftPhaseRolledBack,
+					CurrentStep:        1,
+					CanaryWeight:       awssdk.Int64(0),
+					LastStepTime:       &fiveMinutesAgo,
+					Message:            "canary targets are 50% healthy, below the minimum of 80%",
+				},
+			},
+			wantStatus: elbv2api.TrafficShiftStatus{
+				ObservedGeneration: awssdk.Int64(2),
+				Phase:              elbv2api.TrafficShiftPhaseProgressing,
+				CurrentStep:        0,
+				CanaryWeight:       awssdk.Int64(5),
+				LastStepTime:       &now,
+			},
+			wantRequeueAfter: 10 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &defaultResourceManager{
+				healthCheckInterval: defaultHealthCheckInterval,
+			}
+			ts := &elbv2api.TrafficShift{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "awesome-ns",
+					Name:       "ts-1",
+					Generation: tt.args.generation,
+				},
+				Spec:   tt.args.spec,
+				Status: tt.args.status,
+			}
+			gotStatus, gotRequeueAfter := m.computeStatus(ts, now, tt.args.healthyPercent)
+			assert.Equal(t, tt.wantStatus, gotStatus)
+			assert.Equal(t, tt.wantRequeueAfter, gotRequeueAfter)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -23,10 +24,18 @@ const (
 
 // NewIngressValidator returns a validator for Ingress API.
 func NewIngressValidator(client client.Client, ingConfig config.IngressConfig, logger logr.Logger) *ingressValidator {
+	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
+	classAnnotationMatcher := ingress.NewDefaultClassAnnotationMatcher(ingConfig.IngressClass)
+	classLoader := ingress.NewDefaultClassLoader(client)
+	manageIngressesWithoutIngressClass := ingConfig.IngressClass == ""
+	// groupLoader is only used to load groupIDs, which don't record events.
+	groupLoader := ingress.NewDefaultGroupLoader(client, nil, annotationParser, classLoader, classAnnotationMatcher, manageIngressesWithoutIngressClass)
 	return &ingressValidator{
-		annotationParser:              annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress),
-		classAnnotationMatcher:        ingress.NewDefaultClassAnnotationMatcher(ingConfig.IngressClass),
-		classLoader:                   ingress.NewDefaultClassLoader(client),
+		client:                        client,
+		annotationParser:              annotationParser,
+		classAnnotationMatcher:        classAnnotationMatcher,
+		classLoader:                   classLoader,
+		groupLoader:                   groupLoader,
 		disableIngressClassAnnotation: ingConfig.DisableIngressClassAnnotation,
 		disableIngressGroupAnnotation: ingConfig.DisableIngressGroupNameAnnotation,
 		logger:                        logger,
@@ -36,9 +45,11 @@ func NewIngressValidator(client client.Client, ingConfig config.IngressConfig, l
 var _ webhook.Validator = &ingressValidator{}
 
 type ingressValidator struct {
+	client                        client.Client
 	annotationParser              annotations.Parser
 	classAnnotationMatcher        ingress.ClassAnnotationMatcher
 	classLoader                   ingress.ClassLoader
+	groupLoader                   ingress.GroupLoader
 	disableIngressClassAnnotation bool
 	disableIngressGroupAnnotation bool
 	logger                        logr.Logger
@@ -59,6 +70,9 @@ func (v *ingressValidator) ValidateCreate(ctx context.Context, obj runtime.Objec
 	if err := v.checkIngressClassUsage(ctx, ing, nil); err != nil {
 		return err
 	}
+	if err := v.checkRulePriorityCollisions(ctx, ing); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -74,6 +88,9 @@ func (v *ingressValidator) ValidateUpdate(ctx context.Context, obj runtime.Objec
 	if err := v.checkIngressClassUsage(ctx, ing, oldIng); err != nil {
 		return err
 	}
+	if err := v.checkRulePriorityCollisions(ctx, ing); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -164,6 +181,45 @@ func (v *ingressValidator) checkIngressClassUsage(ctx context.Context, ing *netw
 	return nil
 }
 
+// checkRulePriorityCollisions checks the usage of "rule-priorities" annotation.
+// explicit listener rule priorities of Ingress must be valid, and must not collide with other Ingresses within its IngressGroup.
+func (v *ingressValidator) checkRulePriorityCollisions(ctx context.Context, ing *networking.Ingress) error {
+	rulePriorities, err := ingress.ParseRulePriorities(v.annotationParser, ing)
+	if err != nil {
+		return err
+	}
+	if len(rulePriorities) == 0 {
+		return nil
+	}
+	groupID, err := v.groupLoader.LoadGroupIDIfAny(ctx, ing)
+	if err != nil {
+		return err
+	}
+	if groupID == nil {
+		return nil
+	}
+
+	groupMembers := []*networking.Ingress{ing}
+	if groupID.IsExplicit() {
+		ingList := &networking.IngressList{}
+		if err := v.client.List(ctx, ingList); err != nil {
+			return err
+		}
+		for index := range ingList.Items {
+			member := &ingList.Items[index]
+			if k8s.NamespacedName(member) == k8s.NamespacedName(ing) {
+				continue
+			}
+			memberGroupID, err := v.groupLoader.LoadGroupIDIfAny(ctx, member)
+			if err != nil || memberGroupID == nil || *memberGroupID != *groupID {
+				continue
+			}
+			groupMembers = append(groupMembers, member)
+		}
+	}
+	return ingress.ValidateRulePriorities(v.annotationParser, groupMembers)
+}
+
 // +kubebuilder:webhook:path=/validate-networking-v1-ingress,mutating=false,failurePolicy=fail,groups=networking.k8s.io,resources=ingresses,verbs=create;update,versions=v1,name=vingress.elbv2.k8s.aws,sideEffects=None,matchPolicy=Equivalent,webhookVersions=v1,admissionReviewVersions=v1beta1
 
 func (v *ingressValidator) SetupWithManager(mgr ctrl.Manager) {
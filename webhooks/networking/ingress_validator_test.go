@@ -785,3 +785,116 @@ func Test_ingressValidator_checkIngressClassUsage(t *testing.T) {
 		})
 	}
 }
+
+func Test_ingressValidator_checkRulePriorityCollisions(t *testing.T) {
+	newIngress := func(name string, groupName string, rulePriorities string) *networking.Ingress {
+		ing := &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      name,
+				Annotations: map[string]string{
+					"kubernetes.io/ingress.class": "alb",
+				},
+			},
+		}
+		if groupName != "" {
+			ing.Annotations["alb.ingress.kubernetes.io/group.name"] = groupName
+		}
+		if rulePriorities != "" {
+			ing.Annotations["alb.ingress.kubernetes.io/rule-priorities"] = rulePriorities
+		}
+		return ing
+	}
+	type env struct {
+		ingList []*networking.Ingress
+	}
+	tests := []struct {
+		name    string
+		env     env
+		ing     *networking.Ingress
+		wantErr error
+	}{
+		{
+			name: "ingress without explicit priorities",
+			env: env{
+				ingList: []*networking.Ingress{newIngress("ing-2", "awesome-group", `{"/healthz": 1}`)},
+			},
+			ing:     newIngress("ing-1", "awesome-group", ""),
+			wantErr: nil,
+		},
+		{
+			name:    "ingress with invalid explicit priorities",
+			env:     env{},
+			ing:     newIngress("ing-1", "awesome-group", `{"/healthz": 0}`),
+			wantErr: errors.New("explicit listener rule priority must be within [1:50000], key: /healthz, priority: 0"),
+		},
+		{
+			name: "ingress with explicit priorities that doesn't collide within group",
+			env: env{
+				ingList: []*networking.Ingress{
+					newIngress("ing-2", "awesome-group", `{"/healthz": 2}`),
+					newIngress("ing-3", "other-group", `{"/healthz": 1}`),
+				},
+			},
+			ing:     newIngress("ing-1", "awesome-group", `{"/healthz": 1}`),
+			wantErr: nil,
+		},
+		{
+			name: "ingress with explicit priorities that collides within group",
+			env: env{
+				ingList: []*networking.Ingress{
+					newIngress("ing-2", "awesome-group", `{"/healthz": 1}`),
+				},
+			},
+			ing:     newIngress("ing-1", "awesome-group", `{"/status": 1}`),
+			wantErr: errors.New("conflicting listener rule priority 1 on port 80 between Ingresses: [awesome-ns/ing-1 awesome-ns/ing-2]"),
+		},
+		{
+			name: "ingress update with explicit priorities that previously collided with itself",
+			env: env{
+				ingList: []*networking.Ingress{
+					newIngress("ing-1", "awesome-group", `{"/healthz": 1}`),
+				},
+			},
+			ing:     newIngress("ing-1", "awesome-group", `{"/healthz": 1}`),
+			wantErr: nil,
+		},
+		{
+			name: "ingress in implicit group",
+			env: env{
+				ingList: []*networking.Ingress{
+					newIngress("ing-2", "", `{"/healthz": 1}`),
+				},
+			},
+			ing:     newIngress("ing-1", "", `{"/healthz": 1}`),
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			for _, ing := range tt.env.ingList {
+				assert.NoError(t, k8sClient.Create(ctx, ing.DeepCopy()))
+			}
+
+			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
+			classAnnotationMatcher := ingress.NewDefaultClassAnnotationMatcher("alb")
+			classLoader := ingress.NewDefaultClassLoader(k8sClient)
+			v := &ingressValidator{
+				client:           k8sClient,
+				annotationParser: annotationParser,
+				groupLoader:      ingress.NewDefaultGroupLoader(k8sClient, nil, annotationParser, classLoader, classAnnotationMatcher, false),
+			}
+			err := v.checkRulePriorityCollisions(ctx, tt.ing)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}